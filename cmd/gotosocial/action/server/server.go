@@ -88,6 +88,10 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error creating instance instance: %s", err)
 	}
 
+	if err := dbService.CreateVAPIDKeyPair(ctx); err != nil {
+		return fmt.Errorf("error creating vapid key pair: %s", err)
+	}
+
 	// Open the storage backend
 	storage, err := gtsstorage.AutoConfig()
 	if err != nil {
@@ -70,3 +70,11 @@ const (
 	ObjectCollectionPage    = "CollectionPage"    // ActivityStreamsCollectionPage https://www.w3.org/TR/activitystreams-vocabulary/#dfn-collectionpage
 	ObjectOrderedCollection = "OrderedCollection" // ActivityStreamsOrderedCollection https://www.w3.org/TR/activitystreams-vocabulary/#dfn-orderedcollection
 )
+
+// Properties that aren't part of the activitystreams vocabulary
+// supported by our activitypub library, but which are widely used
+// by other fediverse software, so we get and set them manually.
+const (
+	PropertyAlsoKnownAs = "alsoKnownAs" // https://www.w3.org/TR/did-core/#also-known-as
+	PropertyMovedTo     = "movedTo"     // https://docs.joinmastodon.org/spec/activitypub/#as
+)
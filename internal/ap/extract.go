@@ -32,6 +32,7 @@ import (
 	"time"
 
 	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -168,6 +169,16 @@ func ExtractPublished(i WithPublished) (time.Time, error) {
 	return t, nil
 }
 
+// ExtractUpdated extracts the updated time from the given
+// WithUpdated, returning a zero time if it's not set.
+func ExtractUpdated(i WithUpdated) time.Time {
+	updatedProp := i.GetActivityStreamsUpdated()
+	if updatedProp == nil || !updatedProp.IsXMLSchemaDateTime() {
+		return time.Time{}
+	}
+	return updatedProp.Get()
+}
+
 // ExtractIconURL extracts a URL to a supported image file from something like:
 //
 //	"icon": {
@@ -736,3 +747,162 @@ func ExtractSharedInbox(withEndpoints WithEndpoints) *url.URL {
 
 	return nil
 }
+
+// ExtractAlsoKnownAs extracts the URIs of the accounts that an Actor
+// is also known as, from its alsoKnownAs property. Returns an empty
+// slice if the property isn't set or contains no valid URIs.
+func ExtractAlsoKnownAs(i WithUnknownProperties) []*url.URL {
+	return extractUnknownIRIs(i.GetUnknownProperties()[PropertyAlsoKnownAs])
+}
+
+// ExtractMovedTo extracts the URI of the account that an Actor
+// has moved to, from its movedTo property. Returns nil if this
+// property is not set.
+func ExtractMovedTo(i WithUnknownProperties) *url.URL {
+	iris := extractUnknownIRIs(i.GetUnknownProperties()[PropertyMovedTo])
+	if len(iris) == 0 {
+		return nil
+	}
+	return iris[0]
+}
+
+// extractUnknownIRIs parses absolute IRIs out of the given
+// deserialized json value of a property that's unknown to
+// our activitypub library. The value can be an IRI string,
+// an object with an id, or an array of either.
+func extractUnknownIRIs(v interface{}) []*url.URL {
+	var iris []*url.URL
+
+	switch v := v.(type) {
+	case string:
+		if iri, err := url.Parse(v); err == nil && iri.IsAbs() {
+			iris = append(iris, iri)
+		}
+	case map[string]interface{}:
+		iris = append(iris, extractUnknownIRIs(v["id"])...)
+	case []interface{}:
+		for _, item := range v {
+			iris = append(iris, extractUnknownIRIs(item)...)
+		}
+	}
+
+	return iris
+}
+
+// ExtractPoll extracts a placeholder gtsmodel Poll from a Pollable, with
+// options, vote counts, expiry and closed time set where available.
+//
+// The returned poll will not have an ID or StatusID set; it's up to the
+// caller to do this.
+func ExtractPoll(pollable Pollable) (*gtsmodel.Poll, error) {
+	// Polls are either single choice (oneOf)
+	// or multiple choice (anyOf), never both.
+	var (
+		oneOf    []vocab.Type
+		anyOf    []vocab.Type
+		multiple bool
+	)
+
+	if oneOfProp := pollable.GetActivityStreamsOneOf(); oneOfProp != nil {
+		for iter := oneOfProp.Begin(); iter != oneOfProp.End(); iter = iter.Next() {
+			oneOf = append(oneOf, iter.GetType())
+		}
+	}
+
+	if anyOfProp := pollable.GetActivityStreamsAnyOf(); anyOfProp != nil {
+		for iter := anyOfProp.Begin(); iter != anyOfProp.End(); iter = iter.Next() {
+			anyOf = append(anyOf, iter.GetType())
+		}
+	}
+
+	options, votes := extractPollOptions(oneOf)
+	if len(options) == 0 {
+		multiple = true
+		options, votes = extractPollOptions(anyOf)
+	}
+
+	if len(options) == 0 {
+		return nil, errors.New("ExtractPoll: no poll options found")
+	}
+
+	poll := &gtsmodel.Poll{
+		Multiple:   &multiple,
+		HideCounts: new(bool),
+		Options:    options,
+		Votes:      votes,
+		Voters:     new(int),
+	}
+
+	if endTimeProp := pollable.GetActivityStreamsEndTime(); endTimeProp != nil && endTimeProp.IsXMLSchemaDateTime() {
+		poll.ExpiresAt = endTimeProp.Get()
+	}
+
+	if closedProp := pollable.GetActivityStreamsClosed(); closedProp != nil {
+		for iter := closedProp.Begin(); iter != closedProp.End(); iter = iter.Next() {
+			switch {
+			case iter.IsXMLSchemaDateTime():
+				poll.ClosedAt = iter.GetXMLSchemaDateTime()
+			case iter.IsXMLSchemaBoolean() && iter.GetXMLSchemaBoolean():
+				// Closed without a time given, so
+				// take the end time as closed time.
+				poll.ClosedAt = poll.ExpiresAt
+				if poll.ClosedAt.IsZero() {
+					poll.ClosedAt = time.Now()
+				}
+			}
+		}
+	}
+
+	if votersProp := pollable.GetTootVotersCount(); votersProp != nil && votersProp.IsXMLSchemaNonNegativeInteger() {
+		*poll.Voters = votersProp.Get()
+	} else if !multiple {
+		// For single choice polls the number
+		// of voters is the total of all votes.
+		for _, count := range votes {
+			*poll.Voters += count
+		}
+	}
+
+	return poll, nil
+}
+
+// extractPollOptions extracts the names and vote
+// counts of the given poll options, in order.
+func extractPollOptions(types []vocab.Type) ([]string, []int) {
+	options := make([]string, 0, len(types))
+	votes := make([]int, 0, len(types))
+
+	for _, t := range types {
+		optionable, ok := t.(PollOptionable)
+		if !ok {
+			continue
+		}
+
+		name := ExtractName(optionable)
+		if name == "" {
+			continue
+		}
+
+		options = append(options, name)
+		votes = append(votes, extractPollOptionVotes(optionable))
+	}
+
+	return options, votes
+}
+
+// extractPollOptionVotes returns the vote count of
+// a poll option, taken from the totalItems of its
+// replies collection, or 0 if this isn't available.
+func extractPollOptionVotes(i PollOptionable) int {
+	repliesProp := i.GetActivityStreamsReplies()
+	if repliesProp == nil || !repliesProp.IsActivityStreamsCollection() {
+		return 0
+	}
+
+	totalItemsProp := repliesProp.GetActivityStreamsCollection().GetActivityStreamsTotalItems()
+	if totalItemsProp == nil || !totalItemsProp.IsXMLSchemaNonNegativeInteger() {
+		return 0
+	}
+
+	return totalItemsProp.Get()
+}
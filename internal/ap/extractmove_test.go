@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type ExtractMoveTestSuite struct {
+	ExtractTestSuite
+}
+
+func (suite *ExtractMoveTestSuite) personFromJSON(personJSON string) vocab.ActivityStreamsPerson {
+	var jsonAsMap map[string]interface{}
+	if err := json.Unmarshal([]byte(personJSON), &jsonAsMap); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(context.Background(), jsonAsMap)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return t.(vocab.ActivityStreamsPerson)
+}
+
+func (suite *ExtractMoveTestSuite) TestExtractAlsoKnownAsAndMovedTo() {
+	person := suite.personFromJSON(`
+{
+	"@context": "https://www.w3.org/ns/activitystreams",
+	"id": "https://example.org/users/someone",
+	"type": "Person",
+	"preferredUsername": "someone",
+	"alsoKnownAs": [
+		"https://another.instance/users/someone_else",
+		{"id": "https://third.instance/users/someone"},
+		"not a uri"
+	],
+	"movedTo": "https://another.instance/users/someone_else"
+}`)
+
+	alsoKnownAs := ap.ExtractAlsoKnownAs(person)
+	suite.Len(alsoKnownAs, 2)
+	suite.Equal("https://another.instance/users/someone_else", alsoKnownAs[0].String())
+	suite.Equal("https://third.instance/users/someone", alsoKnownAs[1].String())
+
+	movedTo := ap.ExtractMovedTo(person)
+	suite.NotNil(movedTo)
+	suite.Equal("https://another.instance/users/someone_else", movedTo.String())
+}
+
+func (suite *ExtractMoveTestSuite) TestExtractAlsoKnownAsAndMovedToNotSet() {
+	person := suite.personFromJSON(`
+{
+	"@context": "https://www.w3.org/ns/activitystreams",
+	"id": "https://example.org/users/someone",
+	"type": "Person",
+	"preferredUsername": "someone"
+}`)
+
+	suite.Empty(ap.ExtractAlsoKnownAs(person))
+	suite.Nil(ap.ExtractMovedTo(person))
+}
+
+func TestExtractMoveTestSuite(t *testing.T) {
+	suite.Run(t, &ExtractMoveTestSuite{})
+}
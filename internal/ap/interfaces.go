@@ -41,11 +41,14 @@ type Accountable interface {
 	WithManuallyApprovesFollowers
 	WithEndpoints
 	WithTag
+	WithUnknownProperties
 }
 
 // Statusable represents the minimum activitypub interface for representing a 'status'.
-// This interface is fulfilled by: Article, Document, Image, Video, Note, Page, Event, Place, Mention, Profile
+// This interface is fulfilled by: Article, Document, Image, Video, Note, Page, Event, Place, Mention, Profile, Question
 type Statusable interface {
+	vocab.Type
+
 	WithJSONLDId
 	WithTypeName
 
@@ -53,6 +56,7 @@ type Statusable interface {
 	WithName
 	WithInReplyTo
 	WithPublished
+	WithUpdated
 	WithURL
 	WithAttributedTo
 	WithTo
@@ -65,6 +69,26 @@ type Statusable interface {
 	WithReplies
 }
 
+// Pollable represents the minimum activitypub interface for representing a 'poll' (a status with options that can be voted on).
+// This interface is fulfilled by: Question
+type Pollable interface {
+	Statusable
+
+	WithOneOf
+	WithAnyOf
+	WithEndTime
+	WithClosed
+	WithVotersCount
+}
+
+// PollOptionable represents the minimum activitypub interface for representing a single option of a 'poll', or a vote on a 'poll'.
+// This interface is fulfilled by: Note
+type PollOptionable interface {
+	WithTypeName
+	WithName
+	WithReplies
+}
+
 // Attachmentable represents the minimum activitypub interface for representing a 'mediaAttachment'.
 // This interface is fulfilled by: Audio, Document, Image, Video
 type Attachmentable interface {
@@ -296,6 +320,31 @@ type WithReplies interface {
 	GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty
 }
 
+// WithOneOf represents an activity with ActivityStreamsOneOfProperty
+type WithOneOf interface {
+	GetActivityStreamsOneOf() vocab.ActivityStreamsOneOfProperty
+}
+
+// WithAnyOf represents an activity with ActivityStreamsAnyOfProperty
+type WithAnyOf interface {
+	GetActivityStreamsAnyOf() vocab.ActivityStreamsAnyOfProperty
+}
+
+// WithEndTime represents an activity with ActivityStreamsEndTimeProperty
+type WithEndTime interface {
+	GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty
+}
+
+// WithClosed represents an activity with ActivityStreamsClosedProperty
+type WithClosed interface {
+	GetActivityStreamsClosed() vocab.ActivityStreamsClosedProperty
+}
+
+// WithVotersCount represents an activity with TootVotersCountProperty
+type WithVotersCount interface {
+	GetTootVotersCount() vocab.TootVotersCountProperty
+}
+
 // WithMediaType represents an activity with ActivityStreamsMediaTypeProperty
 type WithMediaType interface {
 	GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty
@@ -354,3 +403,8 @@ type WithManuallyApprovesFollowers interface {
 type WithEndpoints interface {
 	GetActivityStreamsEndpoints() vocab.ActivityStreamsEndpointsProperty
 }
+
+// WithUnknownProperties represents a type with properties that weren't recognized by the activitypub library
+type WithUnknownProperties interface {
+	GetUnknownProperties() map[string]interface{}
+}
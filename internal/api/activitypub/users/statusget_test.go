@@ -25,12 +25,15 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/api/activitypub/users"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -156,6 +159,92 @@ func (suite *StatusGetTestSuite) TestGetStatusLowercase() {
 	suite.EqualValues(targetStatus.Content, a.Content)
 }
 
+func (suite *StatusGetTestSuite) getStatusUnsigned(targetAccount *gtsmodel.Account, targetStatus *gtsmodel.Status) *httptest.ResponseRecorder {
+	// setup request without any signature
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, targetStatus.URI, nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/activity+json")
+
+	suite.signatureCheck(ctx)
+
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   users.UsernameKey,
+			Value: targetAccount.Username,
+		},
+		gin.Param{
+			Key:   users.StatusIDKey,
+			Value: targetStatus.ID,
+		},
+	}
+
+	suite.userModule.StatusGETHandler(ctx)
+	return recorder
+}
+
+func (suite *StatusGetTestSuite) TestGetStatusUnsigned() {
+	// authorized fetch is on by default, so this should be refused
+	recorder := suite.getStatusUnsigned(suite.testAccounts["local_account_1"], suite.testStatuses["local_account_1_status_1"])
+	suite.EqualValues(http.StatusUnauthorized, recorder.Code)
+}
+
+func (suite *StatusGetTestSuite) TestGetStatusUnsignedAuthorizedFetchOff() {
+	config.SetInstanceAuthorizedFetch(false)
+
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+	recorder := suite.getStatusUnsigned(suite.testAccounts["local_account_1"], targetStatus)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+	suite.Contains(recorder.Body.String(), targetStatus.URI)
+}
+
+func (suite *StatusGetTestSuite) TestGetFollowersOnlyStatusUnsignedAuthorizedFetchOff() {
+	config.SetInstanceAuthorizedFetch(false)
+
+	// followers-only status should still not be served to anonymous requesters
+	recorder := suite.getStatusUnsigned(suite.testAccounts["local_account_1"], suite.testStatuses["local_account_1_status_5"])
+	suite.EqualValues(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *StatusGetTestSuite) TestGetStatusSuspendedRequester() {
+	// suspend the account that signed the request
+	requestingAccount := &gtsmodel.Account{}
+	*requestingAccount = *suite.testAccounts["remote_account_1"]
+	requestingAccount.SuspendedAt = time.Now()
+	requestingAccount.SuspensionOrigin = "01F8MH17FWEB39HZJ76B6VXSKF"
+	if err := suite.db.UpdateAccount(context.Background(), requestingAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	derefRequests := testrig.NewTestDereferenceRequests(suite.testAccounts)
+	signedRequest := derefRequests["foss_satan_dereference_local_account_1_status_1"]
+	targetAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, targetStatus.URI, nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/activity+json")
+	ctx.Request.Header.Set("Signature", signedRequest.SignatureHeader)
+	ctx.Request.Header.Set("Date", signedRequest.DateHeader)
+
+	suite.signatureCheck(ctx)
+
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   users.UsernameKey,
+			Value: targetAccount.Username,
+		},
+		gin.Param{
+			Key:   users.StatusIDKey,
+			Value: targetStatus.ID,
+		},
+	}
+
+	suite.userModule.StatusGETHandler(ctx)
+	suite.EqualValues(http.StatusForbidden, recorder.Code)
+}
+
 func TestStatusGetTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusGetTestSuite))
 }
@@ -50,6 +50,8 @@ const (
 
 	// OauthTokenPath is the API path to use for granting token requests to users with valid credentials
 	OauthTokenPath = "/token" // #nosec G101 else we get a hardcoded credentials warning
+	// OauthRevokePath is the API path to use for revoking tokens which were granted to a client
+	OauthRevokePath = "/revoke"
 	// OauthAuthorizePath is the API path for authorization requests (eg., authorize this app to act on my behalf as a user)
 	OauthAuthorizePath = "/authorize"
 	// OauthFinalizePath is the API path for completing user registration with additional user details
@@ -102,6 +104,7 @@ func (m *Module) RouteAuth(attachHandler func(method string, path string, f ...g
 // RouteOauth routes all paths that should have an 'oauth' prefix
 func (m *Module) RouteOauth(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodPost, OauthTokenPath, m.TokenPOSTHandler)
+	attachHandler(http.MethodPost, OauthRevokePath, m.TokenRevokePOSTHandler)
 	attachHandler(http.MethodGet, OauthAuthorizePath, m.AuthorizeGETHandler)
 	attachHandler(http.MethodPost, OauthAuthorizePath, m.AuthorizePOSTHandler)
 	attachHandler(http.MethodPost, OauthFinalizePath, m.FinalizePOSTHandler)
@@ -290,7 +290,11 @@ func saveAuthFormToSession(s sessions.Session, form *apimodel.OAuthAuthorize) gt
 
 	// set default scope to read
 	if form.Scope == "" {
-		form.Scope = "read"
+		form.Scope = string(oauth.ScopeDefault)
+	}
+
+	if _, err := oauth.ParseScopes(form.Scope); err != nil {
+		return gtserror.NewErrorBadRequest(err, err.Error(), oauth.HelpfulAdvice)
 	}
 
 	// save these values from the form so we can use them elsewhere in the session
@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"net/http"
+
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+
+	"github.com/gin-gonic/gin"
+)
+
+type tokenRevokeRequestForm struct {
+	Token         *string `form:"token" json:"token" xml:"token"`
+	TokenTypeHint *string `form:"token_type_hint" json:"token_type_hint" xml:"token_type_hint"`
+	ClientID      *string `form:"client_id" json:"client_id" xml:"client_id"`
+	ClientSecret  *string `form:"client_secret" json:"client_secret" xml:"client_secret"`
+}
+
+// TokenRevokePOSTHandler should be served as a POST at https://example.org/oauth/revoke
+// The idea here is to let a client revoke an access token that was granted to it, as described in RFC 7009.
+func (m *Module) TokenRevokePOSTHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	help := []string{}
+
+	form := &tokenRevokeRequestForm{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.OAuthErrorHandler(c, gtserror.NewErrorBadRequest(oauth.InvalidRequest(), err.Error()))
+		return
+	}
+
+	// Clients may authenticate using
+	// either HTTP basic auth or the form.
+	clientID, clientSecret, ok := c.Request.BasicAuth()
+	if !ok {
+		if form.ClientID != nil {
+			clientID = *form.ClientID
+		} else {
+			help = append(help, "client_id was not set in the token revocation request form")
+		}
+
+		if form.ClientSecret != nil {
+			clientSecret = *form.ClientSecret
+		} else {
+			help = append(help, "client_secret was not set in the token revocation request form")
+		}
+	}
+
+	var token string
+	if form.Token != nil {
+		token = *form.Token
+	} else {
+		help = append(help, "token was not set in the token revocation request form")
+	}
+
+	// token_type_hint is optional, and we only have one type of
+	// token that can be revoked, so it's fine to just ignore it.
+
+	if len(help) != 0 {
+		apiutil.OAuthErrorHandler(c, gtserror.NewErrorBadRequest(oauth.InvalidRequest(), help...))
+		return
+	}
+
+	if errWithCode := m.processor.OAuthRevokeAccessToken(c.Request.Context(), clientID, clientSecret, token); errWithCode != nil {
+		apiutil.OAuthErrorHandler(c, errWithCode)
+		return
+	}
+
+	c.JSON(http.StatusOK, struct{}{})
+}
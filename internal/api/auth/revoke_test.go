@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RevokeTestSuite struct {
+	AuthStandardTestSuite
+}
+
+func (suite *RevokeTestSuite) revoke(form map[string]string, expectedHTTPStatus int, expectedBody string) {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", form)
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+
+	ctx, recorder := suite.newContext(http.MethodPost, "oauth/revoke", bodyBytes, w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+
+	suite.authModule.TokenRevokePOSTHandler(ctx)
+
+	suite.Equal(expectedHTTPStatus, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(expectedBody, string(b))
+}
+
+func (suite *RevokeTestSuite) TestRevokeToken() {
+	testClient := suite.testClients["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	suite.revoke(map[string]string{
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+		"token":         testToken.Access,
+	}, http.StatusOK, `{}`)
+
+	// the token should be gone now
+	_, err := suite.db.GetTokenByAccess(context.Background(), testToken.Access)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *RevokeTestSuite) TestRevokeUnknownToken() {
+	testClient := suite.testClients["local_account_1"]
+
+	suite.revoke(map[string]string{
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+		"token":         "NOTAREALTOKENNOTAREALTOKENNOTAREALTOKEN",
+	}, http.StatusOK, `{}`)
+}
+
+func (suite *RevokeTestSuite) TestRevokeTokenWrongSecret() {
+	testClient := suite.testClients["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	suite.revoke(map[string]string{
+		"client_id":     testClient.ID,
+		"client_secret": "not the secret",
+		"token":         testToken.Access,
+	}, http.StatusUnauthorized, `{"error":"invalid_client","error_description":"Unauthorized: client authentication failed"}`)
+
+	// the token should still be there
+	_, err := suite.db.GetTokenByAccess(context.Background(), testToken.Access)
+	suite.NoError(err)
+}
+
+func (suite *RevokeTestSuite) TestRevokeTokenOfOtherClient() {
+	testClient := suite.testClients["local_account_2"]
+	testToken := suite.testTokens["local_account_1"]
+
+	suite.revoke(map[string]string{
+		"client_id":     testClient.ID,
+		"client_secret": testClient.Secret,
+		"token":         testToken.Access,
+	}, http.StatusForbidden, `{"error":"unauthorized_client","error_description":"Forbidden: token was not issued to this client"}`)
+
+	// the token should still be there
+	_, err := suite.db.GetTokenByAccess(context.Background(), testToken.Access)
+	suite.NoError(err)
+}
+
+func (suite *RevokeTestSuite) TestRevokeEmptyForm() {
+	suite.revoke(map[string]string{}, http.StatusBadRequest, `{"error":"invalid_request","error_description":"Bad Request: client_id was not set in the token revocation request form: client_secret was not set in the token revocation request form: token was not set in the token revocation request form"}`)
+}
+
+func TestRevokeTestSuite(t *testing.T) {
+	suite.Run(t, &RevokeTestSuite{})
+}
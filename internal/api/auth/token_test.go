@@ -96,6 +96,59 @@ func (suite *TokenTestSuite) TestRetrieveClientCredentialsOK() {
 	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "access", Value: t.AccessToken}}, dbToken)
 	suite.NoError(err)
 	suite.NotNil(dbToken)
+
+	// no scope was requested, so the token
+	// should have the scopes of the app
+	suite.Equal("read write follow push", dbToken.Scope)
+}
+
+func (suite *TokenTestSuite) TestRetrieveClientCredentialsWriteOnlyApp() {
+	testClient := suite.testClients["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+
+	// register the app with write scope only
+	testApplication.Scopes = "write"
+	if err := suite.db.UpdateByID(context.Background(), testApplication, testApplication.ID, "scopes"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"grant_type":    "client_credentials",
+			"client_id":     testClient.ID,
+			"client_secret": testClient.Secret,
+			"redirect_uri":  "http://localhost:8080",
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+
+	ctx, recorder := suite.newContext(http.MethodPost, "oauth/token", bodyBytes, w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+
+	suite.authModule.TokenPOSTHandler(ctx)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	t := &apimodel.Token{}
+	err = json.Unmarshal(b, t)
+	suite.NoError(err)
+	suite.NotEmpty(t.AccessToken)
+
+	// the token should get the app's
+	// write scope, instead of read
+	dbToken := &gtsmodel.Token{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "access", Value: t.AccessToken}}, dbToken)
+	suite.NoError(err)
+	suite.Equal("write", dbToken.Scope)
 }
 
 func (suite *TokenTestSuite) TestRetrieveAuthorizationCodeOK() {
@@ -0,0 +1,189 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package accounts_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AccountMoveTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountMoveTestSuite) SetupTest() {
+	suite.AccountStandardTestSuite.SetupTest()
+
+	// moves and aliases modify the test accounts,
+	// so make sure every test starts with fresh ones
+	suite.testAccounts = testrig.NewTestAccounts()
+}
+
+func (suite *AccountMoveTestSuite) TestAccountAliasPOSTHandler() {
+	// set up the request
+	// we're aliasing zork to the_mighty_zork
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"also_known_as_uris[]": suite.testAccounts["local_account_2"].URI,
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, accounts.AliasPath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountAliasPOSTHandler(ctx)
+
+	// 1. we should have OK because our request was valid
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// 2. the aliases should be set on the returned account
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		panic(err)
+	}
+	apimodelAccount := &apimodel.Account{}
+	if err := json.Unmarshal(b, apimodelAccount); err != nil {
+		panic(err)
+	}
+	suite.Equal([]string{suite.testAccounts["local_account_2"].URI}, apimodelAccount.Source.AlsoKnownAsURIs)
+}
+
+func (suite *AccountMoveTestSuite) TestAccountAliasPOSTHandlerSelf() {
+	// set up the request
+	// we're aliasing zork to zork
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"also_known_as_uris[]": suite.testAccounts["local_account_1"].URI,
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, accounts.AliasPath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountAliasPOSTHandler(ctx)
+
+	// 1. we should have BadRequest because an account can't be an alias of itself
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandler() {
+	originAccount := suite.testAccounts["local_account_1"]
+
+	// the target account needs to list zork as an alias
+	targetAccount := suite.testAccounts["local_account_2"]
+	targetAccount.AlsoKnownAsURIs = []string{originAccount.URI}
+	if err := suite.db.UpdateAccount(context.Background(), targetAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// set up the request
+	// we're moving zork to the_mighty_zork
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"password":     "password",
+			"moved_to_uri": targetAccount.URI,
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, accounts.MovePath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountMovePOSTHandler(ctx)
+
+	// 1. we should have Accepted because our request was valid
+	suite.Equal(http.StatusAccepted, recorder.Code)
+
+	// 2. zork should now be marked as moved in the database
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), originAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(targetAccount.URI, dbAccount.MovedToURI)
+	suite.Equal(targetAccount.ID, dbAccount.MovedToAccountID)
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandlerNotAlias() {
+	// set up the request
+	// we're moving zork to the_mighty_zork, which doesn't list zork as an alias
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"password":     "password",
+			"moved_to_uri": suite.testAccounts["local_account_2"].URI,
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, accounts.MovePath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountMovePOSTHandler(ctx)
+
+	// 1. we should have UnprocessableEntity because the target doesn't list zork as an alias
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandlerWrongPassword() {
+	// set up the request
+	// we're moving zork to the_mighty_zork
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"password":     "aaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			"moved_to_uri": suite.testAccounts["local_account_2"].URI,
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, accounts.MovePath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountMovePOSTHandler(ctx)
+
+	// 1. we should have Forbidden because we supplied the wrong password
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func TestAccountMoveTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountMoveTestSuite))
+}
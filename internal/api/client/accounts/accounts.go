@@ -68,8 +68,16 @@ const (
 	BlockPath = BasePathWithID + "/block"
 	// UnblockPath is for removing a block of an account
 	UnblockPath = BasePathWithID + "/unblock"
+	// MutePath is for creating or updating a mute of an account
+	MutePath = BasePathWithID + "/mute"
+	// UnmutePath is for removing a mute of an account
+	UnmutePath = BasePathWithID + "/unmute"
 	// DeleteAccountPath is for deleting one's account via the API
 	DeleteAccountPath = BasePath + "/delete"
+	// AliasPath is for setting the aliases of one's account via the API
+	AliasPath = BasePath + "/alias"
+	// MovePath is for moving one's account to another account via the API
+	MovePath = BasePath + "/move"
 )
 
 type Module struct {
@@ -92,6 +100,10 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// delete account
 	attachHandler(http.MethodPost, DeleteAccountPath, m.AccountDeletePOSTHandler)
 
+	// set aliases of account, or move account
+	attachHandler(http.MethodPost, AliasPath, m.AccountAliasPOSTHandler)
+	attachHandler(http.MethodPost, MovePath, m.AccountMovePOSTHandler)
+
 	// verify account
 	attachHandler(http.MethodGet, VerifyPath, m.AccountVerifyGETHandler)
 
@@ -115,4 +127,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// block or unblock account
 	attachHandler(http.MethodPost, BlockPath, m.AccountBlockPOSTHandler)
 	attachHandler(http.MethodPost, UnblockPath, m.AccountUnblockPOSTHandler)
+
+	// mute or unmute account
+	attachHandler(http.MethodPost, MutePath, m.AccountMutePOSTHandler)
+	attachHandler(http.MethodPost, UnmutePath, m.AccountUnmutePOSTHandler)
 }
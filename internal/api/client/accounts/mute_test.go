@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package accounts_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type MuteTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *MuteTestSuite) postMute(path string, targetAccountID string, form map[string]string, handler gin.HandlerFunc) (int, *apimodel.Relationship) {
+	requestBody, w, err := testrig.CreateMultipartFormData("", "", form)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody.Bytes(), strings.Replace(path, ":id", targetAccountID, 1), w.FormDataContentType())
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   accounts.IDKey,
+			Value: targetAccountID,
+		},
+	}
+
+	// call the handler
+	handler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return recorder.Code, nil
+	}
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	relationship := &apimodel.Relationship{}
+	if err := json.Unmarshal(b, relationship); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, relationship
+}
+
+func (suite *MuteTestSuite) TestMuteUnmute() {
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	// mute with notifications
+	code, relationship := suite.postMute(accounts.MutePath, targetAccount.ID, nil, suite.accountsModule.AccountMutePOSTHandler)
+	suite.Equal(http.StatusOK, code)
+	suite.True(relationship.Muting)
+	suite.True(relationship.MutingNotifications)
+
+	// update the mute to not include notifications
+	code, relationship = suite.postMute(accounts.MutePath, targetAccount.ID, map[string]string{"notifications": "false"}, suite.accountsModule.AccountMutePOSTHandler)
+	suite.Equal(http.StatusOK, code)
+	suite.True(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+
+	// remove the mute
+	code, relationship = suite.postMute(accounts.UnmutePath, targetAccount.ID, nil, suite.accountsModule.AccountUnmutePOSTHandler)
+	suite.Equal(http.StatusOK, code)
+	suite.False(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+}
+
+func (suite *MuteTestSuite) TestMuteSelf() {
+	code, _ := suite.postMute(accounts.MutePath, suite.testAccounts["local_account_1"].ID, nil, suite.accountsModule.AccountMutePOSTHandler)
+	suite.Equal(http.StatusNotAcceptable, code)
+}
+
+func (suite *MuteTestSuite) TestMuteNegativeDuration() {
+	code, _ := suite.postMute(accounts.MutePath, suite.testAccounts["local_account_2"].ID, map[string]string{"duration": "-1"}, suite.accountsModule.AccountMutePOSTHandler)
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func TestMuteTestSuite(t *testing.T) {
+	suite.Run(t, new(MuteTestSuite))
+}
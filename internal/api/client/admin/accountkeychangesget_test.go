@@ -0,0 +1,96 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AccountKeyChangesGetTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountKeyChangesGetTestSuite) getKeyChanges(query string) []*apimodel.AdminAccountKeyChange {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.KeyChangesPath+query, "application/json")
+	suite.adminModule.AccountKeyChangesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	changes := []*apimodel.AdminAccountKeyChange{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &changes); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return changes
+}
+
+func (suite *AccountKeyChangesGetTestSuite) TestGetKeyChanges() {
+	// Nothing recorded yet.
+	suite.Empty(suite.getKeyChanges(""))
+
+	for _, change := range []*gtsmodel.AccountKeyChange{
+		{
+			ID:           "01H1ABCDE1KX1J4Y0RQNH7F7FZ",
+			AccountID:    suite.testAccounts["remote_account_1"].ID,
+			PublicKeyURI: suite.testAccounts["remote_account_1"].PublicKeyURI,
+			OldPublicKey: "-----BEGIN PUBLIC KEY-----\nold\n-----END PUBLIC KEY-----\n",
+			NewPublicKey: "-----BEGIN PUBLIC KEY-----\nnew\n-----END PUBLIC KEY-----\n",
+		},
+		{
+			ID:           "01H1ABCDE2KX1J4Y0RQNH7F7FZ",
+			AccountID:    suite.testAccounts["remote_account_2"].ID,
+			PublicKeyURI: suite.testAccounts["remote_account_2"].PublicKeyURI,
+			OldPublicKey: "-----BEGIN PUBLIC KEY-----\nold\n-----END PUBLIC KEY-----\n",
+			NewPublicKey: "-----BEGIN PUBLIC KEY-----\nnew\n-----END PUBLIC KEY-----\n",
+		},
+	} {
+		if err := suite.db.PutAccountKeyChange(context.Background(), change); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Newest first.
+	changes := suite.getKeyChanges("")
+	suite.Len(changes, 2)
+	suite.Equal("01H1ABCDE2KX1J4Y0RQNH7F7FZ", changes[0].ID)
+	suite.Equal("01H1ABCDE1KX1J4Y0RQNH7F7FZ", changes[1].ID)
+	suite.Equal(suite.testAccounts["remote_account_2"].Username, changes[0].Account.Username)
+	suite.Equal(suite.testAccounts["remote_account_2"].PublicKeyURI, changes[0].PublicKeyURI)
+
+	// Filtered by account.
+	changes = suite.getKeyChanges("?account_id=" + suite.testAccounts["remote_account_1"].ID)
+	suite.Len(changes, 1)
+	suite.Equal("01H1ABCDE1KX1J4Y0RQNH7F7FZ", changes[0].ID)
+
+	// Paged.
+	changes = suite.getKeyChanges("?max_id=01H1ABCDE2KX1J4Y0RQNH7F7FZ")
+	suite.Len(changes, 1)
+	suite.Equal("01H1ABCDE1KX1J4Y0RQNH7F7FZ", changes[0].ID)
+}
+
+func TestAccountKeyChangesGetTestSuite(t *testing.T) {
+	suite.Run(t, &AccountKeyChangesGetTestSuite{})
+}
@@ -25,22 +25,34 @@ import (
 )
 
 const (
-	BasePath               = "/v1/admin"
-	EmojiPath              = BasePath + "/custom_emojis"
-	EmojiPathWithID        = EmojiPath + "/:" + IDKey
-	EmojiCategoriesPath    = EmojiPath + "/categories"
-	DomainBlocksPath       = BasePath + "/domain_blocks"
-	DomainBlocksPathWithID = DomainBlocksPath + "/:" + IDKey
-	AccountsPath           = BasePath + "/accounts"
-	AccountsPathWithID     = AccountsPath + "/:" + IDKey
-	AccountsActionPath     = AccountsPathWithID + "/action"
-	MediaCleanupPath       = BasePath + "/media_cleanup"
-	MediaRefetchPath       = BasePath + "/media_refetch"
-	ReportsPath            = BasePath + "/reports"
-	ReportsPathWithID      = ReportsPath + "/:" + IDKey
-	ReportsResolvePath     = ReportsPathWithID + "/resolve"
-	EmailPath              = BasePath + "/email"
-	EmailTestPath          = EmailPath + "/test"
+	BasePath                           = "/v1/admin"
+	EmojiPath                          = BasePath + "/custom_emojis"
+	EmojiPathWithID                    = EmojiPath + "/:" + IDKey
+	EmojiCategoriesPath                = EmojiPath + "/categories"
+	DomainBlocksPath                   = BasePath + "/domain_blocks"
+	DomainBlocksPathWithID             = DomainBlocksPath + "/:" + IDKey
+	DomainAllowsPath                   = BasePath + "/domain_allows"
+	DomainAllowsPathWithID             = DomainAllowsPath + "/:" + IDKey
+	DomainBlockSubscriptionsPath       = BasePath + "/domain_block_subscriptions"
+	DomainBlockSubscriptionsPathWithID = DomainBlockSubscriptionsPath + "/:" + IDKey
+	DomainBlockSubscriptionsSyncPath   = DomainBlockSubscriptionsPathWithID + "/sync"
+	AccountsPath                       = BasePath + "/accounts"
+	AccountsPathWithID                 = AccountsPath + "/:" + IDKey
+	AccountsActionPath                 = AccountsPathWithID + "/action"
+	MediaCleanupPath                   = BasePath + "/media_cleanup"
+	MediaRefetchPath                   = BasePath + "/media_refetch"
+	ReportsPath                        = BasePath + "/reports"
+	ReportsPathWithID                  = ReportsPath + "/:" + IDKey
+	ReportsResolvePath                 = ReportsPathWithID + "/resolve"
+	ReportsForwardPath                 = ReportsPathWithID + "/forward"
+	EmailPath                          = BasePath + "/email"
+	EmailTestPath                      = EmailPath + "/test"
+	DeliveryQueuePath                  = BasePath + "/delivery_queue"
+	InstancesPath                      = BasePath + "/instances"
+	InstancesPathWithDomain            = InstancesPath + "/:" + DomainKey
+	KeyChangesPath                     = BasePath + "/key_changes"
+	RelaysPath                         = BasePath + "/relays"
+	RelaysPathWithID                   = RelaysPath + "/:" + IDKey
 
 	ExportQueryKey        = "export"
 	ImportQueryKey        = "import"
@@ -56,6 +68,9 @@ const (
 	MaxIDKey              = "max_id"
 	SinceIDKey            = "since_id"
 	MinIDKey              = "min_id"
+	KeepBlocksKey         = "keep_blocks"
+	DomainKey             = "domain"
+	UnavailableKey        = "unavailable"
 )
 
 type Module struct {
@@ -83,6 +98,19 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, DomainBlocksPathWithID, m.DomainBlockGETHandler)
 	attachHandler(http.MethodDelete, DomainBlocksPathWithID, m.DomainBlockDELETEHandler)
 
+	// domain allow stuff
+	attachHandler(http.MethodPost, DomainAllowsPath, m.DomainAllowsPOSTHandler)
+	attachHandler(http.MethodGet, DomainAllowsPath, m.DomainAllowsGETHandler)
+	attachHandler(http.MethodGet, DomainAllowsPathWithID, m.DomainAllowGETHandler)
+	attachHandler(http.MethodDelete, DomainAllowsPathWithID, m.DomainAllowDELETEHandler)
+
+	// domain block subscription stuff
+	attachHandler(http.MethodPost, DomainBlockSubscriptionsPath, m.DomainBlockSubscriptionsPOSTHandler)
+	attachHandler(http.MethodGet, DomainBlockSubscriptionsPath, m.DomainBlockSubscriptionsGETHandler)
+	attachHandler(http.MethodGet, DomainBlockSubscriptionsPathWithID, m.DomainBlockSubscriptionGETHandler)
+	attachHandler(http.MethodDelete, DomainBlockSubscriptionsPathWithID, m.DomainBlockSubscriptionDELETEHandler)
+	attachHandler(http.MethodPost, DomainBlockSubscriptionsSyncPath, m.DomainBlockSubscriptionSyncPOSTHandler)
+
 	// accounts stuff
 	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
 
@@ -94,7 +122,20 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
 	attachHandler(http.MethodGet, ReportsPathWithID, m.ReportGETHandler)
 	attachHandler(http.MethodPost, ReportsResolvePath, m.ReportResolvePOSTHandler)
+	attachHandler(http.MethodPost, ReportsForwardPath, m.ReportForwardPOSTHandler)
 
 	// email stuff
 	attachHandler(http.MethodPost, EmailTestPath, m.EmailTestPOSTHandler)
+
+	// federation stuff
+	attachHandler(http.MethodGet, DeliveryQueuePath, m.DeliveryQueueGETHandler)
+	attachHandler(http.MethodGet, InstancesPath, m.InstancesGETHandler)
+	attachHandler(http.MethodGet, InstancesPathWithDomain, m.InstanceGETHandler)
+	attachHandler(http.MethodGet, KeyChangesPath, m.AccountKeyChangesGETHandler)
+
+	// relay stuff
+	attachHandler(http.MethodPost, RelaysPath, m.RelaysPOSTHandler)
+	attachHandler(http.MethodGet, RelaysPath, m.RelaysGETHandler)
+	attachHandler(http.MethodGet, RelaysPathWithID, m.RelayGETHandler)
+	attachHandler(http.MethodDelete, RelaysPathWithID, m.RelayDELETEHandler)
 }
@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type DomainAllowTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainAllowTestSuite) TestCreateAndDeleteDomainAllow() {
+	config.SetInstanceFederationMode(config.InstanceFederationModeAllowlist)
+	defer config.SetInstanceFederationMode(config.InstanceFederationModeDefault)
+
+	// Nothing is allowed yet, so everything is blocked.
+	blocked, err := suite.db.IsDomainBlocked(context.Background(), "fossbros-anonymous.io")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	form := url.Values{
+		"domain":          {"Fossbros-Anonymous.io"},
+		"private_comment": {"they're alright"},
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(form.Encode()), admin.DomainAllowsPath, "application/x-www-form-urlencoded")
+	suite.adminModule.DomainAllowsPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	domainAllow := &apimodel.DomainAllow{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), domainAllow); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(domainAllow.ID)
+	suite.Equal("fossbros-anonymous.io", domainAllow.Domain.Domain)
+	suite.Equal("they're alright", domainAllow.PrivateComment)
+
+	blocked, err = suite.db.IsDomainBlocked(context.Background(), "fossbros-anonymous.io")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// Should show up in the list of allows.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.DomainAllowsPath, "application/json")
+	suite.adminModule.DomainAllowsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	domainAllows := []*apimodel.DomainAllow{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &domainAllows); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(domainAllows, 1)
+	suite.Equal(domainAllow.ID, domainAllows[0].ID)
+
+	// Delete it again.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, nil, admin.DomainAllowsPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, domainAllow.ID)
+	suite.adminModule.DomainAllowDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	blocked, err = suite.db.IsDomainBlocked(context.Background(), "fossbros-anonymous.io")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	// And it should be gone.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.DomainAllowsPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, domainAllow.ID)
+	suite.adminModule.DomainAllowGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestDomainAllowTestSuite(t *testing.T) {
+	suite.Run(t, &DomainAllowTestSuite{})
+}
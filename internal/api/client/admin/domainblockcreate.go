@@ -27,6 +27,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
@@ -98,10 +99,31 @@ import (
 //			is a useful way of internally keeping track of why a certain domain ended up blocked.
 //			Used only if `import` is not `true`.
 //		type: string
+//	-
+//		name: severity
+//		in: formData
+//		description: >-
+//			Severity of the domain block. `suspend` defederates from the domain entirely, and
+//			suspends all of its accounts. `limit` keeps federating with the domain, but hides its
+//			statuses from public timelines, and turns follows from it into follow requests.
+//			Used only if `import` is not `true`.
+//		type: string
+//		enum:
+//			- suspend
+//			- limit
+//		default: suspend
+//	-
+//		name: reject_media
+//		in: formData
+//		description: >-
+//			Don't fetch media attachments, avatars or headers from the domain.
+//			Used only if `import` is not `true`.
+//		type: boolean
+//		default: false
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:write:domain_blocks
 //
 //	responses:
 //		'200':
@@ -123,9 +145,9 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) DomainBlocksPOSTHandler(c *gin.Context) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := oauth.AuthedScope(c, true, true, true, true, oauth.ScopeAdminWriteDomainBlocks)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -176,7 +198,7 @@ func (m *Module) DomainBlocksPOSTHandler(c *gin.Context) {
 	}
 
 	// we're just creating one block
-	domainBlock, errWithCode := m.processor.Admin().DomainBlockCreate(c.Request.Context(), authed.Account, form.Domain, form.Obfuscate, form.PublicComment, form.PrivateComment, "")
+	domainBlock, errWithCode := m.processor.Admin().DomainBlockCreate(c.Request.Context(), authed.Account, form.Domain, form.Obfuscate, form.PublicComment, form.PrivateComment, "", gtsmodel.DomainBlockSeverity(form.Severity), form.RejectMedia)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -194,6 +216,12 @@ func validateCreateDomainBlock(form *apimodel.DomainBlockCreateRequest, imp bool
 		if form.Domain == "" {
 			return errors.New("empty domain provided")
 		}
+
+		switch gtsmodel.DomainBlockSeverity(form.Severity) {
+		case "", gtsmodel.DomainBlockSeveritySuspend, gtsmodel.DomainBlockSeverityLimit:
+		default:
+			return fmt.Errorf("severity must be either %s or %s, provided value was %s", gtsmodel.DomainBlockSeveritySuspend, gtsmodel.DomainBlockSeverityLimit, form.Severity)
+		}
 	}
 
 	return nil
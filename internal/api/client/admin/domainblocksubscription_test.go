@@ -0,0 +1,210 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const testBlocklistURI = "https://blocklists.example.org/blocklist.csv"
+
+type DomainBlockSubscriptionTestSuite struct {
+	AdminStandardTestSuite
+
+	// blocklist currently served at testBlocklistURI
+	blocklist string
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) SetupTest() {
+	suite.AdminStandardTestSuite.SetupTest()
+
+	// Serve the blocklist instead of the usual test federation responses.
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != testBlocklistURI {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Type": []string{"text/csv"}},
+			Body:          io.NopCloser(strings.NewReader(suite.blocklist)),
+			ContentLength: int64(len(suite.blocklist)),
+		}, nil
+	}, "../../../../testrig/media")
+
+	suite.federator = testrig.NewTestFederator(&suite.state, testrig.NewTestTransportController(&suite.state, httpClient), suite.mediaManager)
+	suite.processor = testrig.NewTestProcessor(&suite.state, suite.federator, suite.emailSender, suite.mediaManager)
+	suite.adminModule = admin.New(suite.processor)
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) do(method string, path string, handler gin.HandlerFunc, form url.Values, id string) (*apimodel.DomainBlockSubscription, int) {
+	recorder := httptest.NewRecorder()
+
+	var body []byte
+	if form != nil {
+		body = []byte(form.Encode())
+	}
+
+	ctx := suite.newContext(recorder, method, body, path, "application/x-www-form-urlencoded")
+	ctx.Request.Method = method
+	if id != "" {
+		ctx.AddParam(admin.IDKey, id)
+	}
+
+	handler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	subscription := &apimodel.DomainBlockSubscription{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), subscription); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return subscription, recorder.Code
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) TestSubscribeAndSync() {
+	suite.blocklist = "#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate\n" +
+		"bad.example.org,suspend,false,false,spam,false\n" +
+		"Worse.Example.org,suspend,false,false,,true\n" +
+		"meh.example.org,silence,false,false,,false\n" +
+		"replyguys.com,suspend,false,false,,false\n"
+
+	form := url.Values{
+		"uri":          {testBlocklistURI},
+		"content_type": {"text/csv"},
+		"title":        {"test list"},
+	}
+
+	subscription, code := suite.do(http.MethodPost, admin.DomainBlockSubscriptionsPath, suite.adminModule.DomainBlockSubscriptionsPOSTHandler, form, "")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("test list", subscription.Title)
+	suite.Empty(subscription.Error)
+	suite.NotEmpty(subscription.SuccessfullyFetchedAt)
+	suite.Equal(2, subscription.BlockCount)
+	suite.Equal([]string{"bad.example.org", "worse.example.org"}, subscription.AddedDomains)
+	suite.Empty(subscription.RemovedDomains)
+
+	// Silenced domain shouldn't be blocked, and the existing
+	// block on replyguys.com shouldn't have been touched.
+	_, err := suite.db.GetDomainBlock(context.Background(), "meh.example.org")
+	suite.ErrorIs(err, db.ErrNoEntries)
+	replyguys, err := suite.db.GetDomainBlock(context.Background(), "replyguys.com")
+	suite.NoError(err)
+	suite.Empty(replyguys.SubscriptionID)
+
+	worse, err := suite.db.GetDomainBlock(context.Background(), "worse.example.org")
+	suite.NoError(err)
+	suite.Equal(subscription.ID, worse.SubscriptionID)
+	suite.True(*worse.Obfuscate)
+
+	blocked, err := suite.db.IsDomainBlocked(context.Background(), "bad.example.org")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	// Take one domain off the list and add another.
+	suite.blocklist = "#domain,#severity\n" +
+		"worse.example.org,suspend\n" +
+		"new.example.org,suspend\n"
+
+	subscription, code = suite.do(http.MethodPost, admin.DomainBlockSubscriptionsSyncPath, suite.adminModule.DomainBlockSubscriptionSyncPOSTHandler, nil, subscription.ID)
+	suite.Equal(http.StatusOK, code)
+	suite.Empty(subscription.Error)
+	suite.Equal(2, subscription.BlockCount)
+	suite.Equal([]string{"new.example.org"}, subscription.AddedDomains)
+	suite.Equal([]string{"bad.example.org"}, subscription.RemovedDomains)
+
+	blocked, err = suite.db.IsDomainBlocked(context.Background(), "bad.example.org")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// An empty list is most likely a broken list,
+	// so the blocks should be left alone.
+	suite.blocklist = ""
+
+	subscription, code = suite.do(http.MethodPost, admin.DomainBlockSubscriptionsSyncPath, suite.adminModule.DomainBlockSubscriptionSyncPOSTHandler, nil, subscription.ID)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal("list contained no valid domains", subscription.Error)
+	suite.Equal(2, subscription.BlockCount)
+
+	// Deleting the subscription removes its blocks.
+	_, code = suite.do(http.MethodDelete, admin.DomainBlockSubscriptionsPathWithID, suite.adminModule.DomainBlockSubscriptionDELETEHandler, nil, subscription.ID)
+	suite.Equal(http.StatusOK, code)
+
+	blocked, err = suite.db.IsDomainBlocked(context.Background(), "worse.example.org")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	blocked, err = suite.db.IsDomainBlocked(context.Background(), "replyguys.com")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	_, code = suite.do(http.MethodGet, admin.DomainBlockSubscriptionsPathWithID, suite.adminModule.DomainBlockSubscriptionGETHandler, nil, subscription.ID)
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) TestSubscribeBadContentType() {
+	form := url.Values{
+		"uri":          {testBlocklistURI},
+		"content_type": {"text/html"},
+	}
+
+	_, code := suite.do(http.MethodPost, admin.DomainBlockSubscriptionsPath, suite.adminModule.DomainBlockSubscriptionsPOSTHandler, form, "")
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) TestSubscribeUnreachable() {
+	form := url.Values{
+		"uri":          {"https://blocklists.example.org/missing.txt"},
+		"content_type": {"text/plain"},
+	}
+
+	subscription, code := suite.do(http.MethodPost, admin.DomainBlockSubscriptionsPath, suite.adminModule.DomainBlockSubscriptionsPOSTHandler, form, "")
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(subscription.Error)
+	suite.Empty(subscription.SuccessfullyFetchedAt)
+	suite.Zero(subscription.BlockCount)
+
+	// Subscribing to the same list twice is a conflict.
+	_, code = suite.do(http.MethodPost, admin.DomainBlockSubscriptionsPath, suite.adminModule.DomainBlockSubscriptionsPOSTHandler, form, "")
+	suite.Equal(http.StatusConflict, code)
+}
+
+func TestDomainBlockSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, &DomainBlockSubscriptionTestSuite{})
+}
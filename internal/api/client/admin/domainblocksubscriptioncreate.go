@@ -0,0 +1,145 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// DomainBlockSubscriptionsPOSTHandler swagger:operation POST /api/v1/admin/domain_block_subscriptions domainBlockSubscriptionCreate
+//
+// Subscribe to a list of domains to block.
+//
+// The list is fetched straight away, and then every hour. Domain blocks are created for domains
+// which appear on the list, and removed for domains which no longer do. Domains which are already
+// blocked manually, or by another subscription, are left alone.
+//
+// The list can be plain text with one domain per line (`text/plain`), a CSV file in Mastodon's
+// domain block export format (`text/csv`), or a JSON array of domain blocks as exported from
+// GoToSocial (`application/json`). Only blocks with severity `suspend` (or no severity) are used.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: uri
+//		in: formData
+//		description: The http or https URI of the list.
+//		type: string
+//		required: true
+//	-
+//		name: content_type
+//		in: formData
+//		description: The format of the list.
+//		type: string
+//		enum:
+//			- text/plain
+//			- text/csv
+//			- application/json
+//		required: true
+//	-
+//		name: title
+//		in: formData
+//		description: Title to help admins tell subscriptions apart.
+//		type: string
+//	-
+//		name: obfuscate
+//		in: formData
+//		description: Obfuscate the names of all domains blocked through this subscription when serving them publicly.
+//		type: boolean
+//		default: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:domain_blocks
+//
+//	responses:
+//		'200':
+//			description: >-
+//				The newly created domain block subscription. If the list could not
+//				be fetched or parsed, the reason will be set in the `error` field.
+//			schema:
+//				"$ref": "#/definitions/domainBlockSubscription"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'409':
+//			description: conflict; a subscription to this uri already exists
+//		'500':
+//			description: internal server error
+func (m *Module) DomainBlockSubscriptionsPOSTHandler(c *gin.Context) {
+	authed, errWithCode := oauth.AuthedScope(c, true, true, true, true, oauth.ScopeAdminWriteDomainBlocks)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.DomainBlockSubscriptionCreateRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.URI == "" {
+		err := errors.New("no uri specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	subscription, errWithCode := m.processor.Admin().DomainBlockSubscriptionCreate(c.Request.Context(), authed.Account, form)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, subscription)
+}
@@ -0,0 +1,178 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const testRelayInbox = "https://relay.example.org/inbox"
+
+type RelayTestSuite struct {
+	AdminStandardTestSuite
+
+	httpClient *testrig.MockHTTPClient
+}
+
+func (suite *RelayTestSuite) SetupTest() {
+	suite.AdminStandardTestSuite.SetupTest()
+
+	// Keep hold of the http client so we can see what was sent to the relay.
+	suite.httpClient = testrig.NewMockHTTPClient(nil, "../../../../testrig/media")
+	suite.federator = testrig.NewTestFederator(&suite.state, testrig.NewTestTransportController(&suite.state, suite.httpClient), suite.mediaManager)
+	suite.processor = testrig.NewTestProcessor(&suite.state, suite.federator, suite.emailSender, suite.mediaManager)
+	suite.adminModule = admin.New(suite.processor)
+}
+
+func (suite *RelayTestSuite) do(method string, path string, handler gin.HandlerFunc, form url.Values, id string) (*apimodel.AdminRelay, int) {
+	recorder := httptest.NewRecorder()
+
+	var body []byte
+	if form != nil {
+		body = []byte(form.Encode())
+	}
+
+	ctx := suite.newContext(recorder, method, body, path, "application/x-www-form-urlencoded")
+	ctx.Request.Method = method
+	if id != "" {
+		ctx.AddParam(admin.IDKey, id)
+	}
+
+	handler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	relay := &apimodel.AdminRelay{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return relay, recorder.Code
+}
+
+// sentToRelay waits for n activities to have been sent to the test relay inbox, and returns them.
+func (suite *RelayTestSuite) sentToRelay(n int) []map[string]interface{} {
+	var sent [][]byte
+	if !suite.Eventually(func() bool {
+		s, ok := suite.httpClient.SentMessages.Load(testRelayInbox)
+		if !ok {
+			return false
+		}
+		sent = s.([][]byte)
+		return len(sent) == n
+	}, 10*time.Second, 10*time.Millisecond) {
+		suite.FailNow("timed out waiting for activities to be sent to relay")
+	}
+
+	activities := make([]map[string]interface{}, 0, len(sent))
+	for _, b := range sent {
+		activity := make(map[string]interface{})
+		if err := json.Unmarshal(b, &activity); err != nil {
+			suite.FailNow(err.Error())
+		}
+		activities = append(activities, activity)
+	}
+
+	return activities
+}
+
+func (suite *RelayTestSuite) TestSubscribeAndUnsubscribe() {
+	instanceAccount, err := suite.db.GetInstanceAccount(context.Background(), "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form := url.Values{
+		"inbox_uri": {testRelayInbox},
+		"publish":   {"true"},
+	}
+
+	relay, code := suite.do(http.MethodPost, admin.RelaysPath, suite.adminModule.RelaysPOSTHandler, form, "")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(testRelayInbox, relay.InboxURI)
+	suite.Equal("pending", relay.State)
+	suite.Empty(relay.ActorURI)
+	suite.True(relay.Publish)
+
+	// The instance account should have
+	// sent a Follow of the public collection.
+	follow := suite.sentToRelay(1)[0]
+	suite.Equal("Follow", follow["type"])
+	suite.Equal(instanceAccount.URI, follow["actor"])
+	suite.Equal("https://www.w3.org/ns/activitystreams#Public", follow["object"])
+
+	// Subscribing to the same relay again should conflict.
+	_, code = suite.do(http.MethodPost, admin.RelaysPath, suite.adminModule.RelaysPOSTHandler, form, "")
+	suite.Equal(http.StatusConflict, code)
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.RelaysPath, "")
+	suite.adminModule.RelaysGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	relays := []*apimodel.AdminRelay{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &relays); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(relays, 1)
+	suite.Equal(relay.ID, relays[0].ID)
+
+	// Unsubscribing should Undo the Follow.
+	deleted, code := suite.do(http.MethodDelete, admin.RelaysPathWithID, suite.adminModule.RelayDELETEHandler, nil, relay.ID)
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(relay.ID, deleted.ID)
+
+	undo := suite.sentToRelay(2)[1]
+	suite.Equal("Undo", undo["type"])
+	suite.Equal(instanceAccount.URI, undo["actor"])
+	undoObject, ok := undo["object"].(map[string]interface{})
+	if !ok {
+		suite.FailNow("undo object was not embedded")
+	}
+	suite.Equal(follow["id"], undoObject["id"])
+
+	_, code = suite.do(http.MethodGet, admin.RelaysPathWithID, suite.adminModule.RelayGETHandler, nil, relay.ID)
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *RelayTestSuite) TestSubscribeInvalidInbox() {
+	form := url.Values{
+		"inbox_uri": {"ftp://relay.example.org/inbox"},
+	}
+
+	_, code := suite.do(http.MethodPost, admin.RelaysPath, suite.adminModule.RelaysPOSTHandler, form, "")
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, &RelayTestSuite{})
+}
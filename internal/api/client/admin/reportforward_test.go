@@ -0,0 +1,144 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ReportForwardTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *ReportForwardTestSuite) forwardReport(
+	account *gtsmodel.Account,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	targetReportID string,
+	expectedHTTPStatus int,
+	expectedBody string,
+) (*apimodel.AdminReport, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request URI
+	requestPath := admin.ReportsPath + "/" + targetReportID + "/forward"
+	baseURI := config.GetProtocol() + "://" + config.GetHost()
+	requestURI := baseURI + "/api/" + requestPath
+
+	// create the request
+	ctx.Request = httptest.NewRequest(http.MethodPost, requestURI, nil)
+	ctx.AddParam(admin.IDKey, targetReportID)
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// trigger the handler
+	suite.adminModule.ReportForwardPOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := &apimodel.AdminReport{}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (suite *ReportForwardTestSuite) TestReportForward() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+
+	// Mark the report as not yet forwarded.
+	testReport := &gtsmodel.Report{}
+	*testReport = *suite.testReports["local_account_2_report_remote_account_1"]
+	testReport.Forwarded = testrig.FalseBool()
+	if _, err := suite.db.UpdateReport(context.Background(), testReport, "forwarded"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	report, err := suite.forwardReport(testAccount, testToken, testUser, testReport.ID, http.StatusOK, "")
+	suite.NoError(err)
+	suite.NotEmpty(report)
+	suite.True(report.Forwarded)
+}
+
+func (suite *ReportForwardTestSuite) TestReportForwardAlreadyForwarded() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testReportID := suite.testReports["local_account_2_report_remote_account_1"].ID
+
+	_, err := suite.forwardReport(testAccount, testToken, testUser, testReportID, http.StatusConflict, `{"error":"Conflict: report 01GP3AWY4CRDVRNZKW0TEAMB5R has already been forwarded"}`)
+	suite.NoError(err)
+}
+
+func (suite *ReportForwardTestSuite) TestReportForwardLocalTarget() {
+	testAccount := suite.testAccounts["admin_account"]
+	testToken := suite.testTokens["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testReportID := suite.testReports["remote_account_1_report_local_account_2"].ID
+
+	_, err := suite.forwardReport(testAccount, testToken, testUser, testReportID, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: report 01GP3DFY9XQ1TJMZT5BGAZPXX7 targets local account 01F8MH5NBDF2MV7CTC4Q5128HF, cannot forward"}`)
+	suite.NoError(err)
+}
+
+func TestReportForwardTestSuite(t *testing.T) {
+	suite.Run(t, &ReportForwardTestSuite{})
+}
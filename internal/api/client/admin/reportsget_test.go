@@ -428,6 +428,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
@@ -629,6 +630,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
@@ -830,6 +832,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
       {
         "id": "01FVW7JHQFSFK166WWKR8CBA6M",
         "created_at": "2021-09-20T10:40:37.000Z",
+        "edited_at": null,
         "in_reply_to_id": null,
         "in_reply_to_account_id": null,
         "sensitive": false,
@@ -936,10 +939,28 @@ func (suite *ReportsGetTestSuite) TestReportsGetResolvedTargetAccount() {
 
 func (suite *ReportsGetTestSuite) TestReportsGetNotAdmin() {
 	testAccount := suite.testAccounts["local_account_1"]
-	testToken := suite.testTokens["local_account_1"]
 	testUser := suite.testUsers["local_account_1"]
 
-	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: user 01F8MGVGPHQ2D3P3X0454H54Z5 not an admin"}`, nil, "", "", "", "", "", 20)
+	// give the token admin scope, so
+	// that the admin check is reached
+	testToken := *suite.testTokens["local_account_1"]
+	testToken.Scope = "read write follow push admin"
+
+	reports, _, err := suite.getReports(testAccount, &testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: user 01F8MGVGPHQ2D3P3X0454H54Z5 not an admin"}`, nil, "", "", "", "", "", 20)
+	suite.NoError(err)
+	suite.Empty(reports)
+}
+
+func (suite *ReportsGetTestSuite) TestReportsGetNoAdminScope() {
+	testAccount := suite.testAccounts["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+
+	// admin user, but the token
+	// doesn't carry admin scope
+	testToken := *suite.testTokens["admin_account"]
+	testToken.Scope = "read write follow push"
+
+	reports, _, err := suite.getReports(testAccount, &testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: token with scope \"read write follow push\" does not have required scope \"admin:read:reports\""}`, nil, "", "", "", "", "", 20)
 	suite.NoError(err)
 	suite.Empty(reports)
 }
@@ -21,12 +21,27 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 const (
-	// BasePath is the base path for serving the filters API, minus the 'api' prefix
+	// BasePath is the base path for serving the v1 filters API, minus the 'api' prefix
 	BasePath = "/v1/filters"
+	// BasePathV2 is the base path for serving the v2 filters API, minus the 'api' prefix
+	BasePathV2 = "/v2/filters"
+	// IDKey is the key for a filter or filter keyword ID in the path
+	IDKey = "id"
+	// BasePathWithID is the base path with the ID key in it, for serving one v1 filter
+	BasePathWithID = BasePath + "/:" + IDKey
+	// BasePathV2WithID is the v2 base path with the ID key in it, for serving one v2 filter
+	BasePathV2WithID = BasePathV2 + "/:" + IDKey
+	// KeywordsPath is the path for serving or adding to the keywords of one v2 filter
+	KeywordsPath = BasePathV2WithID + "/keywords"
+	// KeywordPathWithID is the path for serving one filter keyword
+	KeywordPathWithID = BasePathV2 + "/keywords/:" + IDKey
 )
 
 type Module struct {
@@ -40,5 +55,79 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// v1 filters
 	attachHandler(http.MethodGet, BasePath, m.FiltersGETHandler)
+	attachHandler(http.MethodPost, BasePath, m.FilterPOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, m.FilterGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, m.FilterPUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, m.FilterDELETEHandler)
+
+	// v2 filters
+	attachHandler(http.MethodGet, BasePathV2, m.FiltersV2GETHandler)
+	attachHandler(http.MethodPost, BasePathV2, m.FilterV2POSTHandler)
+	attachHandler(http.MethodGet, BasePathV2WithID, m.FilterV2GETHandler)
+	attachHandler(http.MethodPut, BasePathV2WithID, m.FilterV2PUTHandler)
+	attachHandler(http.MethodDelete, BasePathV2WithID, m.FilterV2DELETEHandler)
+
+	// v2 filter keywords
+	attachHandler(http.MethodGet, KeywordsPath, m.FilterKeywordsGETHandler)
+	attachHandler(http.MethodPost, KeywordsPath, m.FilterKeywordPOSTHandler)
+	attachHandler(http.MethodGet, KeywordPathWithID, m.FilterKeywordGETHandler)
+	attachHandler(http.MethodPut, KeywordPathWithID, m.FilterKeywordPUTHandler)
+	attachHandler(http.MethodDelete, KeywordPathWithID, m.FilterKeywordDELETEHandler)
+}
+
+// validateFilterV1Form validates the form for creating or updating a v1 filter.
+func validateFilterV1Form(form *apimodel.FilterCreateUpdateRequestV1) error {
+	if err := validate.FilterKeyword(form.Phrase); err != nil {
+		return err
+	}
+
+	if err := validate.FilterTitle(form.Phrase); err != nil {
+		return err
+	}
+
+	return validate.FilterContexts(form.Context)
+}
+
+// validateFilterV2Form validates the given fields of a
+// form for creating or updating a v2 filter. Nil fields
+// are assumed to have been left unset, and are skipped.
+func validateFilterV2Form(title *string, context *[]string, filterAction *string) error {
+	if title != nil {
+		if err := validate.FilterTitle(*title); err != nil {
+			return err
+		}
+	}
+
+	if context != nil {
+		if err := validate.FilterContexts(*context); err != nil {
+			return err
+		}
+	}
+
+	if filterAction != nil {
+		if err := validate.FilterAction(gtsmodel.FilterAction(*filterAction)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateFilterKeywordForms validates the keyword
+// forms for creating or updating v2 filter keywords.
+func validateFilterKeywordForms(forms []apimodel.FilterKeywordCreateUpdateRequest) error {
+	for _, form := range forms {
+		if form.Destroy {
+			// Keyword is just being removed.
+			continue
+		}
+
+		if err := validate.FilterKeyword(form.Keyword); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
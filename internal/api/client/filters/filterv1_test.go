@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	filter "github.com/superseriousbusiness/gotosocial/internal/api/client/filters"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type FilterV1TestSuite struct {
+	FiltersStandardTestSuite
+}
+
+func (suite *FilterV1TestSuite) getFilters() []*apimodel.FilterV1 {
+	code, b := suite.callHandler(suite.filtersModule.FiltersGETHandler, http.MethodGet, filter.BasePath, "", nil, "")
+	suite.Equal(http.StatusOK, code)
+
+	filters := []*apimodel.FilterV1{}
+	suite.NoError(json.Unmarshal(b, &filters))
+	return filters
+}
+
+func (suite *FilterV1TestSuite) TestGetFilters() {
+	filters := suite.getFilters()
+	if !suite.Len(filters, 1) {
+		suite.FailNow("")
+	}
+
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+	suite.Equal(testKeyword.ID, filters[0].ID)
+	suite.Equal("fnord", filters[0].Phrase)
+	suite.Equal([]string{"home", "public"}, filters[0].Context)
+	suite.True(filters[0].WholeWord)
+	suite.False(filters[0].Irreversible)
+	suite.Nil(filters[0].ExpiresAt)
+}
+
+func (suite *FilterV1TestSuite) TestCreateFilter() {
+	form := url.Values{
+		"phrase":       {"scrapple"},
+		"context[]":    {"home", "thread"},
+		"irreversible": {"true"},
+		"whole_word":   {"false"},
+		"expires_in":   {"86400"},
+	}
+
+	code, b := suite.callHandler(suite.filtersModule.FilterPOSTHandler, http.MethodPost, filter.BasePath, "", strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	suite.Equal(http.StatusOK, code)
+
+	apiFilter := &apimodel.FilterV1{}
+	suite.NoError(json.Unmarshal(b, apiFilter))
+	suite.NotEmpty(apiFilter.ID)
+	suite.Equal("scrapple", apiFilter.Phrase)
+	suite.Equal([]string{"home", "thread"}, apiFilter.Context)
+	suite.False(apiFilter.WholeWord)
+	suite.True(apiFilter.Irreversible)
+	suite.NotNil(apiFilter.ExpiresAt)
+
+	// The new filter should be returned alongside the existing one.
+	suite.Len(suite.getFilters(), 2)
+}
+
+func (suite *FilterV1TestSuite) TestCreateFilterNoContext() {
+	form := url.Values{"phrase": {"scrapple"}}
+
+	code, b := suite.callHandler(suite.filtersModule.FilterPOSTHandler, http.MethodPost, filter.BasePath, "", strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: at least one filter context must be provided"}`, string(b))
+}
+
+func (suite *FilterV1TestSuite) TestUpdateFilter() {
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+	form := url.Values{
+		"phrase":    {"fnords"},
+		"context[]": {"notifications"},
+	}
+
+	code, b := suite.callHandler(suite.filtersModule.FilterPUTHandler, http.MethodPut, filter.BasePath+"/"+testKeyword.ID, testKeyword.ID, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	suite.Equal(http.StatusOK, code)
+
+	apiFilter := &apimodel.FilterV1{}
+	suite.NoError(json.Unmarshal(b, apiFilter))
+	suite.Equal(testKeyword.ID, apiFilter.ID)
+	suite.Equal("fnords", apiFilter.Phrase)
+	suite.Equal([]string{"notifications"}, apiFilter.Context)
+	suite.True(apiFilter.WholeWord)
+}
+
+func (suite *FilterV1TestSuite) TestDeleteFilter() {
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+
+	code, b := suite.callHandler(suite.filtersModule.FilterDELETEHandler, http.MethodDelete, filter.BasePath+"/"+testKeyword.ID, testKeyword.ID, nil, "")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{}`, string(b))
+
+	// Filter was the last keyword of its
+	// parent filter, so that's gone too.
+	suite.Empty(suite.getFilters())
+	_, err := suite.db.GetFilterByID(context.Background(), testKeyword.FilterID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *FilterV1TestSuite) TestGetFilterNotFound() {
+	code, b := suite.callHandler(suite.filtersModule.FilterGETHandler, http.MethodGet, filter.BasePath+"/01GZ8S1QTRJKAP2HPQP5K9AS4Z", "01GZ8S1QTRJKAP2HPQP5K9AS4Z", nil, "")
+	suite.Equal(http.StatusNotFound, code)
+	suite.Equal(`{"error":"Not Found"}`, string(b))
+}
+
+func TestFilterV1TestSuite(t *testing.T) {
+	suite.Run(t, &FilterV1TestSuite{})
+}
@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package filter_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	filter "github.com/superseriousbusiness/gotosocial/internal/api/client/filters"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type FilterV2TestSuite struct {
+	FiltersStandardTestSuite
+}
+
+func (suite *FilterV2TestSuite) TestGetFilters() {
+	code, b := suite.callHandler(suite.filtersModule.FiltersV2GETHandler, http.MethodGet, filter.BasePathV2, "", nil, "")
+	suite.Equal(http.StatusOK, code)
+
+	filters := []*apimodel.FilterV2{}
+	suite.NoError(json.Unmarshal(b, &filters))
+	if !suite.Len(filters, 1) {
+		suite.FailNow("")
+	}
+
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+	suite.Equal(testFilter.ID, filters[0].ID)
+	suite.Equal("fnord", filters[0].Title)
+	suite.Equal("warn", filters[0].FilterAction)
+	suite.Equal([]string{"home", "public"}, filters[0].Context)
+	suite.Empty(filters[0].Statuses)
+	if suite.Len(filters[0].Keywords, 1) {
+		suite.Equal("fnord", filters[0].Keywords[0].Keyword)
+		suite.True(filters[0].Keywords[0].WholeWord)
+	}
+}
+
+func (suite *FilterV2TestSuite) TestCreateFilterJSON() {
+	body := `{
+  "title": "breakfast",
+  "context": ["home", "public"],
+  "filter_action": "hide",
+  "keywords_attributes": [
+    {"keyword": "scrapple"},
+    {"keyword": "hash brown", "whole_word": false}
+  ]
+}`
+
+	code, b := suite.callHandler(suite.filtersModule.FilterV2POSTHandler, http.MethodPost, filter.BasePathV2, "", strings.NewReader(body), "application/json")
+	suite.Equal(http.StatusOK, code)
+
+	apiFilter := &apimodel.FilterV2{}
+	suite.NoError(json.Unmarshal(b, apiFilter))
+	suite.NotEmpty(apiFilter.ID)
+	suite.Equal("breakfast", apiFilter.Title)
+	suite.Equal("hide", apiFilter.FilterAction)
+	suite.Nil(apiFilter.ExpiresAt)
+	if suite.Len(apiFilter.Keywords, 2) {
+		suite.Equal("scrapple", apiFilter.Keywords[0].Keyword)
+		suite.True(apiFilter.Keywords[0].WholeWord)
+		suite.Equal("hash brown", apiFilter.Keywords[1].Keyword)
+		suite.False(apiFilter.Keywords[1].WholeWord)
+	}
+}
+
+func (suite *FilterV2TestSuite) TestCreateFilterBadAction() {
+	form := url.Values{
+		"title":         {"breakfast"},
+		"context[]":     {"home"},
+		"filter_action": {"explode"},
+	}
+
+	code, b := suite.callHandler(suite.filtersModule.FilterV2POSTHandler, http.MethodPost, filter.BasePathV2, "", strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: filter_action must be either empty or one of 'warn', 'hide'"}`, string(b))
+}
+
+func (suite *FilterV2TestSuite) TestUpdateFilterKeywords() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+	body := `{
+  "title": "discordianism",
+  "keywords_attributes": [
+    {"id": "` + testKeyword.ID + `", "_destroy": true},
+    {"keyword": "hail eris"}
+  ]
+}`
+
+	code, b := suite.callHandler(suite.filtersModule.FilterV2PUTHandler, http.MethodPut, filter.BasePathV2+"/"+testFilter.ID, testFilter.ID, strings.NewReader(body), "application/json")
+	suite.Equal(http.StatusOK, code)
+
+	apiFilter := &apimodel.FilterV2{}
+	suite.NoError(json.Unmarshal(b, apiFilter))
+	suite.Equal(testFilter.ID, apiFilter.ID)
+	suite.Equal("discordianism", apiFilter.Title)
+	suite.Equal([]string{"home", "public"}, apiFilter.Context)
+	if suite.Len(apiFilter.Keywords, 1) {
+		suite.Equal("hail eris", apiFilter.Keywords[0].Keyword)
+	}
+}
+
+func (suite *FilterV2TestSuite) TestAddDuplicateKeyword() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+	form := url.Values{"keyword": {"fnord"}}
+
+	code, b := suite.callHandler(suite.filtersModule.FilterKeywordPOSTHandler, http.MethodPost, filter.BasePathV2+"/"+testFilter.ID+"/keywords", testFilter.ID, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	suite.Equal(http.StatusConflict, code)
+	suite.Equal(`{"error":"Conflict: duplicate keyword in filter"}`, string(b))
+}
+
+func (suite *FilterV2TestSuite) TestUpdateKeyword() {
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+	form := url.Values{
+		"keyword":    {"fnords"},
+		"whole_word": {"false"},
+	}
+
+	code, b := suite.callHandler(suite.filtersModule.FilterKeywordPUTHandler, http.MethodPut, filter.BasePathV2+"/keywords/"+testKeyword.ID, testKeyword.ID, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	suite.Equal(http.StatusOK, code)
+
+	apiKeyword := &apimodel.FilterKeyword{}
+	suite.NoError(json.Unmarshal(b, apiKeyword))
+	suite.Equal(testKeyword.ID, apiKeyword.ID)
+	suite.Equal("fnords", apiKeyword.Keyword)
+	suite.False(apiKeyword.WholeWord)
+}
+
+func (suite *FilterV2TestSuite) TestDeleteFilter() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	code, b := suite.callHandler(suite.filtersModule.FilterV2DELETEHandler, http.MethodDelete, filter.BasePathV2+"/"+testFilter.ID, testFilter.ID, nil, "")
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(`{}`, string(b))
+
+	// Keywords should be gone too.
+	code, _ = suite.callHandler(suite.filtersModule.FilterKeywordGETHandler, http.MethodGet, filter.BasePathV2+"/keywords/01HN272TAVWAXX72ZX4M8JZ0PS", "01HN272TAVWAXX72ZX4M8JZ0PS", nil, "")
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func TestFilterV2TestSuite(t *testing.T) {
+	suite.Run(t, &FilterV2TestSuite{})
+}
@@ -398,8 +398,13 @@ func (suite *InstancePatchTestSuite) TestInstancePatch5() {
 	recorder := httptest.NewRecorder()
 	ctx := suite.newContext(recorder, http.MethodPatch, instance.InstanceInformationPathV1, bodyBytes, w.FormDataContentType(), true)
 
+	// give the token admin scope, so
+	// that the admin check is reached
+	testToken := *suite.testTokens["local_account_1"]
+	testToken.Scope = "read write follow push admin"
+
 	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
-	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(&testToken))
 	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
 	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
 
@@ -782,6 +787,45 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
 }`, dst.String())
 }
 
+func (suite *InstancePatchTestSuite) TestInstancePatchNoAdminScope() {
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"short_description": "<p>This is some html, which is <em>allowed</em> in short descriptions.</p>",
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+
+	// set up the request
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPatch, instance.InstanceInformationPathV1, bodyBytes, w.FormDataContentType(), true)
+
+	// admin user, but the token
+	// doesn't carry admin scope
+	testToken := *suite.testTokens["admin_account"]
+	testToken.Scope = "read write follow push"
+
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["admin_account"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(&testToken))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["admin_account"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["admin_account"])
+
+	// call the handler
+	suite.instanceModule.InstanceUpdatePATCHHandler(ctx)
+
+	suite.Equal(http.StatusForbidden, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	suite.Equal(`{"error":"Forbidden: token with scope \"read write follow push\" does not have required scope \"admin:write\""}`, string(b))
+}
+
 func TestInstancePatchTestSuite(t *testing.T) {
 	suite.Run(t, &InstancePatchTestSuite{})
 }
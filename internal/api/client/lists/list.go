@@ -27,6 +27,17 @@ import (
 const (
 	// BasePath is the base path for serving the lists API, minus the 'api' prefix
 	BasePath = "/v1/lists"
+	// IDKey is the key for a list ID in the path
+	IDKey = "id"
+	// BasePathWithID is the base path with the ID key in it, for serving one list
+	BasePathWithID = BasePath + "/:" + IDKey
+	// AccountsPath is the path for managing the accounts of one list
+	AccountsPath = BasePathWithID + "/accounts"
+
+	MaxIDKey   = "max_id"
+	SinceIDKey = "since_id"
+	MinIDKey   = "min_id"
+	LimitKey   = "limit"
 )
 
 type Module struct {
@@ -40,5 +51,15 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// create / get / update / delete lists
+	attachHandler(http.MethodPost, BasePath, m.ListCreatePOSTHandler)
 	attachHandler(http.MethodGet, BasePath, m.ListsGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, m.ListGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, m.ListUpdatePUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, m.ListDELETEHandler)
+
+	// get / add / remove list accounts
+	attachHandler(http.MethodGet, AccountsPath, m.ListAccountsGETHandler)
+	attachHandler(http.MethodPost, AccountsPath, m.ListAccountsPOSTHandler)
+	attachHandler(http.MethodDelete, AccountsPath, m.ListAccountsDELETEHandler)
 }
@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package lists_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/lists"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ListAccountsTestSuite struct {
+	ListsStandardTestSuite
+}
+
+func (suite *ListAccountsTestSuite) doRequest(
+	handler gin.HandlerFunc,
+	method string,
+	listID string,
+	query string,
+	accountIDs []string,
+	expectedHTTPStatus int,
+	expectedBody string,
+) ([]byte, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	requestURL := config.GetProtocol() + "://" + config.GetHost() + "/api/" + lists.BasePath + "/" + listID + "/accounts"
+	if query != "" {
+		requestURL += "?" + query
+	}
+
+	var body *strings.Reader
+	if accountIDs != nil {
+		body = strings.NewReader(url.Values{"account_ids[]": accountIDs}.Encode())
+	} else {
+		body = strings.NewReader("")
+	}
+
+	ctx.Request = httptest.NewRequest(method, requestURL, body)
+	ctx.Request.Header.Set("accept", "application/json")
+	if accountIDs != nil {
+		ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	}
+	ctx.AddParam("id", listID)
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	if expectedBody != "" && string(b) != expectedBody {
+		errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+	}
+
+	return b, errs.Combine()
+}
+
+func (suite *ListAccountsTestSuite) getListAccounts(listID string, query string) []*apimodel.Account {
+	b, err := suite.doRequest(suite.listsModule.ListAccountsGETHandler, http.MethodGet, listID, query, nil, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	accounts := []*apimodel.Account{}
+	if err := json.Unmarshal(b, &accounts); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return accounts
+}
+
+func (suite *ListAccountsTestSuite) TestGetListAccountsAll() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+
+	accounts := suite.getListAccounts(listID, "limit=0")
+	suite.Len(accounts, 2)
+}
+
+func (suite *ListAccountsTestSuite) TestGetListAccountsPaged() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+
+	accounts := suite.getListAccounts(listID, "limit=1")
+	if !suite.Len(accounts, 1) {
+		suite.FailNow("")
+	}
+
+	// Newest list entry is for admin_account.
+	suite.Equal(suite.testAccounts["admin_account"].ID, accounts[0].ID)
+}
+
+func (suite *ListAccountsTestSuite) TestAddListAccountNotFollowed() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+	targetAccountID := suite.testAccounts["remote_account_1"].ID
+
+	_, err := suite.doRequest(
+		suite.listsModule.ListAccountsPOSTHandler,
+		http.MethodPost,
+		listID,
+		"",
+		[]string{targetAccountID},
+		http.StatusNotFound,
+		`{"error":"Not Found"}`,
+	)
+	suite.NoError(err)
+}
+
+func (suite *ListAccountsTestSuite) TestAddListAccountAlreadyInList() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+	targetAccountID := suite.testAccounts["local_account_2"].ID
+
+	_, err := suite.doRequest(
+		suite.listsModule.ListAccountsPOSTHandler,
+		http.MethodPost,
+		listID,
+		"",
+		[]string{targetAccountID},
+		http.StatusUnprocessableEntity,
+		`{"error":"Unprocessable Entity: account `+targetAccountID+` is already in list `+listID+`"}`,
+	)
+	suite.NoError(err)
+}
+
+func (suite *ListAccountsTestSuite) TestRemoveListAccount() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+	targetAccountID := suite.testAccounts["local_account_2"].ID
+
+	_, err := suite.doRequest(
+		suite.listsModule.ListAccountsDELETEHandler,
+		http.MethodDelete,
+		listID,
+		url.Values{"account_ids[]": {targetAccountID}}.Encode(),
+		nil,
+		http.StatusOK,
+		`{}`,
+	)
+	suite.NoError(err)
+
+	// Only admin account should remain in the list.
+	accounts := suite.getListAccounts(listID, "limit=0")
+	if !suite.Len(accounts, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal(suite.testAccounts["admin_account"].ID, accounts[0].ID)
+}
+
+func TestListAccountsTestSuite(t *testing.T) {
+	suite.Run(t, &ListAccountsTestSuite{})
+}
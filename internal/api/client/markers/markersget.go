@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package markers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// MarkersGETHandler swagger:operation GET /api/v1/markers markersGet
+//
+// Get the read position markers of the requesting account for the given timelines.
+//
+// Timelines that have no marker yet are omitted from the response.
+//
+//	---
+//	tags:
+//	- markers
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: timeline[]
+//		type: array
+//		items:
+//			type: string
+//			enum:
+//				- home
+//				- notifications
+//		description: Timelines to get markers for. If not set, markers for all timelines are returned.
+//		in: query
+//		required: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:statuses
+//
+//	responses:
+//		'200':
+//			description: Requested markers.
+//			schema:
+//				"$ref": "#/definitions/markers"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) MarkersGETHandler(c *gin.Context) {
+	authed, errWithCode := oauth.AuthedScope(c, true, true, true, true, oauth.ScopeReadStatuses)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	names := []gtsmodel.MarkerName{}
+	for _, timeline := range c.QueryArray(TimelineKey) {
+		name := gtsmodel.MarkerName(timeline)
+		switch name {
+		case gtsmodel.MarkerNameHome, gtsmodel.MarkerNameNotifications:
+			names = append(names, name)
+		default:
+			err := fmt.Errorf("timeline %q not recognised, must be one of 'home', 'notifications'", timeline)
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+	}
+
+	if len(names) == 0 {
+		names = []gtsmodel.MarkerName{gtsmodel.MarkerNameHome, gtsmodel.MarkerNameNotifications}
+	}
+
+	marker, errWithCode := m.processor.Markers().Get(c.Request.Context(), authed.Account, names)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, marker)
+}
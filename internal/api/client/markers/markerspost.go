@@ -0,0 +1,133 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package markers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// MarkersPOSTHandler swagger:operation POST /api/v1/markers markersPost
+//
+// Update the read position markers of the requesting account for the given timelines.
+//
+// The updated markers are also streamed to the requesting account's
+// open user streams as a `marker` event, so other clients can follow along.
+//
+//	---
+//	tags:
+//	- markers
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: home[last_read_id]
+//		type: string
+//		description: ID of the last status read in the home timeline.
+//		in: formData
+//	-
+//		name: notifications[last_read_id]
+//		type: string
+//		description: ID of the last notification read.
+//		in: formData
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:statuses
+//
+//	responses:
+//		'200':
+//			description: Updated markers.
+//			schema:
+//				"$ref": "#/definitions/markers"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'409':
+//			description: conflict (marker was updated concurrently by another client)
+//		'500':
+//			description: internal server error
+func (m *Module) MarkersPOSTHandler(c *gin.Context) {
+	authed, errWithCode := oauth.AuthedScope(c, true, true, true, true, oauth.ScopeWriteStatuses)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.MarkerPostRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	markers := []*gtsmodel.Marker{}
+	for _, marker := range []*gtsmodel.Marker{
+		{Name: gtsmodel.MarkerNameHome, LastReadID: form.HomeLastReadID()},
+		{Name: gtsmodel.MarkerNameNotifications, LastReadID: form.NotificationsLastReadID()},
+	} {
+		if marker.LastReadID == "" {
+			continue
+		}
+
+		if !validate.ULID(marker.LastReadID) {
+			err := fmt.Errorf("%s last_read_id %q is not a valid id", marker.Name, marker.LastReadID)
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+
+		markers = append(markers, marker)
+	}
+
+	if len(markers) == 0 {
+		err := errors.New("no markers specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	marker, errWithCode := m.processor.Markers().Update(c.Request.Context(), authed.Account, markers)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, marker)
+}
@@ -0,0 +1,204 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package markers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/markers"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type MarkersTestSuite struct {
+	MarkersStandardTestSuite
+}
+
+func (suite *MarkersTestSuite) markers(
+	method string,
+	handler func(*gin.Context),
+	query string,
+	body io.Reader,
+	contentType string,
+	expectedHTTPStatus int,
+	expectedBody string,
+) (*apimodel.Marker, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+markers.BasePath+query, body)
+	ctx.Request.Header.Set("accept", "application/json")
+	if contentType != "" {
+		ctx.Request.Header.Set("content-type", contentType)
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := &apimodel.Marker{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, errs.Combine()
+}
+
+func (suite *MarkersTestSuite) postForm(form url.Values, expectedHTTPStatus int, expectedBody string) (*apimodel.Marker, error) {
+	return suite.markers(http.MethodPost, suite.markersModule.MarkersPOSTHandler, "", strings.NewReader(form.Encode()), "application/x-www-form-urlencoded", expectedHTTPStatus, expectedBody)
+}
+
+func (suite *MarkersTestSuite) get(query string, expectedHTTPStatus int, expectedBody string) (*apimodel.Marker, error) {
+	return suite.markers(http.MethodGet, suite.markersModule.MarkersGETHandler, query, nil, "", expectedHTTPStatus, expectedBody)
+}
+
+func (suite *MarkersTestSuite) TestGetMarkersNone() {
+	_, err := suite.get("", http.StatusOK, `{}`)
+	suite.NoError(err)
+}
+
+func (suite *MarkersTestSuite) TestPostMarkersForm() {
+	marker, err := suite.postForm(url.Values{"home[last_read_id]": {"01F8MH75CBF9JFX4ZAD54N0W0R"}}, http.StatusOK, "")
+	suite.NoError(err)
+	suite.Nil(marker.Notifications)
+	if suite.NotNil(marker.Home) {
+		suite.Equal("01F8MH75CBF9JFX4ZAD54N0W0R", marker.Home.LastReadID)
+		suite.Equal(0, marker.Home.Version)
+		suite.NotEmpty(marker.Home.UpdatedAt)
+	}
+
+	// Updating again bumps the version.
+	marker, err = suite.postForm(url.Values{"home[last_read_id]": {"01F8MHAAY43M6RJ473VQFCVH37"}}, http.StatusOK, "")
+	suite.NoError(err)
+	if suite.NotNil(marker.Home) {
+		suite.Equal("01F8MHAAY43M6RJ473VQFCVH37", marker.Home.LastReadID)
+		suite.Equal(1, marker.Home.Version)
+	}
+}
+
+func (suite *MarkersTestSuite) TestPostMarkersJSON() {
+	body := `{"notifications":{"last_read_id":"01F8Q0ANPTWW10DAKTX7BRPBJP"}}`
+	marker, err := suite.markers(http.MethodPost, suite.markersModule.MarkersPOSTHandler, "", strings.NewReader(body), "application/json", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Nil(marker.Home)
+	if suite.NotNil(marker.Notifications) {
+		suite.Equal("01F8Q0ANPTWW10DAKTX7BRPBJP", marker.Notifications.LastReadID)
+	}
+}
+
+func (suite *MarkersTestSuite) TestPostMarkersInvalid() {
+	_, err := suite.postForm(url.Values{"home[last_read_id]": {"not an id"}}, http.StatusBadRequest, `{"error":"Bad Request: home last_read_id \"not an id\" is not a valid id"}`)
+	suite.NoError(err)
+
+	_, err = suite.postForm(url.Values{}, http.StatusBadRequest, `{"error":"Bad Request: no markers specified"}`)
+	suite.NoError(err)
+}
+
+func (suite *MarkersTestSuite) TestPostMarkersStreams() {
+	account := suite.testAccounts["local_account_1"]
+
+	// another client of zork's has the user stream open
+	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), account, stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	_, err := suite.postForm(url.Values{"notifications[last_read_id]": {"01F8Q0ANPTWW10DAKTX7BRPBJP"}}, http.StatusOK, "")
+	suite.NoError(err)
+
+	msg := <-wssStream.Messages
+	suite.Equal(stream.EventTypeMarker, msg.Event)
+	suite.EqualValues([]string{stream.TimelineHome}, msg.Stream)
+
+	streamed := &apimodel.Marker{}
+	suite.NoError(json.Unmarshal([]byte(msg.Payload), streamed))
+	suite.Nil(streamed.Home)
+	if suite.NotNil(streamed.Notifications) {
+		suite.Equal("01F8Q0ANPTWW10DAKTX7BRPBJP", streamed.Notifications.LastReadID)
+	}
+}
+
+func (suite *MarkersTestSuite) TestGetMarkers() {
+	_, err := suite.postForm(url.Values{
+		"home[last_read_id]":          {"01F8MH75CBF9JFX4ZAD54N0W0R"},
+		"notifications[last_read_id]": {"01F8Q0ANPTWW10DAKTX7BRPBJP"},
+	}, http.StatusOK, "")
+	suite.NoError(err)
+
+	// Both markers when no timeline is given.
+	marker, err := suite.get("", http.StatusOK, "")
+	suite.NoError(err)
+	suite.NotNil(marker.Home)
+	suite.NotNil(marker.Notifications)
+
+	// Only the requested marker otherwise.
+	marker, err = suite.get("?timeline[]=notifications", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Nil(marker.Home)
+	if suite.NotNil(marker.Notifications) {
+		suite.Equal("01F8Q0ANPTWW10DAKTX7BRPBJP", marker.Notifications.LastReadID)
+	}
+
+	_, err = suite.get("?timeline[]=public", http.StatusBadRequest, `{"error":"Bad Request: timeline \"public\" not recognised, must be one of 'home', 'notifications'"}`)
+	suite.NoError(err)
+}
+
+func TestMarkersTestSuite(t *testing.T) {
+	suite.Run(t, new(MarkersTestSuite))
+}
@@ -0,0 +1,134 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package polls_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/polls"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollVoteTestSuite struct {
+	PollsStandardTestSuite
+}
+
+func (suite *PollVoteTestSuite) vote(accountKey string, choices []string, expectedHTTPStatus int, expectedBody string) (*apimodel.Poll, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+
+	// create the request
+	form := url.Values{"choices[]": choices}
+	path := strings.Replace(polls.VotesPath, ":"+polls.IDKey, suite.testPoll.ID, 1)
+	ctx.Request = httptest.NewRequest(http.MethodPost, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, strings.NewReader(form.Encode()))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx.AddParam(polls.IDKey, suite.testPoll.ID)
+
+	// trigger the handler
+	suite.pollsModule.PollVotePOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := &apimodel.Poll{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, errs.Combine()
+}
+
+func (suite *PollVoteTestSuite) TestVoteOK() {
+	poll, err := suite.vote("local_account_1", []string{"1"}, http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal(suite.testPoll.ID, poll.ID)
+	suite.Equal(1, poll.VotesCount)
+	suite.True(*poll.Voted)
+	suite.Equal([]int{1}, *poll.OwnVotes)
+	if suite.Len(poll.Options, 2) {
+		suite.Equal("coffee", poll.Options[1].Title)
+		suite.Equal(1, *poll.Options[1].VotesCount)
+		suite.Equal(0, *poll.Options[0].VotesCount)
+	}
+}
+
+func (suite *PollVoteTestSuite) TestVoteTwice() {
+	_, err := suite.vote("local_account_1", []string{"0"}, http.StatusOK, "")
+	suite.NoError(err)
+
+	_, err = suite.vote("local_account_1", []string{"1"}, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: you have already voted in this poll"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollVoteTestSuite) TestVoteMultipleInSingleChoice() {
+	_, err := suite.vote("local_account_1", []string{"0", "1"}, http.StatusBadRequest, `{"error":"Bad Request: invalid choices for this poll"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollVoteTestSuite) TestVoteOutOfRange() {
+	_, err := suite.vote("local_account_1", []string{"2"}, http.StatusBadRequest, `{"error":"Bad Request: invalid choices for this poll"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollVoteTestSuite) TestVoteOwnPoll() {
+	_, err := suite.vote("admin_account", []string{"0"}, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: you can't vote in your own poll"}`)
+	suite.NoError(err)
+}
+
+func TestPollVoteTestSuite(t *testing.T) {
+	suite.Run(t, new(PollVoteTestSuite))
+}
@@ -0,0 +1,231 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/push"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const (
+	testEndpoint = "https://push.example.org/push/01H1G8RXPVDH8E3YV8K0GN4S2Q"
+	testP256dh   = "BHtyye27n_ObWVB5xBivu1fbwEL7Fey0-xPSXQtZK6nwC_iRHZ1AETnEQyXeG-cr_2HU-LtEVYRhGF8DXZNulJo"
+	testAuth     = "NwpU7I5e-6GFB4FMCNkSJQ"
+)
+
+type PushSubscriptionTestSuite struct {
+	PushStandardTestSuite
+}
+
+func (suite *PushSubscriptionTestSuite) subscription(
+	method string,
+	handler func(*gin.Context),
+	body io.Reader,
+	contentType string,
+	expectedHTTPStatus int,
+	expectedBody string,
+) (*apimodel.PushSubscription, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+push.BasePath, body)
+	ctx.Request.Header.Set("accept", "application/json")
+	if contentType != "" {
+		ctx.Request.Header.Set("content-type", contentType)
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := &apimodel.PushSubscription{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, errs.Combine()
+}
+
+func (suite *PushSubscriptionTestSuite) create(form url.Values, expectedHTTPStatus int, expectedBody string) (*apimodel.PushSubscription, error) {
+	return suite.subscription(
+		http.MethodPost,
+		suite.pushModule.PushSubscriptionPOSTHandler,
+		strings.NewReader(form.Encode()),
+		"application/x-www-form-urlencoded",
+		expectedHTTPStatus,
+		expectedBody,
+	)
+}
+
+func (suite *PushSubscriptionTestSuite) validForm() url.Values {
+	return url.Values{
+		"subscription[endpoint]":       {testEndpoint},
+		"subscription[keys][p256dh]":   {testP256dh},
+		"subscription[keys][auth]":     {testAuth},
+		"data[alerts][mention]":        {"true"},
+		"data[alerts][favourite]":      {"true"},
+		"data[alerts][follow_request]": {"false"},
+	}
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscription() {
+	subscription, err := suite.create(suite.validForm(), http.StatusOK, "")
+	suite.NoError(err)
+
+	suite.NotEmpty(subscription.ID)
+	suite.Equal(testEndpoint, subscription.Endpoint)
+	suite.Equal("all", subscription.Policy)
+	suite.Equal(apimodel.PushSubscriptionAlerts{
+		Mention:   true,
+		Favourite: true,
+	}, *subscription.Alerts)
+
+	// server key should be the instance vapid key
+	keyPair, err := suite.db.GetVAPIDKeyPair(context.Background())
+	suite.NoError(err)
+	suite.Equal(keyPair.PublicKey, subscription.ServerKey)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscriptionReplacesExisting() {
+	first, err := suite.create(suite.validForm(), http.StatusOK, "")
+	suite.NoError(err)
+
+	second, err := suite.create(suite.validForm(), http.StatusOK, "")
+	suite.NoError(err)
+	suite.NotEqual(first.ID, second.ID)
+
+	subscriptions, err := suite.db.GetWebPushSubscriptionsByAccountID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	if suite.Len(subscriptions, 1) {
+		suite.Equal(second.ID, subscriptions[0].ID)
+	}
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscriptionHTTPEndpoint() {
+	form := suite.validForm()
+	form.Set("subscription[endpoint]", "http://push.example.org/push/01H1G8RXPVDH8E3YV8K0GN4S2Q")
+
+	_, err := suite.create(form, http.StatusBadRequest, `{"error":"Bad Request: push subscription endpoint must be an absolute https url"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscriptionNoKeys() {
+	form := suite.validForm()
+	form.Del("subscription[keys][auth]")
+
+	_, err := suite.create(form, http.StatusBadRequest, `{"error":"Bad Request: push subscription keys p256dh and auth must both be provided"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscriptionBadPolicy() {
+	form := suite.validForm()
+	form.Set("data[policy]", "mutuals")
+
+	_, err := suite.create(form, http.StatusBadRequest, `{"error":"Bad Request: push subscription policy must be one of 'all', 'followed', 'follower', 'none'"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestGetSubscription() {
+	created, err := suite.create(suite.validForm(), http.StatusOK, "")
+	suite.NoError(err)
+
+	subscription, err := suite.subscription(http.MethodGet, suite.pushModule.PushSubscriptionGETHandler, nil, "", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal(created, subscription)
+}
+
+func (suite *PushSubscriptionTestSuite) TestGetSubscriptionNotFound() {
+	_, err := suite.subscription(http.MethodGet, suite.pushModule.PushSubscriptionGETHandler, nil, "", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestUpdateSubscription() {
+	created, err := suite.create(suite.validForm(), http.StatusOK, "")
+	suite.NoError(err)
+
+	body := `{"data":{"alerts":{"mention":false,"follow":true},"policy":"followed"}}`
+	subscription, err := suite.subscription(http.MethodPut, suite.pushModule.PushSubscriptionPUTHandler, strings.NewReader(body), "application/json", http.StatusOK, "")
+	suite.NoError(err)
+
+	suite.Equal(created.ID, subscription.ID)
+	suite.Equal("followed", subscription.Policy)
+	suite.Equal(apimodel.PushSubscriptionAlerts{
+		Follow:    true,
+		Favourite: true,
+	}, *subscription.Alerts)
+}
+
+func (suite *PushSubscriptionTestSuite) TestDeleteSubscription() {
+	_, err := suite.create(suite.validForm(), http.StatusOK, "")
+	suite.NoError(err)
+
+	_, err = suite.subscription(http.MethodDelete, suite.pushModule.PushSubscriptionDELETEHandler, nil, "", http.StatusOK, `{}`)
+	suite.NoError(err)
+
+	_, err = suite.subscription(http.MethodGet, suite.pushModule.PushSubscriptionGETHandler, nil, "", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func TestPushSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, new(PushSubscriptionTestSuite))
+}
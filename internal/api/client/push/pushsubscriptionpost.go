@@ -0,0 +1,191 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// PushSubscriptionPOSTHandler swagger:operation POST /api/v1/push/subscription pushSubscriptionCreate
+//
+// Create a push subscription for the current access token, replacing any existing push subscription of the token.
+//
+// Push messages are encrypted with the given keys as described in RFC 8291,
+// and signed with the server key of the returned subscription as described
+// in RFC 8292.
+//
+//	---
+//	tags:
+//	- push
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: subscription[endpoint]
+//		type: string
+//		description: The https URL of the push endpoint.
+//		in: formData
+//		required: true
+//	-
+//		name: subscription[keys][p256dh]
+//		type: string
+//		description: User agent public key. Base64url-encoded P-256 ECDH public key.
+//		in: formData
+//		required: true
+//	-
+//		name: subscription[keys][auth]
+//		type: string
+//		description: Auth secret. Base64url-encoded 16 bytes of random data.
+//		in: formData
+//		required: true
+//	-
+//		name: data[alerts][follow]
+//		type: boolean
+//		description: Receive a push notification when someone has followed you?
+//		in: formData
+//	-
+//		name: data[alerts][follow_request]
+//		type: boolean
+//		description: Receive a push notification when someone has requested to follow you?
+//		in: formData
+//	-
+//		name: data[alerts][favourite]
+//		type: boolean
+//		description: Receive a push notification when a status you created has been favourited by someone else?
+//		in: formData
+//	-
+//		name: data[alerts][mention]
+//		type: boolean
+//		description: Receive a push notification when someone else has mentioned you in a status?
+//		in: formData
+//	-
+//		name: data[alerts][reblog]
+//		type: boolean
+//		description: Receive a push notification when a status you created has been boosted by someone else?
+//		in: formData
+//	-
+//		name: data[alerts][poll]
+//		type: boolean
+//		description: Receive a push notification when a poll you voted in or created has ended?
+//		in: formData
+//	-
+//		name: data[alerts][status]
+//		type: boolean
+//		description: Receive a push notification when someone you enabled notifications for has posted a status?
+//		in: formData
+//	-
+//		name: data[alerts][update]
+//		type: boolean
+//		description: Receive a push notification when a status you boosted has been edited?
+//		in: formData
+//	-
+//		name: data[alerts][admin.report]
+//		type: boolean
+//		description: Receive a push notification when a new report has been created? Only for moderators and admins.
+//		in: formData
+//	-
+//		name: data[policy]
+//		type: string
+//		description: |-
+//			Which accounts to receive push notifications from.
+//			all = Receive push notifications from all accounts
+//			followed = Receive push notifications from accounts you follow
+//			follower = Receive push notifications from accounts that follow you
+//			none = Receive no push notifications
+//		enum:
+//			- all
+//			- followed
+//			- follower
+//			- none
+//		in: formData
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- push
+//
+//	responses:
+//		'200':
+//			description: The newly created push subscription.
+//			schema:
+//				"$ref": "#/definitions/pushSubscription"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) PushSubscriptionPOSTHandler(c *gin.Context) {
+	authed, errWithCode := oauth.AuthedScope(c, true, true, true, true, oauth.ScopePush)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.PushSubscriptionCreateRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if err := validate.PushEndpoint(form.Subscription.Endpoint); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if err := validate.PushKeys(form.Subscription.Keys.P256dh, form.Subscription.Keys.Auth); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.Data.Policy != nil {
+		if err := validate.PushPolicy(gtsmodel.WebPushPolicy(*form.Data.Policy)); err != nil {
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+	}
+
+	subscription, errWithCode := m.processor.Push().SubscriptionCreate(c.Request.Context(), authed, form)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, subscription)
+}
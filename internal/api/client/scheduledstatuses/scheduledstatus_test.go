@@ -0,0 +1,226 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package scheduledstatuses_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/scheduledstatuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ScheduledStatusTestSuite struct {
+	ScheduledStatusesStandardTestSuite
+}
+
+func (suite *ScheduledStatusTestSuite) scheduledStatuses(
+	method string,
+	handler func(*gin.Context),
+	id string,
+	query string,
+	form url.Values,
+	expectedHTTPStatus int,
+	expectedBody string,
+	out interface{},
+) (http.Header, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	path := config.GetProtocol() + "://" + config.GetHost() + "/api" + scheduledstatuses.BasePath
+	if id != "" {
+		path += "/" + id
+		ctx.AddParam(scheduledstatuses.IDKey, id)
+	}
+
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	ctx.Request = httptest.NewRequest(method, path+query, body)
+	ctx.Request.Header.Set("accept", "application/json")
+	if form != nil {
+		ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return result.Header, errs.Combine()
+	}
+
+	if err := json.Unmarshal(b, out); err != nil {
+		return nil, err
+	}
+
+	return result.Header, errs.Combine()
+}
+
+// schedule creates a new scheduled status for
+// local_account_1, an hour or so in the future.
+func (suite *ScheduledStatusTestSuite) schedule(text string) *apimodel.ScheduledStatus {
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      text,
+			Visibility:  apimodel.VisibilityPublic,
+			ScheduledAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+	}
+
+	scheduledStatus, errWithCode := suite.processor.Status().ScheduledCreate(
+		context.Background(),
+		suite.testAccounts["local_account_1"],
+		suite.testApplications["application_1"],
+		form,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	return scheduledStatus
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatusesNone() {
+	_, err := suite.scheduledStatuses(http.MethodGet, suite.scheduledStatusesModule.ScheduledStatusesGETHandler, "", "", nil, http.StatusOK, `[]`, nil)
+	suite.NoError(err)
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatuses() {
+	first := suite.schedule("first announcement")
+	// ULIDs only sort by time to the millisecond,
+	// so make sure the second one is minted later.
+	time.Sleep(2 * time.Millisecond)
+	second := suite.schedule("second announcement")
+
+	// Newest first.
+	scheduledStatuses := []*apimodel.ScheduledStatus{}
+	_, err := suite.scheduledStatuses(http.MethodGet, suite.scheduledStatusesModule.ScheduledStatusesGETHandler, "", "", nil, http.StatusOK, "", &scheduledStatuses)
+	suite.NoError(err)
+	if suite.Len(scheduledStatuses, 2) {
+		suite.Equal(second.ID, scheduledStatuses[0].ID)
+		suite.Equal(first.ID, scheduledStatuses[1].ID)
+	}
+
+	// Paging.
+	scheduledStatuses = []*apimodel.ScheduledStatus{}
+	header, err := suite.scheduledStatuses(http.MethodGet, suite.scheduledStatusesModule.ScheduledStatusesGETHandler, "", "?limit=1", nil, http.StatusOK, "", &scheduledStatuses)
+	suite.NoError(err)
+	if suite.Len(scheduledStatuses, 1) {
+		suite.Equal(second.ID, scheduledStatuses[0].ID)
+	}
+	suite.Equal(`<http://localhost:8080/api/v1/scheduled_statuses?limit=1&max_id=`+second.ID+`>; rel="next", <http://localhost:8080/api/v1/scheduled_statuses?limit=1&min_id=`+second.ID+`>; rel="prev"`, header.Get("link"))
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatus() {
+	scheduled := suite.schedule("an announcement")
+
+	scheduledStatus := &apimodel.ScheduledStatus{}
+	_, err := suite.scheduledStatuses(http.MethodGet, suite.scheduledStatusesModule.ScheduledStatusGETHandler, scheduled.ID, "", nil, http.StatusOK, "", scheduledStatus)
+	suite.NoError(err)
+	suite.Equal(scheduled.ID, scheduledStatus.ID)
+	suite.Equal(scheduled.ScheduledAt, scheduledStatus.ScheduledAt)
+	suite.Equal("an announcement", scheduledStatus.Params.Text)
+	suite.Equal(apimodel.VisibilityPublic, scheduledStatus.Params.Visibility)
+	suite.Empty(scheduledStatus.MediaAttachments)
+
+	_, err = suite.scheduledStatuses(http.MethodGet, suite.scheduledStatusesModule.ScheduledStatusGETHandler, "01H1MTNY2V4XGB4P6QJ3YDEXF8", "", nil, http.StatusNotFound, `{"error":"Not Found"}`, nil)
+	suite.NoError(err)
+}
+
+func (suite *ScheduledStatusTestSuite) TestUpdateScheduledStatus() {
+	scheduled := suite.schedule("an announcement")
+	newScheduledAt := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+
+	scheduledStatus := &apimodel.ScheduledStatus{}
+	_, err := suite.scheduledStatuses(http.MethodPut, suite.scheduledStatusesModule.ScheduledStatusPUTHandler, scheduled.ID, "", url.Values{
+		"scheduled_at": {newScheduledAt.Format(time.RFC3339)},
+	}, http.StatusOK, "", scheduledStatus)
+	suite.NoError(err)
+	suite.Equal(scheduled.ID, scheduledStatus.ID)
+	suite.Equal("an announcement", scheduledStatus.Params.Text)
+
+	dbScheduledStatus, err := suite.db.GetScheduledStatusByID(context.Background(), scheduled.ID)
+	suite.NoError(err)
+	suite.True(newScheduledAt.Equal(dbScheduledStatus.ScheduledAt))
+
+	_, err = suite.scheduledStatuses(http.MethodPut, suite.scheduledStatusesModule.ScheduledStatusPUTHandler, scheduled.ID, "", url.Values{
+		"scheduled_at": {"tomorrow, probably"},
+	}, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: scheduled_at tomorrow, probably could not be parsed as an ISO 8601 datetime"}`, nil)
+	suite.NoError(err)
+}
+
+func (suite *ScheduledStatusTestSuite) TestDeleteScheduledStatus() {
+	scheduled := suite.schedule("an announcement")
+
+	_, err := suite.scheduledStatuses(http.MethodDelete, suite.scheduledStatusesModule.ScheduledStatusDELETEHandler, scheduled.ID, "", nil, http.StatusOK, `{}`, nil)
+	suite.NoError(err)
+
+	_, err = suite.db.GetScheduledStatusByID(context.Background(), scheduled.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Deleting again should 404.
+	_, err = suite.scheduledStatuses(http.MethodDelete, suite.scheduledStatusesModule.ScheduledStatusDELETEHandler, scheduled.ID, "", nil, http.StatusNotFound, `{"error":"Not Found"}`, nil)
+	suite.NoError(err)
+}
+
+func TestScheduledStatusTestSuite(t *testing.T) {
+	suite.Run(t, &ScheduledStatusTestSuite{})
+}
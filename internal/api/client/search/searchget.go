@@ -36,10 +36,81 @@ import (
 //
 // If statuses are in the result, they will be returned in descending chronological order (newest first), with sequential IDs (bigger = newer).
 //
+// If the query isn't an exact match for an account or status, accounts are searched by username or display name prefix,
+// statuses are searched by their text, and hashtags are searched by name prefix. Only statuses that the requester authored,
+// interacted with, or that are public, will be returned.
+//
 //	---
 //	tags:
 //	- search
 //
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: q
+//		type: string
+//		description: Query to search for.
+//		in: query
+//		required: true
+//	-
+//		name: type
+//		type: string
+//		description: >-
+//			Type of results to return, one of `accounts`, `hashtags` or `statuses`.
+//			If not set, all types will be returned.
+//		in: query
+//		required: false
+//	-
+//		name: resolve
+//		type: boolean
+//		description: Attempt to resolve the query by looking it up on a remote instance.
+//		default: false
+//		in: query
+//		required: false
+//	-
+//		name: following
+//		type: boolean
+//		description: Only return accounts that the requester follows.
+//		default: false
+//		in: query
+//		required: false
+//	-
+//		name: account_id
+//		type: string
+//		description: Only return statuses authored by the account with this ID.
+//		in: query
+//		required: false
+//	-
+//		name: max_id
+//		type: string
+//		description: Return only results with an ID *LOWER* than the given max ID.
+//		in: query
+//		required: false
+//	-
+//		name: min_id
+//		type: string
+//		description: Return only results with an ID *HIGHER* than the given min ID.
+//		in: query
+//		required: false
+//	-
+//		name: limit
+//		type: integer
+//		description: Number of results of each type to return.
+//		default: 20
+//		maximum: 40
+//		minimum: 1
+//		in: query
+//		required: false
+//	-
+//		name: offset
+//		type: integer
+//		description: Number of results of each type to skip.
+//		default: 0
+//		in: query
+//		required: false
+//
 //	security:
 //	- OAuth2 Bearer:
 //		- read:search
@@ -63,9 +134,9 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) SearchGETHandler(c *gin.Context) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := oauth.AuthedScope(c, true, true, true, true, oauth.ScopeReadSearch)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -93,6 +164,16 @@ func (m *Module) SearchGETHandler(c *gin.Context) {
 		return
 	}
 
+	searchType := c.Query(TypeKey)
+	switch searchType {
+	case "", TypeAccounts, TypeHashtags, TypeStatuses:
+		// fine
+	default:
+		err := fmt.Errorf("%s must be one of %s, %s or %s", TypeKey, TypeAccounts, TypeHashtags, TypeStatuses)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
 	resolve := false
 	resolveString := c.Query(ResolveKey)
 	if resolveString != "" {
@@ -105,7 +186,7 @@ func (m *Module) SearchGETHandler(c *gin.Context) {
 		}
 	}
 
-	limit := 2
+	limit := 20
 	limitString := c.Query(LimitKey)
 	if limitString != "" {
 		i, err := strconv.ParseInt(limitString, 10, 32)
@@ -151,7 +232,7 @@ func (m *Module) SearchGETHandler(c *gin.Context) {
 		AccountID:         c.Query(AccountIDKey),
 		MaxID:             c.Query(MaxIDKey),
 		MinID:             c.Query(MinIDKey),
-		Type:              c.Query(TypeKey),
+		Type:              searchType,
 		ExcludeUnreviewed: excludeUnreviewed,
 		Query:             query,
 		Resolve:           resolve,
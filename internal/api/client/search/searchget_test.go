@@ -279,6 +279,49 @@ func (suite *SearchGetTestSuite) TestSearchBlockedDomainNamestring() {
 	suite.Len(searchResult.Hashtags, 0)
 }
 
+func (suite *SearchGetTestSuite) TestSearchAccountsByText() {
+	query := "turtle"
+	resolve := false
+
+	searchResult, err := suite.testSearch(query, resolve, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(searchResult.Accounts, 1) {
+		suite.FailNow("expected 1 account in search results")
+	}
+
+	suite.Equal("1happyturtle", searchResult.Accounts[0].Username)
+}
+
+func (suite *SearchGetTestSuite) TestSearchHashtagsByText() {
+	query := "welc"
+	resolve := false
+
+	searchResult, err := suite.testSearch(query, resolve, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(searchResult.Hashtags, 1) {
+		suite.FailNow("expected 1 hashtag in search results")
+	}
+
+	suite.Equal("welcome", searchResult.Hashtags[0].Name)
+}
+
+func (suite *SearchGetTestSuite) TestSearchBadType() {
+	requestPath := fmt.Sprintf("%s?q=%s&type=%s", search.BasePathV1, "turtle", "users")
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, requestPath)
+
+	suite.searchModule.SearchGETHandler(ctx)
+
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
 func TestSearchGetTestSuite(t *testing.T) {
 	suite.Run(t, &SearchGetTestSuite{})
 }
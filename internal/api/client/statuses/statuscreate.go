@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -35,6 +36,9 @@ import (
 //
 // Create a new status.
 //
+// If scheduled_at is set, the status will instead be scheduled for publishing
+// at that time, and the scheduled status will be returned rather than a status.
+//
 // The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
 // The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
 //
@@ -56,7 +60,7 @@ import (
 //
 //	responses:
 //		'200':
-//			description: "The newly created status."
+//			description: "The newly created status, or the newly scheduled status if scheduled_at was set."
 //			schema:
 //				"$ref": "#/definitions/status"
 //		'400':
@@ -69,12 +73,14 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: scheduled_at was invalid or not far enough in the future
 //		'500':
 //			description: internal server error
 func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
-	authed, err := oauth.Authed(c, true, true, true, true)
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	authed, errWithCode := oauth.AuthedScope(c, true, true, true, true, oauth.ScopeWriteStatuses)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
@@ -103,6 +109,17 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if form.ScheduledAt != "" {
+		apiScheduledStatus, errWithCode := m.processor.Status().ScheduledCreate(c.Request.Context(), authed.Account, authed.Application, form)
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+
+		c.JSON(http.StatusOK, apiScheduledStatus)
+		return
+	}
+
 	apiStatus, errWithCode := m.processor.Status().Create(c.Request.Context(), authed.Account, authed.Application, form)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -112,6 +129,13 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, apiStatus)
 }
 
+// poll expiry bounds, in seconds; these match
+// the polls configuration served by the instance.
+const (
+	pollMinExpiresIn = 300
+	pollMaxExpiresIn = 2629746
+)
+
 func validateCreateStatus(form *apimodel.AdvancedStatusCreateForm) error {
 	hasStatus := form.Status != ""
 	hasMedia := len(form.MediaIDs) != 0
@@ -145,14 +169,23 @@ func validateCreateStatus(form *apimodel.AdvancedStatusCreateForm) error {
 		if form.Poll.Options == nil {
 			return errors.New("poll with no options")
 		}
+		if len(form.Poll.Options) < 2 {
+			return errors.New("poll must have at least 2 options")
+		}
 		if len(form.Poll.Options) > maxPollOptions {
 			return fmt.Errorf("too many poll options provided, %d provided but limit is %d", len(form.Poll.Options), maxPollOptions)
 		}
 		for _, p := range form.Poll.Options {
+			if strings.TrimSpace(p) == "" {
+				return errors.New("poll option cannot be empty")
+			}
 			if length := len([]rune(p)); length > maxPollChars {
 				return fmt.Errorf("poll option too long, %d characters provided but limit is %d", length, maxPollChars)
 			}
 		}
+		if form.Poll.ExpiresIn < pollMinExpiresIn || form.Poll.ExpiresIn > pollMaxExpiresIn {
+			return fmt.Errorf("poll expires_in must be between %d and %d seconds", pollMinExpiresIn, pollMaxExpiresIn)
+		}
 	}
 
 	if form.SpoilerText != "" {
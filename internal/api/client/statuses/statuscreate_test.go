@@ -26,6 +26,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
@@ -146,6 +147,35 @@ func (suite *StatusCreateTestSuite) TestPostNewStatusMarkdown() {
 }
 
 // mention an account that is not yet known to the instance -- it should be looked up and put in the db
+func (suite *StatusCreateTestSuite) TestPostNewStatusReadOnlyToken() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	oauthToken.SetScope("read")
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", statuses.BasePath), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"status":     {"this should not be posted"},
+		"visibility": {string(apimodel.VisibilityPublic)},
+	}
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+
+	suite.EqualValues(http.StatusForbidden, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Forbidden: token with scope \"read\" does not have required scope \"write:statuses\""}`, string(b))
+}
+
 func (suite *StatusCreateTestSuite) TestMentionUnknownAccount() {
 	// first remove remote account 1 from the database so it gets looked up again
 	remoteAccount := suite.testAccounts["remote_account_1"]
@@ -221,6 +251,85 @@ func (suite *StatusCreateTestSuite) TestPostAnotherNewStatus() {
 	suite.Equal("<p><a href=\"http://localhost:8080/tags/test\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>test</span></a> alright, should be able to post <a href=\"http://localhost:8080/tags/links\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>links</span></a> with fragments in them now, let's see........<br><br><a href=\"https://docs.gotosocial.org/en/latest/user_guide/posts/#links\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">https://docs.gotosocial.org/en/latest/user_guide/posts/#links</a><br><br><a href=\"http://localhost:8080/tags/gotosocial\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>gotosocial</span></a><br><br>(tobi remember to pull the docker image challenge)</p>", statusReply.Content)
 }
 
+func (suite *StatusCreateTestSuite) TestPostNewStatusWithPoll() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", statuses.BasePath), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"status":            {"tea or coffee?"},
+		"poll[options][]":   {"tea", "coffee"},
+		"poll[expires_in]":  {"3600"},
+		"poll[multiple]":    {"true"},
+		"poll[hide_totals]": {"false"},
+	}
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	statusReply := &apimodel.Status{}
+	err = json.Unmarshal(b, statusReply)
+	suite.NoError(err)
+
+	suite.NotNil(statusReply.Poll)
+	suite.True(statusReply.Poll.Multiple)
+	suite.False(statusReply.Poll.Expired)
+	suite.Equal(0, statusReply.Poll.VotesCount)
+	if suite.Len(statusReply.Poll.Options, 2) {
+		suite.Equal("tea", statusReply.Poll.Options[0].Title)
+		suite.Equal("coffee", statusReply.Poll.Options[1].Title)
+	}
+
+	// poll should be stored along with the status
+	dbStatus, err := suite.db.GetStatusByID(context.Background(), statusReply.ID)
+	suite.NoError(err)
+	suite.Equal(statusReply.Poll.ID, dbStatus.PollID)
+	suite.NotNil(dbStatus.Poll)
+	suite.Equal("Question", dbStatus.ActivityStreamsType)
+}
+
+func (suite *StatusCreateTestSuite) TestPostNewStatusWithPollOneOption() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", statuses.BasePath), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"status":           {"tea?"},
+		"poll[options][]":  {"tea"},
+		"poll[expires_in]": {"3600"},
+	}
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: poll must have at least 2 options"}`, string(b))
+}
+
 func (suite *StatusCreateTestSuite) TestPostNewStatusWithEmoji() {
 	t := suite.testTokens["local_account_1"]
 	oauthToken := oauth.DBTokenToToken(t)
@@ -392,6 +501,78 @@ func (suite *StatusCreateTestSuite) TestAttachNewMediaSuccess() {
 	suite.Equal(statusResponse.ID, gtsAttachment.StatusID)
 }
 
+func (suite *StatusCreateTestSuite) TestPostNewScheduledStatus() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", statuses.BasePath), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"status":       {"this status will be posted later"},
+		"visibility":   {string(apimodel.VisibilityPublic)},
+		"scheduled_at": {time.Now().Add(time.Hour).UTC().Format(time.RFC3339)},
+	}
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	scheduledStatusReply := &apimodel.ScheduledStatus{}
+	err = json.Unmarshal(b, scheduledStatusReply)
+	suite.NoError(err)
+
+	suite.NotEmpty(scheduledStatusReply.ID)
+	suite.NotEmpty(scheduledStatusReply.ScheduledAt)
+	suite.Equal("this status will be posted later", scheduledStatusReply.Params.Text)
+	suite.Equal(apimodel.VisibilityPublic, scheduledStatusReply.Params.Visibility)
+	suite.Equal(suite.testApplications["application_1"].ID, scheduledStatusReply.Params.ApplicationID)
+
+	// the status should be stored for later, not created now
+	scheduledStatus, err := suite.db.GetScheduledStatusByID(context.Background(), scheduledStatusReply.ID)
+	suite.NoError(err)
+	suite.Equal(suite.testAccounts["local_account_1"].ID, scheduledStatus.AccountID)
+}
+
+func (suite *StatusCreateTestSuite) TestPostNewScheduledStatusTooSoon() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", statuses.BasePath), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"status":       {"this status will be posted a bit too soon"},
+		"scheduled_at": {time.Now().Add(time.Minute).UTC().Format(time.RFC3339)},
+	}
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+
+	suite.EqualValues(http.StatusUnprocessableEntity, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Unprocessable Entity: scheduled_at must be at least 5m0s in the future"}`, string(b))
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
@@ -0,0 +1,183 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusEditTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) newContext(recorder *httptest.ResponseRecorder, method string, path string, statusID string, account string) *gin.Context {
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[account]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[account])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[account])
+	ctx.Request = httptest.NewRequest(method, fmt.Sprintf("http://localhost:8080%s", strings.Replace(path, ":id", statusID, 1)), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: statusID,
+		},
+	}
+
+	return ctx
+}
+
+func (suite *StatusEditTestSuite) editStatus(statusID string, account string, form url.Values) (*apimodel.Status, int) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, statuses.BasePathWithID, statusID, account)
+	ctx.Request.Form = form
+	suite.statusModule.StatusEditPUTHandler(ctx)
+
+	if recorder.Code != http.StatusOK {
+		return nil, recorder.Code
+	}
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiStatus := &apimodel.Status{}
+	if err := json.Unmarshal(b, apiStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiStatus, recorder.Code
+}
+
+func (suite *StatusEditTestSuite) TestEditStatus() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	apiStatus, code := suite.editStatus(targetStatus.ID, "local_account_1", url.Values{
+		"status":       {"hello everyone! (edited)"},
+		"spoiler_text": {"an edit"},
+		"sensitive":    {"true"},
+	})
+	suite.Equal(http.StatusOK, code)
+	suite.Equal(targetStatus.ID, apiStatus.ID)
+	suite.Equal("<p>hello everyone! (edited)</p>", apiStatus.Content)
+	suite.Equal("an edit", apiStatus.SpoilerText)
+	suite.True(apiStatus.Sensitive)
+	suite.NotNil(apiStatus.EditedAt)
+
+	// the previous version should have been stored
+	dbEdits, err := suite.db.GetStatusEditsByStatusID(context.Background(), targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(dbEdits, 1) {
+		suite.Equal("hello everyone!", dbEdits[0].Content)
+		suite.Equal("hello everyone!", dbEdits[0].Text)
+	}
+
+	// history should include the previous version and the current one
+	recorder := httptest.NewRecorder()
+	hctx := suite.newContext(recorder, http.MethodGet, statuses.HistoryPath, targetStatus.ID, "local_account_2")
+	suite.statusModule.StatusHistoryGETHandler(hctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	history := []*apimodel.StatusEdit{}
+	if err := json.NewDecoder(recorder.Body).Decode(&history); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(history, 2) {
+		suite.Equal("hello everyone!", history[0].Content)
+		suite.Equal("<p>hello everyone! (edited)</p>", history[1].Content)
+		suite.Equal("an edit", history[1].SpoilerText)
+		suite.Equal("the_mighty_zork", history[1].Account.Username)
+	}
+
+	// source should return the plain text of the current version
+	recorder = httptest.NewRecorder()
+	sctx := suite.newContext(recorder, http.MethodGet, statuses.SourcePath, targetStatus.ID, "local_account_1")
+	suite.statusModule.StatusSourceGETHandler(sctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	source := &apimodel.StatusSource{}
+	if err := json.NewDecoder(recorder.Body).Decode(source); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(targetStatus.ID, source.ID)
+	suite.Equal("hello everyone! (edited)", source.Text)
+	suite.Equal("an edit", source.SpoilerText)
+}
+
+func (suite *StatusEditTestSuite) TestEditSomeoneElsesStatus() {
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	_, code := suite.editStatus(targetStatus.ID, "local_account_1", url.Values{
+		"status": {"this isn't mine to edit"},
+	})
+	suite.Equal(http.StatusForbidden, code)
+
+	dbStatus, err := suite.db.GetStatusByID(context.Background(), targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(targetStatus.Content, dbStatus.Content)
+	suite.True(dbStatus.EditedAt.IsZero())
+}
+
+func (suite *StatusEditTestSuite) TestSourceSomeoneElsesStatus() {
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, statuses.SourcePath, targetStatus.ID, "local_account_1")
+	suite.statusModule.StatusSourceGETHandler(ctx)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *StatusEditTestSuite) TestEditBoost() {
+	targetStatus := suite.testStatuses["admin_account_status_4"]
+	suite.NotEmpty(targetStatus.BoostOfID)
+
+	_, code := suite.editStatus(targetStatus.ID, "admin_account", url.Values{
+		"status": {"boosts can't be edited"},
+	})
+	suite.Equal(http.StatusUnprocessableEntity, code)
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
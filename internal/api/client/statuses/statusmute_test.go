@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusMuteTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusMuteTestSuite) postMute(path string, targetStatusID string, handler gin.HandlerFunc) *model.Status {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080%s", strings.Replace(path, ":id", targetStatusID, 1)), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatusID,
+		},
+	}
+
+	handler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	statusReply := &model.Status{}
+	err = json.Unmarshal(b, statusReply)
+	suite.NoError(err)
+
+	return statusReply
+}
+
+func (suite *StatusMuteTestSuite) TestMuteUnmuteThread() {
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	// both of these statuses are replies to local_account_1_status_1
+	targetStatus := suite.testStatuses["admin_account_status_3"]
+	otherReply := suite.testStatuses["local_account_2_status_5"]
+
+	statusReply := suite.postMute(statuses.MutePath, targetStatus.ID, suite.statusModule.StatusMutePOSTHandler)
+	suite.True(statusReply.Muted)
+
+	// the whole thread should now be muted
+	apiStatus, errWithCode := suite.processor.Status().Get(context.Background(), requestingAccount, otherReply.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiStatus.Muted)
+
+	// unmuting via any status in the thread unmutes it all
+	statusReply = suite.postMute(statuses.UnmutePath, otherReply.ID, suite.statusModule.StatusUnmutePOSTHandler)
+	suite.False(statusReply.Muted)
+
+	apiStatus, errWithCode = suite.processor.Status().Get(context.Background(), requestingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.False(apiStatus.Muted)
+}
+
+func TestStatusMuteTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusMuteTestSuite))
+}
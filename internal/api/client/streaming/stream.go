@@ -19,6 +19,9 @@ package streaming
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"codeberg.org/gruf/go-kv"
@@ -28,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	streampkg "github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"golang.org/x/exp/slices"
 
 	"github.com/gin-gonic/gin"
@@ -82,10 +86,24 @@ import (
 //			`direct`: receive updates for direct messages.
 //		in: query
 //		required: true
+//	-
+//		name: list
+//		type: string
+//		description: |-
+//			ID of the list to stream updates for.
+//			Only used, and required, when `stream` is `list`.
+//		in: query
+//	-
+//		name: tag
+//		type: string
+//		description: |-
+//			Name of the hashtag to stream updates for.
+//			Only used, and required, when `stream` is `hashtag` or `hashtag:local`.
+//		in: query
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- read:streaming
+//		- read:statuses
 //
 //	responses:
 //		'101':
@@ -154,10 +172,9 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 		}
 	} else {
 		// If no explicit token was provided, try regular oauth
-		auth, errStr := oauth.Authed(c, true, true, true, true)
-		if errStr != nil {
-			err := gtserror.NewErrorUnauthorized(errStr, errStr.Error())
-			apiutil.ErrorHandler(c, err, m.processor.InstanceGetV1)
+		auth, errWithCode := oauth.AuthedScope(c, true, true, true, true, oauth.ScopeReadStatuses)
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 			return
 		}
 		account = auth.Account
@@ -166,6 +183,25 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 	// Get the initial stream type, if there is one.
 	// streamType will be an empty string if one wasn't supplied. Open() will deal with this
 	streamType := c.Query(StreamQueryKey)
+	switch streamType {
+	case streampkg.TimelineList:
+		// List streams need the list ID too.
+		var errWithCode gtserror.WithCode
+		streamType, errWithCode = m.listStreamType(c.Request.Context(), account, c.Query(StreamListKey))
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+	case streampkg.TimelineHashtag, streampkg.TimelineHashtagLocal:
+		// Hashtag streams need the tag name too.
+		var errWithCode gtserror.WithCode
+		streamType, errWithCode = tagStreamType(streamType, c.Query(StreamTagKey))
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+	}
+
 	stream, errWithCode := m.processor.Stream().Open(c.Request.Context(), account, streamType)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -251,6 +287,25 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 					continue
 				}
 
+				switch streamType {
+				case streampkg.TimelineList:
+					// List streams need the list ID too.
+					var errWithCode gtserror.WithCode
+					streamType, errWithCode = m.listStreamType(ctx, account, msg[StreamListKey])
+					if errWithCode != nil {
+						l.Warnf("Invalid 'list' field: %v: %v", msg, errWithCode)
+						continue
+					}
+				case streampkg.TimelineHashtag, streampkg.TimelineHashtagLocal:
+					// Hashtag streams need the tag name too.
+					var errWithCode gtserror.WithCode
+					streamType, errWithCode = tagStreamType(streamType, msg[StreamTagKey])
+					if errWithCode != nil {
+						l.Warnf("Invalid 'tag' field: %v: %v", msg, errWithCode)
+						continue
+					}
+				}
+
 				switch action {
 				case "subscribe":
 					stream.Lock()
@@ -297,3 +352,31 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 		}
 	}()
 }
+
+// listStreamType returns the stream type for the list with the given
+// ID, checking first that the list exists and is owned by the account.
+func (m *Module) listStreamType(ctx context.Context, account *gtsmodel.Account, listID string) (string, gtserror.WithCode) {
+	if listID == "" {
+		err := errors.New("no list id provided for list stream")
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if _, errWithCode := m.processor.List().Get(ctx, account, listID); errWithCode != nil {
+		return "", errWithCode
+	}
+
+	return streampkg.TimelineList + ":" + listID, nil
+}
+
+// tagStreamType returns the stream type for the given hashtag
+// stream type and tag name, checking first that the name is valid.
+func tagStreamType(streamType string, tagName string) (string, gtserror.WithCode) {
+	normalized, ok := text.NormalizeHashtag(tagName)
+	if !ok {
+		err := fmt.Errorf("invalid tag name %q provided for hashtag stream", tagName)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Statuses are streamed by lowercase tag name.
+	return streamType + ":" + strings.ToLower(normalized), nil
+}
@@ -32,6 +32,10 @@ const (
 
 	// StreamQueryKey is the query key for the type of stream being requested
 	StreamQueryKey = "stream"
+	// StreamListKey is the query key for the ID of the list to stream, when stream is "list"
+	StreamListKey = "list"
+	// StreamTagKey is the query key for the name of the tag to stream, when stream is "hashtag" or "hashtag:local"
+	StreamTagKey = "tag"
 
 	// AccessTokenQueryKey is the query key for an oauth access token that should be passed in streaming requests.
 	AccessTokenQueryKey = "access_token"
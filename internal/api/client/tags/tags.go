@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tags
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// TagNameKey is the key for a tag name in the path
+	TagNameKey = "tag_name"
+	// BasePath is the base path for serving the tags API, minus the 'api' prefix
+	BasePath = "/v1/tags"
+	// BasePathWithName is the base path with the tag name key in it, for serving one tag
+	BasePathWithName = BasePath + "/:" + TagNameKey
+	// FollowPath is the path for following one tag
+	FollowPath = BasePathWithName + "/follow"
+	// UnfollowPath is the path for unfollowing one tag
+	UnfollowPath = BasePathWithName + "/unfollow"
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, BasePathWithName, m.TagGETHandler)
+	attachHandler(http.MethodPost, FollowPath, m.TagFollowPOSTHandler)
+	attachHandler(http.MethodPost, UnfollowPath, m.TagUnfollowPOSTHandler)
+}
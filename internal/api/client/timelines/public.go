@@ -111,18 +111,18 @@ import (
 //			description: bad request
 func (m *Module) PublicTimelineGETHandler(c *gin.Context) {
 	var authed *oauth.Auth
-	var err error
+	var errWithCode gtserror.WithCode
 
 	if config.GetInstanceExposePublicTimeline() {
 		// If the public timeline is allowed to be exposed, still check if we
 		// can extract various authentication properties, but don't require them.
-		authed, err = oauth.Authed(c, false, false, false, false)
+		authed, errWithCode = oauth.AuthedScope(c, false, false, false, false, oauth.ScopeReadStatuses)
 	} else {
-		authed, err = oauth.Authed(c, true, true, true, true)
+		authed, errWithCode = oauth.AuthedScope(c, true, true, true, true, oauth.ScopeReadStatuses)
 	}
 
-	if err != nil {
-		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
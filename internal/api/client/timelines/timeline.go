@@ -31,6 +31,14 @@ const (
 	HomeTimeline = BasePath + "/home"
 	// PublicTimeline is the path for the public (and public local) timeline
 	PublicTimeline = BasePath + "/public"
+	// IDKey is the key for a list ID in the list timeline path
+	IDKey = "id"
+	// ListTimeline is the path for the timeline of one list
+	ListTimeline = BasePath + "/list/:" + IDKey
+	// TagNameKey is the key for a tag name in the tag timeline path
+	TagNameKey = "tag_name"
+	// TagTimeline is the path for the timeline of one hashtag
+	TagTimeline = BasePath + "/tag/:" + TagNameKey
 	// MaxIDKey is the url query for setting a max status ID to return
 	MaxIDKey = "max_id"
 	// SinceIDKey is the url query for returning results newer than the given ID
@@ -56,4 +64,6 @@ func New(processor *processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, HomeTimeline, m.HomeTimelineGETHandler)
 	attachHandler(http.MethodGet, PublicTimeline, m.PublicTimelineGETHandler)
+	attachHandler(http.MethodGet, ListTimeline, m.ListTimelineGETHandler)
+	attachHandler(http.MethodGet, TagTimeline, m.TagTimelineGETHandler)
 }
@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AuthorizedAppsTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *AuthorizedAppsTestSuite) authorizedApps(requestMethod string, handler func(*gin.Context), appID string, expectedHTTPStatus int) string {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(requestMethod, fmt.Sprintf("http://localhost:8080/api%s/%s", user.AuthorizedAppsPath, appID), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	if appID != "" {
+		ctx.AddParam(user.IDKey, appID)
+	}
+
+	handler(ctx)
+
+	suite.EqualValues(expectedHTTPStatus, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	return string(b)
+}
+
+func (suite *AuthorizedAppsTestSuite) TestAuthorizedAppsGET() {
+	b := suite.authorizedApps(http.MethodGet, suite.userModule.AuthorizedAppsGETHandler, "", http.StatusOK)
+	suite.Equal(`[{"id":"01F8MGY43H3N2C8EWPR2FPYEXG","name":"really cool gts application","website":"https://reallycool.app","scopes":["read","write","follow","push"],"created_at":"2022-06-10T15:22:08.000Z","last_used_at":null,"last_used_ip":null}]`, b)
+}
+
+func (suite *AuthorizedAppsTestSuite) TestAuthorizedAppDELETE() {
+	b := suite.authorizedApps(http.MethodDelete, suite.userModule.AuthorizedAppDELETEHandler, "01F8MGY43H3N2C8EWPR2FPYEXG", http.StatusOK)
+	suite.Equal(`{}`, b)
+
+	// the token should be revoked
+	_, err := suite.db.GetTokenByAccess(context.Background(), suite.testTokens["local_account_1"].Access)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	b = suite.authorizedApps(http.MethodGet, suite.userModule.AuthorizedAppsGETHandler, "", http.StatusOK)
+	suite.Equal(`[]`, b)
+}
+
+func (suite *AuthorizedAppsTestSuite) TestAuthorizedAppDELETENotAuthorized() {
+	b := suite.authorizedApps(http.MethodDelete, suite.userModule.AuthorizedAppDELETEHandler, "01F8MGYG9E893WRHW0TAEXR8GJ", http.StatusNotFound)
+	suite.Equal(`{"error":"Not Found"}`, b)
+}
+
+func TestAuthorizedAppsTestSuite(t *testing.T) {
+	suite.Run(t, &AuthorizedAppsTestSuite{})
+}
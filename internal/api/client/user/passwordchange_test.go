@@ -28,6 +28,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -70,6 +71,10 @@ func (suite *PasswordChangeTestSuite) TestPasswordChangePOST() {
 	// old password should fail
 	err = bcrypt.CompareHashAndPassword([]byte(dbUser.EncryptedPassword), []byte("password"))
 	suite.EqualError(err, "crypto/bcrypt: hashedPassword is not the hash of the given password")
+
+	// token used to change the password should be revoked
+	_, err = suite.db.GetTokenByAccess(context.Background(), t.Access)
+	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
 func (suite *PasswordChangeTestSuite) TestPasswordMissingOldPassword() {
@@ -29,6 +29,12 @@ const (
 	BasePath = "/v1/user"
 	// PasswordChangePath is the path for POSTing a password change request.
 	PasswordChangePath = BasePath + "/password_change"
+	// AuthorizedAppsPath is the path for viewing the applications authorized by the user.
+	AuthorizedAppsPath = BasePath + "/authorized_apps"
+	// IDKey is the key to use for retrieving an application ID from a request.
+	IDKey = "id"
+	// AuthorizedAppPath is the path for revoking the access of one application.
+	AuthorizedAppPath = AuthorizedAppsPath + "/:" + IDKey
 )
 
 type Module struct {
@@ -43,4 +49,6 @@ func New(processor *processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodPost, PasswordChangePath, m.PasswordChangePOSTHandler)
+	attachHandler(http.MethodGet, AuthorizedAppsPath, m.AuthorizedAppsGETHandler)
+	attachHandler(http.MethodDelete, AuthorizedAppPath, m.AuthorizedAppDELETEHandler)
 }
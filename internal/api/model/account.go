@@ -96,6 +96,9 @@ type Account struct {
 	// Role of the account on this instance.
 	// Omitted for remote accounts.
 	Role *AccountRole `json:"role,omitempty"`
+	// If this account has moved to another account, the account it moved to.
+	// Omitted if the account has not moved.
+	Moved *Account `json:"moved,omitempty"`
 }
 
 // AccountCreateRequest models account creation parameters.
@@ -200,6 +203,18 @@ type AccountFollowRequest struct {
 	Notify *bool `form:"notify" json:"notify" xml:"notify"`
 }
 
+// UserMuteCreateUpdateRequest models a request to create or update a mute of an account.
+//
+// swagger:ignore
+type UserMuteCreateUpdateRequest struct {
+	// The id of the account to mute.
+	ID string `form:"-" json:"-" xml:"-"`
+	// Mute notifications from this account as well as statuses.
+	Notifications *bool `form:"notifications" json:"notifications" xml:"notifications"`
+	// Number of seconds after which the mute should expire. 0 or not set means the mute doesn't expire.
+	Duration *int `form:"duration" json:"duration" xml:"duration"`
+}
+
 // AccountDeleteRequest models a request to delete an account.
 //
 // swagger:ignore
@@ -211,6 +226,25 @@ type AccountDeleteRequest struct {
 	DeleteOriginID string `form:"-" json:"-" xml:"-"`
 }
 
+// AccountAliasRequest models a request to set the aliases of an account.
+//
+// swagger:ignore
+type AccountAliasRequest struct {
+	// ActivityPub URIs of other accounts this account is also known as.
+	// An empty slice removes all aliases.
+	AlsoKnownAsURIs []string `form:"also_known_as_uris[]" json:"also_known_as_uris" xml:"also_known_as_uris"`
+}
+
+// AccountMoveRequest models a request to move an account to another account.
+//
+// swagger:ignore
+type AccountMoveRequest struct {
+	// Password of the account's user, for confirmation.
+	Password string `form:"password" json:"password" xml:"password"`
+	// ActivityPub URI of the account to move to.
+	MovedToURI string `form:"moved_to_uri" json:"moved_to_uri" xml:"moved_to_uri"`
+}
+
 // AccountRole models the role of an account.
 //
 // swagger:model accountRole
@@ -189,3 +189,136 @@ type AdminSendTestEmailRequest struct {
 	// Email address to send the test email to.
 	Email string `form:"email" json:"email" xml:"email"`
 }
+
+// AdminDeliveryQueue models the admin view of the queue of outgoing federation deliveries.
+//
+// swagger:model adminDeliveryQueue
+type AdminDeliveryQueue struct {
+	// Number of deliveries currently queued, including those being attempted for the first time.
+	// example: 42
+	Depth int `json:"depth"`
+	// Number of queued deliveries which have failed at least once, and are waiting to be retried.
+	// example: 12
+	Failing int `json:"failing"`
+	// Hosts with failing deliveries, most failing deliveries first.
+	FailingHosts []AdminDeliveryHost `json:"failing_hosts"`
+}
+
+// AdminDeliveryHost models the failing deliveries queued for one host.
+//
+// swagger:model adminDeliveryHost
+type AdminDeliveryHost struct {
+	// Host that deliveries are failing to.
+	// example: example.org
+	Host string `json:"host"`
+	// Number of queued deliveries to this host which have failed at least once.
+	// example: 6
+	Deliveries int `json:"deliveries"`
+	// Time when the oldest failing delivery to this host was queued (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	FailingSince string `json:"failing_since"`
+	// Time when delivery to this host was last attempted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	LastAttemptAt string `json:"last_attempt_at,omitempty"`
+}
+
+// AdminAccountKeyChange models a change to the public key of a remote account,
+// noticed when verifying an http signature made with the new key.
+//
+// swagger:model adminAccountKeyChange
+type AdminAccountKeyChange struct {
+	// The ID of the key change.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Time when the key change was noticed (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The account whose key changed.
+	Account *Account `json:"account"`
+	// The URI of the key that changed.
+	// example: https://example.org/users/someone#main-key
+	PublicKeyURI string `json:"public_key_uri"`
+	// The PEM encoded public key from before the change.
+	OldPublicKey string `json:"old_public_key"`
+	// The PEM encoded public key from after the change.
+	NewPublicKey string `json:"new_public_key"`
+}
+
+// AdminRelay models a subscription of this instance to an ActivityPub relay.
+//
+// swagger:model adminRelay
+type AdminRelay struct {
+	// The ID of the relay subscription.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Time when the relay was subscribed to (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The inbox of the relay.
+	// example: https://relay.example.org/inbox
+	InboxURI string `json:"inbox_uri"`
+	// The URI of the relay actor.
+	// Empty until the relay has accepted the subscription.
+	// example: https://relay.example.org/actor
+	ActorURI string `json:"actor_uri,omitempty"`
+	// State of the subscription: `pending`, `accepted`, or `rejected`.
+	// example: accepted
+	State string `json:"state"`
+	// Whether public posts from this instance are delivered to the relay.
+	// example: true
+	Publish bool `json:"publish"`
+	// ID of the account that subscribed to the relay.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by"`
+}
+
+// AdminRelayCreateRequest is the form submitted as a POST to /api/v1/admin/relays to subscribe to a relay.
+//
+// swagger:model adminRelayCreateRequest
+type AdminRelayCreateRequest struct {
+	// The inbox of the relay.
+	InboxURI string `form:"inbox_uri" json:"inbox_uri" xml:"inbox_uri"`
+	// Whether public posts from this instance should be delivered to the relay.
+	Publish bool `form:"publish" json:"publish" xml:"publish"`
+}
+
+// AdminInstance models the admin view of a remote instance.
+//
+// swagger:model adminInstance
+type AdminInstance struct {
+	// The domain of the instance.
+	// example: example.org
+	Domain string `json:"domain"`
+	// The base URI of the instance.
+	// example: https://example.org
+	URI string `json:"uri"`
+	// The title of the instance, if known.
+	// example: Example Instance
+	Title string `json:"title,omitempty"`
+	// Version of the software running on the instance, if known.
+	// example: 4.1.2
+	Version string `json:"version,omitempty"`
+	// Time when the instance was first seen (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time when the instance was suspended (ISO 8601 Datetime). Key will not be present if the instance isn't suspended.
+	// example: 2021-07-30T09:20:25+00:00
+	SuspendedAt string `json:"suspended_at,omitempty"`
+	// Time when delivery of a message to the instance last succeeded (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	LastDeliverySuccessAt string `json:"last_delivery_success_at,omitempty"`
+	// Time when delivery of a message to the instance last failed (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	LastDeliveryFailureAt string `json:"last_delivery_failure_at,omitempty"`
+	// Time since when delivery to the instance has been failing (ISO 8601 Datetime).
+	// Key will not be present if the last delivery succeeded.
+	// example: 2021-07-30T09:20:25+00:00
+	DeliveryFailingSince string `json:"delivery_failing_since,omitempty"`
+	// Delivery to the instance is skipped (apart from occasional probes),
+	// because delivery had been failing for longer than instance-unavailable-after.
+	// example: false
+	Unavailable bool `json:"unavailable"`
+	// Time when the instance was marked as unavailable (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UnavailableAt string `json:"unavailable_at,omitempty"`
+}
@@ -0,0 +1,44 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// AuthorizedApplication represents an application which a user
+// has authorized to act on their behalf, via one or more access tokens.
+//
+// swagger:model authorizedApplication
+type AuthorizedApplication struct {
+	// The ID of the application.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// The name of the application.
+	// example: Tusky
+	Name string `json:"name"`
+	// The website associated with the application (url)
+	// example: https://tusky.app
+	Website string `json:"website,omitempty"`
+	// Scopes granted to the application, across all of its access tokens.
+	// example: ["read","write"]
+	Scopes []string `json:"scopes"`
+	// When the application was first authorized (ISO 8601 Datetime).
+	CreatedAt string `json:"created_at"`
+	// When one of the application's access tokens was last used (ISO 8601 Datetime), if known.
+	LastUsedAt *string `json:"last_used_at"`
+	// The IP address that one of the application's access tokens was last used from, if known.
+	// example: 192.0.2.1
+	LastUsedIP *string `json:"last_used_ip"`
+}
@@ -18,6 +18,8 @@
 package model
 
 // Conversation represents a conversation with "direct message" visibility.
+//
+// swagger:model conversation
 type Conversation struct {
 	// REQUIRED
 
@@ -32,6 +32,10 @@ type Domain struct {
 	// Time at which this domain was silenced. Key will not be present on open domains.
 	// example: 2021-07-30T09:20:25+00:00
 	SilencedAt string `json:"silenced_at,omitempty"`
+	// Time at which this domain was marked as unavailable, because delivery to it
+	// had been failing for too long. Key will not be present on available domains.
+	// example: 2021-07-30T09:20:25+00:00
+	UnavailableAt string `json:"unavailable_at,omitempty"`
 	// If the domain is blocked, what's the publicly-stated reason for the block.
 	// example: they smell
 	PublicComment string `form:"public_comment" json:"public_comment,omitempty"`
@@ -53,6 +57,15 @@ type DomainBlock struct {
 	// Private comment for this block, visible to our instance admins only.
 	// example: they are poopoo
 	PrivateComment string `json:"private_comment,omitempty"`
+	// Severity of this domain block.
+	// `suspend` defederates from the domain entirely, and suspends all of its accounts.
+	// `limit` keeps federating, but hides statuses from the domain from public timelines,
+	// and turns follows from the domain into follow requests.
+	// example: suspend
+	Severity string `json:"severity,omitempty"`
+	// Skip fetching media attachments, avatars and headers from this domain.
+	// example: false
+	RejectMedia bool `json:"reject_media,omitempty"`
 	// The ID of the subscription that created/caused this domain block.
 	// example: 01FBW25TF5J67JW3HFHZCSD23K
 	SubscriptionID string `json:"subscription_id,omitempty"`
@@ -78,4 +91,99 @@ type DomainBlockCreateRequest struct {
 	PrivateComment string `form:"private_comment" json:"private_comment" xml:"private_comment"`
 	// public comment on the reason for the domain block
 	PublicComment string `form:"public_comment" json:"public_comment" xml:"public_comment"`
+	// severity of the domain block: suspend (default) or limit
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+	// whether media from the domain should be skipped
+	RejectMedia bool `form:"reject_media" json:"reject_media" xml:"reject_media"`
+}
+
+// DomainAllow represents an allow on one domain, used when running in allowlist federation mode.
+//
+// swagger:model domainAllow
+type DomainAllow struct {
+	Domain
+	// The ID of the domain allow.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id,omitempty"`
+	// Private comment for this allow, visible to our instance admins only.
+	// example: they are nice
+	PrivateComment string `json:"private_comment,omitempty"`
+	// ID of the account that created this domain allow.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by,omitempty"`
+	// Time at which this allow was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// DomainAllowCreateRequest is the form submitted as a POST to /api/v1/admin/domain_allows to create a new allow.
+//
+// swagger:model domainAllowCreateRequest
+type DomainAllowCreateRequest struct {
+	// hostname/domain to allow
+	Domain string `form:"domain" json:"domain" xml:"domain"`
+	// private comment for other admins on why the domain was allowed
+	PrivateComment string `form:"private_comment" json:"private_comment" xml:"private_comment"`
+	// public comment on the reason for the domain allow
+	PublicComment string `form:"public_comment" json:"public_comment" xml:"public_comment"`
+}
+
+// DomainBlockSubscription represents a subscription to a remote list of domains to block.
+//
+// swagger:model domainBlockSubscription
+type DomainBlockSubscription struct {
+	// The ID of the subscription.
+	// example: 01FBW25TF5J67JW3HFHZCSD23K
+	// readonly: true
+	ID string `json:"id"`
+	// Title of this subscription, visible to our instance admins only.
+	// example: some good blocks
+	Title string `json:"title,omitempty"`
+	// URI of the list of domains to block.
+	// example: https://example.org/blocklist.csv
+	URI string `json:"uri"`
+	// Content type of the list of domains to block.
+	// One of `text/plain`, `text/csv` (Mastodon export format), or `application/json` (GoToSocial export format).
+	// example: text/csv
+	ContentType string `json:"content_type"`
+	// Obfuscate the domain names of blocks created by this subscription when serving them publicly.
+	// example: false
+	Obfuscate bool `json:"obfuscate"`
+	// ID of the account that created this subscription.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by"`
+	// Time at which this subscription was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the list of domains was last fetched (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	FetchedAt string `json:"fetched_at,omitempty"`
+	// Time at which the list of domains was last fetched and synced without error (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	SuccessfullyFetchedAt string `json:"successfully_fetched_at,omitempty"`
+	// Error encountered during the last fetch of the list of domains, if any.
+	// example: GET request to https://example.org/blocklist.csv failed: 404 Not Found
+	Error string `json:"error,omitempty"`
+	// Number of domains currently blocked through this subscription.
+	// example: 10
+	BlockCount int `json:"block_count"`
+	// Domains that were blocked by the last successful sync.
+	AddedDomains []string `json:"added_domains"`
+	// Domains that were unblocked by the last successful sync.
+	RemovedDomains []string `json:"removed_domains"`
+}
+
+// DomainBlockSubscriptionCreateRequest is the form submitted as a POST to /api/v1/admin/domain_block_subscriptions to create a new subscription.
+//
+// swagger:model domainBlockSubscriptionCreateRequest
+type DomainBlockSubscriptionCreateRequest struct {
+	// URI of the list of domains to block.
+	URI string `form:"uri" json:"uri" xml:"uri"`
+	// Content type of the list of domains to block: text/plain, text/csv or application/json.
+	ContentType string `form:"content_type" json:"content_type" xml:"content_type"`
+	// Title of the subscription, visible to admins only.
+	Title string `form:"title" json:"title" xml:"title"`
+	// Whether blocks created by this subscription should be obfuscated when being displayed publicly.
+	Obfuscate bool `form:"obfuscate" json:"obfuscate" xml:"obfuscate"`
 }
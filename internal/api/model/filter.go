@@ -17,29 +17,165 @@
 
 package model
 
-// Filter represents a user-defined filter for determining which statuses should not be shown to the user.
+// FilterV1 represents a user-defined filter for determining which statuses should not be shown to the user.
+// Each v1 filter corresponds to a single keyword of a v2 filter.
 // If whole_word is true , client app should do:
 // Define ‘word constituent character’ for your app. In the official implementation, it’s [A-Za-z0-9_] in JavaScript, and [[:word:]] in Ruby.
 // Ruby uses the POSIX character class (Letter | Mark | Decimal_Number | Connector_Punctuation).
 // If the phrase starts with a word character, and if the previous character before matched range is a word character, its matched range should be treated to not match.
 // If the phrase ends with a word character, and if the next character after matched range is a word character, its matched range should be treated to not match.
 // Please check app/javascript/mastodon/selectors/index.js and app/lib/feed_manager.rb in the Mastodon source code for more details.
-type Filter struct {
+//
+// swagger:model filterV1
+type FilterV1 struct {
 	// The ID of the filter in the database.
 	ID string `json:"id"`
 	// The text to be filtered.
-	Phrase string `json:"text"`
+	// example: fnord
+	Phrase string `json:"phrase"`
 	// The contexts in which the filter should be applied.
 	// Array of String (Enumerable anyOf)
 	// 	home = home timeline and lists
 	// 	notifications = notifications timeline
 	// 	public = public timelines
 	// 	thread = expanded thread of a detailed status
+	// 	account = account profiles
 	Context []string `json:"context"`
 	// Should the filter consider word boundaries?
 	WholeWord bool `json:"whole_word"`
-	// When the filter should no longer be applied (ISO 8601 Datetime), or null if the filter does not expire
-	ExpiresAt string `json:"expires_at,omitempty"`
+	// When the filter should no longer be applied (ISO 8601 Datetime), or null if the filter does not expire.
+	// nullable: true
+	ExpiresAt *string `json:"expires_at"`
 	// Should matching entities in home and notifications be dropped by the server?
 	Irreversible bool `json:"irreversible"`
 }
+
+// FilterV2 represents a user-defined filter for determining which statuses should not be shown to the user.
+//
+// swagger:model filterV2
+type FilterV2 struct {
+	// The ID of the filter in the database.
+	ID string `json:"id"`
+	// The name given by the user to this filter.
+	// example: fnord
+	Title string `json:"title"`
+	// The contexts in which the filter should be applied.
+	// Array of String (Enumerable anyOf)
+	// 	home = home timeline and lists
+	// 	notifications = notifications timeline
+	// 	public = public timelines
+	// 	thread = expanded thread of a detailed status
+	// 	account = account profiles
+	Context []string `json:"context"`
+	// When the filter should no longer be applied (ISO 8601 Datetime), or null if the filter does not expire.
+	// nullable: true
+	ExpiresAt *string `json:"expires_at"`
+	// The action to be taken when a status matches this filter.
+	// 	warn = show a warning that identifies the matching filter by title, and allow the user to expand the filtered status
+	// 	hide = do not show this status if it is received
+	FilterAction string `json:"filter_action"`
+	// The keywords grouped under this filter.
+	Keywords []FilterKeyword `json:"keywords"`
+	// The statuses grouped under this filter.
+	// Filtering individual statuses is not currently supported, so this will always be empty.
+	Statuses []FilterStatus `json:"statuses"`
+}
+
+// FilterKeyword represents a keyword that, if matched, should cause a filter action to be taken.
+//
+// swagger:model filterKeyword
+type FilterKeyword struct {
+	// The ID of the filter keyword in the database.
+	ID string `json:"id"`
+	// The phrase to be matched against.
+	// example: fnord
+	Keyword string `json:"keyword"`
+	// Should the filter consider word boundaries?
+	WholeWord bool `json:"whole_word"`
+}
+
+// FilterStatus represents a single status that, if matched, should cause a filter action to be taken.
+//
+// swagger:model filterStatus
+type FilterStatus struct {
+	// The ID of the filter status in the database.
+	ID string `json:"id"`
+	// The ID of the filtered status in the database.
+	StatusID string `json:"status_id"`
+}
+
+// FilterResult is returned along with a status, and describes why the status was matched by a filter.
+//
+// swagger:model filterResult
+type FilterResult struct {
+	// The filter that was matched.
+	Filter FilterV2 `json:"filter"`
+	// The keywords within the filter that were matched.
+	KeywordMatches []string `json:"keyword_matches"`
+	// The status IDs within the filter that were matched.
+	StatusMatches []string `json:"status_matches"`
+}
+
+// FilterCreateUpdateRequestV1 captures params for creating or updating a v1 filter.
+//
+// swagger:ignore
+type FilterCreateUpdateRequestV1 struct {
+	// The text to be filtered.
+	Phrase string `form:"phrase" json:"phrase" xml:"phrase"`
+	// The contexts in which the filter should be applied.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// Should matching entities be dropped by the server instead of hidden behind a warning?
+	Irreversible *bool `form:"irreversible" json:"irreversible" xml:"irreversible"`
+	// Should the filter consider word boundaries?
+	WholeWord *bool `form:"whole_word" json:"whole_word" xml:"whole_word"`
+	// Number of seconds from now that the filter should expire. If omitted or zero, the filter never expires.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// FilterCreateRequestV2 captures params for creating a v2 filter.
+//
+// swagger:ignore
+type FilterCreateRequestV2 struct {
+	// The name of the filter.
+	Title string `form:"title" json:"title" xml:"title"`
+	// The contexts in which the filter should be applied.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// The action to take when a status matches the filter.
+	FilterAction *string `form:"filter_action" json:"filter_action" xml:"filter_action"`
+	// Number of seconds from now that the filter should expire. If omitted or zero, the filter never expires.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Keywords to be added to the newly-created filter.
+	// Only settable using a JSON request body, since nested form fields are not supported.
+	Keywords []FilterKeywordCreateUpdateRequest `form:"-" json:"keywords_attributes" xml:"keywords_attributes"`
+}
+
+// FilterUpdateRequestV2 captures params for updating a v2 filter.
+//
+// swagger:ignore
+type FilterUpdateRequestV2 struct {
+	// The name of the filter.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// The contexts in which the filter should be applied.
+	Context *[]string `form:"context[]" json:"context" xml:"context"`
+	// The action to take when a status matches the filter.
+	FilterAction *string `form:"filter_action" json:"filter_action" xml:"filter_action"`
+	// Number of seconds from now that the filter should expire. Zero removes any expiry.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Keywords to be added to, changed in, or removed from the filter.
+	// Only settable using a JSON request body, since nested form fields are not supported.
+	Keywords []FilterKeywordCreateUpdateRequest `form:"-" json:"keywords_attributes" xml:"keywords_attributes"`
+}
+
+// FilterKeywordCreateUpdateRequest captures params for creating or updating a filter keyword.
+//
+// swagger:ignore
+type FilterKeywordCreateUpdateRequest struct {
+	// The ID of an existing keyword to update or destroy. Only used when updating a filter.
+	ID string `form:"-" json:"id" xml:"id"`
+	// The keyword or phrase to be filtered.
+	Keyword string `form:"keyword" json:"keyword" xml:"keyword"`
+	// Should the filter consider word boundaries?
+	WholeWord *bool `form:"whole_word" json:"whole_word" xml:"whole_word"`
+	// If true, the existing keyword with the given ID will be removed. Only used when updating a filter.
+	Destroy bool `form:"-" json:"_destroy" xml:"_destroy"`
+}
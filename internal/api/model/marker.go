@@ -18,19 +18,60 @@
 package model
 
 // Marker represents the last read position within a user's timelines.
+//
+// swagger:model markers
 type Marker struct {
 	// Information about the user's position in the home timeline.
-	Home *TimelineMarker `json:"home"`
+	Home *TimelineMarker `json:"home,omitempty"`
 	// Information about the user's position in their notifications.
-	Notifications *TimelineMarker `json:"notifications"`
+	Notifications *TimelineMarker `json:"notifications,omitempty"`
 }
 
 // TimelineMarker contains information about a user's progress through a specific timeline.
+//
+// swagger:model timelineMarker
 type TimelineMarker struct {
 	// The ID of the most recently viewed entity.
 	LastReadID string `json:"last_read_id"`
 	// The timestamp of when the marker was set (ISO 8601 Datetime)
 	UpdatedAt string `json:"updated_at"`
 	// Used for locking to prevent write conflicts.
-	Version string `json:"version"`
+	Version int `json:"version"`
+}
+
+// MarkerPostRequest models a request to update timeline markers.
+// The form fields are flattened, since html forms can't nest.
+//
+// swagger:ignore
+type MarkerPostRequest struct {
+	Home                        *MarkerPostRequestMarker `form:"-" json:"home" xml:"home"`
+	FormHomeLastReadID          string                   `form:"home[last_read_id]" json:"-" xml:"-"`
+	Notifications               *MarkerPostRequestMarker `form:"-" json:"notifications" xml:"notifications"`
+	FormNotificationsLastReadID string                   `form:"notifications[last_read_id]" json:"-" xml:"-"`
+}
+
+// MarkerPostRequestMarker models the update of one timeline marker.
+//
+// swagger:ignore
+type MarkerPostRequestMarker struct {
+	// The ID of the most recently viewed entity.
+	LastReadID string `json:"last_read_id" xml:"last_read_id"`
+}
+
+// HomeLastReadID returns the home timeline
+// last read id set by the request, if any.
+func (r *MarkerPostRequest) HomeLastReadID() string {
+	if r.Home != nil {
+		return r.Home.LastReadID
+	}
+	return r.FormHomeLastReadID
+}
+
+// NotificationsLastReadID returns the notifications
+// last read id set by the request, if any.
+func (r *MarkerPostRequest) NotificationsLastReadID() string {
+	if r.Notifications != nil {
+		return r.Notifications.LastReadID
+	}
+	return r.FormNotificationsLastReadID
 }
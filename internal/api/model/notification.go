@@ -33,6 +33,7 @@ type Notification struct {
 	// 	favourite = Someone favourited one of your statuses
 	// 	poll = A poll you have voted in or created has ended
 	// 	status = Someone you enabled notifications for has posted a status
+	// 	admin.report = Someone has reported an account (only sent to moderators and admins)
 	Type string `json:"type"`
 	// The timestamp of the notification (ISO 8601 Datetime)
 	CreatedAt string `json:"created_at"`
@@ -43,6 +44,8 @@ type Notification struct {
 
 	// Status that was the object of the notification, e.g. in mentions, reblogs, favourites, or polls.
 	Status *Status `json:"status,omitempty"`
+	// Report that was the object of the notification, for admin.report notifications.
+	Report *AdminReport `json:"report,omitempty"`
 }
 
 /*
@@ -18,6 +18,8 @@
 package model
 
 // PushSubscription represents a subscription to the push streaming server.
+//
+// swagger:model pushSubscription
 type PushSubscription struct {
 	// The id of the push subscription in the database.
 	ID string `json:"id"`
@@ -27,12 +29,22 @@ type PushSubscription struct {
 	ServerKey string `json:"server_key"`
 	// Which alerts should be delivered to the endpoint.
 	Alerts *PushSubscriptionAlerts `json:"alerts"`
+	// Which accounts to receive push notifications from.
+	// 	all = Receive push notifications from all accounts
+	// 	followed = Receive push notifications from accounts you follow
+	// 	follower = Receive push notifications from accounts that follow you
+	// 	none = Receive no push notifications
+	Policy string `json:"policy"`
 }
 
 // PushSubscriptionAlerts represents the specific alerts that this push subscription will give.
+//
+// swagger:model pushSubscriptionAlerts
 type PushSubscriptionAlerts struct {
 	// Receive a push notification when someone has followed you?
 	Follow bool `json:"follow"`
+	// Receive a push notification when someone has requested to follow you?
+	FollowRequest bool `json:"follow_request"`
 	// Receive a push notification when a status you created has been favourited by someone else?
 	Favourite bool `json:"favourite"`
 	// Receive a push notification when someone else has mentioned you in a status?
@@ -41,4 +53,92 @@ type PushSubscriptionAlerts struct {
 	Reblog bool `json:"reblog"`
 	// Receive a push notification when a poll you voted in or created has ended?
 	Poll bool `json:"poll"`
+	// Receive a push notification when someone you enabled notifications for has posted a status?
+	Status bool `json:"status"`
+	// Receive a push notification when a status you boosted has been edited?
+	Update bool `json:"update"`
+	// Receive a push notification when a new report has been created? Only for moderators and admins.
+	AdminReport bool `json:"admin.report"`
+}
+
+// PushSubscriptionCreateRequest models a request to create a push subscription.
+//
+// swagger:ignore
+type PushSubscriptionCreateRequest struct {
+	Subscription PushSubscriptionRequestSubscription `json:"subscription" xml:"subscription"`
+	Data         PushSubscriptionRequestData         `json:"data" xml:"data"`
+}
+
+// PushSubscriptionUpdateRequest models a request to update the alerts and policy of a push subscription.
+//
+// swagger:ignore
+type PushSubscriptionUpdateRequest struct {
+	Data PushSubscriptionRequestData `json:"data" xml:"data"`
+}
+
+// PushSubscriptionRequestSubscription models the push endpoint and keys of a push subscription request.
+//
+// swagger:ignore
+type PushSubscriptionRequestSubscription struct {
+	// URL of the push endpoint.
+	Endpoint string `form:"subscription[endpoint]" json:"endpoint" xml:"endpoint"`
+	// Keys of the user agent.
+	Keys PushSubscriptionRequestKeys `json:"keys" xml:"keys"`
+}
+
+// PushSubscriptionRequestKeys models the user agent keys used to encrypt push messages.
+//
+// swagger:ignore
+type PushSubscriptionRequestKeys struct {
+	// User agent public key: base64url-encoded P-256 ECDH public key.
+	P256dh string `form:"subscription[keys][p256dh]" json:"p256dh" xml:"p256dh"`
+	// Auth secret: base64url-encoded 16 bytes of random data.
+	Auth string `form:"subscription[keys][auth]" json:"auth" xml:"auth"`
+}
+
+// PushSubscriptionRequestData models the alerts and policy of a push subscription request.
+//
+// swagger:ignore
+type PushSubscriptionRequestData struct {
+	Alerts PushSubscriptionRequestAlerts `json:"alerts" xml:"alerts"`
+	// Which accounts to receive push notifications from: all, followed, follower, or none.
+	Policy *string `form:"data[policy]" json:"policy" xml:"policy"`
+}
+
+// PushSubscriptionRequestAlerts models the alerts of a push subscription request.
+// Alerts that are not set are disabled on create, and left unchanged on update.
+//
+// swagger:ignore
+type PushSubscriptionRequestAlerts struct {
+	Follow        *bool `form:"data[alerts][follow]" json:"follow" xml:"follow"`
+	FollowRequest *bool `form:"data[alerts][follow_request]" json:"follow_request" xml:"follow_request"`
+	Favourite     *bool `form:"data[alerts][favourite]" json:"favourite" xml:"favourite"`
+	Mention       *bool `form:"data[alerts][mention]" json:"mention" xml:"mention"`
+	Reblog        *bool `form:"data[alerts][reblog]" json:"reblog" xml:"reblog"`
+	Poll          *bool `form:"data[alerts][poll]" json:"poll" xml:"poll"`
+	Status        *bool `form:"data[alerts][status]" json:"status" xml:"status"`
+	Update        *bool `form:"data[alerts][update]" json:"update" xml:"update"`
+	AdminReport   *bool `form:"data[alerts][admin.report]" json:"admin.report" xml:"admin.report"`
+}
+
+// WebPushNotification is the plaintext payload of a push message
+// delivered to a push endpoint, before it is encrypted.
+//
+// swagger:ignore
+type WebPushNotification struct {
+	// Access token of the push subscription, so that
+	// the client knows which account the push is for.
+	AccessToken string `json:"access_token"`
+	// The id of the notification in the database.
+	NotificationID string `json:"notification_id"`
+	// The type of event that resulted in the notification.
+	NotificationType string `json:"notification_type"`
+	// Avatar of the account that performed the action that generated the notification.
+	Icon string `json:"icon"`
+	// Title of the push message.
+	Title string `json:"title"`
+	// Body of the push message.
+	Body string `json:"body"`
+	// Locale preferred by the subscribing user.
+	PreferredLocale string `json:"preferred_locale"`
 }
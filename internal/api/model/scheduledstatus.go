@@ -18,21 +18,50 @@
 package model
 
 // ScheduledStatus represents a status that will be published at a future scheduled date.
+//
+// swagger:model scheduledStatus
 type ScheduledStatus struct {
-	ID               string        `json:"id"`
-	ScheduledAt      string        `json:"scheduled_at"`
-	Params           *StatusParams `json:"params"`
-	MediaAttachments []Attachment  `json:"media_attachments"`
+	// ID of the scheduled status in the database.
+	ID string `json:"id"`
+	// When the status will be published (ISO 8601 Datetime).
+	ScheduledAt string `json:"scheduled_at"`
+	// Parameters that will be used to create the status.
+	Params *StatusParams `json:"params"`
+	// Media that will be attached when the status is published.
+	MediaAttachments []Attachment `json:"media_attachments"`
 }
 
 // StatusParams represents parameters for a scheduled status.
+//
+// swagger:model statusParams
 type StatusParams struct {
-	Text          string   `json:"text"`
-	InReplyToID   string   `json:"in_reply_to_id,omitempty"`
-	MediaIDs      []string `json:"media_ids,omitempty"`
-	Sensitive     bool     `json:"sensitive,omitempty"`
-	SpoilerText   string   `json:"spoiler_text,omitempty"`
-	Visibility    string   `json:"visibility"`
-	ScheduledAt   string   `json:"scheduled_at,omitempty"`
-	ApplicationID string   `json:"application_id"`
+	// Text to be used as status content.
+	Text string `json:"text"`
+	// Poll to be attached to the status.
+	Poll *PollRequest `json:"poll,omitempty"`
+	// IDs of media attachments that will be attached to the status.
+	MediaIDs []string `json:"media_ids,omitempty"`
+	// Whether the status will be marked as sensitive.
+	Sensitive bool `json:"sensitive,omitempty"`
+	// Text to be shown as a warning or subject before the actual content.
+	SpoilerText string `json:"spoiler_text,omitempty"`
+	// Visibility that the status will have.
+	Visibility Visibility `json:"visibility,omitempty"`
+	// ID of the status being replied to, if status will be a reply.
+	InReplyToID string `json:"in_reply_to_id,omitempty"`
+	// ISO 639 language code for the status.
+	Language string `json:"language,omitempty"`
+	// When the status will be published (ISO 8601 Datetime).
+	ScheduledAt string `json:"scheduled_at,omitempty"`
+	// ID of the application that was used to schedule the status.
+	ApplicationID string `json:"application_id"`
+}
+
+// ScheduledStatusUpdateRequest models a request to reschedule a scheduled status.
+//
+// swagger:ignore
+type ScheduledStatusUpdateRequest struct {
+	// ISO 8601 Datetime at which the status should be published.
+	// Must be at least 5 minutes in the future.
+	ScheduledAt string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
 }
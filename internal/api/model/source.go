@@ -38,4 +38,7 @@ type Source struct {
 	Fields []Field `json:"fields"`
 	// The number of pending follow requests.
 	FollowRequestsCount int `json:"follow_requests_count"`
+	// ActivityPub URIs of other accounts this account is also known as,
+	// ie., aliases from which this account can be moved to.
+	AlsoKnownAsURIs []string `json:"also_known_as_uris,omitempty"`
 }
@@ -27,6 +27,11 @@ type Status struct {
 	// The date when this status was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at"`
+	// The date when this status was last edited (ISO 8601 Datetime).
+	// Will be null if the status has never been edited.
+	// example: 2021-07-30T09:20:25+00:00
+	// nullable: true
+	EditedAt *string `json:"edited_at"`
 	// ID of the status being replied to.
 	// example: 01FBVD42CQ3ZEEVMW180SBX03B
 	// nullable: true
@@ -98,6 +103,9 @@ type Status struct {
 	// so the user may redraft from the source text without the client having to reverse-engineer
 	// the original text from the HTML content.
 	Text string `json:"text,omitempty"`
+	// Filters that matched this status, if any, along with what matched.
+	// Only set when the status is served in a context where the requesting account has a filter with the "warn" action.
+	Filtered []FilterResult `json:"filtered,omitempty"`
 }
 
 /*
@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// StatusEdit represents one version of a status, as it
+// was at the time of its creation or one of its edits.
+//
+// swagger:model statusEdit
+type StatusEdit struct {
+	// The content of this version of the status. Should be HTML, but might also be plaintext in some cases.
+	// example: <p>Hey this is a status!</p>
+	Content string `json:"content"`
+	// Subject, summary, or content warning for this version of the status.
+	// example: warning nsfw
+	SpoilerText string `json:"spoiler_text"`
+	// This version of the status contains sensitive content.
+	// example: false
+	Sensitive bool `json:"sensitive"`
+	// The date when this version of the status was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The account that authored this status.
+	Account *Account `json:"account"`
+	// The poll attached to this version of the status, if any.
+	// nullable: true
+	Poll *StatusEditPoll `json:"poll"`
+	// Media that was attached to this version of the status.
+	MediaAttachments []Attachment `json:"media_attachments"`
+	// Custom emoji to be used when rendering this version of the status.
+	Emojis []Emoji `json:"emojis"`
+}
+
+// StatusEditPoll represents the options of a poll
+// attached to one version of a status.
+//
+// swagger:model statusEditPoll
+type StatusEditPoll struct {
+	// Possible answers for the poll.
+	Options []StatusEditPollOption `json:"options"`
+}
+
+// StatusEditPollOption represents one option of a poll
+// attached to one version of a status.
+//
+// swagger:model statusEditPollOption
+type StatusEditPollOption struct {
+	// The text value of the poll option.
+	Title string `json:"title"`
+}
+
+// StatusSource represents the plain-text source of a status, for use when editing it.
+//
+// swagger:model statusSource
+type StatusSource struct {
+	// ID of the status.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Plain-text source of the status.
+	Text string `json:"text"`
+	// Plain-text version of the spoiler text / content warning.
+	SpoilerText string `json:"spoiler_text"`
+}
+
+// StatusEditRequest models status edit parameters.
+//
+// swagger:model statusEditRequest
+type StatusEditRequest struct {
+	// Text content of the status.
+	// If media_ids is provided, this becomes optional.
+	// Attaching a poll is optional while status is provided.
+	// in: formData
+	Status string `form:"status" json:"status" xml:"status"`
+	// Array of Attachment ids to be attached as media.
+	// If provided, status becomes optional, and poll cannot be used.
+	//
+	// If the status is being submitted as a form, the key is 'media_ids[]',
+	// but if it's json or xml, the key is 'media_ids'.
+	//
+	// in: formData
+	MediaIDs []string `form:"media_ids[]" json:"media_ids" xml:"media_ids"`
+	// Poll to include with this status.
+	// swagger:ignore
+	Poll *PollRequest `form:"poll" json:"poll" xml:"poll"`
+	// Status and attached media should be marked as sensitive.
+	// in: formData
+	Sensitive bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
+	// Text to be shown as a warning or subject before the actual content.
+	// Statuses are generally collapsed behind this field.
+	// in: formData
+	SpoilerText string `form:"spoiler_text" json:"spoiler_text" xml:"spoiler_text"`
+	// ISO 639 language code for this status.
+	// in: formData
+	Language string `form:"language" json:"language" xml:"language"`
+	// Content type to use when parsing this status.
+	// in: formData
+	ContentType StatusContentType `form:"content_type" json:"content_type" xml:"content_type"`
+}
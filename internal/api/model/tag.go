@@ -27,4 +27,7 @@ type Tag struct {
 	// Web link to the hashtag.
 	// example: https://example.org/tags/helloworld
 	URL string `json:"url"`
+	// Is the requesting account following this hashtag?
+	// Only set when the tag is fetched directly, or as a followed tag.
+	Following *bool `json:"following,omitempty"`
 }
@@ -25,60 +25,61 @@ import (
 	"github.com/miekg/dns"
 )
 
-// BlockCache provides a means of caching domain blocks in memory to reduce load
+// Cache provides a means of caching domain blocks (or allows) in memory to reduce load
 // on an underlying storage mechanism, e.g. a database.
 //
-// It consists of a TTL primary cache that stores calculated domain string to block results,
-// that on cache miss is filled by calculating block status by iterating over a list of all of
-// the domain blocks stored in memory. This reduces CPU usage required by not need needing to
+// It consists of a TTL primary cache that stores calculated domain string to match results,
+// that on cache miss is filled by calculating match status by iterating over a list of all of
+// the domains stored in memory. This reduces CPU usage required by not need needing to
 // iterate through a possible 100-1000s long block list, while saving memory by having a primary
-// cache of limited size that evicts stale entries. The raw list of all domain blocks should in
+// cache of limited size that evicts stale entries. The raw list of all domains should in
 // most cases be negligible when it comes to memory usage.
 //
-// The in-memory block list is kept up-to-date by means of a passed loader function during every
-// call to .IsBlocked(). In the case of a nil internal block list, the loader function is called to
-// hydrate the cache with the latest list of domain blocks. The .Clear() function can be used to invalidate
+// The in-memory domain list is kept up-to-date by means of a passed loader function during every
+// call to .Matches(). In the case of a nil internal domain list, the loader function is called to
+// hydrate the cache with the latest list of domains. The .Clear() function can be used to invalidate
 // the cache, e.g. when a domain block is added / deleted from the database. It will drop the current
-// list of domain blocks and clear all entries from the primary cache.
-type BlockCache struct {
-	pcache *ttl.Cache[string, bool] // primary cache of domains -> block results
-	blocks []block                  // raw list of all domain blocks, nil => not loaded.
+// list of domains and clear all entries from the primary cache.
+type Cache struct {
+	pcache  *ttl.Cache[string, bool] // primary cache of domains -> match results
+	domains []entry                  // raw list of all domains, nil => not loaded.
 }
 
-// New returns a new initialized BlockCache instance with given primary cache capacity and TTL.
-func New(pcap int, pttl time.Duration) *BlockCache {
-	c := new(BlockCache)
+// New returns a new initialized Cache instance with given primary cache capacity and TTL.
+func New(pcap int, pttl time.Duration) *Cache {
+	c := new(Cache)
 	c.pcache = new(ttl.Cache[string, bool])
 	c.pcache.Init(0, pcap, pttl)
 	return c
 }
 
 // Start will start the cache background eviction routine with given sweep frequency. If already running or a freq <= 0 provided, this is a no-op. This will block until the eviction routine has started.
-func (b *BlockCache) Start(pfreq time.Duration) bool {
+func (b *Cache) Start(pfreq time.Duration) bool {
 	return b.pcache.Start(pfreq)
 }
 
 // Stop will stop cache background eviction routine. If not running this is a no-op. This will block until the eviction routine has stopped.
-func (b *BlockCache) Stop() bool {
+func (b *Cache) Stop() bool {
 	return b.pcache.Stop()
 }
 
-// IsBlocked checks whether domain is blocked. If the cache is not currently loaded, then the provided load function is used to hydrate it.
+// Matches checks whether domain matches (ie., is, or is a subdomain of) one of the domains in the cache.
+// If the cache is not currently loaded, then the provided load function is used to hydrate it.
 // NOTE: be VERY careful using any kind of locking mechanism within the load function, as this itself is ran within the cache mutex lock.
-func (b *BlockCache) IsBlocked(domain string, load func() ([]string, error)) (bool, error) {
-	var blocked bool
+func (b *Cache) Matches(domain string, load func() ([]string, error)) (bool, error) {
+	var match bool
 
 	// Acquire cache lock
 	b.pcache.Lock()
 	defer b.pcache.Unlock()
 
 	// Check primary cache for result
-	entry, ok := b.pcache.Cache.Get(domain)
+	cached, ok := b.pcache.Cache.Get(domain)
 	if ok {
-		return entry.Value, nil
+		return cached.Value, nil
 	}
 
-	if b.blocks == nil {
+	if b.domains == nil {
 		// Cache is not hydrated
 		//
 		// Load domains from callback
@@ -87,42 +88,42 @@ func (b *BlockCache) IsBlocked(domain string, load func() ([]string, error)) (bo
 			return false, fmt.Errorf("error reloading cache: %w", err)
 		}
 
-		// Drop all domain blocks and recreate
-		b.blocks = make([]block, len(domains))
+		// Drop all domains and recreate
+		b.domains = make([]entry, len(domains))
 
 		for i, domain := range domains {
-			// Store pre-split labels for each domain block
-			b.blocks[i].labels = dns.SplitDomainName(domain)
+			// Store pre-split labels for each domain
+			b.domains[i].labels = dns.SplitDomainName(domain)
 		}
 	}
 
 	// Split domain into it separate labels
 	labels := dns.SplitDomainName(domain)
 
-	// Compare this to our stored blocks
-	for _, block := range b.blocks {
-		if block.Blocks(labels) {
-			blocked = true
+	// Compare this to our stored domains
+	for _, e := range b.domains {
+		if e.Matches(labels) {
+			match = true
 			break
 		}
 	}
 
-	// Store block result in primary cache
+	// Store match result in primary cache
 	b.pcache.Cache.Set(domain, &ttl.Entry[string, bool]{
 		Key:    domain,
-		Value:  blocked,
+		Value:  match,
 		Expiry: time.Now().Add(b.pcache.TTL),
 	})
 
-	return blocked, nil
+	return match, nil
 }
 
 // Clear will drop the currently loaded domain list, and clear the primary cache.
-// This will trigger a reload on next call to .IsBlocked().
-func (b *BlockCache) Clear() {
-	// Drop all blocks.
+// This will trigger a reload on next call to .Matches().
+func (b *Cache) Clear() {
+	// Drop all domains.
 	b.pcache.Lock()
-	b.blocks = nil
+	b.domains = nil
 	b.pcache.Unlock()
 
 	// Clear needs to be done _outside_ of
@@ -130,37 +131,37 @@ func (b *BlockCache) Clear() {
 	b.pcache.Clear()
 }
 
-// block represents a domain block, and stores the
-// deconstructed labels of a singular domain block.
+// entry represents a domain block (or allow), and stores
+// the deconstructed labels of a singular domain.
 // e.g. []string{"gts", "superseriousbusiness", "org"}.
-type block struct {
+type entry struct {
 	labels []string
 }
 
-// Blocks checks whether the separated domain labels of an
-// incoming domain matches the stored (receiving struct) block.
-func (b block) Blocks(labels []string) bool {
+// Matches checks whether the separated domain labels of an
+// incoming domain matches the stored (receiving struct) domain.
+func (e entry) Matches(labels []string) bool {
 	// Calculate length difference
-	d := len(labels) - len(b.labels)
+	d := len(labels) - len(e.labels)
 	if d < 0 {
 		return false
 	}
 
-	// Iterate backwards through domain block's
+	// Iterate backwards through stored domain's
 	// labels, omparing against the incoming domain's.
 	//
 	// So for the following input:
 	// labels   = []string{"mail", "google", "com"}
-	// b.labels = []string{"google", "com"}
+	// e.labels = []string{"google", "com"}
 	//
 	// These would be matched in reverse order along
-	// the entirety of the block object's labels:
+	// the entirety of the entry's labels:
 	// "com"    => match
 	// "google" => match
 	//
 	// And so would reach the end and return true.
-	for i := len(b.labels) - 1; i >= 0; i-- {
-		if b.labels[i] != labels[i+d] {
+	for i := len(e.labels) - 1; i >= 0; i-- {
+		if e.labels[i] != labels[i+d] {
 			return false
 		}
 	}
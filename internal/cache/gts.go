@@ -42,8 +42,20 @@ type GTSCaches interface {
 	// Block provides access to the gtsmodel Block (account) database cache.
 	Block() *result.Cache[*gtsmodel.Block]
 
+	// Conversation provides access to the gtsmodel Conversation database cache.
+	Conversation() *result.Cache[*gtsmodel.Conversation]
+
+	// DomainAllow provides access to the domain allow database cache.
+	DomainAllow() *domain.Cache
+
 	// DomainBlock provides access to the domain block database cache.
-	DomainBlock() *domain.BlockCache
+	DomainBlock() *domain.Cache
+
+	// DomainLimit provides access to the limited domain block database cache.
+	DomainLimit() *domain.Cache
+
+	// DomainRejectMedia provides access to the media rejecting domain block database cache.
+	DomainRejectMedia() *domain.Cache
 
 	// Emoji provides access to the gtsmodel Emoji database cache.
 	Emoji() *result.Cache[*gtsmodel.Emoji]
@@ -51,6 +63,18 @@ type GTSCaches interface {
 	// EmojiCategory provides access to the gtsmodel EmojiCategory database cache.
 	EmojiCategory() *result.Cache[*gtsmodel.EmojiCategory]
 
+	// Filter provides access to the gtsmodel Filter database cache.
+	Filter() *result.Cache[*gtsmodel.Filter]
+
+	// FilterKeyword provides access to the gtsmodel FilterKeyword database cache.
+	FilterKeyword() *result.Cache[*gtsmodel.FilterKeyword]
+
+	// List provides access to the gtsmodel List database cache.
+	List() *result.Cache[*gtsmodel.List]
+
+	// ListEntry provides access to the gtsmodel ListEntry database cache.
+	ListEntry() *result.Cache[*gtsmodel.ListEntry]
+
 	// Mention provides access to the gtsmodel Mention database cache.
 	Mention() *result.Cache[*gtsmodel.Mention]
 
@@ -60,18 +84,30 @@ type GTSCaches interface {
 	// Notification provides access to the gtsmodel Notification database cache.
 	Notification() *result.Cache[*gtsmodel.Notification]
 
+	// Poll provides access to the gtsmodel Poll database cache.
+	Poll() *result.Cache[*gtsmodel.Poll]
+
+	// PollVote provides access to the gtsmodel PollVote database cache.
+	PollVote() *result.Cache[*gtsmodel.PollVote]
+
 	// Report provides access to the gtsmodel Report database cache.
 	Report() *result.Cache[*gtsmodel.Report]
 
 	// Status provides access to the gtsmodel Status database cache.
 	Status() *result.Cache[*gtsmodel.Status]
 
+	// StatusEdit provides access to the gtsmodel StatusEdit database cache.
+	StatusEdit() *result.Cache[*gtsmodel.StatusEdit]
+
 	// Tombstone provides access to the gtsmodel Tombstone database cache.
 	Tombstone() *result.Cache[*gtsmodel.Tombstone]
 
 	// User provides access to the gtsmodel User database cache.
 	User() *result.Cache[*gtsmodel.User]
 
+	// UserMute provides access to the gtsmodel UserMute database cache.
+	UserMute() *result.Cache[*gtsmodel.UserMute]
+
 	// Webfinger
 	Webfinger() *ttl.Cache[string, string]
 }
@@ -82,34 +118,58 @@ func NewGTS() GTSCaches {
 }
 
 type gtsCaches struct {
-	account       *result.Cache[*gtsmodel.Account]
-	block         *result.Cache[*gtsmodel.Block]
-	domainBlock   *domain.BlockCache
-	emoji         *result.Cache[*gtsmodel.Emoji]
-	emojiCategory *result.Cache[*gtsmodel.EmojiCategory]
-	media         *result.Cache[*gtsmodel.MediaAttachment]
-	mention       *result.Cache[*gtsmodel.Mention]
-	notification  *result.Cache[*gtsmodel.Notification]
-	report        *result.Cache[*gtsmodel.Report]
-	status        *result.Cache[*gtsmodel.Status]
-	tombstone     *result.Cache[*gtsmodel.Tombstone]
-	user          *result.Cache[*gtsmodel.User]
-	webfinger     *ttl.Cache[string, string]
+	account           *result.Cache[*gtsmodel.Account]
+	block             *result.Cache[*gtsmodel.Block]
+	conversation      *result.Cache[*gtsmodel.Conversation]
+	domainAllow       *domain.Cache
+	domainBlock       *domain.Cache
+	domainLimit       *domain.Cache
+	domainRejectMedia *domain.Cache
+	emoji             *result.Cache[*gtsmodel.Emoji]
+	emojiCategory     *result.Cache[*gtsmodel.EmojiCategory]
+	filter            *result.Cache[*gtsmodel.Filter]
+	filterKeyword     *result.Cache[*gtsmodel.FilterKeyword]
+	list              *result.Cache[*gtsmodel.List]
+	listEntry         *result.Cache[*gtsmodel.ListEntry]
+	media             *result.Cache[*gtsmodel.MediaAttachment]
+	mention           *result.Cache[*gtsmodel.Mention]
+	notification      *result.Cache[*gtsmodel.Notification]
+	poll              *result.Cache[*gtsmodel.Poll]
+	pollVote          *result.Cache[*gtsmodel.PollVote]
+	report            *result.Cache[*gtsmodel.Report]
+	status            *result.Cache[*gtsmodel.Status]
+	statusEdit        *result.Cache[*gtsmodel.StatusEdit]
+	tombstone         *result.Cache[*gtsmodel.Tombstone]
+	user              *result.Cache[*gtsmodel.User]
+	userMute          *result.Cache[*gtsmodel.UserMute]
+	webfinger         *ttl.Cache[string, string]
 }
 
 func (c *gtsCaches) Init() {
 	c.initAccount()
 	c.initBlock()
+	c.initConversation()
+	c.initDomainAllow()
 	c.initDomainBlock()
+	c.initDomainLimit()
+	c.initDomainRejectMedia()
 	c.initEmoji()
 	c.initEmojiCategory()
+	c.initFilter()
+	c.initFilterKeyword()
+	c.initList()
+	c.initListEntry()
 	c.initMedia()
 	c.initMention()
 	c.initNotification()
+	c.initPoll()
+	c.initPollVote()
 	c.initReport()
 	c.initStatus()
+	c.initStatusEdit()
 	c.initTombstone()
 	c.initUser()
+	c.initUserMute()
 	c.initWebfinger()
 }
 
@@ -120,15 +180,39 @@ func (c *gtsCaches) Start() {
 	tryUntil("starting gtsmodel.Block cache", 5, func() bool {
 		return c.block.Start(config.GetCacheGTSBlockSweepFreq())
 	})
+	tryUntil("starting gtsmodel.Conversation cache", 5, func() bool {
+		return c.conversation.Start(config.GetCacheGTSConversationSweepFreq())
+	})
+	tryUntil("starting gtsmodel.DomainAllow cache", 5, func() bool {
+		return c.domainAllow.Start(config.GetCacheGTSDomainBlockSweepFreq())
+	})
 	tryUntil("starting gtsmodel.DomainBlock cache", 5, func() bool {
 		return c.domainBlock.Start(config.GetCacheGTSDomainBlockSweepFreq())
 	})
+	tryUntil("starting limited gtsmodel.DomainBlock cache", 5, func() bool {
+		return c.domainLimit.Start(config.GetCacheGTSDomainBlockSweepFreq())
+	})
+	tryUntil("starting media rejecting gtsmodel.DomainBlock cache", 5, func() bool {
+		return c.domainRejectMedia.Start(config.GetCacheGTSDomainBlockSweepFreq())
+	})
 	tryUntil("starting gtsmodel.Emoji cache", 5, func() bool {
 		return c.emoji.Start(config.GetCacheGTSEmojiSweepFreq())
 	})
 	tryUntil("starting gtsmodel.EmojiCategory cache", 5, func() bool {
 		return c.emojiCategory.Start(config.GetCacheGTSEmojiCategorySweepFreq())
 	})
+	tryUntil("starting gtsmodel.Filter cache", 5, func() bool {
+		return c.filter.Start(config.GetCacheGTSFilterSweepFreq())
+	})
+	tryUntil("starting gtsmodel.FilterKeyword cache", 5, func() bool {
+		return c.filterKeyword.Start(config.GetCacheGTSFilterKeywordSweepFreq())
+	})
+	tryUntil("starting gtsmodel.List cache", 5, func() bool {
+		return c.list.Start(config.GetCacheGTSListSweepFreq())
+	})
+	tryUntil("starting gtsmodel.ListEntry cache", 5, func() bool {
+		return c.listEntry.Start(config.GetCacheGTSListEntrySweepFreq())
+	})
 	tryUntil("starting gtsmodel.MediaAttachment cache", 5, func() bool {
 		return c.media.Start(config.GetCacheGTSMediaSweepFreq())
 	})
@@ -138,18 +222,30 @@ func (c *gtsCaches) Start() {
 	tryUntil("starting gtsmodel.Notification cache", 5, func() bool {
 		return c.notification.Start(config.GetCacheGTSNotificationSweepFreq())
 	})
+	tryUntil("starting gtsmodel.Poll cache", 5, func() bool {
+		return c.poll.Start(config.GetCacheGTSPollSweepFreq())
+	})
+	tryUntil("starting gtsmodel.PollVote cache", 5, func() bool {
+		return c.pollVote.Start(config.GetCacheGTSPollVoteSweepFreq())
+	})
 	tryUntil("starting gtsmodel.Report cache", 5, func() bool {
 		return c.report.Start(config.GetCacheGTSReportSweepFreq())
 	})
 	tryUntil("starting gtsmodel.Status cache", 5, func() bool {
 		return c.status.Start(config.GetCacheGTSStatusSweepFreq())
 	})
+	tryUntil("starting gtsmodel.StatusEdit cache", 5, func() bool {
+		return c.statusEdit.Start(config.GetCacheGTSStatusEditSweepFreq())
+	})
 	tryUntil("starting gtsmodel.Tombstone cache", 5, func() bool {
 		return c.tombstone.Start(config.GetCacheGTSTombstoneSweepFreq())
 	})
 	tryUntil("starting gtsmodel.User cache", 5, func() bool {
 		return c.user.Start(config.GetCacheGTSUserSweepFreq())
 	})
+	tryUntil("starting gtsmodel.UserMute cache", 5, func() bool {
+		return c.userMute.Start(config.GetCacheGTSUserMuteSweepFreq())
+	})
 	tryUntil("starting gtsmodel.Webfinger cache", 5, func() bool {
 		return c.webfinger.Start(config.GetCacheGTSWebfingerSweepFreq())
 	})
@@ -158,16 +254,28 @@ func (c *gtsCaches) Start() {
 func (c *gtsCaches) Stop() {
 	tryUntil("stopping gtsmodel.Account cache", 5, c.account.Stop)
 	tryUntil("stopping gtsmodel.Block cache", 5, c.block.Stop)
+	tryUntil("stopping gtsmodel.Conversation cache", 5, c.conversation.Stop)
+	tryUntil("stopping gtsmodel.DomainAllow cache", 5, c.domainAllow.Stop)
 	tryUntil("stopping gtsmodel.DomainBlock cache", 5, c.domainBlock.Stop)
+	tryUntil("stopping limited gtsmodel.DomainBlock cache", 5, c.domainLimit.Stop)
+	tryUntil("stopping media rejecting gtsmodel.DomainBlock cache", 5, c.domainRejectMedia.Stop)
 	tryUntil("stopping gtsmodel.Emoji cache", 5, c.emoji.Stop)
 	tryUntil("stopping gtsmodel.EmojiCategory cache", 5, c.emojiCategory.Stop)
+	tryUntil("stopping gtsmodel.Filter cache", 5, c.filter.Stop)
+	tryUntil("stopping gtsmodel.FilterKeyword cache", 5, c.filterKeyword.Stop)
+	tryUntil("stopping gtsmodel.List cache", 5, c.list.Stop)
+	tryUntil("stopping gtsmodel.ListEntry cache", 5, c.listEntry.Stop)
 	tryUntil("stopping gtsmodel.MediaAttachment cache", 5, c.media.Stop)
 	tryUntil("stopping gtsmodel.Mention cache", 5, c.mention.Stop)
 	tryUntil("stopping gtsmodel.Notification cache", 5, c.notification.Stop)
+	tryUntil("stopping gtsmodel.Poll cache", 5, c.poll.Stop)
+	tryUntil("stopping gtsmodel.PollVote cache", 5, c.pollVote.Stop)
 	tryUntil("stopping gtsmodel.Report cache", 5, c.report.Stop)
 	tryUntil("stopping gtsmodel.Status cache", 5, c.status.Stop)
+	tryUntil("stopping gtsmodel.StatusEdit cache", 5, c.statusEdit.Stop)
 	tryUntil("stopping gtsmodel.Tombstone cache", 5, c.tombstone.Stop)
 	tryUntil("stopping gtsmodel.User cache", 5, c.user.Stop)
+	tryUntil("stopping gtsmodel.UserMute cache", 5, c.userMute.Stop)
 	tryUntil("stopping gtsmodel.Webfinger cache", 5, c.webfinger.Stop)
 }
 
@@ -179,10 +287,26 @@ func (c *gtsCaches) Block() *result.Cache[*gtsmodel.Block] {
 	return c.block
 }
 
-func (c *gtsCaches) DomainBlock() *domain.BlockCache {
+func (c *gtsCaches) Conversation() *result.Cache[*gtsmodel.Conversation] {
+	return c.conversation
+}
+
+func (c *gtsCaches) DomainAllow() *domain.Cache {
+	return c.domainAllow
+}
+
+func (c *gtsCaches) DomainBlock() *domain.Cache {
 	return c.domainBlock
 }
 
+func (c *gtsCaches) DomainLimit() *domain.Cache {
+	return c.domainLimit
+}
+
+func (c *gtsCaches) DomainRejectMedia() *domain.Cache {
+	return c.domainRejectMedia
+}
+
 func (c *gtsCaches) Emoji() *result.Cache[*gtsmodel.Emoji] {
 	return c.emoji
 }
@@ -191,6 +315,22 @@ func (c *gtsCaches) EmojiCategory() *result.Cache[*gtsmodel.EmojiCategory] {
 	return c.emojiCategory
 }
 
+func (c *gtsCaches) Filter() *result.Cache[*gtsmodel.Filter] {
+	return c.filter
+}
+
+func (c *gtsCaches) FilterKeyword() *result.Cache[*gtsmodel.FilterKeyword] {
+	return c.filterKeyword
+}
+
+func (c *gtsCaches) List() *result.Cache[*gtsmodel.List] {
+	return c.list
+}
+
+func (c *gtsCaches) ListEntry() *result.Cache[*gtsmodel.ListEntry] {
+	return c.listEntry
+}
+
 func (c *gtsCaches) Media() *result.Cache[*gtsmodel.MediaAttachment] {
 	return c.media
 }
@@ -203,6 +343,14 @@ func (c *gtsCaches) Notification() *result.Cache[*gtsmodel.Notification] {
 	return c.notification
 }
 
+func (c *gtsCaches) Poll() *result.Cache[*gtsmodel.Poll] {
+	return c.poll
+}
+
+func (c *gtsCaches) PollVote() *result.Cache[*gtsmodel.PollVote] {
+	return c.pollVote
+}
+
 func (c *gtsCaches) Report() *result.Cache[*gtsmodel.Report] {
 	return c.report
 }
@@ -211,6 +359,10 @@ func (c *gtsCaches) Status() *result.Cache[*gtsmodel.Status] {
 	return c.status
 }
 
+func (c *gtsCaches) StatusEdit() *result.Cache[*gtsmodel.StatusEdit] {
+	return c.statusEdit
+}
+
 func (c *gtsCaches) Tombstone() *result.Cache[*gtsmodel.Tombstone] {
 	return c.tombstone
 }
@@ -219,6 +371,10 @@ func (c *gtsCaches) User() *result.Cache[*gtsmodel.User] {
 	return c.user
 }
 
+func (c *gtsCaches) UserMute() *result.Cache[*gtsmodel.UserMute] {
+	return c.userMute
+}
+
 func (c *gtsCaches) Webfinger() *ttl.Cache[string, string] {
 	return c.webfinger
 }
@@ -251,6 +407,31 @@ func (c *gtsCaches) initBlock() {
 	c.block.SetTTL(config.GetCacheGTSBlockTTL(), true)
 }
 
+func (c *gtsCaches) initConversation() {
+	c.conversation = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "AccountID.ThreadID.OtherAccountsKey"},
+	}, func(c1 *gtsmodel.Conversation) *gtsmodel.Conversation {
+		c2 := new(gtsmodel.Conversation)
+		*c2 = *c1
+		// always repopulated on load
+		c2.Account = nil
+		c2.OtherAccounts = nil
+		c2.LastStatus = nil
+		return c2
+	}, config.GetCacheGTSConversationMaxSize())
+	c.conversation.SetTTL(config.GetCacheGTSConversationTTL(), true)
+}
+
+func (c *gtsCaches) initDomainAllow() {
+	// Domain allows are few and far between compared to
+	// domain blocks, so just share the block cache sizing.
+	c.domainAllow = domain.New(
+		config.GetCacheGTSDomainBlockMaxSize(),
+		config.GetCacheGTSDomainBlockTTL(),
+	)
+}
+
 func (c *gtsCaches) initDomainBlock() {
 	c.domainBlock = domain.New(
 		config.GetCacheGTSDomainBlockMaxSize(),
@@ -258,6 +439,22 @@ func (c *gtsCaches) initDomainBlock() {
 	)
 }
 
+func (c *gtsCaches) initDomainLimit() {
+	// These are just subsets of
+	// domain blocks, so share sizing.
+	c.domainLimit = domain.New(
+		config.GetCacheGTSDomainBlockMaxSize(),
+		config.GetCacheGTSDomainBlockTTL(),
+	)
+}
+
+func (c *gtsCaches) initDomainRejectMedia() {
+	c.domainRejectMedia = domain.New(
+		config.GetCacheGTSDomainBlockMaxSize(),
+		config.GetCacheGTSDomainBlockTTL(),
+	)
+}
+
 func (c *gtsCaches) initEmoji() {
 	c.emoji = result.New([]result.Lookup{
 		{Name: "ID"},
@@ -284,6 +481,52 @@ func (c *gtsCaches) initEmojiCategory() {
 	c.emojiCategory.SetTTL(config.GetCacheGTSEmojiCategoryTTL(), true)
 }
 
+func (c *gtsCaches) initFilter() {
+	c.filter = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(f1 *gtsmodel.Filter) *gtsmodel.Filter {
+		f2 := new(gtsmodel.Filter)
+		*f2 = *f1
+		f2.Keywords = nil // always repopulated on load
+		return f2
+	}, config.GetCacheGTSFilterMaxSize())
+	c.filter.SetTTL(config.GetCacheGTSFilterTTL(), true)
+}
+
+func (c *gtsCaches) initFilterKeyword() {
+	c.filterKeyword = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(k1 *gtsmodel.FilterKeyword) *gtsmodel.FilterKeyword {
+		k2 := new(gtsmodel.FilterKeyword)
+		*k2 = *k1
+		k2.Filter = nil // always repopulated on load
+		return k2
+	}, config.GetCacheGTSFilterKeywordMaxSize())
+	c.filterKeyword.SetTTL(config.GetCacheGTSFilterKeywordTTL(), true)
+}
+
+func (c *gtsCaches) initList() {
+	c.list = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(l1 *gtsmodel.List) *gtsmodel.List {
+		l2 := new(gtsmodel.List)
+		*l2 = *l1
+		return l2
+	}, config.GetCacheGTSListMaxSize())
+	c.list.SetTTL(config.GetCacheGTSListTTL(), true)
+}
+
+func (c *gtsCaches) initListEntry() {
+	c.listEntry = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(l1 *gtsmodel.ListEntry) *gtsmodel.ListEntry {
+		l2 := new(gtsmodel.ListEntry)
+		*l2 = *l1
+		return l2
+	}, config.GetCacheGTSListEntryMaxSize())
+	c.listEntry.SetTTL(config.GetCacheGTSListEntryTTL(), true)
+}
+
 func (c *gtsCaches) initMedia() {
 	c.media = result.New([]result.Lookup{
 		{Name: "ID"},
@@ -317,6 +560,33 @@ func (c *gtsCaches) initNotification() {
 	c.notification.SetTTL(config.GetCacheGTSNotificationTTL(), true)
 }
 
+func (c *gtsCaches) initPoll() {
+	c.poll = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(p1 *gtsmodel.Poll) *gtsmodel.Poll {
+		p2 := new(gtsmodel.Poll)
+		*p2 = *p1
+		p2.Votes = append([]int(nil), p1.Votes...) // vote counts are modified in place
+		p2.Status = nil                            // always repopulated on load
+		return p2
+	}, config.GetCacheGTSPollMaxSize())
+	c.poll.SetTTL(config.GetCacheGTSPollTTL(), true)
+}
+
+func (c *gtsCaches) initPollVote() {
+	c.pollVote = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "PollID.AccountID"},
+	}, func(v1 *gtsmodel.PollVote) *gtsmodel.PollVote {
+		v2 := new(gtsmodel.PollVote)
+		*v2 = *v1
+		v2.Account = nil // always repopulated on load
+		v2.Poll = nil    // always repopulated on load
+		return v2
+	}, config.GetCacheGTSPollVoteMaxSize())
+	c.pollVote.SetTTL(config.GetCacheGTSPollVoteTTL(), true)
+}
+
 func (c *gtsCaches) initReport() {
 	c.report = result.New([]result.Lookup{
 		{Name: "ID"},
@@ -336,11 +606,23 @@ func (c *gtsCaches) initStatus() {
 	}, func(s1 *gtsmodel.Status) *gtsmodel.Status {
 		s2 := new(gtsmodel.Status)
 		*s2 = *s1
+		s2.Poll = nil // always repopulated on load
 		return s2
 	}, config.GetCacheGTSStatusMaxSize())
 	c.status.SetTTL(config.GetCacheGTSStatusTTL(), true)
 }
 
+func (c *gtsCaches) initStatusEdit() {
+	c.statusEdit = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(e1 *gtsmodel.StatusEdit) *gtsmodel.StatusEdit {
+		e2 := new(gtsmodel.StatusEdit)
+		*e2 = *e1
+		return e2
+	}, config.GetCacheGTSStatusEditMaxSize())
+	c.statusEdit.SetTTL(config.GetCacheGTSStatusEditTTL(), true)
+}
+
 // initTombstone will initialize the gtsmodel.Tombstone cache.
 func (c *gtsCaches) initTombstone() {
 	c.tombstone = result.New([]result.Lookup{
@@ -369,6 +651,18 @@ func (c *gtsCaches) initUser() {
 	c.user.SetTTL(config.GetCacheGTSUserTTL(), true)
 }
 
+func (c *gtsCaches) initUserMute() {
+	c.userMute = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "AccountID.TargetAccountID"},
+	}, func(m1 *gtsmodel.UserMute) *gtsmodel.UserMute {
+		m2 := new(gtsmodel.UserMute)
+		*m2 = *m1
+		return m2
+	}, config.GetCacheGTSUserMuteMaxSize())
+	c.userMute.SetTTL(config.GetCacheGTSUserMuteTTL(), true)
+}
+
 func (c *gtsCaches) initWebfinger() {
 	c.webfinger = ttl.New[string, string](
 		0,
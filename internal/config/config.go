@@ -74,11 +74,15 @@ type Configuration struct {
 	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
 	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
 
-	InstanceExposePeers            bool `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
-	InstanceExposeSuspended        bool `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
-	InstanceExposeSuspendedWeb     bool `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
-	InstanceExposePublicTimeline   bool `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
-	InstanceDeliverToSharedInboxes bool `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceExposePeers            bool          `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
+	InstanceExposeSuspended        bool          `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
+	InstanceExposeSuspendedWeb     bool          `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
+	InstanceExposePublicTimeline   bool          `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
+	InstanceDeliverToSharedInboxes bool          `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceFederationMode         string        `name:"instance-federation-mode" usage:"Set instance federation mode: 'blocklist' to federate with everyone apart from blocked domains, 'allowlist' to federate only with explicitly allowed domains."`
+	InstanceAuthorizedFetch        bool          `name:"instance-authorized-fetch" usage:"Require http signatures on GET requests to ActivityPub endpoints (users, statuses, collections, emojis), and check the signer against account and domain blocks. Public keys and webfinger are always served."`
+	InstanceUnavailableAfter       time.Duration `name:"instance-unavailable-after" usage:"Mark instances as unavailable once delivery to them has been failing for this long, and skip delivery to them apart from occasional probes. 0 to never mark instances as unavailable."`
+	InstanceSignRFC9421            bool          `name:"instance-sign-rfc9421" usage:"Sign outgoing http requests with RFC 9421 http message signatures, falling back to draft-cavage http signatures for instances which don't accept them."`
 
 	AccountsRegistrationOpen bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
 	AccountsApprovalRequired bool `name:"accounts-approval-required" usage:"Do account signups require approval by an admin or moderator before user can log in? If false, new registrations will be automatically approved."`
@@ -168,6 +172,10 @@ type GTSCacheConfiguration struct {
 	BlockTTL       time.Duration `name:"block-ttl"`
 	BlockSweepFreq time.Duration `name:"block-sweep-freq"`
 
+	ConversationMaxSize   int           `name:"conversation-max-size"`
+	ConversationTTL       time.Duration `name:"conversation-ttl"`
+	ConversationSweepFreq time.Duration `name:"conversation-sweep-freq"`
+
 	DomainBlockMaxSize   int           `name:"domain-block-max-size"`
 	DomainBlockTTL       time.Duration `name:"domain-block-ttl"`
 	DomainBlockSweepFreq time.Duration `name:"domain-block-sweep-freq"`
@@ -180,6 +188,22 @@ type GTSCacheConfiguration struct {
 	EmojiCategoryTTL       time.Duration `name:"emoji-category-ttl"`
 	EmojiCategorySweepFreq time.Duration `name:"emoji-category-sweep-freq"`
 
+	FilterMaxSize   int           `name:"filter-max-size"`
+	FilterTTL       time.Duration `name:"filter-ttl"`
+	FilterSweepFreq time.Duration `name:"filter-sweep-freq"`
+
+	FilterKeywordMaxSize   int           `name:"filter-keyword-max-size"`
+	FilterKeywordTTL       time.Duration `name:"filter-keyword-ttl"`
+	FilterKeywordSweepFreq time.Duration `name:"filter-keyword-sweep-freq"`
+
+	ListMaxSize   int           `name:"list-max-size"`
+	ListTTL       time.Duration `name:"list-ttl"`
+	ListSweepFreq time.Duration `name:"list-sweep-freq"`
+
+	ListEntryMaxSize   int           `name:"list-entry-max-size"`
+	ListEntryTTL       time.Duration `name:"list-entry-ttl"`
+	ListEntrySweepFreq time.Duration `name:"list-entry-sweep-freq"`
+
 	MediaMaxSize   int           `name:"media-max-size"`
 	MediaTTL       time.Duration `name:"media-ttl"`
 	MediaSweepFreq time.Duration `name:"media-sweep-freq"`
@@ -192,6 +216,14 @@ type GTSCacheConfiguration struct {
 	NotificationTTL       time.Duration `name:"notification-ttl"`
 	NotificationSweepFreq time.Duration `name:"notification-sweep-freq"`
 
+	PollMaxSize   int           `name:"poll-max-size"`
+	PollTTL       time.Duration `name:"poll-ttl"`
+	PollSweepFreq time.Duration `name:"poll-sweep-freq"`
+
+	PollVoteMaxSize   int           `name:"poll-vote-max-size"`
+	PollVoteTTL       time.Duration `name:"poll-vote-ttl"`
+	PollVoteSweepFreq time.Duration `name:"poll-vote-sweep-freq"`
+
 	ReportMaxSize   int           `name:"report-max-size"`
 	ReportTTL       time.Duration `name:"report-ttl"`
 	ReportSweepFreq time.Duration `name:"report-sweep-freq"`
@@ -200,6 +232,10 @@ type GTSCacheConfiguration struct {
 	StatusTTL       time.Duration `name:"status-ttl"`
 	StatusSweepFreq time.Duration `name:"status-sweep-freq"`
 
+	StatusEditMaxSize   int           `name:"status-edit-max-size"`
+	StatusEditTTL       time.Duration `name:"status-edit-ttl"`
+	StatusEditSweepFreq time.Duration `name:"status-edit-sweep-freq"`
+
 	TombstoneMaxSize   int           `name:"tombstone-max-size"`
 	TombstoneTTL       time.Duration `name:"tombstone-ttl"`
 	TombstoneSweepFreq time.Duration `name:"tombstone-sweep-freq"`
@@ -208,6 +244,10 @@ type GTSCacheConfiguration struct {
 	UserTTL       time.Duration `name:"user-ttl"`
 	UserSweepFreq time.Duration `name:"user-sweep-freq"`
 
+	UserMuteMaxSize   int           `name:"user-mute-max-size"`
+	UserMuteTTL       time.Duration `name:"user-mute-ttl"`
+	UserMuteSweepFreq time.Duration `name:"user-mute-sweep-freq"`
+
 	WebfingerMaxSize   int           `name:"webfinger-max-size"`
 	WebfingerTTL       time.Duration `name:"webfinger-ttl"`
 	WebfingerSweepFreq time.Duration `name:"webfinger-sweep-freq"`
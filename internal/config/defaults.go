@@ -60,6 +60,10 @@ var Defaults = Configuration{
 	InstanceExposeSuspended:        false,
 	InstanceExposeSuspendedWeb:     false,
 	InstanceDeliverToSharedInboxes: true,
+	InstanceFederationMode:         InstanceFederationModeDefault,
+	InstanceAuthorizedFetch:        true,
+	InstanceUnavailableAfter:       7 * 24 * time.Hour,
+	InstanceSignRFC9421:            false,
 
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
@@ -127,6 +131,10 @@ var Defaults = Configuration{
 			BlockTTL:       time.Minute * 5,
 			BlockSweepFreq: time.Second * 30,
 
+			ConversationMaxSize:   1000,
+			ConversationTTL:       time.Minute * 5,
+			ConversationSweepFreq: time.Second * 30,
+
 			DomainBlockMaxSize:   1000,
 			DomainBlockTTL:       time.Hour * 24,
 			DomainBlockSweepFreq: time.Minute,
@@ -139,6 +147,22 @@ var Defaults = Configuration{
 			EmojiCategoryTTL:       time.Minute * 5,
 			EmojiCategorySweepFreq: time.Second * 30,
 
+			FilterMaxSize:   1000,
+			FilterTTL:       time.Minute * 5,
+			FilterSweepFreq: time.Second * 30,
+
+			FilterKeywordMaxSize:   2000,
+			FilterKeywordTTL:       time.Minute * 5,
+			FilterKeywordSweepFreq: time.Second * 30,
+
+			ListMaxSize:   2000,
+			ListTTL:       time.Minute * 5,
+			ListSweepFreq: time.Second * 30,
+
+			ListEntryMaxSize:   2000,
+			ListEntryTTL:       time.Minute * 5,
+			ListEntrySweepFreq: time.Second * 30,
+
 			MediaMaxSize:   500,
 			MediaTTL:       time.Minute * 5,
 			MediaSweepFreq: time.Second * 30,
@@ -151,6 +175,14 @@ var Defaults = Configuration{
 			NotificationTTL:       time.Minute * 5,
 			NotificationSweepFreq: time.Second * 30,
 
+			PollMaxSize:   1000,
+			PollTTL:       time.Minute * 5,
+			PollSweepFreq: time.Second * 30,
+
+			PollVoteMaxSize:   2000,
+			PollVoteTTL:       time.Minute * 5,
+			PollVoteSweepFreq: time.Second * 30,
+
 			ReportMaxSize:   100,
 			ReportTTL:       time.Minute * 5,
 			ReportSweepFreq: time.Second * 30,
@@ -159,6 +191,10 @@ var Defaults = Configuration{
 			StatusTTL:       time.Minute * 5,
 			StatusSweepFreq: time.Second * 30,
 
+			StatusEditMaxSize:   500,
+			StatusEditTTL:       time.Minute * 5,
+			StatusEditSweepFreq: time.Second * 30,
+
 			TombstoneMaxSize:   100,
 			TombstoneTTL:       time.Minute * 5,
 			TombstoneSweepFreq: time.Second * 30,
@@ -167,6 +203,10 @@ var Defaults = Configuration{
 			UserTTL:       time.Minute * 5,
 			UserSweepFreq: time.Second * 30,
 
+			UserMuteMaxSize:   1000,
+			UserMuteTTL:       time.Minute * 5,
+			UserMuteSweepFreq: time.Second * 30,
+
 			WebfingerMaxSize:   250,
 			WebfingerTTL:       time.Hour * 24,
 			WebfingerSweepFreq: time.Minute * 15,
@@ -80,6 +80,10 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Bool(InstanceExposeSuspendedFlag(), cfg.InstanceExposeSuspended, fieldtag("InstanceExposeSuspended", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
+		cmd.Flags().String(InstanceFederationModeFlag(), cfg.InstanceFederationMode, fieldtag("InstanceFederationMode", "usage"))
+		cmd.Flags().Bool(InstanceAuthorizedFetchFlag(), cfg.InstanceAuthorizedFetch, fieldtag("InstanceAuthorizedFetch", "usage"))
+		cmd.Flags().Duration(InstanceUnavailableAfterFlag(), cfg.InstanceUnavailableAfter, fieldtag("InstanceUnavailableAfter", "usage"))
+		cmd.Flags().Bool(InstanceSignRFC9421Flag(), cfg.InstanceSignRFC9421, fieldtag("InstanceSignRFC9421", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
@@ -824,6 +824,106 @@ func GetInstanceDeliverToSharedInboxes() bool { return global.GetInstanceDeliver
 // SetInstanceDeliverToSharedInboxes safely sets the value for global configuration 'InstanceDeliverToSharedInboxes' field
 func SetInstanceDeliverToSharedInboxes(v bool) { global.SetInstanceDeliverToSharedInboxes(v) }
 
+// GetInstanceFederationMode safely fetches the Configuration value for state's 'InstanceFederationMode' field
+func (st *ConfigState) GetInstanceFederationMode() (v string) {
+	st.mutex.Lock()
+	v = st.config.InstanceFederationMode
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceFederationMode safely sets the Configuration value for state's 'InstanceFederationMode' field
+func (st *ConfigState) SetInstanceFederationMode(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationMode = v
+	st.reloadToViper()
+}
+
+// InstanceFederationModeFlag returns the flag name for the 'InstanceFederationMode' field
+func InstanceFederationModeFlag() string { return "instance-federation-mode" }
+
+// GetInstanceFederationMode safely fetches the value for global configuration 'InstanceFederationMode' field
+func GetInstanceFederationMode() string { return global.GetInstanceFederationMode() }
+
+// SetInstanceFederationMode safely sets the value for global configuration 'InstanceFederationMode' field
+func SetInstanceFederationMode(v string) { global.SetInstanceFederationMode(v) }
+
+// GetInstanceAuthorizedFetch safely fetches the Configuration value for state's 'InstanceAuthorizedFetch' field
+func (st *ConfigState) GetInstanceAuthorizedFetch() (v bool) {
+	st.mutex.Lock()
+	v = st.config.InstanceAuthorizedFetch
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceAuthorizedFetch safely sets the Configuration value for state's 'InstanceAuthorizedFetch' field
+func (st *ConfigState) SetInstanceAuthorizedFetch(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceAuthorizedFetch = v
+	st.reloadToViper()
+}
+
+// InstanceAuthorizedFetchFlag returns the flag name for the 'InstanceAuthorizedFetch' field
+func InstanceAuthorizedFetchFlag() string { return "instance-authorized-fetch" }
+
+// GetInstanceAuthorizedFetch safely fetches the value for global configuration 'InstanceAuthorizedFetch' field
+func GetInstanceAuthorizedFetch() bool { return global.GetInstanceAuthorizedFetch() }
+
+// SetInstanceAuthorizedFetch safely sets the value for global configuration 'InstanceAuthorizedFetch' field
+func SetInstanceAuthorizedFetch(v bool) { global.SetInstanceAuthorizedFetch(v) }
+
+// GetInstanceUnavailableAfter safely fetches the Configuration value for state's 'InstanceUnavailableAfter' field
+func (st *ConfigState) GetInstanceUnavailableAfter() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.InstanceUnavailableAfter
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceUnavailableAfter safely sets the Configuration value for state's 'InstanceUnavailableAfter' field
+func (st *ConfigState) SetInstanceUnavailableAfter(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceUnavailableAfter = v
+	st.reloadToViper()
+}
+
+// InstanceUnavailableAfterFlag returns the flag name for the 'InstanceUnavailableAfter' field
+func InstanceUnavailableAfterFlag() string { return "instance-unavailable-after" }
+
+// GetInstanceUnavailableAfter safely fetches the value for global configuration 'InstanceUnavailableAfter' field
+func GetInstanceUnavailableAfter() time.Duration { return global.GetInstanceUnavailableAfter() }
+
+// SetInstanceUnavailableAfter safely sets the value for global configuration 'InstanceUnavailableAfter' field
+func SetInstanceUnavailableAfter(v time.Duration) { global.SetInstanceUnavailableAfter(v) }
+
+// GetInstanceSignRFC9421 safely fetches the Configuration value for state's 'InstanceSignRFC9421' field
+func (st *ConfigState) GetInstanceSignRFC9421() (v bool) {
+	st.mutex.Lock()
+	v = st.config.InstanceSignRFC9421
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceSignRFC9421 safely sets the Configuration value for state's 'InstanceSignRFC9421' field
+func (st *ConfigState) SetInstanceSignRFC9421(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceSignRFC9421 = v
+	st.reloadToViper()
+}
+
+// InstanceSignRFC9421Flag returns the flag name for the 'InstanceSignRFC9421' field
+func InstanceSignRFC9421Flag() string { return "instance-sign-rfc9421" }
+
+// GetInstanceSignRFC9421 safely fetches the value for global configuration 'InstanceSignRFC9421' field
+func GetInstanceSignRFC9421() bool { return global.GetInstanceSignRFC9421() }
+
+// SetInstanceSignRFC9421 safely sets the value for global configuration 'InstanceSignRFC9421' field
+func SetInstanceSignRFC9421(v bool) { global.SetInstanceSignRFC9421(v) }
+
 // GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
 func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
 	st.mutex.Lock()
@@ -2274,6 +2374,83 @@ func GetCacheGTSBlockSweepFreq() time.Duration { return global.GetCacheGTSBlockS
 // SetCacheGTSBlockSweepFreq safely sets the value for global configuration 'Cache.GTS.BlockSweepFreq' field
 func SetCacheGTSBlockSweepFreq(v time.Duration) { global.SetCacheGTSBlockSweepFreq(v) }
 
+// GetCacheGTSConversationMaxSize safely fetches the Configuration value for state's 'Cache.GTS.ConversationMaxSize' field
+func (st *ConfigState) GetCacheGTSConversationMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ConversationMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSConversationMaxSize safely sets the Configuration value for state's 'Cache.GTS.ConversationMaxSize' field
+func (st *ConfigState) SetCacheGTSConversationMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ConversationMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSConversationMaxSizeFlag returns the flag name for the 'Cache.GTS.ConversationMaxSize' field
+func CacheGTSConversationMaxSizeFlag() string { return "cache-gts-conversation-max-size" }
+
+// GetCacheGTSConversationMaxSize safely fetches the value for global configuration 'Cache.GTS.ConversationMaxSize' field
+func GetCacheGTSConversationMaxSize() int { return global.GetCacheGTSConversationMaxSize() }
+
+// SetCacheGTSConversationMaxSize safely sets the value for global configuration 'Cache.GTS.ConversationMaxSize' field
+func SetCacheGTSConversationMaxSize(v int) { global.SetCacheGTSConversationMaxSize(v) }
+
+// GetCacheGTSConversationTTL safely fetches the Configuration value for state's 'Cache.GTS.ConversationTTL' field
+func (st *ConfigState) GetCacheGTSConversationTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ConversationTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSConversationTTL safely sets the Configuration value for state's 'Cache.GTS.ConversationTTL' field
+func (st *ConfigState) SetCacheGTSConversationTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ConversationTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSConversationTTLFlag returns the flag name for the 'Cache.GTS.ConversationTTL' field
+func CacheGTSConversationTTLFlag() string { return "cache-gts-conversation-ttl" }
+
+// GetCacheGTSConversationTTL safely fetches the value for global configuration 'Cache.GTS.ConversationTTL' field
+func GetCacheGTSConversationTTL() time.Duration { return global.GetCacheGTSConversationTTL() }
+
+// SetCacheGTSConversationTTL safely sets the value for global configuration 'Cache.GTS.ConversationTTL' field
+func SetCacheGTSConversationTTL(v time.Duration) { global.SetCacheGTSConversationTTL(v) }
+
+// GetCacheGTSConversationSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.ConversationSweepFreq' field
+func (st *ConfigState) GetCacheGTSConversationSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ConversationSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSConversationSweepFreq safely sets the Configuration value for state's 'Cache.GTS.ConversationSweepFreq' field
+func (st *ConfigState) SetCacheGTSConversationSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ConversationSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSConversationSweepFreqFlag returns the flag name for the 'Cache.GTS.ConversationSweepFreq' field
+func CacheGTSConversationSweepFreqFlag() string { return "cache-gts-conversation-sweep-freq" }
+
+// GetCacheGTSConversationSweepFreq safely fetches the value for global configuration 'Cache.GTS.ConversationSweepFreq' field
+func GetCacheGTSConversationSweepFreq() time.Duration {
+	return global.GetCacheGTSConversationSweepFreq()
+}
+
+// SetCacheGTSConversationSweepFreq safely sets the value for global configuration 'Cache.GTS.ConversationSweepFreq' field
+func SetCacheGTSConversationSweepFreq(v time.Duration) { global.SetCacheGTSConversationSweepFreq(v) }
+
 // GetCacheGTSDomainBlockMaxSize safely fetches the Configuration value for state's 'Cache.GTS.DomainBlockMaxSize' field
 func (st *ConfigState) GetCacheGTSDomainBlockMaxSize() (v int) {
 	st.mutex.Lock()
@@ -2501,6 +2678,308 @@ func GetCacheGTSEmojiCategorySweepFreq() time.Duration {
 // SetCacheGTSEmojiCategorySweepFreq safely sets the value for global configuration 'Cache.GTS.EmojiCategorySweepFreq' field
 func SetCacheGTSEmojiCategorySweepFreq(v time.Duration) { global.SetCacheGTSEmojiCategorySweepFreq(v) }
 
+// GetCacheGTSFilterMaxSize safely fetches the Configuration value for state's 'Cache.GTS.FilterMaxSize' field
+func (st *ConfigState) GetCacheGTSFilterMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterMaxSize safely sets the Configuration value for state's 'Cache.GTS.FilterMaxSize' field
+func (st *ConfigState) SetCacheGTSFilterMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterMaxSizeFlag returns the flag name for the 'Cache.GTS.FilterMaxSize' field
+func CacheGTSFilterMaxSizeFlag() string { return "cache-gts-filter-max-size" }
+
+// GetCacheGTSFilterMaxSize safely fetches the value for global configuration 'Cache.GTS.FilterMaxSize' field
+func GetCacheGTSFilterMaxSize() int { return global.GetCacheGTSFilterMaxSize() }
+
+// SetCacheGTSFilterMaxSize safely sets the value for global configuration 'Cache.GTS.FilterMaxSize' field
+func SetCacheGTSFilterMaxSize(v int) { global.SetCacheGTSFilterMaxSize(v) }
+
+// GetCacheGTSFilterTTL safely fetches the Configuration value for state's 'Cache.GTS.FilterTTL' field
+func (st *ConfigState) GetCacheGTSFilterTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterTTL safely sets the Configuration value for state's 'Cache.GTS.FilterTTL' field
+func (st *ConfigState) SetCacheGTSFilterTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterTTLFlag returns the flag name for the 'Cache.GTS.FilterTTL' field
+func CacheGTSFilterTTLFlag() string { return "cache-gts-filter-ttl" }
+
+// GetCacheGTSFilterTTL safely fetches the value for global configuration 'Cache.GTS.FilterTTL' field
+func GetCacheGTSFilterTTL() time.Duration { return global.GetCacheGTSFilterTTL() }
+
+// SetCacheGTSFilterTTL safely sets the value for global configuration 'Cache.GTS.FilterTTL' field
+func SetCacheGTSFilterTTL(v time.Duration) { global.SetCacheGTSFilterTTL(v) }
+
+// GetCacheGTSFilterSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.FilterSweepFreq' field
+func (st *ConfigState) GetCacheGTSFilterSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterSweepFreq safely sets the Configuration value for state's 'Cache.GTS.FilterSweepFreq' field
+func (st *ConfigState) SetCacheGTSFilterSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterSweepFreqFlag returns the flag name for the 'Cache.GTS.FilterSweepFreq' field
+func CacheGTSFilterSweepFreqFlag() string { return "cache-gts-filter-sweep-freq" }
+
+// GetCacheGTSFilterSweepFreq safely fetches the value for global configuration 'Cache.GTS.FilterSweepFreq' field
+func GetCacheGTSFilterSweepFreq() time.Duration { return global.GetCacheGTSFilterSweepFreq() }
+
+// SetCacheGTSFilterSweepFreq safely sets the value for global configuration 'Cache.GTS.FilterSweepFreq' field
+func SetCacheGTSFilterSweepFreq(v time.Duration) { global.SetCacheGTSFilterSweepFreq(v) }
+
+// GetCacheGTSFilterKeywordMaxSize safely fetches the Configuration value for state's 'Cache.GTS.FilterKeywordMaxSize' field
+func (st *ConfigState) GetCacheGTSFilterKeywordMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterKeywordMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterKeywordMaxSize safely sets the Configuration value for state's 'Cache.GTS.FilterKeywordMaxSize' field
+func (st *ConfigState) SetCacheGTSFilterKeywordMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterKeywordMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterKeywordMaxSizeFlag returns the flag name for the 'Cache.GTS.FilterKeywordMaxSize' field
+func CacheGTSFilterKeywordMaxSizeFlag() string { return "cache-gts-filter-keyword-max-size" }
+
+// GetCacheGTSFilterKeywordMaxSize safely fetches the value for global configuration 'Cache.GTS.FilterKeywordMaxSize' field
+func GetCacheGTSFilterKeywordMaxSize() int { return global.GetCacheGTSFilterKeywordMaxSize() }
+
+// SetCacheGTSFilterKeywordMaxSize safely sets the value for global configuration 'Cache.GTS.FilterKeywordMaxSize' field
+func SetCacheGTSFilterKeywordMaxSize(v int) { global.SetCacheGTSFilterKeywordMaxSize(v) }
+
+// GetCacheGTSFilterKeywordTTL safely fetches the Configuration value for state's 'Cache.GTS.FilterKeywordTTL' field
+func (st *ConfigState) GetCacheGTSFilterKeywordTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterKeywordTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterKeywordTTL safely sets the Configuration value for state's 'Cache.GTS.FilterKeywordTTL' field
+func (st *ConfigState) SetCacheGTSFilterKeywordTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterKeywordTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterKeywordTTLFlag returns the flag name for the 'Cache.GTS.FilterKeywordTTL' field
+func CacheGTSFilterKeywordTTLFlag() string { return "cache-gts-filter-keyword-ttl" }
+
+// GetCacheGTSFilterKeywordTTL safely fetches the value for global configuration 'Cache.GTS.FilterKeywordTTL' field
+func GetCacheGTSFilterKeywordTTL() time.Duration { return global.GetCacheGTSFilterKeywordTTL() }
+
+// SetCacheGTSFilterKeywordTTL safely sets the value for global configuration 'Cache.GTS.FilterKeywordTTL' field
+func SetCacheGTSFilterKeywordTTL(v time.Duration) { global.SetCacheGTSFilterKeywordTTL(v) }
+
+// GetCacheGTSFilterKeywordSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.FilterKeywordSweepFreq' field
+func (st *ConfigState) GetCacheGTSFilterKeywordSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterKeywordSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterKeywordSweepFreq safely sets the Configuration value for state's 'Cache.GTS.FilterKeywordSweepFreq' field
+func (st *ConfigState) SetCacheGTSFilterKeywordSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterKeywordSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterKeywordSweepFreqFlag returns the flag name for the 'Cache.GTS.FilterKeywordSweepFreq' field
+func CacheGTSFilterKeywordSweepFreqFlag() string { return "cache-gts-filter-keyword-sweep-freq" }
+
+// GetCacheGTSFilterKeywordSweepFreq safely fetches the value for global configuration 'Cache.GTS.FilterKeywordSweepFreq' field
+func GetCacheGTSFilterKeywordSweepFreq() time.Duration {
+	return global.GetCacheGTSFilterKeywordSweepFreq()
+}
+
+// SetCacheGTSFilterKeywordSweepFreq safely sets the value for global configuration 'Cache.GTS.FilterKeywordSweepFreq' field
+func SetCacheGTSFilterKeywordSweepFreq(v time.Duration) { global.SetCacheGTSFilterKeywordSweepFreq(v) }
+
+// GetCacheGTSListMaxSize safely fetches the Configuration value for state's 'Cache.GTS.ListMaxSize' field
+func (st *ConfigState) GetCacheGTSListMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListMaxSize safely sets the Configuration value for state's 'Cache.GTS.ListMaxSize' field
+func (st *ConfigState) SetCacheGTSListMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSListMaxSizeFlag returns the flag name for the 'Cache.GTS.ListMaxSize' field
+func CacheGTSListMaxSizeFlag() string { return "cache-gts-list-max-size" }
+
+// GetCacheGTSListMaxSize safely fetches the value for global configuration 'Cache.GTS.ListMaxSize' field
+func GetCacheGTSListMaxSize() int { return global.GetCacheGTSListMaxSize() }
+
+// SetCacheGTSListMaxSize safely sets the value for global configuration 'Cache.GTS.ListMaxSize' field
+func SetCacheGTSListMaxSize(v int) { global.SetCacheGTSListMaxSize(v) }
+
+// GetCacheGTSListTTL safely fetches the Configuration value for state's 'Cache.GTS.ListTTL' field
+func (st *ConfigState) GetCacheGTSListTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListTTL safely sets the Configuration value for state's 'Cache.GTS.ListTTL' field
+func (st *ConfigState) SetCacheGTSListTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSListTTLFlag returns the flag name for the 'Cache.GTS.ListTTL' field
+func CacheGTSListTTLFlag() string { return "cache-gts-list-ttl" }
+
+// GetCacheGTSListTTL safely fetches the value for global configuration 'Cache.GTS.ListTTL' field
+func GetCacheGTSListTTL() time.Duration { return global.GetCacheGTSListTTL() }
+
+// SetCacheGTSListTTL safely sets the value for global configuration 'Cache.GTS.ListTTL' field
+func SetCacheGTSListTTL(v time.Duration) { global.SetCacheGTSListTTL(v) }
+
+// GetCacheGTSListSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.ListSweepFreq' field
+func (st *ConfigState) GetCacheGTSListSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListSweepFreq safely sets the Configuration value for state's 'Cache.GTS.ListSweepFreq' field
+func (st *ConfigState) SetCacheGTSListSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSListSweepFreqFlag returns the flag name for the 'Cache.GTS.ListSweepFreq' field
+func CacheGTSListSweepFreqFlag() string { return "cache-gts-list-sweep-freq" }
+
+// GetCacheGTSListSweepFreq safely fetches the value for global configuration 'Cache.GTS.ListSweepFreq' field
+func GetCacheGTSListSweepFreq() time.Duration { return global.GetCacheGTSListSweepFreq() }
+
+// SetCacheGTSListSweepFreq safely sets the value for global configuration 'Cache.GTS.ListSweepFreq' field
+func SetCacheGTSListSweepFreq(v time.Duration) { global.SetCacheGTSListSweepFreq(v) }
+
+// GetCacheGTSListEntryMaxSize safely fetches the Configuration value for state's 'Cache.GTS.ListEntryMaxSize' field
+func (st *ConfigState) GetCacheGTSListEntryMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListEntryMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListEntryMaxSize safely sets the Configuration value for state's 'Cache.GTS.ListEntryMaxSize' field
+func (st *ConfigState) SetCacheGTSListEntryMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListEntryMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSListEntryMaxSizeFlag returns the flag name for the 'Cache.GTS.ListEntryMaxSize' field
+func CacheGTSListEntryMaxSizeFlag() string { return "cache-gts-list-entry-max-size" }
+
+// GetCacheGTSListEntryMaxSize safely fetches the value for global configuration 'Cache.GTS.ListEntryMaxSize' field
+func GetCacheGTSListEntryMaxSize() int { return global.GetCacheGTSListEntryMaxSize() }
+
+// SetCacheGTSListEntryMaxSize safely sets the value for global configuration 'Cache.GTS.ListEntryMaxSize' field
+func SetCacheGTSListEntryMaxSize(v int) { global.SetCacheGTSListEntryMaxSize(v) }
+
+// GetCacheGTSListEntryTTL safely fetches the Configuration value for state's 'Cache.GTS.ListEntryTTL' field
+func (st *ConfigState) GetCacheGTSListEntryTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListEntryTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListEntryTTL safely sets the Configuration value for state's 'Cache.GTS.ListEntryTTL' field
+func (st *ConfigState) SetCacheGTSListEntryTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListEntryTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSListEntryTTLFlag returns the flag name for the 'Cache.GTS.ListEntryTTL' field
+func CacheGTSListEntryTTLFlag() string { return "cache-gts-list-entry-ttl" }
+
+// GetCacheGTSListEntryTTL safely fetches the value for global configuration 'Cache.GTS.ListEntryTTL' field
+func GetCacheGTSListEntryTTL() time.Duration { return global.GetCacheGTSListEntryTTL() }
+
+// SetCacheGTSListEntryTTL safely sets the value for global configuration 'Cache.GTS.ListEntryTTL' field
+func SetCacheGTSListEntryTTL(v time.Duration) { global.SetCacheGTSListEntryTTL(v) }
+
+// GetCacheGTSListEntrySweepFreq safely fetches the Configuration value for state's 'Cache.GTS.ListEntrySweepFreq' field
+func (st *ConfigState) GetCacheGTSListEntrySweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListEntrySweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListEntrySweepFreq safely sets the Configuration value for state's 'Cache.GTS.ListEntrySweepFreq' field
+func (st *ConfigState) SetCacheGTSListEntrySweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListEntrySweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSListEntrySweepFreqFlag returns the flag name for the 'Cache.GTS.ListEntrySweepFreq' field
+func CacheGTSListEntrySweepFreqFlag() string { return "cache-gts-list-entry-sweep-freq" }
+
+// GetCacheGTSListEntrySweepFreq safely fetches the value for global configuration 'Cache.GTS.ListEntrySweepFreq' field
+func GetCacheGTSListEntrySweepFreq() time.Duration { return global.GetCacheGTSListEntrySweepFreq() }
+
+// SetCacheGTSListEntrySweepFreq safely sets the value for global configuration 'Cache.GTS.ListEntrySweepFreq' field
+func SetCacheGTSListEntrySweepFreq(v time.Duration) { global.SetCacheGTSListEntrySweepFreq(v) }
+
 // GetCacheGTSMediaMaxSize safely fetches the Configuration value for state's 'Cache.GTS.MediaMaxSize' field
 func (st *ConfigState) GetCacheGTSMediaMaxSize() (v int) {
 	st.mutex.Lock()
@@ -2728,6 +3207,156 @@ func GetCacheGTSNotificationSweepFreq() time.Duration {
 // SetCacheGTSNotificationSweepFreq safely sets the value for global configuration 'Cache.GTS.NotificationSweepFreq' field
 func SetCacheGTSNotificationSweepFreq(v time.Duration) { global.SetCacheGTSNotificationSweepFreq(v) }
 
+// GetCacheGTSPollMaxSize safely fetches the Configuration value for state's 'Cache.GTS.PollMaxSize' field
+func (st *ConfigState) GetCacheGTSPollMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollMaxSize safely sets the Configuration value for state's 'Cache.GTS.PollMaxSize' field
+func (st *ConfigState) SetCacheGTSPollMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollMaxSizeFlag returns the flag name for the 'Cache.GTS.PollMaxSize' field
+func CacheGTSPollMaxSizeFlag() string { return "cache-gts-poll-max-size" }
+
+// GetCacheGTSPollMaxSize safely fetches the value for global configuration 'Cache.GTS.PollMaxSize' field
+func GetCacheGTSPollMaxSize() int { return global.GetCacheGTSPollMaxSize() }
+
+// SetCacheGTSPollMaxSize safely sets the value for global configuration 'Cache.GTS.PollMaxSize' field
+func SetCacheGTSPollMaxSize(v int) { global.SetCacheGTSPollMaxSize(v) }
+
+// GetCacheGTSPollTTL safely fetches the Configuration value for state's 'Cache.GTS.PollTTL' field
+func (st *ConfigState) GetCacheGTSPollTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollTTL safely sets the Configuration value for state's 'Cache.GTS.PollTTL' field
+func (st *ConfigState) SetCacheGTSPollTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollTTLFlag returns the flag name for the 'Cache.GTS.PollTTL' field
+func CacheGTSPollTTLFlag() string { return "cache-gts-poll-ttl" }
+
+// GetCacheGTSPollTTL safely fetches the value for global configuration 'Cache.GTS.PollTTL' field
+func GetCacheGTSPollTTL() time.Duration { return global.GetCacheGTSPollTTL() }
+
+// SetCacheGTSPollTTL safely sets the value for global configuration 'Cache.GTS.PollTTL' field
+func SetCacheGTSPollTTL(v time.Duration) { global.SetCacheGTSPollTTL(v) }
+
+// GetCacheGTSPollSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.PollSweepFreq' field
+func (st *ConfigState) GetCacheGTSPollSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollSweepFreq safely sets the Configuration value for state's 'Cache.GTS.PollSweepFreq' field
+func (st *ConfigState) SetCacheGTSPollSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollSweepFreqFlag returns the flag name for the 'Cache.GTS.PollSweepFreq' field
+func CacheGTSPollSweepFreqFlag() string { return "cache-gts-poll-sweep-freq" }
+
+// GetCacheGTSPollSweepFreq safely fetches the value for global configuration 'Cache.GTS.PollSweepFreq' field
+func GetCacheGTSPollSweepFreq() time.Duration { return global.GetCacheGTSPollSweepFreq() }
+
+// SetCacheGTSPollSweepFreq safely sets the value for global configuration 'Cache.GTS.PollSweepFreq' field
+func SetCacheGTSPollSweepFreq(v time.Duration) { global.SetCacheGTSPollSweepFreq(v) }
+
+// GetCacheGTSPollVoteMaxSize safely fetches the Configuration value for state's 'Cache.GTS.PollVoteMaxSize' field
+func (st *ConfigState) GetCacheGTSPollVoteMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollVoteMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollVoteMaxSize safely sets the Configuration value for state's 'Cache.GTS.PollVoteMaxSize' field
+func (st *ConfigState) SetCacheGTSPollVoteMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollVoteMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollVoteMaxSizeFlag returns the flag name for the 'Cache.GTS.PollVoteMaxSize' field
+func CacheGTSPollVoteMaxSizeFlag() string { return "cache-gts-poll-vote-max-size" }
+
+// GetCacheGTSPollVoteMaxSize safely fetches the value for global configuration 'Cache.GTS.PollVoteMaxSize' field
+func GetCacheGTSPollVoteMaxSize() int { return global.GetCacheGTSPollVoteMaxSize() }
+
+// SetCacheGTSPollVoteMaxSize safely sets the value for global configuration 'Cache.GTS.PollVoteMaxSize' field
+func SetCacheGTSPollVoteMaxSize(v int) { global.SetCacheGTSPollVoteMaxSize(v) }
+
+// GetCacheGTSPollVoteTTL safely fetches the Configuration value for state's 'Cache.GTS.PollVoteTTL' field
+func (st *ConfigState) GetCacheGTSPollVoteTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollVoteTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollVoteTTL safely sets the Configuration value for state's 'Cache.GTS.PollVoteTTL' field
+func (st *ConfigState) SetCacheGTSPollVoteTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollVoteTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollVoteTTLFlag returns the flag name for the 'Cache.GTS.PollVoteTTL' field
+func CacheGTSPollVoteTTLFlag() string { return "cache-gts-poll-vote-ttl" }
+
+// GetCacheGTSPollVoteTTL safely fetches the value for global configuration 'Cache.GTS.PollVoteTTL' field
+func GetCacheGTSPollVoteTTL() time.Duration { return global.GetCacheGTSPollVoteTTL() }
+
+// SetCacheGTSPollVoteTTL safely sets the value for global configuration 'Cache.GTS.PollVoteTTL' field
+func SetCacheGTSPollVoteTTL(v time.Duration) { global.SetCacheGTSPollVoteTTL(v) }
+
+// GetCacheGTSPollVoteSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.PollVoteSweepFreq' field
+func (st *ConfigState) GetCacheGTSPollVoteSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollVoteSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollVoteSweepFreq safely sets the Configuration value for state's 'Cache.GTS.PollVoteSweepFreq' field
+func (st *ConfigState) SetCacheGTSPollVoteSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollVoteSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollVoteSweepFreqFlag returns the flag name for the 'Cache.GTS.PollVoteSweepFreq' field
+func CacheGTSPollVoteSweepFreqFlag() string { return "cache-gts-poll-vote-sweep-freq" }
+
+// GetCacheGTSPollVoteSweepFreq safely fetches the value for global configuration 'Cache.GTS.PollVoteSweepFreq' field
+func GetCacheGTSPollVoteSweepFreq() time.Duration { return global.GetCacheGTSPollVoteSweepFreq() }
+
+// SetCacheGTSPollVoteSweepFreq safely sets the value for global configuration 'Cache.GTS.PollVoteSweepFreq' field
+func SetCacheGTSPollVoteSweepFreq(v time.Duration) { global.SetCacheGTSPollVoteSweepFreq(v) }
+
 // GetCacheGTSReportMaxSize safely fetches the Configuration value for state's 'Cache.GTS.ReportMaxSize' field
 func (st *ConfigState) GetCacheGTSReportMaxSize() (v int) {
 	st.mutex.Lock()
@@ -2878,6 +3507,81 @@ func GetCacheGTSStatusSweepFreq() time.Duration { return global.GetCacheGTSStatu
 // SetCacheGTSStatusSweepFreq safely sets the value for global configuration 'Cache.GTS.StatusSweepFreq' field
 func SetCacheGTSStatusSweepFreq(v time.Duration) { global.SetCacheGTSStatusSweepFreq(v) }
 
+// GetCacheGTSStatusEditMaxSize safely fetches the Configuration value for state's 'Cache.GTS.StatusEditMaxSize' field
+func (st *ConfigState) GetCacheGTSStatusEditMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.StatusEditMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSStatusEditMaxSize safely sets the Configuration value for state's 'Cache.GTS.StatusEditMaxSize' field
+func (st *ConfigState) SetCacheGTSStatusEditMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.StatusEditMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSStatusEditMaxSizeFlag returns the flag name for the 'Cache.GTS.StatusEditMaxSize' field
+func CacheGTSStatusEditMaxSizeFlag() string { return "cache-gts-status-edit-max-size" }
+
+// GetCacheGTSStatusEditMaxSize safely fetches the value for global configuration 'Cache.GTS.StatusEditMaxSize' field
+func GetCacheGTSStatusEditMaxSize() int { return global.GetCacheGTSStatusEditMaxSize() }
+
+// SetCacheGTSStatusEditMaxSize safely sets the value for global configuration 'Cache.GTS.StatusEditMaxSize' field
+func SetCacheGTSStatusEditMaxSize(v int) { global.SetCacheGTSStatusEditMaxSize(v) }
+
+// GetCacheGTSStatusEditTTL safely fetches the Configuration value for state's 'Cache.GTS.StatusEditTTL' field
+func (st *ConfigState) GetCacheGTSStatusEditTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.StatusEditTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSStatusEditTTL safely sets the Configuration value for state's 'Cache.GTS.StatusEditTTL' field
+func (st *ConfigState) SetCacheGTSStatusEditTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.StatusEditTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSStatusEditTTLFlag returns the flag name for the 'Cache.GTS.StatusEditTTL' field
+func CacheGTSStatusEditTTLFlag() string { return "cache-gts-status-edit-ttl" }
+
+// GetCacheGTSStatusEditTTL safely fetches the value for global configuration 'Cache.GTS.StatusEditTTL' field
+func GetCacheGTSStatusEditTTL() time.Duration { return global.GetCacheGTSStatusEditTTL() }
+
+// SetCacheGTSStatusEditTTL safely sets the value for global configuration 'Cache.GTS.StatusEditTTL' field
+func SetCacheGTSStatusEditTTL(v time.Duration) { global.SetCacheGTSStatusEditTTL(v) }
+
+// GetCacheGTSStatusEditSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.StatusEditSweepFreq' field
+func (st *ConfigState) GetCacheGTSStatusEditSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.StatusEditSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSStatusEditSweepFreq safely sets the Configuration value for state's 'Cache.GTS.StatusEditSweepFreq' field
+func (st *ConfigState) SetCacheGTSStatusEditSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.StatusEditSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSStatusEditSweepFreqFlag returns the flag name for the 'Cache.GTS.StatusEditSweepFreq' field
+func CacheGTSStatusEditSweepFreqFlag() string { return "cache-gts-status-edit-sweep-freq" }
+
+// GetCacheGTSStatusEditSweepFreq safely fetches the value for global configuration 'Cache.GTS.StatusEditSweepFreq' field
+func GetCacheGTSStatusEditSweepFreq() time.Duration { return global.GetCacheGTSStatusEditSweepFreq() }
+
+// SetCacheGTSStatusEditSweepFreq safely sets the value for global configuration 'Cache.GTS.StatusEditSweepFreq' field
+func SetCacheGTSStatusEditSweepFreq(v time.Duration) { global.SetCacheGTSStatusEditSweepFreq(v) }
+
 // GetCacheGTSTombstoneMaxSize safely fetches the Configuration value for state's 'Cache.GTS.TombstoneMaxSize' field
 func (st *ConfigState) GetCacheGTSTombstoneMaxSize() (v int) {
 	st.mutex.Lock()
@@ -3028,6 +3732,81 @@ func GetCacheGTSUserSweepFreq() time.Duration { return global.GetCacheGTSUserSwe
 // SetCacheGTSUserSweepFreq safely sets the value for global configuration 'Cache.GTS.UserSweepFreq' field
 func SetCacheGTSUserSweepFreq(v time.Duration) { global.SetCacheGTSUserSweepFreq(v) }
 
+// GetCacheGTSUserMuteMaxSize safely fetches the Configuration value for state's 'Cache.GTS.UserMuteMaxSize' field
+func (st *ConfigState) GetCacheGTSUserMuteMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.UserMuteMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSUserMuteMaxSize safely sets the Configuration value for state's 'Cache.GTS.UserMuteMaxSize' field
+func (st *ConfigState) SetCacheGTSUserMuteMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.UserMuteMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSUserMuteMaxSizeFlag returns the flag name for the 'Cache.GTS.UserMuteMaxSize' field
+func CacheGTSUserMuteMaxSizeFlag() string { return "cache-gts-user-mute-max-size" }
+
+// GetCacheGTSUserMuteMaxSize safely fetches the value for global configuration 'Cache.GTS.UserMuteMaxSize' field
+func GetCacheGTSUserMuteMaxSize() int { return global.GetCacheGTSUserMuteMaxSize() }
+
+// SetCacheGTSUserMuteMaxSize safely sets the value for global configuration 'Cache.GTS.UserMuteMaxSize' field
+func SetCacheGTSUserMuteMaxSize(v int) { global.SetCacheGTSUserMuteMaxSize(v) }
+
+// GetCacheGTSUserMuteTTL safely fetches the Configuration value for state's 'Cache.GTS.UserMuteTTL' field
+func (st *ConfigState) GetCacheGTSUserMuteTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.UserMuteTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSUserMuteTTL safely sets the Configuration value for state's 'Cache.GTS.UserMuteTTL' field
+func (st *ConfigState) SetCacheGTSUserMuteTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.UserMuteTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSUserMuteTTLFlag returns the flag name for the 'Cache.GTS.UserMuteTTL' field
+func CacheGTSUserMuteTTLFlag() string { return "cache-gts-user-mute-ttl" }
+
+// GetCacheGTSUserMuteTTL safely fetches the value for global configuration 'Cache.GTS.UserMuteTTL' field
+func GetCacheGTSUserMuteTTL() time.Duration { return global.GetCacheGTSUserMuteTTL() }
+
+// SetCacheGTSUserMuteTTL safely sets the value for global configuration 'Cache.GTS.UserMuteTTL' field
+func SetCacheGTSUserMuteTTL(v time.Duration) { global.SetCacheGTSUserMuteTTL(v) }
+
+// GetCacheGTSUserMuteSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.UserMuteSweepFreq' field
+func (st *ConfigState) GetCacheGTSUserMuteSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.UserMuteSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSUserMuteSweepFreq safely sets the Configuration value for state's 'Cache.GTS.UserMuteSweepFreq' field
+func (st *ConfigState) SetCacheGTSUserMuteSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.UserMuteSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSUserMuteSweepFreqFlag returns the flag name for the 'Cache.GTS.UserMuteSweepFreq' field
+func CacheGTSUserMuteSweepFreqFlag() string { return "cache-gts-user-mute-sweep-freq" }
+
+// GetCacheGTSUserMuteSweepFreq safely fetches the value for global configuration 'Cache.GTS.UserMuteSweepFreq' field
+func GetCacheGTSUserMuteSweepFreq() time.Duration { return global.GetCacheGTSUserMuteSweepFreq() }
+
+// SetCacheGTSUserMuteSweepFreq safely sets the value for global configuration 'Cache.GTS.UserMuteSweepFreq' field
+func SetCacheGTSUserMuteSweepFreq(v time.Duration) { global.SetCacheGTSUserMuteSweepFreq(v) }
+
 // GetCacheGTSWebfingerMaxSize safely fetches the Configuration value for state's 'Cache.GTS.WebfingerMaxSize' field
 func (st *ConfigState) GetCacheGTSWebfingerMaxSize() (v int) {
 	st.mutex.Lock()
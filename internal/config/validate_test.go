@@ -140,6 +140,15 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigBadProtocolNoHost() {
 	suite.EqualError(err, "host must be set; protocol must be set to either http or https, provided value was foo")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadFederationMode() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceFederationMode("foo")
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-federation-mode must be set to either blocklist or allowlist, provided value was foo")
+}
+
 func TestConfigValidateTestSuite(t *testing.T) {
 	suite.Run(t, &ConfigValidateTestSuite{})
 }
@@ -103,4 +103,11 @@ type Account interface {
 	// GetInstanceAccount returns the instance account for the given domain.
 	// If domain is empty, this instance account will be returned.
 	GetInstanceAccount(ctx context.Context, domain string) (*gtsmodel.Account, Error)
+
+	// PutAccountKeyChange stores a record of a change to a remote account's public key.
+	PutAccountKeyChange(ctx context.Context, change *gtsmodel.AccountKeyChange) Error
+
+	// GetAccountKeyChanges returns recorded public key changes, newest first, optionally paged by maxID and minID.
+	// If accountID is set, only key changes of the given account are returned.
+	GetAccountKeyChanges(ctx context.Context, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.AccountKeyChange, Error)
 }
@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"net"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Application handles getting of applications, and getting/updating/deletion of the oauth tokens granted to them.
+type Application interface {
+	// GetApplicationByID gets one application by its db id.
+	GetApplicationByID(ctx context.Context, id string) (*gtsmodel.Application, Error)
+
+	// GetApplicationByClientID gets the application with the given oauth client id.
+	GetApplicationByClientID(ctx context.Context, clientID string) (*gtsmodel.Application, Error)
+
+	// GetTokenByAccess gets the token with the given access code.
+	GetTokenByAccess(ctx context.Context, access string) (*gtsmodel.Token, Error)
+
+	// GetAccessTokensByUserID gets all access tokens owned by the user with the given db id, oldest first.
+	GetAccessTokensByUserID(ctx context.Context, userID string) ([]*gtsmodel.Token, Error)
+
+	// UpdateTokenLastUsed marks the token with the given access code as last used now, from the given IP.
+	// To avoid a write on every request, tokens already marked as used within the last hour are left alone.
+	UpdateTokenLastUsed(ctx context.Context, access string, ip net.IP) Error
+
+	// DeleteTokenByID deletes the token with the given db id, along with any Web Push subscription it created.
+	DeleteTokenByID(ctx context.Context, id string) Error
+
+	// DeleteTokensByUserID deletes all tokens granted by the user with the given db id, including any authorization
+	// codes not yet exchanged for an access token, along with any Web Push subscriptions they created.
+	// If clientID is set, only tokens granted to the client with that oauth client id are deleted.
+	DeleteTokensByUserID(ctx context.Context, userID string, clientID string) Error
+}
@@ -549,6 +549,49 @@ func (a *accountDB) GetAccountBlocks(ctx context.Context, accountID string, maxI
 	return accounts, nextMaxID, prevMinID, nil
 }
 
+func (a *accountDB) PutAccountKeyChange(ctx context.Context, change *gtsmodel.AccountKeyChange) db.Error {
+	_, err := a.conn.
+		NewInsert().
+		Model(change).
+		Exec(ctx)
+	return a.conn.ProcessError(err)
+}
+
+func (a *accountDB) GetAccountKeyChanges(ctx context.Context, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.AccountKeyChange, db.Error) {
+	changes := []*gtsmodel.AccountKeyChange{}
+
+	q := a.conn.
+		NewSelect().
+		Model(&changes).
+		Order("account_key_change.id DESC")
+
+	if accountID != "" {
+		q = q.Where("? = ?", bun.Ident("account_key_change.account_id"), accountID)
+	}
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account_key_change.id"), maxID)
+	}
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("account_key_change.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	if len(changes) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return changes, nil
+}
+
 func (a *accountDB) statusesFromIDs(ctx context.Context, statusIDs []string) ([]*gtsmodel.Status, db.Error) {
 	// Catch case of no statuses early
 	if len(statusIDs) == 0 {
@@ -0,0 +1,186 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+// tokenLastUsedPrecision is how often
+// the last used time of a token is updated.
+const tokenLastUsedPrecision = time.Hour
+
+type applicationDB struct {
+	conn *DBConn
+}
+
+func (a *applicationDB) getApplication(ctx context.Context, column string, value string) (*gtsmodel.Application, db.Error) {
+	application := new(gtsmodel.Application)
+
+	if err := a.conn.
+		NewSelect().
+		Model(application).
+		Where("? = ?", bun.Ident("application."+column), value).
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return application, nil
+}
+
+func (a *applicationDB) GetApplicationByID(ctx context.Context, id string) (*gtsmodel.Application, db.Error) {
+	return a.getApplication(ctx, "id", id)
+}
+
+func (a *applicationDB) GetApplicationByClientID(ctx context.Context, clientID string) (*gtsmodel.Application, db.Error) {
+	return a.getApplication(ctx, "client_id", clientID)
+}
+
+func (a *applicationDB) GetTokenByAccess(ctx context.Context, access string) (*gtsmodel.Token, db.Error) {
+	token := new(gtsmodel.Token)
+
+	if err := a.conn.
+		NewSelect().
+		Model(token).
+		Where("? = ?", bun.Ident("token.access"), access).
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return token, nil
+}
+
+func (a *applicationDB) GetAccessTokensByUserID(ctx context.Context, userID string) ([]*gtsmodel.Token, db.Error) {
+	tokens := []*gtsmodel.Token{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&tokens).
+		Where("? = ?", bun.Ident("token.user_id"), userID).
+		Where("? != ''", bun.Ident("token.access")).
+		Order("token.id ASC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	if len(tokens) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return tokens, nil
+}
+
+func (a *applicationDB) UpdateTokenLastUsed(ctx context.Context, access string, ip net.IP) db.Error {
+	now := time.Now()
+
+	if _, err := a.conn.
+		NewUpdate().
+		Model(&gtsmodel.Token{}).
+		Set("? = ?", bun.Ident("last_used_at"), now).
+		Set("? = ?", bun.Ident("last_used_ip"), ip).
+		Where("? = ?", bun.Ident("token.access"), access).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("token.last_used_at")).
+				WhereOr("? < ?", bun.Ident("token.last_used_at"), now.Add(-tokenLastUsedPrecision))
+		}).
+		Exec(ctx); err != nil {
+		return a.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (a *applicationDB) DeleteTokenByID(ctx context.Context, id string) db.Error {
+	if err := a.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// clear out any web push subscription
+		// that was created by this token
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("web_push_subscriptions"), bun.Ident("web_push_subscription")).
+			Where("? = ?", bun.Ident("web_push_subscription.token_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// delete the token
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("tokens"), bun.Ident("token")).
+			Where("? = ?", bun.Ident("token.id"), id).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return a.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (a *applicationDB) DeleteTokensByUserID(ctx context.Context, userID string, clientID string) db.Error {
+	if err := a.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// select the ids of all tokens granted by this user,
+		// including ones which only carry an authorization code
+		q := tx.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("tokens"), bun.Ident("token")).
+			Column("token.id").
+			Where("? = ?", bun.Ident("token.user_id"), userID)
+		if clientID != "" {
+			q = q.Where("? = ?", bun.Ident("token.client_id"), clientID)
+		}
+
+		var tokenIDs []string
+		if err := q.Scan(ctx, &tokenIDs); err != nil {
+			return err
+		}
+
+		if len(tokenIDs) == 0 {
+			// nothing to delete
+			return nil
+		}
+
+		// clear out any web push subscriptions
+		// that were created by these tokens
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("web_push_subscriptions"), bun.Ident("web_push_subscription")).
+			Where("? IN (?)", bun.Ident("web_push_subscription.token_id"), bun.In(tokenIDs)).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// delete the tokens
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("tokens"), bun.Ident("token")).
+			Where("? IN (?)", bun.Ident("token.id"), bun.In(tokenIDs)).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return a.conn.ProcessError(err)
+	}
+
+	return nil
+}
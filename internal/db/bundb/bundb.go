@@ -60,20 +60,33 @@ var registerTables = []interface{}{
 type DBService struct {
 	db.Account
 	db.Admin
+	db.Application
 	db.Basic
+	db.Conversation
+	db.Delivery
 	db.Domain
 	db.Emoji
+	db.Filter
 	db.Instance
+	db.List
+	db.Marker
 	db.Media
 	db.Mention
 	db.Notification
+	db.Poll
 	db.Relationship
+	db.Relay
 	db.Report
+	db.ScheduledStatus
+	db.Search
 	db.Session
 	db.Status
+	db.StatusEdit
+	db.Tag
 	db.Timeline
 	db.User
 	db.Tombstone
+	db.WebPush
 	conn *DBConn
 }
 
@@ -162,9 +175,19 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Application: &applicationDB{
+			conn: conn,
+		},
 		Basic: &basicDB{
 			conn: conn,
 		},
+		Conversation: &conversationDB{
+			conn:  conn,
+			state: state,
+		},
+		Delivery: &deliveryDB{
+			conn: conn,
+		},
 		Domain: &domainDB{
 			conn:  conn,
 			state: state,
@@ -173,9 +196,20 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Filter: &filterDB{
+			conn:  conn,
+			state: state,
+		},
 		Instance: &instanceDB{
 			conn: conn,
 		},
+		List: &listDB{
+			conn:  conn,
+			state: state,
+		},
+		Marker: &markerDB{
+			conn: conn,
+		},
 		Media: &mediaDB{
 			conn:  conn,
 			state: state,
@@ -188,14 +222,28 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Poll: &pollDB{
+			conn:  conn,
+			state: state,
+		},
 		Relationship: &relationshipDB{
 			conn:  conn,
 			state: state,
 		},
+		Relay: &relayDB{
+			conn: conn,
+		},
 		Report: &reportDB{
 			conn:  conn,
 			state: state,
 		},
+		ScheduledStatus: &scheduledStatusDB{
+			conn: conn,
+		},
+		Search: &searchDB{
+			conn:  conn,
+			state: state,
+		},
 		Session: &sessionDB{
 			conn: conn,
 		},
@@ -203,6 +251,14 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		StatusEdit: &statusEditDB{
+			conn:  conn,
+			state: state,
+		},
+		Tag: &tagDB{
+			conn:  conn,
+			state: state,
+		},
 		Timeline: &timelineDB{
 			conn:  conn,
 			state: state,
@@ -215,6 +271,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		WebPush: &webPushDB{
+			conn: conn,
+		},
 		conn: conn,
 	}
 
@@ -32,18 +32,22 @@ type BunDBStandardTestSuite struct {
 	state state.State
 
 	// standard suite models
-	testTokens       map[string]*gtsmodel.Token
-	testClients      map[string]*gtsmodel.Client
-	testApplications map[string]*gtsmodel.Application
-	testUsers        map[string]*gtsmodel.User
-	testAccounts     map[string]*gtsmodel.Account
-	testAttachments  map[string]*gtsmodel.MediaAttachment
-	testStatuses     map[string]*gtsmodel.Status
-	testTags         map[string]*gtsmodel.Tag
-	testMentions     map[string]*gtsmodel.Mention
-	testFollows      map[string]*gtsmodel.Follow
-	testEmojis       map[string]*gtsmodel.Emoji
-	testReports      map[string]*gtsmodel.Report
+	testTokens         map[string]*gtsmodel.Token
+	testClients        map[string]*gtsmodel.Client
+	testApplications   map[string]*gtsmodel.Application
+	testUsers          map[string]*gtsmodel.User
+	testAccounts       map[string]*gtsmodel.Account
+	testAttachments    map[string]*gtsmodel.MediaAttachment
+	testStatuses       map[string]*gtsmodel.Status
+	testTags           map[string]*gtsmodel.Tag
+	testMentions       map[string]*gtsmodel.Mention
+	testFollows        map[string]*gtsmodel.Follow
+	testEmojis         map[string]*gtsmodel.Emoji
+	testReports        map[string]*gtsmodel.Report
+	testLists          map[string]*gtsmodel.List
+	testListEntries    map[string]*gtsmodel.ListEntry
+	testFilters        map[string]*gtsmodel.Filter
+	testFilterKeywords map[string]*gtsmodel.FilterKeyword
 }
 
 func (suite *BunDBStandardTestSuite) SetupSuite() {
@@ -59,6 +63,10 @@ func (suite *BunDBStandardTestSuite) SetupSuite() {
 	suite.testFollows = testrig.NewTestFollows()
 	suite.testEmojis = testrig.NewTestEmojis()
 	suite.testReports = testrig.NewTestReports()
+	suite.testLists = testrig.NewTestLists()
+	suite.testListEntries = testrig.NewTestListEntries()
+	suite.testFilters = testrig.NewTestFilters()
+	suite.testFilterKeywords = testrig.NewTestFilterKeywords()
 }
 
 func (suite *BunDBStandardTestSuite) SetupTest() {
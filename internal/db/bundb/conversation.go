@@ -0,0 +1,394 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type conversationDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+// otherAccountsKey returns the key used to look up conversations
+// with the given set of other accounts, regardless of the order of the IDs.
+func otherAccountsKey(otherAccountIDs []string) string {
+	ids := make([]string, len(otherAccountIDs))
+	copy(ids, otherAccountIDs)
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
+
+func (c *conversationDB) getConversation(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Conversation) error, keyParts ...any) (*gtsmodel.Conversation, db.Error) {
+	conversation, err := c.state.Caches.GTS.Conversation().Load(lookup, func() (*gtsmodel.Conversation, error) {
+		var conversation gtsmodel.Conversation
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&conversation); err != nil {
+			return nil, c.conn.ProcessError(err)
+		}
+
+		return &conversation, nil
+	}, keyParts...)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	if err := c.state.DB.PopulateConversation(ctx, conversation); err != nil {
+		return nil, err
+	}
+
+	return conversation, nil
+}
+
+func (c *conversationDB) GetConversationByID(ctx context.Context, id string) (*gtsmodel.Conversation, db.Error) {
+	return c.getConversation(
+		ctx,
+		"ID",
+		func(conversation *gtsmodel.Conversation) error {
+			return c.conn.NewSelect().
+				Model(conversation).
+				Where("? = ?", bun.Ident("conversation.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (c *conversationDB) GetConversationByThreadAndAccountIDs(ctx context.Context, accountID string, threadID string, otherAccountIDs []string) (*gtsmodel.Conversation, db.Error) {
+	key := otherAccountsKey(otherAccountIDs)
+	return c.getConversation(
+		ctx,
+		"AccountID.ThreadID.OtherAccountsKey",
+		func(conversation *gtsmodel.Conversation) error {
+			return c.conn.NewSelect().
+				Model(conversation).
+				Where("? = ?", bun.Ident("conversation.account_id"), accountID).
+				Where("? = ?", bun.Ident("conversation.thread_id"), threadID).
+				Where("? = ?", bun.Ident("conversation.other_accounts_key"), key).
+				Scan(ctx)
+		},
+		accountID,
+		threadID,
+		key,
+	)
+}
+
+func (c *conversationDB) GetConversationsForAccountID(ctx context.Context,
+	accountID string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) ([]*gtsmodel.Conversation, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	var (
+		conversationIDs = make([]string, 0, limit)
+		frontToBack     = true
+	)
+
+	q := c.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("conversations"), bun.Ident("conversation")).
+		// Select only IDs from table
+		Column("conversation.id").
+		// Select only conversations belonging to accountID.
+		Where("? = ?", bun.Ident("conversation.account_id"), accountID)
+
+	if maxID != "" {
+		// return only conversations with a last status LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("conversation.last_status_id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only conversations with a last status HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("conversation.last_status_id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only conversations with a last status HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("conversation.last_status_id"), minID)
+
+		// page up
+		frontToBack = false
+	}
+
+	if limit > 0 {
+		// limit amount of conversations returned
+		q = q.Limit(limit)
+	}
+
+	if frontToBack {
+		// Page down.
+		q = q.Order("conversation.last_status_id DESC")
+	} else {
+		// Page up.
+		q = q.Order("conversation.last_status_id ASC")
+	}
+
+	if err := q.Scan(ctx, &conversationIDs); err != nil {
+		return nil, c.conn.ProcessError(err)
+	}
+
+	if len(conversationIDs) == 0 {
+		return nil, nil
+	}
+
+	// If we're paging up, we still want conversations
+	// to be sorted by last status desc, so reverse ids slice.
+	// https://zchee.github.io/golang-wiki/SliceTricks/#reversing
+	if !frontToBack {
+		for i, j := 0, len(conversationIDs)-1; i < j; i, j = i+1, j-1 {
+			conversationIDs[i], conversationIDs[j] = conversationIDs[j], conversationIDs[i]
+		}
+	}
+
+	// Select each conversation using its ID to ensure cache used.
+	conversations := make([]*gtsmodel.Conversation, 0, len(conversationIDs))
+	for _, id := range conversationIDs {
+		conversation, err := c.state.DB.GetConversationByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching conversation %q: %v", id, err)
+			continue
+		}
+
+		// Append conversation.
+		conversations = append(conversations, conversation)
+	}
+
+	return conversations, nil
+}
+
+func (c *conversationDB) PopulateConversation(ctx context.Context, conversation *gtsmodel.Conversation) db.Error {
+	var err error
+
+	if conversation.Account == nil {
+		// Conversation account is not set, fetch from the database.
+		conversation.Account, err = c.state.DB.GetAccountByID(ctx, conversation.AccountID)
+		if err != nil {
+			return fmt.Errorf("error populating conversation account: %w", err)
+		}
+	}
+
+	if conversation.OtherAccounts == nil {
+		// Other accounts are not set, fetch from the database.
+		conversation.OtherAccounts = make([]*gtsmodel.Account, 0, len(conversation.OtherAccountIDs))
+		for _, id := range conversation.OtherAccountIDs {
+			account, err := c.state.DB.GetAccountByID(ctx, id)
+			if err != nil {
+				// The account may since have been deleted;
+				// this shouldn't stop the conversation loading.
+				log.Errorf(ctx, "error populating conversation other account %q: %v", id, err)
+				continue
+			}
+			conversation.OtherAccounts = append(conversation.OtherAccounts, account)
+		}
+	}
+
+	if conversation.LastStatus == nil {
+		// Last status is not set, fetch from the database.
+		conversation.LastStatus, err = c.state.DB.GetStatusByID(ctx, conversation.LastStatusID)
+		if err != nil {
+			return fmt.Errorf("error populating conversation last status: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *conversationDB) PutConversation(ctx context.Context, conversation *gtsmodel.Conversation) db.Error {
+	// Make sure the lookup key matches the other accounts.
+	conversation.OtherAccountsKey = otherAccountsKey(conversation.OtherAccountIDs)
+
+	return c.state.Caches.GTS.Conversation().Store(conversation, func() error {
+		_, err := c.conn.NewInsert().Model(conversation).Exec(ctx)
+		return c.conn.ProcessError(err)
+	})
+}
+
+func (c *conversationDB) UpdateConversation(ctx context.Context, conversation *gtsmodel.Conversation, columns ...string) db.Error {
+	conversation.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update conversation in the database, invalidating the cache.
+	if _, err := c.conn.
+		NewUpdate().
+		Model(conversation).
+		Where("? = ?", bun.Ident("conversation.id"), conversation.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return c.conn.ProcessError(err)
+	}
+
+	c.state.Caches.GTS.Conversation().Invalidate("ID", conversation.ID)
+	return nil
+}
+
+func (c *conversationDB) DeleteConversationByID(ctx context.Context, id string) db.Error {
+	// Invalidate the conversation from the cache.
+	defer c.state.Caches.GTS.Conversation().Invalidate("ID", id)
+
+	// Delete all links to statuses for this conversation, and the conversation itself.
+	return c.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			Table("conversation_to_statuses").
+			Where("? = ?", bun.Ident("conversation_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			Table("conversations").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (c *conversationDB) DeleteConversationsByOwnerAccountID(ctx context.Context, accountID string) db.Error {
+	var conversationIDs []string
+	if err := c.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("conversations"), bun.Ident("conversation")).
+		Column("conversation.id").
+		Where("? = ?", bun.Ident("conversation.account_id"), accountID).
+		Scan(ctx, &conversationIDs); err != nil {
+		return c.conn.ProcessError(err)
+	}
+
+	for _, id := range conversationIDs {
+		if err := c.DeleteConversationByID(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *conversationDB) LinkConversationToStatus(ctx context.Context, conversationID string, statusID string) db.Error {
+	if _, err := c.conn.
+		NewInsert().
+		Model(&gtsmodel.ConversationToStatus{
+			ConversationID: conversationID,
+			StatusID:       statusID,
+		}).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("conversation_id"), bun.Ident("status_id")).
+		Exec(ctx); err != nil {
+		return c.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (c *conversationDB) DeleteStatusFromConversations(ctx context.Context, statusID string) db.Error {
+	// Find all the conversations this status is a part of.
+	var conversationIDs []string
+	if err := c.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+		Column("conversation_to_status.conversation_id").
+		Where("? = ?", bun.Ident("conversation_to_status.status_id"), statusID).
+		Scan(ctx, &conversationIDs); err != nil {
+		return c.conn.ProcessError(err)
+	}
+
+	// Remove the links from the status to those conversations.
+	if _, err := c.conn.
+		NewDelete().
+		Table("conversation_to_statuses").
+		Where("? = ?", bun.Ident("status_id"), statusID).
+		Exec(ctx); err != nil {
+		return c.conn.ProcessError(err)
+	}
+
+	for _, id := range conversationIDs {
+		// Select the conversation directly rather than via the
+		// cache, since the last status may be the one being
+		// deleted, in which case population would fail.
+		conversation := &gtsmodel.Conversation{}
+		if err := c.conn.
+			NewSelect().
+			Model(conversation).
+			Where("? = ?", bun.Ident("conversation.id"), id).
+			Scan(ctx); err != nil {
+			err = c.conn.ProcessError(err)
+			if errors.Is(err, db.ErrNoEntries) {
+				// Already gone.
+				continue
+			}
+			return err
+		}
+
+		if conversation.LastStatusID != statusID {
+			// Nothing else to do.
+			continue
+		}
+
+		// Find the newest remaining status in the conversation.
+		var lastStatusIDs []string
+		if err := c.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+			Column("conversation_to_status.status_id").
+			Where("? = ?", bun.Ident("conversation_to_status.conversation_id"), id).
+			Order("conversation_to_status.status_id DESC").
+			Limit(1).
+			Scan(ctx, &lastStatusIDs); err != nil {
+			return c.conn.ProcessError(err)
+		}
+
+		if len(lastStatusIDs) == 0 {
+			// No statuses left, delete the conversation.
+			if err := c.DeleteConversationByID(ctx, id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		conversation.LastStatusID = lastStatusIDs[0]
+		conversation.LastStatus = nil
+		if err := c.UpdateConversation(ctx, conversation, "last_status_id"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ConversationTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ConversationTestSuite) putConversation() *gtsmodel.Conversation {
+	var (
+		ctx          = context.Background()
+		owner        = suite.testAccounts["local_account_1"]
+		other        = suite.testAccounts["local_account_2"]
+		dmStatus     = suite.testStatuses["local_account_2_status_6"]
+		unread       = false
+		conversation = &gtsmodel.Conversation{
+			ID:              "01HN2Q1J8DZ5C7W6X4Y3V2T1S0",
+			AccountID:       owner.ID,
+			OtherAccountIDs: []string{other.ID},
+			ThreadID:        dmStatus.ID,
+			LastStatusID:    dmStatus.ID,
+			Read:            &unread,
+		}
+	)
+
+	if err := suite.db.PutConversation(ctx, conversation); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.LinkConversationToStatus(ctx, conversation.ID, dmStatus.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return conversation
+}
+
+func (suite *ConversationTestSuite) TestPutGetConversation() {
+	ctx := context.Background()
+	conversation := suite.putConversation()
+
+	dbConversation, err := suite.db.GetConversationByThreadAndAccountIDs(ctx,
+		conversation.AccountID,
+		conversation.ThreadID,
+		conversation.OtherAccountIDs,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(conversation.ID, dbConversation.ID)
+	suite.NotNil(dbConversation.Account)
+	suite.Len(dbConversation.OtherAccounts, 1)
+	suite.Equal(conversation.LastStatusID, dbConversation.LastStatus.ID)
+
+	conversations, err := suite.db.GetConversationsForAccountID(ctx, conversation.AccountID, "", "", "", 20)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(conversations, 1) {
+		suite.Equal(conversation.ID, conversations[0].ID)
+	}
+
+	// Linking the same status again should be a no-op.
+	if err := suite.db.LinkConversationToStatus(ctx, conversation.ID, conversation.LastStatusID); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *ConversationTestSuite) TestDeleteStatusFromConversations() {
+	ctx := context.Background()
+	conversation := suite.putConversation()
+
+	// Add an older status to the conversation.
+	olderStatus := suite.testStatuses["local_account_2_status_1"]
+	if err := suite.db.LinkConversationToStatus(ctx, conversation.ID, olderStatus.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Deleting the last status should fall back to the older one.
+	if err := suite.db.DeleteStatusFromConversations(ctx, conversation.LastStatusID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbConversation, err := suite.db.GetConversationByID(ctx, conversation.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(olderStatus.ID, dbConversation.LastStatusID)
+
+	// Deleting the only remaining status should delete the conversation.
+	if err := suite.db.DeleteStatusFromConversations(ctx, olderStatus.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetConversationByID(ctx, conversation.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestConversationTestSuite(t *testing.T) {
+	suite.Run(t, new(ConversationTestSuite))
+}
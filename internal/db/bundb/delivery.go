@@ -0,0 +1,158 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type deliveryDB struct {
+	conn *DBConn
+}
+
+func (d *deliveryDB) GetDeliveryByID(ctx context.Context, id string) (*gtsmodel.Delivery, db.Error) {
+	delivery := &gtsmodel.Delivery{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(delivery).
+		Where("? = ?", bun.Ident("delivery.id"), id).
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return delivery, nil
+}
+
+func (d *deliveryDB) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*gtsmodel.Delivery, db.Error) {
+	deliveries := []*gtsmodel.Delivery{}
+
+	q := d.conn.
+		NewSelect().
+		Model(&deliveries).
+		Where("? <= ?", bun.Ident("delivery.next_attempt_at"), now).
+		Order("delivery.next_attempt_at ASC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	if len(deliveries) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return deliveries, nil
+}
+
+func (d *deliveryDB) IsDeliveryQueued(ctx context.Context, activityID string, inboxURI string) (bool, db.Error) {
+	q := d.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery")).
+		Column("delivery.id").
+		Where("? = ?", bun.Ident("delivery.activity_id"), activityID).
+		Where("? = ?", bun.Ident("delivery.inbox_uri"), inboxURI)
+
+	return d.conn.Exists(ctx, q)
+}
+
+func (d *deliveryDB) CountDeliveries(ctx context.Context) (int, int, db.Error) {
+	total, err := d.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery")).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, d.conn.ProcessError(err)
+	}
+
+	failing, err := d.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery")).
+		Where("? > 0", bun.Ident("delivery.attempts")).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, d.conn.ProcessError(err)
+	}
+
+	return total, failing, nil
+}
+
+func (d *deliveryDB) GetDeliveryHosts(ctx context.Context, limit int) ([]*gtsmodel.DeliveryHost, db.Error) {
+	hosts := []*gtsmodel.DeliveryHost{}
+
+	q := d.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery")).
+		ColumnExpr("? AS ?", bun.Ident("delivery.host"), bun.Ident("host")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("deliveries")).
+		ColumnExpr("MIN(?) AS ?", bun.Ident("delivery.created_at"), bun.Ident("failing_since")).
+		ColumnExpr("MAX(?) AS ?", bun.Ident("delivery.last_attempt_at"), bun.Ident("last_attempt_at")).
+		Where("? > 0", bun.Ident("delivery.attempts")).
+		Group("delivery.host").
+		OrderExpr("COUNT(*) DESC, ? ASC", bun.Ident("delivery.host"))
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &hosts); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return hosts, nil
+}
+
+func (d *deliveryDB) PutDelivery(ctx context.Context, delivery *gtsmodel.Delivery) db.Error {
+	_, err := d.conn.
+		NewInsert().
+		Model(delivery).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) UpdateDelivery(ctx context.Context, delivery *gtsmodel.Delivery, columns ...string) db.Error {
+	delivery.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.conn.
+		NewUpdate().
+		Model(delivery).
+		Column(columns...).
+		Where("? = ?", bun.Ident("delivery.id"), delivery.ID).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) DeleteDeliveryByID(ctx context.Context, id string) db.Error {
+	_, err := d.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery")).
+		Where("? = ?", bun.Ident("delivery.id"), id).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
@@ -0,0 +1,107 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type DeliveryTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *DeliveryTestSuite) putDelivery(inboxURI string, host string, attempts int, nextAttemptAt time.Time) *gtsmodel.Delivery {
+	delivery := &gtsmodel.Delivery{
+		ID:            id.NewULID(),
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+		PubKeyID:      suite.testAccounts["local_account_1"].PublicKeyURI,
+		ActivityID:    "http://localhost:8080/users/the_mighty_zork/activity/" + id.NewULID(),
+		InboxURI:      inboxURI,
+		Host:          host,
+		Body:          []byte(`{"type":"Create"}`),
+		Attempts:      attempts,
+		NextAttemptAt: nextAttemptAt,
+	}
+
+	if err := suite.db.PutDelivery(context.Background(), delivery); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return delivery
+}
+
+func (suite *DeliveryTestSuite) TestDeliveryQueue() {
+	ctx := context.Background()
+	now := time.Now()
+
+	due := suite.putDelivery("https://example.org/inbox", "example.org", 0, now.Add(-time.Minute))
+	failing := suite.putDelivery("https://down.example.org/inbox", "down.example.org", 3, now.Add(-time.Second))
+	suite.putDelivery("https://down.example.org/users/someone/inbox", "down.example.org", 1, now.Add(time.Hour))
+
+	// Only the deliveries due by now, oldest due first.
+	deliveries, err := suite.db.GetDueDeliveries(ctx, now, 10)
+	suite.NoError(err)
+	if suite.Len(deliveries, 2) {
+		suite.Equal(due.ID, deliveries[0].ID)
+		suite.Equal(failing.ID, deliveries[1].ID)
+	}
+
+	queued, err := suite.db.IsDeliveryQueued(ctx, due.ActivityID, due.InboxURI)
+	suite.NoError(err)
+	suite.True(queued)
+
+	queued, err = suite.db.IsDeliveryQueued(ctx, due.ActivityID, failing.InboxURI)
+	suite.NoError(err)
+	suite.False(queued)
+
+	total, failingCount, err := suite.db.CountDeliveries(ctx)
+	suite.NoError(err)
+	suite.Equal(3, total)
+	suite.Equal(2, failingCount)
+
+	hosts, err := suite.db.GetDeliveryHosts(ctx, 10)
+	suite.NoError(err)
+	if suite.Len(hosts, 1) {
+		suite.Equal("down.example.org", hosts[0].Host)
+		suite.Equal(2, hosts[0].Deliveries)
+		suite.False(hosts[0].FailingSince.IsZero())
+	}
+
+	// Push back the due delivery.
+	due.NextAttemptAt = now.Add(time.Hour)
+	suite.NoError(suite.db.UpdateDelivery(ctx, due, "next_attempt_at"))
+
+	suite.NoError(suite.db.DeleteDeliveryByID(ctx, failing.ID))
+	_, err = suite.db.GetDeliveryByID(ctx, failing.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetDueDeliveries(ctx, now, 10)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestDeliveryTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryTestSuite))
+}
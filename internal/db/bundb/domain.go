@@ -21,6 +21,7 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -62,8 +63,8 @@ func (d *domainDB) CreateDomainBlock(ctx context.Context, block *gtsmodel.Domain
 		return d.conn.ProcessError(err)
 	}
 
-	// Clear the domain block cache (for later reload)
-	d.state.Caches.GTS.DomainBlock().Clear()
+	// Clear the domain block caches (for later reload)
+	d.clearDomainBlockCaches()
 
 	return nil
 }
@@ -97,6 +98,28 @@ func (d *domainDB) GetDomainBlock(ctx context.Context, domain string) (*gtsmodel
 	return &block, nil
 }
 
+func (d *domainDB) UpdateDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock, columns ...string) db.Error {
+	block.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update the block in the DB
+	if _, err := d.conn.NewUpdate().
+		Model(block).
+		Where("? = ?", bun.Ident("domain_block.id"), block.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	// Clear the domain block caches (for later reload)
+	d.clearDomainBlockCaches()
+
+	return nil
+}
+
 func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) db.Error {
 	var err error
 
@@ -113,8 +136,8 @@ func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) db.Erro
 		return d.conn.ProcessError(err)
 	}
 
-	// Clear the domain block cache (for later reload)
-	d.state.Caches.GTS.DomainBlock().Clear()
+	// Clear the domain block caches (for later reload)
+	d.clearDomainBlockCaches()
 
 	return nil
 }
@@ -132,14 +155,28 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, db
 		return false, nil
 	}
 
+	if config.GetInstanceFederationMode() == config.InstanceFederationModeAllowlist {
+		allowed, err := d.IsDomainAllowed(ctx, domain)
+		if err != nil {
+			return false, err
+		}
+
+		if !allowed {
+			// In allowlist mode, anything that
+			// isn't explicitly allowed is blocked.
+			return true, nil
+		}
+	}
+
 	// Check the cache for a domain block (hydrating the cache with callback if necessary)
-	return d.state.Caches.GTS.DomainBlock().IsBlocked(domain, func() ([]string, error) {
+	return d.state.Caches.GTS.DomainBlock().Matches(domain, func() ([]string, error) {
 		var domains []string
 
-		// Scan list of all blocked domains from DB
+		// Scan list of all suspended domains from DB
 		q := d.conn.NewSelect().
 			Table("domain_blocks").
-			Column("domain")
+			Column("domain").
+			Where("? = ?", bun.Ident("severity"), gtsmodel.DomainBlockSeveritySuspend)
 		if err := q.Scan(ctx, &domains); err != nil {
 			return nil, d.conn.ProcessError(err)
 		}
@@ -148,6 +185,74 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, db
 	})
 }
 
+func (d *domainDB) IsDomainLimited(ctx context.Context, domain string) (bool, db.Error) {
+	// Normalize the domain as punycode
+	domain, err := normalizeDomain(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// Check for easy case, domain referencing *us*
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return false, nil
+	}
+
+	// Check the cache for a limiting domain block (hydrating the cache with callback if necessary)
+	return d.state.Caches.GTS.DomainLimit().Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all limited domains from DB
+		q := d.conn.NewSelect().
+			Table("domain_blocks").
+			Column("domain").
+			Where("? = ?", bun.Ident("severity"), gtsmodel.DomainBlockSeverityLimit)
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, d.conn.ProcessError(err)
+		}
+
+		return domains, nil
+	})
+}
+
+func (d *domainDB) IsDomainMediaRejected(ctx context.Context, domain string) (bool, db.Error) {
+	// Normalize the domain as punycode
+	domain, err := normalizeDomain(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// Check for easy case, domain referencing *us*
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return false, nil
+	}
+
+	// Check the cache for a media rejecting domain block (hydrating the cache with callback if necessary)
+	return d.state.Caches.GTS.DomainRejectMedia().Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all domains with rejected media from DB
+		q := d.conn.NewSelect().
+			Table("domain_blocks").
+			Column("domain").
+			Where("? = ?", bun.Ident("reject_media"), true)
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, d.conn.ProcessError(err)
+		}
+
+		return domains, nil
+	})
+}
+
+// clearDomainBlockCaches clears all the caches
+// which are hydrated from the domain blocks table.
+func (d *domainDB) clearDomainBlockCaches() {
+	d.state.Caches.GTS.DomainBlock().Clear()
+	d.state.Caches.GTS.DomainLimit().Clear()
+	d.state.Caches.GTS.DomainRejectMedia().Clear()
+}
+
 func (d *domainDB) AreDomainsBlocked(ctx context.Context, domains []string) (bool, db.Error) {
 	for _, domain := range domains {
 		if blocked, err := d.IsDomainBlocked(ctx, domain); err != nil {
@@ -173,3 +278,218 @@ func (d *domainDB) AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, d
 	}
 	return false, nil
 }
+
+func (d *domainDB) CreateDomainAllow(ctx context.Context, allow *gtsmodel.DomainAllow) db.Error {
+	var err error
+
+	// Normalize the domain as punycode
+	allow.Domain, err = normalizeDomain(allow.Domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to store domain in DB
+	if _, err := d.conn.NewInsert().
+		Model(allow).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	// Clear the domain allow cache (for later reload)
+	d.state.Caches.GTS.DomainAllow().Clear()
+
+	return nil
+}
+
+func (d *domainDB) GetDomainAllow(ctx context.Context, domain string) (*gtsmodel.DomainAllow, db.Error) {
+	var err error
+
+	// Normalize the domain as punycode
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for easy case, domain referencing *us*
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return nil, db.ErrNoEntries
+	}
+
+	var allow gtsmodel.DomainAllow
+
+	// Look for allow matching domain in DB
+	q := d.conn.
+		NewSelect().
+		Model(&allow).
+		Where("? = ?", bun.Ident("domain_allow.domain"), domain)
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return &allow, nil
+}
+
+func (d *domainDB) GetDomainAllowByID(ctx context.Context, id string) (*gtsmodel.DomainAllow, db.Error) {
+	var allow gtsmodel.DomainAllow
+
+	q := d.conn.
+		NewSelect().
+		Model(&allow).
+		Where("? = ?", bun.Ident("domain_allow.id"), id)
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return &allow, nil
+}
+
+func (d *domainDB) GetDomainAllows(ctx context.Context) ([]*gtsmodel.DomainAllow, db.Error) {
+	allows := []*gtsmodel.DomainAllow{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(&allows).
+		Order("domain_allow.domain ASC").
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return allows, nil
+}
+
+func (d *domainDB) DeleteDomainAllow(ctx context.Context, domain string) db.Error {
+	var err error
+
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to delete domain allow
+	if _, err := d.conn.NewDelete().
+		Model((*gtsmodel.DomainAllow)(nil)).
+		Where("? = ?", bun.Ident("domain_allow.domain"), domain).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	// Clear the domain allow cache (for later reload)
+	d.state.Caches.GTS.DomainAllow().Clear()
+
+	return nil
+}
+
+func (d *domainDB) IsDomainAllowed(ctx context.Context, domain string) (bool, db.Error) {
+	// Normalize the domain as punycode
+	domain, err := normalizeDomain(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// Check for easy case, domain referencing *us*
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return true, nil
+	}
+
+	// Check the cache for a domain allow (hydrating the cache with callback if necessary)
+	return d.state.Caches.GTS.DomainAllow().Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all allowed domains from DB
+		q := d.conn.NewSelect().
+			Table("domain_allows").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, d.conn.ProcessError(err)
+		}
+
+		return domains, nil
+	})
+}
+
+func (d *domainDB) GetDomainBlocksBySubscriptionID(ctx context.Context, subscriptionID string) ([]*gtsmodel.DomainBlock, db.Error) {
+	blocks := []*gtsmodel.DomainBlock{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(&blocks).
+		Where("? = ?", bun.Ident("domain_block.subscription_id"), subscriptionID).
+		Order("domain_block.domain ASC").
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return blocks, nil
+}
+
+func (d *domainDB) GetDomainBlockSubscriptionByID(ctx context.Context, id string) (*gtsmodel.DomainBlockSubscription, db.Error) {
+	subscription := new(gtsmodel.DomainBlockSubscription)
+
+	if err := d.conn.
+		NewSelect().
+		Model(subscription).
+		Where("? = ?", bun.Ident("domain_block_subscription.id"), id).
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return subscription, nil
+}
+
+func (d *domainDB) GetDomainBlockSubscriptions(ctx context.Context) ([]*gtsmodel.DomainBlockSubscription, db.Error) {
+	subscriptions := []*gtsmodel.DomainBlockSubscription{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(&subscriptions).
+		Order("domain_block_subscription.id ASC").
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return subscriptions, nil
+}
+
+func (d *domainDB) PutDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) db.Error {
+	if _, err := d.conn.
+		NewInsert().
+		Model(subscription).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) UpdateDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription, columns ...string) db.Error {
+	subscription.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := d.conn.
+		NewUpdate().
+		Model(subscription).
+		Column(columns...).
+		Where("? = ?", bun.Ident("domain_block_subscription.id"), subscription.ID).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) DeleteDomainBlockSubscription(ctx context.Context, id string) db.Error {
+	if _, err := d.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("domain_block_subscriptions"), bun.Ident("domain_block_subscription")).
+		Where("? = ?", bun.Ident("domain_block_subscription.id"), id).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
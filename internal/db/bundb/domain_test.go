@@ -23,7 +23,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type DomainTestSuite struct {
@@ -159,6 +161,135 @@ func (suite *DomainTestSuite) TestIsDomainBlockedNonASCII2() {
 	suite.True(blocked)
 }
 
+func (suite *DomainTestSuite) TestIsDomainLimited() {
+	ctx := context.Background()
+
+	err := suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01H0P21F6Y2T3Q8V9M4A5K7C0B",
+		Domain:             "meh.apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		Severity:           gtsmodel.DomainBlockSeverityLimit,
+		RejectMedia:        testrig.TrueBool(),
+	})
+	suite.NoError(err)
+
+	// a limited domain isn't blocked
+	blocked, err := suite.db.IsDomainBlocked(ctx, "sub.meh.apples")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	limited, err := suite.db.IsDomainLimited(ctx, "sub.meh.apples")
+	suite.NoError(err)
+	suite.True(limited)
+
+	rejectMedia, err := suite.db.IsDomainMediaRejected(ctx, "sub.meh.apples")
+	suite.NoError(err)
+	suite.True(rejectMedia)
+
+	// suspended domains aren't limited, and by default don't reject media
+	limited, err = suite.db.IsDomainLimited(ctx, "replyguys.com")
+	suite.NoError(err)
+	suite.False(limited)
+
+	rejectMedia, err = suite.db.IsDomainMediaRejected(ctx, "replyguys.com")
+	suite.NoError(err)
+	suite.False(rejectMedia)
+}
+
+func (suite *DomainTestSuite) TestUpdateDomainBlock() {
+	ctx := context.Background()
+
+	block := &gtsmodel.DomainBlock{
+		ID:                 "01HPQCX3M7A9D2F5K8N1R4T6W0",
+		Domain:             "meh.apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		Severity:           gtsmodel.DomainBlockSeverityLimit,
+		RejectMedia:        testrig.FalseBool(),
+	}
+	err := suite.db.CreateDomainBlock(ctx, block)
+	suite.NoError(err)
+
+	// prime the caches with the limit
+	limited, err := suite.db.IsDomainLimited(ctx, "meh.apples")
+	suite.NoError(err)
+	suite.True(limited)
+
+	blocked, err := suite.db.IsDomainBlocked(ctx, "meh.apples")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// escalate the limit to a suspension
+	block.Severity = gtsmodel.DomainBlockSeveritySuspend
+	block.RejectMedia = testrig.TrueBool()
+	err = suite.db.UpdateDomainBlock(ctx, block, "severity", "reject_media")
+	suite.NoError(err)
+
+	limited, err = suite.db.IsDomainLimited(ctx, "meh.apples")
+	suite.NoError(err)
+	suite.False(limited)
+
+	blocked, err = suite.db.IsDomainBlocked(ctx, "meh.apples")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	dbBlock, err := suite.db.GetDomainBlock(ctx, "meh.apples")
+	suite.NoError(err)
+	suite.Equal(gtsmodel.DomainBlockSeveritySuspend, dbBlock.Severity)
+	suite.True(*dbBlock.RejectMedia)
+}
+
+func (suite *DomainTestSuite) TestIsDomainBlockedAllowlist() {
+	ctx := context.Background()
+
+	config.SetInstanceFederationMode(config.InstanceFederationModeAllowlist)
+	defer config.SetInstanceFederationMode(config.InstanceFederationModeDefault)
+
+	domainAllow := &gtsmodel.DomainAllow{
+		ID:                 "01H0KXZ6ZRYQ1M5N3E4G1W2NVQ",
+		Domain:             "good.apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		CreatedByAccount:   suite.testAccounts["admin_account"],
+	}
+
+	// no domain allow exists yet, so domain is blocked
+	blocked, err := suite.db.IsDomainBlocked(ctx, "sub.good.apples")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	// our own domain is never blocked
+	blocked, err = suite.db.IsDomainBlocked(ctx, config.GetHost())
+	suite.NoError(err)
+	suite.False(blocked)
+
+	err = suite.db.CreateDomainAllow(ctx, domainAllow)
+	suite.NoError(err)
+
+	// domain and subdomains are now allowed
+	blocked, err = suite.db.IsDomainBlocked(ctx, "sub.good.apples")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// an explicit block still takes precedence over an allow
+	err = suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01H0KY0V3C8R5ZJ2KX0Z5Q8F7M",
+		Domain:             "sub.good.apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	})
+	suite.NoError(err)
+
+	blocked, err = suite.db.IsDomainBlocked(ctx, "sub.good.apples")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	// removing the allow blocks the domain again
+	err = suite.db.DeleteDomainAllow(ctx, domainAllow.Domain)
+	suite.NoError(err)
+
+	blocked, err = suite.db.IsDomainBlocked(ctx, "good.apples")
+	suite.NoError(err)
+	suite.True(blocked)
+}
+
 func TestDomainTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainTestSuite))
 }
@@ -0,0 +1,421 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type filterDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+/*
+	FILTER FUNCTIONS
+*/
+
+// loadFilter loads a filter using the cache where possible, without populating it.
+func (f *filterDB) loadFilter(ctx context.Context, id string) (*gtsmodel.Filter, db.Error) {
+	return f.state.Caches.GTS.Filter().Load("ID", func() (*gtsmodel.Filter, error) {
+		var filter gtsmodel.Filter
+
+		// Not cached! Perform database query.
+		if err := f.conn.
+			NewSelect().
+			Model(&filter).
+			Where("? = ?", bun.Ident("filter.id"), id).
+			Scan(ctx); err != nil {
+			return nil, f.conn.ProcessError(err)
+		}
+
+		return &filter, nil
+	}, id)
+}
+
+func (f *filterDB) GetFilterByID(ctx context.Context, id string) (*gtsmodel.Filter, db.Error) {
+	filter, err := f.loadFilter(ctx, id)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	if err := f.state.DB.PopulateFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func (f *filterDB) GetFiltersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Filter, db.Error) {
+	// Fetch IDs of all filters owned by this account.
+	var filterIDs []string
+	if err := f.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("filters"), bun.Ident("filter")).
+		Column("filter.id").
+		Where("? = ?", bun.Ident("filter.account_id"), accountID).
+		Order("filter.id DESC").
+		Scan(ctx, &filterIDs); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	if len(filterIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each filter using its ID to ensure cache used.
+	filters := make([]*gtsmodel.Filter, 0, len(filterIDs))
+	for _, id := range filterIDs {
+		filter, err := f.state.DB.GetFilterByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching filter %q: %v", id, err)
+			continue
+		}
+
+		// Append filter.
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func (f *filterDB) GetFiltersExpiredBetween(ctx context.Context, after time.Time, before time.Time) ([]*gtsmodel.Filter, db.Error) {
+	// Fetch IDs of all filters that expired in the window.
+	var filterIDs []string
+	if err := f.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("filters"), bun.Ident("filter")).
+		Column("filter.id").
+		Where("? IS NOT NULL", bun.Ident("filter.expires_at")).
+		Where("? > ?", bun.Ident("filter.expires_at"), after).
+		Where("? <= ?", bun.Ident("filter.expires_at"), before).
+		Order("filter.id ASC").
+		Scan(ctx, &filterIDs); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	if len(filterIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each filter using its ID to ensure cache used.
+	filters := make([]*gtsmodel.Filter, 0, len(filterIDs))
+	for _, id := range filterIDs {
+		filter, err := f.loadFilter(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching filter %q: %v", id, err)
+			continue
+		}
+
+		// Append filter.
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func (f *filterDB) PopulateFilter(ctx context.Context, filter *gtsmodel.Filter) db.Error {
+	if filter.Keywords == nil {
+		// Filter keywords are not set, fetch from the database.
+		keywords, err := f.state.DB.GetFilterKeywordsForFilterID(ctx, filter.ID)
+		if err != nil {
+			return fmt.Errorf("error populating filter keywords: %w", err)
+		}
+
+		// Point each keyword back at this (populated) filter.
+		for _, keyword := range keywords {
+			keyword.Filter = filter
+		}
+
+		filter.Keywords = keywords
+	}
+
+	return nil
+}
+
+func (f *filterDB) PutFilter(ctx context.Context, filter *gtsmodel.Filter) db.Error {
+	// Ensure keyword regular expressions are prepared before caching.
+	for _, keyword := range filter.Keywords {
+		if err := keyword.Compile(); err != nil {
+			return fmt.Errorf("error compiling filter keyword %q: %w", keyword.Keyword, err)
+		}
+	}
+
+	// Insert filter and keywords in a transaction so we don't end up with partial updates.
+	if err := f.state.Caches.GTS.Filter().Store(filter, func() error {
+		return f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+			if _, err := tx.
+				NewInsert().
+				Model(filter).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			for _, keyword := range filter.Keywords {
+				if _, err := tx.
+					NewInsert().
+					Model(keyword).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (f *filterDB) UpdateFilter(ctx context.Context, filter *gtsmodel.Filter, columns ...string) db.Error {
+	filter.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update filter in the database, invalidating the cache.
+	if _, err := f.conn.
+		NewUpdate().
+		Model(filter).
+		Where("? = ?", bun.Ident("filter.id"), filter.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	f.state.Caches.GTS.Filter().Invalidate("ID", filter.ID)
+	return nil
+}
+
+func (f *filterDB) DeleteFilterByID(ctx context.Context, id string) db.Error {
+	// Load filter by ID into cache to ensure we can perform
+	// all necessary cache invalidation hooks on removal.
+	filter, err := f.GetFilterByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Already gone.
+			return nil
+		}
+		return err
+	}
+
+	// Invalidate all keywords of this filter from the cache.
+	for _, keyword := range filter.Keywords {
+		f.state.Caches.GTS.FilterKeyword().Invalidate("ID", keyword.ID)
+	}
+
+	// Invalidate the filter itself.
+	defer f.state.Caches.GTS.Filter().Invalidate("ID", id)
+
+	// Delete all keywords attached to this filter, and the filter itself.
+	return f.conn.ProcessError(f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			Table("filter_keywords").
+			Where("? = ?", bun.Ident("filter_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			Table("filters").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	}))
+}
+
+func (f *filterDB) DeleteFiltersForAccountID(ctx context.Context, accountID string) db.Error {
+	// Fetch IDs of all filters owned by this account.
+	var filterIDs []string
+	if err := f.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("filters"), bun.Ident("filter")).
+		Column("filter.id").
+		Where("? = ?", bun.Ident("filter.account_id"), accountID).
+		Scan(ctx, &filterIDs); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	for _, id := range filterIDs {
+		if err := f.DeleteFilterByID(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+	FILTER KEYWORD FUNCTIONS
+*/
+
+func (f *filterDB) GetFilterKeywordByID(ctx context.Context, id string) (*gtsmodel.FilterKeyword, db.Error) {
+	filterKeyword, err := f.state.Caches.GTS.FilterKeyword().Load("ID", func() (*gtsmodel.FilterKeyword, error) {
+		var filterKeyword gtsmodel.FilterKeyword
+
+		// Not cached! Perform database query.
+		if err := f.conn.
+			NewSelect().
+			Model(&filterKeyword).
+			Where("? = ?", bun.Ident("filter_keyword.id"), id).
+			Scan(ctx); err != nil {
+			return nil, f.conn.ProcessError(err)
+		}
+
+		// Prepare the regular expression
+		// once, before it gets cached.
+		if err := filterKeyword.Compile(); err != nil {
+			return nil, fmt.Errorf("error compiling filter keyword %q: %w", filterKeyword.Keyword, err)
+		}
+
+		return &filterKeyword, nil
+	}, id)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	if err := f.state.DB.PopulateFilterKeyword(ctx, filterKeyword); err != nil {
+		return nil, err
+	}
+
+	return filterKeyword, nil
+}
+
+func (f *filterDB) GetFilterKeywordsForFilterID(ctx context.Context, filterID string) ([]*gtsmodel.FilterKeyword, db.Error) {
+	return f.getFilterKeywordsWhere(ctx, "filter_id", filterID)
+}
+
+func (f *filterDB) GetFilterKeywordsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FilterKeyword, db.Error) {
+	return f.getFilterKeywordsWhere(ctx, "account_id", accountID)
+}
+
+// getFilterKeywordsWhere fetches all filter keywords where
+// the given column equals the given value, using the cache
+// where possible. Errors fetching single keywords are logged
+// and skipped.
+func (f *filterDB) getFilterKeywordsWhere(ctx context.Context, column string, value string) ([]*gtsmodel.FilterKeyword, db.Error) {
+	var keywordIDs []string
+	if err := f.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("filter_keywords"), bun.Ident("filter_keyword")).
+		Column("filter_keyword.id").
+		Where("? = ?", bun.Ident("filter_keyword."+column), value).
+		Order("filter_keyword.id DESC").
+		Scan(ctx, &keywordIDs); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	if len(keywordIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each keyword using its ID to ensure cache used.
+	keywords := make([]*gtsmodel.FilterKeyword, 0, len(keywordIDs))
+	for _, id := range keywordIDs {
+		keyword, err := f.state.DB.GetFilterKeywordByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching filter keyword %q: %v", id, err)
+			continue
+		}
+
+		// Append keyword.
+		keywords = append(keywords, keyword)
+	}
+
+	return keywords, nil
+}
+
+func (f *filterDB) PopulateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) db.Error {
+	if filterKeyword.Filter == nil {
+		// Filter is not set, fetch from the database. We don't
+		// populate the filter here, as populating it would in
+		// turn fetch (and populate) this keyword again.
+		filter, err := f.loadFilter(ctx, filterKeyword.FilterID)
+		if err != nil {
+			return fmt.Errorf("error populating filter keyword filter: %w", err)
+		}
+		filterKeyword.Filter = filter
+	}
+
+	return nil
+}
+
+func (f *filterDB) PutFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) db.Error {
+	// Ensure regular expression is prepared before caching.
+	if err := filterKeyword.Compile(); err != nil {
+		return fmt.Errorf("error compiling filter keyword %q: %w", filterKeyword.Keyword, err)
+	}
+
+	return f.state.Caches.GTS.FilterKeyword().Store(filterKeyword, func() error {
+		_, err := f.conn.
+			NewInsert().
+			Model(filterKeyword).
+			Exec(ctx)
+		return f.conn.ProcessError(err)
+	})
+}
+
+func (f *filterDB) UpdateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, columns ...string) db.Error {
+	filterKeyword.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update keyword in the database, invalidating the cache.
+	if _, err := f.conn.
+		NewUpdate().
+		Model(filterKeyword).
+		Where("? = ?", bun.Ident("filter_keyword.id"), filterKeyword.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	f.state.Caches.GTS.FilterKeyword().Invalidate("ID", filterKeyword.ID)
+	return nil
+}
+
+func (f *filterDB) DeleteFilterKeywordByID(ctx context.Context, id string) db.Error {
+	if _, err := f.conn.
+		NewDelete().
+		Table("filter_keywords").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	f.state.Caches.GTS.FilterKeyword().Invalidate("ID", id)
+	return nil
+}
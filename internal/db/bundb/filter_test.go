@@ -0,0 +1,175 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FilterTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FilterTestSuite) TestGetFilterByID() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	dbFilter, err := suite.db.GetFilterByID(context.Background(), testFilter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testFilter.Title, dbFilter.Title)
+	suite.Equal(gtsmodel.FilterActionWarn, dbFilter.Action)
+	suite.True(dbFilter.AppliesTo(gtsmodel.FilterContextHome))
+	suite.False(dbFilter.AppliesTo(gtsmodel.FilterContextThread))
+	if suite.Len(dbFilter.Keywords, 1) {
+		keyword := dbFilter.Keywords[0]
+		suite.Equal("fnord", keyword.Keyword)
+		suite.Equal(dbFilter, keyword.Filter)
+		suite.NotNil(keyword.Regexp)
+	}
+}
+
+func (suite *FilterTestSuite) TestGetFilterKeywordsForAccountID() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	keywords, err := suite.db.GetFilterKeywordsForAccountID(context.Background(), testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if l := len(keywords); l != 1 {
+		suite.FailNow("", "expected %d keywords, got %d", 1, l)
+	}
+	suite.NotNil(keywords[0].Filter)
+	suite.Equal(suite.testFilters["local_account_1_filter_1"].ID, keywords[0].Filter.ID)
+}
+
+func (suite *FilterTestSuite) TestPutFilterAndKeyword() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	filter := &gtsmodel.Filter{
+		ID:          "01HN2A5X7ZK1Y9QZP4X7J9M3V2",
+		AccountID:   testAccount.ID,
+		Title:       "breakfast",
+		Action:      gtsmodel.FilterActionHide,
+		ContextHome: testrig.TrueBool(),
+	}
+	filter.Keywords = []*gtsmodel.FilterKeyword{{
+		ID:        "01HN2A6S3QJ5K8VZ2N9T4W7B1C",
+		AccountID: testAccount.ID,
+		FilterID:  filter.ID,
+		Keyword:   "scrapple",
+	}}
+
+	if err := suite.db.PutFilter(ctx, filter); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.PutFilterKeyword(ctx, &gtsmodel.FilterKeyword{
+		ID:        "01HN2A7F2D0R1S6G8H4J5K9M3N",
+		AccountID: testAccount.ID,
+		FilterID:  filter.ID,
+		Keyword:   "grits",
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Adding the same keyword again should conflict.
+	err := suite.db.PutFilterKeyword(ctx, &gtsmodel.FilterKeyword{
+		ID:        "01HN2A8B6C4D2E0F9G7H5J3K1M",
+		AccountID: testAccount.ID,
+		FilterID:  filter.ID,
+		Keyword:   "grits",
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	dbFilter, err := suite.db.GetFilterByID(ctx, filter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(dbFilter.Keywords, 2)
+
+	filters, err := suite.db.GetFiltersForAccountID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(filters, 2)
+}
+
+func (suite *FilterTestSuite) TestDeleteFilterByID() {
+	ctx := context.Background()
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+
+	if err := suite.db.DeleteFilterByID(ctx, testFilter.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetFilterByID(ctx, testFilter.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetFilterKeywordByID(ctx, testKeyword.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *FilterTestSuite) TestGetFiltersExpiredBetween() {
+	ctx := context.Background()
+	now := time.Now()
+
+	filter := &gtsmodel.Filter{
+		ID:          "01HPQAJ4W8N2C6T0Y5E3R7K9BM",
+		ExpiresAt:   now.Add(-30 * time.Minute),
+		AccountID:   suite.testAccounts["local_account_1"].ID,
+		Title:       "temporary",
+		Action:      gtsmodel.FilterActionHide,
+		ContextHome: testrig.TrueBool(),
+	}
+	if err := suite.db.PutFilter(ctx, filter); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Window containing the expiry time.
+	filters, err := suite.db.GetFiltersExpiredBetween(ctx, now.Add(-time.Hour), now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(filters, 1) {
+		suite.Equal(filter.ID, filters[0].ID)
+	}
+
+	// Window after the expiry time; the test
+	// filter which never expires isn't included.
+	filters, err = suite.db.GetFiltersExpiredBetween(ctx, now.Add(-20*time.Minute), now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(filters)
+}
+
+func TestFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(FilterTestSuite))
+}
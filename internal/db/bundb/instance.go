@@ -19,6 +19,7 @@ package bundb
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -130,6 +131,103 @@ func (i *instanceDB) GetInstancePeers(ctx context.Context, includeSuspended bool
 	return instances, nil
 }
 
+func (i *instanceDB) GetUnavailableInstances(ctx context.Context) ([]*gtsmodel.Instance, db.Error) {
+	instances := []*gtsmodel.Instance{}
+
+	if err := i.conn.
+		NewSelect().
+		Model(&instances).
+		Where("? IS NOT NULL", bun.Ident("instance.unavailable_at")).
+		Order("instance.domain ASC").
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return instances, nil
+}
+
+func (i *instanceDB) ClaimInstanceProbe(ctx context.Context, instance *gtsmodel.Instance, notAfter time.Time) (bool, db.Error) {
+	now := time.Now()
+
+	// Only one caller can move the last
+	// failure time on, so only one wins.
+	res, err := i.conn.
+		NewUpdate().
+		TableExpr("? AS ?", bun.Ident("instances"), bun.Ident("instance")).
+		Set("? = ?", bun.Ident("last_delivery_failure_at"), now).
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Where("? = ?", bun.Ident("instance.id"), instance.ID).
+		Where("? IS NOT NULL", bun.Ident("instance.unavailable_at")).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("instance.last_delivery_failure_at")).
+				WhereOr("? <= ?", bun.Ident("instance.last_delivery_failure_at"), notAfter)
+		}).
+		Exec(ctx)
+	if err != nil {
+		return false, i.conn.ProcessError(err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rows == 0 {
+		return false, nil
+	}
+
+	instance.LastDeliveryFailureAt = now
+	instance.UpdatedAt = now
+	return true, nil
+}
+
+func (i *instanceDB) UpdateInstance(ctx context.Context, instance *gtsmodel.Instance, columns ...string) db.Error {
+	instance.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.conn.
+		NewUpdate().
+		Model(instance).
+		Column(columns...).
+		Where("? = ?", bun.Ident("instance.id"), instance.ID).
+		Exec(ctx)
+	return i.conn.ProcessError(err)
+}
+
+func (i *instanceDB) GetInstanceByInboxURI(ctx context.Context, inboxURI string) (*gtsmodel.Instance, db.Error) {
+	var domain string
+
+	// Look for an account with this inbox first,
+	// since inbox_uri is indexed, then fall back
+	// to looking for one with this shared inbox.
+	for _, column := range []string{"account.inbox_uri", "account.shared_inbox_uri"} {
+		if err := i.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+			Column("account.domain").
+			Where("? = ?", bun.Ident(column), inboxURI).
+			Where("? IS NOT NULL", bun.Ident("account.domain")).
+			Limit(1).
+			Scan(ctx, &domain); err != nil {
+			err = i.conn.ProcessError(err)
+			if err != db.ErrNoEntries {
+				return nil, err
+			}
+			continue
+		}
+		break
+	}
+
+	if domain == "" {
+		return nil, db.ErrNoEntries
+	}
+
+	return i.GetInstance(ctx, domain)
+}
+
 func (i *instanceDB) GetInstanceAccounts(ctx context.Context, domain string, maxID string, limit int) ([]*gtsmodel.Account, db.Error) {
 	accounts := []*gtsmodel.Account{}
 
@@ -187,3 +285,33 @@ func (i *instanceDB) GetInstanceModeratorAddresses(ctx context.Context) ([]strin
 
 	return addresses, nil
 }
+
+func (i *instanceDB) GetInstanceModerators(ctx context.Context) ([]*gtsmodel.User, db.Error) {
+	users := []*gtsmodel.User{}
+
+	// Select approved, confirmed,
+	// and enabled moderators or admins.
+
+	q := i.conn.
+		NewSelect().
+		Model(&users).
+		Where("? = ?", bun.Ident("user.approved"), true).
+		Where("? IS NOT NULL", bun.Ident("user.confirmed_at")).
+		Where("? = ?", bun.Ident("user.disabled"), false).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("user.moderator"), true).
+				WhereOr("? = ?", bun.Ident("user.admin"), true)
+		}).
+		OrderExpr("? ASC", bun.Ident("user.id"))
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	if len(users) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return users, nil
+}
@@ -128,6 +128,14 @@ func (suite *InstanceTestSuite) TestGetInstanceModeratorAddressesNoAdmin() {
 	suite.Empty(addresses)
 }
 
+func (suite *InstanceTestSuite) TestGetInstanceModeratorsOK() {
+	// We have one admin user by default.
+	users, err := suite.db.GetInstanceModerators(context.Background())
+	suite.NoError(err)
+	suite.Len(users, 1)
+	suite.Equal(suite.testUsers["admin_account"].ID, users[0].ID)
+}
+
 func TestInstanceTestSuite(t *testing.T) {
 	suite.Run(t, new(InstanceTestSuite))
 }
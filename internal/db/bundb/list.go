@@ -0,0 +1,469 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type listDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+/*
+	LIST FUNCTIONS
+*/
+
+func (l *listDB) getList(ctx context.Context, lookup string, dbQuery func(*gtsmodel.List) error, keyParts ...any) (*gtsmodel.List, db.Error) {
+	list, err := l.state.Caches.GTS.List().Load(lookup, func() (*gtsmodel.List, error) {
+		var list gtsmodel.List
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&list); err != nil {
+			return nil, l.conn.ProcessError(err)
+		}
+
+		return &list, nil
+	}, keyParts...)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	if err := l.state.DB.PopulateList(ctx, list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (l *listDB) GetListByID(ctx context.Context, id string) (*gtsmodel.List, db.Error) {
+	return l.getList(
+		ctx,
+		"ID",
+		func(list *gtsmodel.List) error {
+			return l.conn.NewSelect().
+				Model(list).
+				Where("? = ?", bun.Ident("list.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (l *listDB) GetListsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.List, db.Error) {
+	// Fetch IDs of all lists owned by this account.
+	var listIDs []string
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("lists"), bun.Ident("list")).
+		Column("list.id").
+		Where("? = ?", bun.Ident("list.account_id"), accountID).
+		Order("list.id DESC").
+		Scan(ctx, &listIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(listIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each list using its ID to ensure cache used.
+	lists := make([]*gtsmodel.List, 0, len(listIDs))
+	for _, id := range listIDs {
+		list, err := l.state.DB.GetListByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching list %q: %v", id, err)
+			continue
+		}
+
+		// Append list.
+		lists = append(lists, list)
+	}
+
+	return lists, nil
+}
+
+func (l *listDB) PopulateList(ctx context.Context, list *gtsmodel.List) db.Error {
+	var err error
+
+	if list.Account == nil {
+		// List account is not set, fetch from the database.
+		list.Account, err = l.state.DB.GetAccountByID(ctx, list.AccountID)
+		if err != nil {
+			return fmt.Errorf("error populating list account: %w", err)
+		}
+	}
+
+	if list.ListEntries == nil {
+		// List entries are not set, fetch from the database.
+		list.ListEntries, err = l.state.DB.GetListEntries(ctx, list.ID, "", "", "", 0)
+		if err != nil {
+			return fmt.Errorf("error populating list entries: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (l *listDB) PutList(ctx context.Context, list *gtsmodel.List) db.Error {
+	return l.state.Caches.GTS.List().Store(list, func() error {
+		_, err := l.conn.NewInsert().Model(list).Exec(ctx)
+		return l.conn.ProcessError(err)
+	})
+}
+
+func (l *listDB) UpdateList(ctx context.Context, list *gtsmodel.List, columns ...string) db.Error {
+	list.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update list in the database, invalidating the cache.
+	if _, err := l.conn.
+		NewUpdate().
+		Model(list).
+		Where("? = ?", bun.Ident("list.id"), list.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	l.state.Caches.GTS.List().Invalidate("ID", list.ID)
+	return nil
+}
+
+func (l *listDB) DeleteListByID(ctx context.Context, id string) db.Error {
+	// Load list by ID into cache to ensure we can perform
+	// all necessary cache invalidation hooks on removal.
+	list, err := l.GetListByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Already gone.
+			return nil
+		}
+		return err
+	}
+
+	// Invalidate all entries of this list from the cache.
+	for _, entry := range list.ListEntries {
+		l.state.Caches.GTS.ListEntry().Invalidate("ID", entry.ID)
+	}
+
+	// Invalidate the list itself.
+	defer l.state.Caches.GTS.List().Invalidate("ID", id)
+
+	// Delete all entries attached to this list, and the list itself.
+	return l.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			Table("list_entries").
+			Where("? = ?", bun.Ident("list_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			Table("lists").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+/*
+	LIST ENTRY functions
+*/
+
+func (l *listDB) getListEntry(ctx context.Context, lookup string, dbQuery func(*gtsmodel.ListEntry) error, keyParts ...any) (*gtsmodel.ListEntry, db.Error) {
+	listEntry, err := l.state.Caches.GTS.ListEntry().Load(lookup, func() (*gtsmodel.ListEntry, error) {
+		var listEntry gtsmodel.ListEntry
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&listEntry); err != nil {
+			return nil, l.conn.ProcessError(err)
+		}
+
+		return &listEntry, nil
+	}, keyParts...)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	if err := l.state.DB.PopulateListEntry(ctx, listEntry); err != nil {
+		return nil, err
+	}
+
+	return listEntry, nil
+}
+
+func (l *listDB) GetListEntryByID(ctx context.Context, id string) (*gtsmodel.ListEntry, db.Error) {
+	return l.getListEntry(
+		ctx,
+		"ID",
+		func(listEntry *gtsmodel.ListEntry) error {
+			return l.conn.NewSelect().
+				Model(listEntry).
+				Where("? = ?", bun.Ident("list_entry.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (l *listDB) GetListEntries(ctx context.Context,
+	listID string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) ([]*gtsmodel.ListEntry, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	var (
+		entryIDs    = make([]string, 0, limit)
+		frontToBack = true
+	)
+
+	q := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("entry")).
+		// Select only IDs from table
+		Column("entry.id").
+		// Only entries whose follow still exists.
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("follows"),
+			bun.Ident("follow"),
+			bun.Ident("follow.id"),
+			bun.Ident("entry.follow_id"),
+		).
+		// Select only entries belonging to listID.
+		Where("? = ?", bun.Ident("entry.list_id"), listID)
+
+	if maxID != "" {
+		// return only entries LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("entry.id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only entries HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("entry.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only entries HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("entry.id"), minID)
+
+		// page up
+		frontToBack = false
+	}
+
+	if limit > 0 {
+		// limit amount of entries returned
+		q = q.Limit(limit)
+	}
+
+	if frontToBack {
+		// Page down.
+		q = q.Order("entry.id DESC")
+	} else {
+		// Page up.
+		q = q.Order("entry.id ASC")
+	}
+
+	if err := q.Scan(ctx, &entryIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	// If we're paging up, we still want entries
+	// to be sorted by ID desc, so reverse ids slice.
+	// https://zchee.github.io/golang-wiki/SliceTricks/#reversing
+	if !frontToBack {
+		for i, j := 0, len(entryIDs)-1; i < j; i, j = i+1, j-1 {
+			entryIDs[i], entryIDs[j] = entryIDs[j], entryIDs[i]
+		}
+	}
+
+	return l.getListEntriesByIDs(ctx, entryIDs), nil
+}
+
+func (l *listDB) GetListEntriesForFollowID(ctx context.Context, followID string) ([]*gtsmodel.ListEntry, db.Error) {
+	entryIDs := []string{}
+
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("entry")).
+		// Select only IDs from table
+		Column("entry.id").
+		// Select only entries belonging with given followID.
+		Where("? = ?", bun.Ident("entry.follow_id"), followID).
+		Scan(ctx, &entryIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	return l.getListEntriesByIDs(ctx, entryIDs), nil
+}
+
+// getListEntriesByIDs fetches each list entry with the given ID,
+// using the cache where possible. Errors are logged and skipped.
+func (l *listDB) getListEntriesByIDs(ctx context.Context, entryIDs []string) []*gtsmodel.ListEntry {
+	listEntries := make([]*gtsmodel.ListEntry, 0, len(entryIDs))
+	for _, id := range entryIDs {
+		listEntry, err := l.state.DB.GetListEntryByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching list entry %q: %v", id, err)
+			continue
+		}
+
+		// Append list entry.
+		listEntries = append(listEntries, listEntry)
+	}
+
+	return listEntries
+}
+
+func (l *listDB) PopulateListEntry(ctx context.Context, listEntry *gtsmodel.ListEntry) db.Error {
+	if listEntry.Follow == nil {
+		// ListEntry follow is not set, fetch from the database.
+		follow := &gtsmodel.Follow{}
+		if err := l.conn.
+			NewSelect().
+			Model(follow).
+			Relation("TargetAccount").
+			Where("? = ?", bun.Ident("follow.id"), listEntry.FollowID).
+			Scan(ctx); err != nil {
+			return fmt.Errorf("error populating listEntry follow: %w", l.conn.ProcessError(err))
+		}
+		listEntry.Follow = follow
+	}
+
+	return nil
+}
+
+func (l *listDB) PutListEntries(ctx context.Context, listEntries []*gtsmodel.ListEntry) db.Error {
+	// Insert all entries in a transaction so we don't end up with partial updates.
+	if err := l.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		for _, entry := range listEntries {
+			if _, err := tx.
+				NewInsert().
+				Model(entry).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	// Entries are now in the db; invalidate the lists they
+	// were added to, so that their entries are repopulated.
+	for _, entry := range listEntries {
+		l.state.Caches.GTS.List().Invalidate("ID", entry.ListID)
+	}
+
+	return nil
+}
+
+func (l *listDB) DeleteListEntry(ctx context.Context, id string) db.Error {
+	// Fetch the entry first so we know which list to invalidate.
+	entry, err := l.GetListEntryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Already gone.
+			return nil
+		}
+		return err
+	}
+
+	if _, err := l.conn.
+		NewDelete().
+		Table("list_entries").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	l.state.Caches.GTS.ListEntry().Invalidate("ID", id)
+	l.state.Caches.GTS.List().Invalidate("ID", entry.ListID)
+	return nil
+}
+
+func (l *listDB) DeleteListEntriesForFollowID(ctx context.Context, followID string) db.Error {
+	// Fetch entry IDs for follow ID.
+	entryIDs := []string{}
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("entry")).
+		Column("entry.id").
+		Where("? = ?", bun.Ident("entry.follow_id"), followID).
+		Order("entry.id DESC").
+		Scan(ctx, &entryIDs); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	for _, id := range entryIDs {
+		if err := l.DeleteListEntry(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *listDB) ListIncludesAccount(ctx context.Context, listID string, accountID string) (bool, db.Error) {
+	exists, err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("entry")).
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("follows"),
+			bun.Ident("follow"),
+			bun.Ident("follow.id"),
+			bun.Ident("entry.follow_id"),
+		).
+		Where("? = ?", bun.Ident("entry.list_id"), listID).
+		Where("? = ?", bun.Ident("follow.target_account_id"), accountID).
+		Exists(ctx)
+
+	return exists, l.conn.ProcessError(err)
+}
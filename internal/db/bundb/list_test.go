@@ -0,0 +1,160 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ListTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ListTestSuite) testStructs() (*gtsmodel.List, *gtsmodel.Account) {
+	testList := &gtsmodel.List{}
+	*testList = *suite.testLists["local_account_1_list_1"]
+
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	return testList, testAccount
+}
+
+func (suite *ListTestSuite) TestGetListByID() {
+	testList, _ := suite.testStructs()
+
+	dbList, err := suite.db.GetListByID(context.Background(), testList.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testList.Title, dbList.Title)
+	suite.NotNil(dbList.Account)
+	suite.Len(dbList.ListEntries, 2)
+	for _, entry := range dbList.ListEntries {
+		suite.NotNil(entry.Follow)
+		suite.NotNil(entry.Follow.TargetAccount)
+	}
+}
+
+func (suite *ListTestSuite) TestGetListsForAccountID() {
+	testList, testAccount := suite.testStructs()
+
+	lists, err := suite.db.GetListsForAccountID(context.Background(), testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if l := len(lists); l != 1 {
+		suite.FailNow("", "expected %d lists, got %d", 1, l)
+	}
+	suite.Equal(testList.ID, lists[0].ID)
+}
+
+func (suite *ListTestSuite) TestGetListEntries() {
+	testList, _ := suite.testStructs()
+
+	entries, err := suite.db.GetListEntries(context.Background(), testList.ID, "", "", "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if l := len(entries); l != 2 {
+		suite.FailNow("", "expected %d entries, got %d", 2, l)
+	}
+
+	// Newest entry should be first.
+	suite.Equal("01H0G8FFM1AGQDRNGBGGX8CYJQ", entries[0].ID)
+	suite.Equal("01H0G89MWVQE0M58VD2HQYMQWH", entries[1].ID)
+}
+
+func (suite *ListTestSuite) TestListIncludesAccount() {
+	testList, _ := suite.testStructs()
+	ctx := context.Background()
+
+	includes, err := suite.db.ListIncludesAccount(ctx, testList.ID, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.True(includes)
+
+	includes, err = suite.db.ListIncludesAccount(ctx, testList.ID, suite.testAccounts["remote_account_1"].ID)
+	suite.NoError(err)
+	suite.False(includes)
+}
+
+func (suite *ListTestSuite) TestDeleteListByID() {
+	testList, _ := suite.testStructs()
+	ctx := context.Background()
+
+	if err := suite.db.DeleteListByID(ctx, testList.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// List should be gone.
+	_, err := suite.db.GetListByID(ctx, testList.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Entries should be gone too.
+	entries, err := suite.db.GetListEntries(ctx, testList.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Empty(entries)
+}
+
+func (suite *ListTestSuite) TestDeleteListEntriesForFollowID() {
+	testList, _ := suite.testStructs()
+	ctx := context.Background()
+
+	// Remove the follow for local_account_2 from all lists.
+	if err := suite.db.DeleteListEntriesForFollowID(ctx, suite.testFollows["local_account_1_local_account_2"].ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbList, err := suite.db.GetListByID(ctx, testList.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only one entry should remain.
+	suite.Len(dbList.ListEntries, 1)
+	suite.Equal(suite.testFollows["local_account_1_admin_account"].ID, dbList.ListEntries[0].FollowID)
+}
+
+func (suite *ListTestSuite) TestGetListTimeline() {
+	testList, _ := suite.testStructs()
+
+	statuses, err := suite.db.GetListTimeline(context.Background(), testList.ID, "", "", "", 20)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(statuses)
+	for _, s := range statuses {
+		suite.Contains([]string{
+			suite.testAccounts["local_account_2"].ID,
+			suite.testAccounts["admin_account"].ID,
+		}, s.AccountID)
+	}
+}
+
+func TestListTestSuite(t *testing.T) {
+	suite.Run(t, new(ListTestSuite))
+}
@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type markerDB struct {
+	conn *DBConn
+}
+
+func (m *markerDB) GetMarker(ctx context.Context, accountID string, name gtsmodel.MarkerName) (*gtsmodel.Marker, db.Error) {
+	marker := new(gtsmodel.Marker)
+
+	if err := m.conn.
+		NewSelect().
+		Model(marker).
+		Where("? = ?", bun.Ident("marker.account_id"), accountID).
+		Where("? = ?", bun.Ident("marker.name"), name).
+		Scan(ctx); err != nil {
+		return nil, m.conn.ProcessError(err)
+	}
+
+	return marker, nil
+}
+
+func (m *markerDB) UpdateMarker(ctx context.Context, marker *gtsmodel.Marker) db.Error {
+	marker.UpdatedAt = time.Now()
+
+	return m.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Check for an existing marker to
+		// take the previous version from.
+		var version int
+		err := tx.
+			NewSelect().
+			Table("markers").
+			Column("version").
+			Where("? = ?", bun.Ident("account_id"), marker.AccountID).
+			Where("? = ?", bun.Ident("name"), marker.Name).
+			Scan(ctx, &version)
+		err = m.conn.ProcessError(err)
+
+		switch {
+		case errors.Is(err, db.ErrNoEntries):
+			// First marker for this timeline.
+			marker.Version = 0
+			_, err := tx.
+				NewInsert().
+				Model(marker).
+				Exec(ctx)
+			return err
+
+		case err != nil:
+			return err
+		}
+
+		// Only update if the version hasn't been
+		// changed by someone else in the meantime.
+		marker.Version = version + 1
+		res, err := tx.
+			NewUpdate().
+			Model(marker).
+			Column("last_read_id", "version", "updated_at").
+			Where("? = ?", bun.Ident("marker.account_id"), marker.AccountID).
+			Where("? = ?", bun.Ident("marker.name"), marker.Name).
+			Where("? = ?", bun.Ident("marker.version"), version).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if rows, err := res.RowsAffected(); err != nil {
+			return err
+		} else if rows == 0 {
+			return db.ErrAlreadyExists
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type MarkerTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *MarkerTestSuite) TestGetMarkerNone() {
+	marker, err := suite.db.GetMarker(context.Background(), suite.testAccounts["local_account_1"].ID, gtsmodel.MarkerNameHome)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+	suite.Nil(marker)
+}
+
+func (suite *MarkerTestSuite) TestUpdateMarker() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["local_account_1"].ID
+
+	// First update creates the marker at version 0.
+	err := suite.db.UpdateMarker(ctx, &gtsmodel.Marker{
+		AccountID:  accountID,
+		Name:       gtsmodel.MarkerNameHome,
+		LastReadID: suite.testStatuses["admin_account_status_1"].ID,
+	})
+	suite.NoError(err)
+
+	// Second update moves it along and bumps the version.
+	err = suite.db.UpdateMarker(ctx, &gtsmodel.Marker{
+		AccountID:  accountID,
+		Name:       gtsmodel.MarkerNameHome,
+		LastReadID: suite.testStatuses["admin_account_status_2"].ID,
+	})
+	suite.NoError(err)
+
+	marker, err := suite.db.GetMarker(ctx, accountID, gtsmodel.MarkerNameHome)
+	suite.NoError(err)
+	suite.Equal(suite.testStatuses["admin_account_status_2"].ID, marker.LastReadID)
+	suite.Equal(1, marker.Version)
+	suite.NotZero(marker.UpdatedAt)
+
+	// The notifications marker is separate.
+	_, err = suite.db.GetMarker(ctx, accountID, gtsmodel.MarkerNameNotifications)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestMarkerTestSuite(t *testing.T) {
+	suite.Run(t, new(MarkerTestSuite))
+}
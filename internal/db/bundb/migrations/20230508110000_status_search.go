@@ -0,0 +1,128 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"html"
+	"regexp"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			switch tx.Dialect().Name() {
+			case dialect.PG:
+				// Postgres can search the statuses table directly,
+				// it just needs an index over the status text, with
+				// html tags stripped from the content as for SQLite.
+				_, err := tx.ExecContext(ctx,
+					"CREATE INDEX IF NOT EXISTS ? ON ? USING GIN (to_tsvector('simple', COALESCE(?, '') || ' ' || regexp_replace(COALESCE(?, ''), '<[^>]*>', ' ', 'g')))",
+					bun.Ident("statuses_text_search_idx"),
+					bun.Ident("statuses"),
+					bun.Ident("content_warning"),
+					bun.Ident("content"),
+				)
+				return err
+
+			case dialect.SQLite:
+				// SQLite needs a separate FTS5 table, which is kept
+				// in sync with the statuses table by the status db.
+				if _, err := tx.ExecContext(ctx,
+					"CREATE VIRTUAL TABLE IF NOT EXISTS ? USING fts5(?, ?, tokenize = 'unicode61 remove_diacritics 2')",
+					bun.Ident("status_fts"),
+					bun.Ident("status_id"),
+					bun.Ident("text"),
+				); err != nil {
+					return err
+				}
+
+				return backfillStatusFTS(ctx, tx)
+
+			default:
+				log.Panic(ctx, "db dialect was neither pg nor sqlite")
+				return nil
+			}
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
+
+// backfillStatusFTS puts the text of all existing
+// statuses into the SQLite status_fts table.
+func backfillStatusFTS(ctx context.Context, tx bun.Tx) error {
+	type status struct {
+		ID             string
+		ContentWarning string
+		Content        string
+	}
+
+	htmlTag := regexp.MustCompile(`<[^>]*>`)
+
+	var maxID string
+	for {
+		statuses := []status{}
+		q := tx.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			Column("status.id", "status.content_warning", "status.content").
+			Order("status.id ASC").
+			Limit(1000)
+
+		if maxID != "" {
+			q = q.Where("? > ?", bun.Ident("status.id"), maxID)
+		}
+
+		if err := q.Scan(ctx, &statuses); err != nil {
+			return err
+		}
+
+		if len(statuses) == 0 {
+			return nil
+		}
+
+		for _, s := range statuses {
+			text := s.ContentWarning + " " + htmlTag.ReplaceAllString(s.Content, " ")
+			if _, err := tx.ExecContext(ctx,
+				"INSERT INTO ? (?, ?) VALUES (?, ?)",
+				bun.Ident("status_fts"),
+				bun.Ident("status_id"),
+				bun.Ident("text"),
+				s.ID,
+				html.UnescapeString(text),
+			); err != nil {
+				return err
+			}
+		}
+
+		maxID = statuses[len(statuses)-1].ID
+	}
+}
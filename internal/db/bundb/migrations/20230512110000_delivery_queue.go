@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	gtsmodel "github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Delivery queue table.
+			if _, err := tx.
+				NewCreateTable().
+				Model(&gtsmodel.Delivery{}).
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index deliveries by next attempt, so
+			// that due deliveries are found quickly.
+			if _, err := tx.
+				NewCreateIndex().
+				Model(&gtsmodel.Delivery{}).
+				Index("deliveries_next_attempt_at_idx").
+				Column("next_attempt_at").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			// Index deliveries by activity and inbox,
+			// so that duplicate deliveries are skipped.
+			if _, err := tx.
+				NewCreateIndex().
+				Model(&gtsmodel.Delivery{}).
+				Index("deliveries_activity_id_inbox_uri_idx").
+				Column("activity_id", "inbox_uri").
+				IfNotExists().
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// The settings panel used to register itself with
+			// the made-up scope "user", which is no longer
+			// accepted now that scopes are validated; replace
+			// it with the scopes it was meant to stand for.
+			for table, column := range map[string]string{
+				"applications": "scopes",
+				"tokens":       "scope",
+			} {
+				var rows []struct {
+					ID     string
+					Scopes string
+				}
+
+				if err := tx.NewSelect().
+					Table(table).
+					Column("id").
+					ColumnExpr("? AS ?", bun.Ident(column), bun.Ident("scopes")).
+					Where("? LIKE ?", bun.Ident(column), "%user%").
+					Scan(ctx, &rows); err != nil {
+					return err
+				}
+
+				for _, row := range rows {
+					scopes := strings.Fields(row.Scopes)
+					for i, scope := range scopes {
+						if scope == "user" {
+							scopes[i] = "read write"
+						}
+					}
+
+					if _, err := tx.NewUpdate().
+						Table(table).
+						Set("? = ?", bun.Ident(column), strings.Join(scopes, " ")).
+						Where("? = ?", bun.Ident("id"), row.ID).
+						Exec(ctx); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,498 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+	"golang.org/x/exp/slices"
+)
+
+type pollDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+/*
+	POLL FUNCTIONS
+*/
+
+func (p *pollDB) GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, db.Error) {
+	return p.state.Caches.GTS.Poll().Load("ID", func() (*gtsmodel.Poll, error) {
+		var poll gtsmodel.Poll
+
+		// Not cached! Perform database query.
+		if err := p.conn.
+			NewSelect().
+			Model(&poll).
+			Where("? = ?", bun.Ident("poll.id"), id).
+			Scan(ctx); err != nil {
+			return nil, p.conn.ProcessError(err)
+		}
+
+		return &poll, nil
+	}, id)
+}
+
+func (p *pollDB) GetOpenPollsExpiredBefore(ctx context.Context, t time.Time) ([]*gtsmodel.Poll, db.Error) {
+	// Fetch IDs of all open polls that have expired.
+	var pollIDs []string
+	if err := p.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("polls"), bun.Ident("poll")).
+		Column("poll.id").
+		Where("? IS NULL", bun.Ident("poll.closed_at")).
+		Where("? IS NOT NULL", bun.Ident("poll.expires_at")).
+		Where("? <= ?", bun.Ident("poll.expires_at"), t).
+		Order("poll.id ASC").
+		Scan(ctx, &pollIDs); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	if len(pollIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each poll using its ID to ensure cache used.
+	polls := make([]*gtsmodel.Poll, 0, len(pollIDs))
+	for _, id := range pollIDs {
+		poll, err := p.state.DB.GetPollByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching poll %q: %v", id, err)
+			continue
+		}
+
+		// Append poll.
+		polls = append(polls, poll)
+	}
+
+	return polls, nil
+}
+
+func (p *pollDB) PopulatePoll(ctx context.Context, poll *gtsmodel.Poll) db.Error {
+	if poll.Status == nil {
+		// Status is not set, fetch from the database.
+		status, err := p.state.DB.GetStatusByID(ctx, poll.StatusID)
+		if err != nil {
+			return fmt.Errorf("error populating poll status: %w", err)
+		}
+		poll.Status = status
+	}
+
+	// Make sure the status points back to
+	// this poll, and not some older copy.
+	poll.Status.Poll = poll
+
+	return nil
+}
+
+func (p *pollDB) PutPoll(ctx context.Context, poll *gtsmodel.Poll) db.Error {
+	return p.state.Caches.GTS.Poll().Store(poll, func() error {
+		_, err := p.conn.
+			NewInsert().
+			Model(poll).
+			Exec(ctx)
+		return p.conn.ProcessError(err)
+	})
+}
+
+func (p *pollDB) UpdatePoll(ctx context.Context, poll *gtsmodel.Poll, columns ...string) db.Error {
+	poll.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update poll in the database, invalidating the cache.
+	if _, err := p.conn.
+		NewUpdate().
+		Model(poll).
+		Where("? = ?", bun.Ident("poll.id"), poll.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return p.conn.ProcessError(err)
+	}
+
+	p.state.Caches.GTS.Poll().Invalidate("ID", poll.ID)
+	return nil
+}
+
+func (p *pollDB) DeletePollByID(ctx context.Context, id string) db.Error {
+	// Gather the votes in this poll so we
+	// can invalidate them from the cache.
+	votes, err := p.GetPollVotes(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	defer func() {
+		for _, vote := range votes {
+			p.state.Caches.GTS.PollVote().Invalidate("ID", vote.ID)
+		}
+		p.state.Caches.GTS.Poll().Invalidate("ID", id)
+	}()
+
+	// Delete all votes in this poll, and the poll itself.
+	return p.conn.ProcessError(p.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			Table("poll_votes").
+			Where("? = ?", bun.Ident("poll_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			Table("polls").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	}))
+}
+
+/*
+	POLL VOTE FUNCTIONS
+*/
+
+func (p *pollDB) GetPollVoteByID(ctx context.Context, id string) (*gtsmodel.PollVote, db.Error) {
+	return p.getPollVote(
+		ctx,
+		"ID",
+		func(vote *gtsmodel.PollVote) error {
+			return p.conn.
+				NewSelect().
+				Model(vote).
+				Where("? = ?", bun.Ident("poll_vote.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (p *pollDB) GetPollVoteBy(ctx context.Context, pollID string, accountID string) (*gtsmodel.PollVote, db.Error) {
+	return p.getPollVote(
+		ctx,
+		"PollID.AccountID",
+		func(vote *gtsmodel.PollVote) error {
+			return p.conn.
+				NewSelect().
+				Model(vote).
+				Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+				Where("? = ?", bun.Ident("poll_vote.account_id"), accountID).
+				Scan(ctx)
+		},
+		pollID,
+		accountID,
+	)
+}
+
+func (p *pollDB) getPollVote(ctx context.Context, lookup string, dbQuery func(*gtsmodel.PollVote) error, keyParts ...any) (*gtsmodel.PollVote, db.Error) {
+	vote, err := p.state.Caches.GTS.PollVote().Load(lookup, func() (*gtsmodel.PollVote, error) {
+		var vote gtsmodel.PollVote
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&vote); err != nil {
+			return nil, p.conn.ProcessError(err)
+		}
+
+		return &vote, nil
+	}, keyParts...)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	if err := p.state.DB.PopulatePollVote(ctx, vote); err != nil {
+		return nil, err
+	}
+
+	return vote, nil
+}
+
+func (p *pollDB) GetPollVotes(ctx context.Context, pollID string) ([]*gtsmodel.PollVote, db.Error) {
+	return p.getPollVotesWhere(ctx, "poll_id", pollID)
+}
+
+// getPollVotesWhere fetches all poll votes where the given
+// column equals the given value, using the cache where
+// possible. Errors fetching single votes are logged and skipped.
+func (p *pollDB) getPollVotesWhere(ctx context.Context, column string, value string) ([]*gtsmodel.PollVote, db.Error) {
+	var voteIDs []string
+	if err := p.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+		Column("poll_vote.id").
+		Where("? = ?", bun.Ident("poll_vote."+column), value).
+		Order("poll_vote.id ASC").
+		Scan(ctx, &voteIDs); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	if len(voteIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each vote using its ID to ensure cache used.
+	votes := make([]*gtsmodel.PollVote, 0, len(voteIDs))
+	for _, id := range voteIDs {
+		vote, err := p.state.DB.GetPollVoteByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching poll vote %q: %v", id, err)
+			continue
+		}
+
+		// Append vote.
+		votes = append(votes, vote)
+	}
+
+	return votes, nil
+}
+
+func (p *pollDB) PopulatePollVote(ctx context.Context, vote *gtsmodel.PollVote) db.Error {
+	var err error
+
+	if vote.Account == nil {
+		// Account is not set, fetch from the database.
+		vote.Account, err = p.state.DB.GetAccountByID(ctx, vote.AccountID)
+		if err != nil {
+			return fmt.Errorf("error populating poll vote account: %w", err)
+		}
+	}
+
+	if vote.Poll == nil {
+		// Poll is not set, fetch from the database.
+		vote.Poll, err = p.state.DB.GetPollByID(ctx, vote.PollID)
+		if err != nil {
+			return fmt.Errorf("error populating poll vote poll: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *pollDB) PutPollVote(ctx context.Context, vote *gtsmodel.PollVote) db.Error {
+	var poll *gtsmodel.Poll
+
+	if err := p.state.Caches.GTS.PollVote().Store(vote, func() error {
+		// Insert the vote and count it in the poll in one transaction,
+		// so that concurrent votes can't overwrite each other's counts.
+		return p.conn.ProcessError(p.conn.RunInTx(ctx, func(tx bun.Tx) error {
+			if _, err := tx.
+				NewInsert().
+				Model(vote).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			var err error
+			poll, err = p.selectPollVotes(ctx, tx, vote.PollID)
+			if err != nil {
+				return err
+			}
+
+			poll.IncrementVotes(vote.Choices, true)
+			return p.updatePollVotes(ctx, tx, poll)
+		}))
+	}); err != nil {
+		return err
+	}
+
+	p.state.Caches.GTS.Poll().Invalidate("ID", vote.PollID)
+	setPollVotes(vote.Poll, poll)
+	return nil
+}
+
+func (p *pollDB) AddPollVoteChoices(ctx context.Context, vote *gtsmodel.PollVote, choices []int) db.Error {
+	var (
+		poll  *gtsmodel.Poll
+		voted []int
+	)
+
+	if err := p.conn.ProcessError(p.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Select the currently stored choices of this vote,
+		// in case they've changed since the vote was loaded.
+		var stored gtsmodel.PollVote
+		q := tx.
+			NewSelect().
+			Model(&stored).
+			Column("poll_vote.id", "poll_vote.choices").
+			Where("? = ?", bun.Ident("poll_vote.id"), vote.ID)
+		if p.conn.Dialect().Name() == dialect.PG {
+			q = q.For("UPDATE")
+		}
+
+		if err := q.Scan(ctx); err != nil {
+			return err
+		}
+
+		// Only add choices which aren't already counted.
+		voted = stored.Choices
+		added := make([]int, 0, len(choices))
+		for _, choice := range choices {
+			if !slices.Contains(voted, choice) {
+				voted = append(voted, choice)
+				added = append(added, choice)
+			}
+		}
+
+		if len(added) == 0 {
+			// Nothing to do.
+			return nil
+		}
+
+		stored.Choices = voted
+		if _, err := tx.
+			NewUpdate().
+			Model(&stored).
+			Column("choices").
+			Where("? = ?", bun.Ident("poll_vote.id"), vote.ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		var err error
+		poll, err = p.selectPollVotes(ctx, tx, vote.PollID)
+		if err != nil {
+			return err
+		}
+
+		poll.IncrementVotes(added, false)
+		return p.updatePollVotes(ctx, tx, poll)
+	})); err != nil {
+		return err
+	}
+
+	p.state.Caches.GTS.PollVote().Invalidate("ID", vote.ID)
+	p.state.Caches.GTS.Poll().Invalidate("ID", vote.PollID)
+	vote.Choices = voted
+	setPollVotes(vote.Poll, poll)
+	return nil
+}
+
+func (p *pollDB) UpdatePollVote(ctx context.Context, vote *gtsmodel.PollVote, columns ...string) db.Error {
+	// Update vote in the database, invalidating the cache.
+	if _, err := p.conn.
+		NewUpdate().
+		Model(vote).
+		Where("? = ?", bun.Ident("poll_vote.id"), vote.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return p.conn.ProcessError(err)
+	}
+
+	p.state.Caches.GTS.PollVote().Invalidate("ID", vote.ID)
+	return nil
+}
+
+func (p *pollDB) DeletePollVotesByAccountID(ctx context.Context, accountID string) db.Error {
+	votes, err := p.getPollVotesWhere(ctx, "account_id", accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range votes {
+		// Delete the vote and take it back out of the poll's
+		// counts in one transaction, as in PutPollVote.
+		if err := p.conn.ProcessError(p.conn.RunInTx(ctx, func(tx bun.Tx) error {
+			poll, err := p.selectPollVotes(ctx, tx, vote.PollID)
+			if err != nil {
+				return err
+			}
+
+			if !poll.Closed() {
+				// Poll is still open, so take
+				// this account's votes back out.
+				poll.DecrementVotes(vote.Choices)
+				if err := p.updatePollVotes(ctx, tx, poll); err != nil {
+					return err
+				}
+			}
+
+			_, err = tx.
+				NewDelete().
+				Table("poll_votes").
+				Where("? = ?", bun.Ident("id"), vote.ID).
+				Exec(ctx)
+			return err
+		})); err != nil {
+			return err
+		}
+
+		p.state.Caches.GTS.PollVote().Invalidate("ID", vote.ID)
+		p.state.Caches.GTS.Poll().Invalidate("ID", vote.PollID)
+	}
+
+	return nil
+}
+
+// selectPollVotes selects the vote counts of the poll with the given ID
+// within the given transaction, locking the poll's row until the end of
+// the transaction where supported. (SQLite transactions already hold the
+// database write lock from the start, see the "_txlock=immediate" DSN arg).
+func (p *pollDB) selectPollVotes(ctx context.Context, tx bun.Tx, pollID string) (*gtsmodel.Poll, error) {
+	var poll gtsmodel.Poll
+
+	q := tx.
+		NewSelect().
+		Model(&poll).
+		Column("poll.id", "poll.options", "poll.votes", "poll.voters", "poll.closed_at").
+		Where("? = ?", bun.Ident("poll.id"), pollID)
+	if p.conn.Dialect().Name() == dialect.PG {
+		q = q.For("UPDATE")
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &poll, nil
+}
+
+// updatePollVotes updates the vote counts
+// of the given poll within the given transaction.
+func (p *pollDB) updatePollVotes(ctx context.Context, tx bun.Tx, poll *gtsmodel.Poll) error {
+	poll.UpdatedAt = time.Now()
+	_, err := tx.
+		NewUpdate().
+		Model(poll).
+		Column("votes", "voters", "updated_at").
+		Where("? = ?", bun.Ident("poll.id"), poll.ID).
+		Exec(ctx)
+	return err
+}
+
+// setPollVotes copies the vote counts selected from
+// the database in from, to the (possibly cached) poll.
+func setPollVotes(poll *gtsmodel.Poll, from *gtsmodel.Poll) {
+	if poll == nil || from == nil {
+		return
+	}
+	poll.Votes = from.Votes
+	poll.Voters = from.Voters
+	poll.UpdatedAt = from.UpdatedAt
+}
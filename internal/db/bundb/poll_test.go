@@ -0,0 +1,251 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *PollTestSuite) putTestPoll(expiresAt time.Time) *gtsmodel.Poll {
+	poll := &gtsmodel.Poll{
+		ID:         "01GYGTSV8K2ZMTQKFJ9J4YMPZQ",
+		Multiple:   testrig.TrueBool(),
+		HideCounts: testrig.FalseBool(),
+		Options:    []string{"tea", "coffee", "water"},
+		Votes:      []int{0, 0, 0},
+		Voters:     new(int),
+		StatusID:   suite.testStatuses["local_account_1_status_1"].ID,
+		ExpiresAt:  expiresAt,
+	}
+
+	if err := suite.db.PutPoll(context.Background(), poll); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return poll
+}
+
+func (suite *PollTestSuite) TestPutGetPoll() {
+	ctx := context.Background()
+	poll := suite.putTestPoll(time.Now().Add(time.Hour))
+
+	dbPoll, err := suite.db.GetPollByID(ctx, poll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(poll.Options, dbPoll.Options)
+	suite.Equal([]int{0, 0, 0}, dbPoll.Votes)
+	suite.Equal(0, *dbPoll.Voters)
+	suite.True(*dbPoll.Multiple)
+	suite.False(dbPoll.Closed())
+	suite.Nil(dbPoll.Status)
+
+	if err := suite.db.PopulatePoll(ctx, dbPoll); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(poll.StatusID, dbPoll.Status.ID)
+}
+
+func (suite *PollTestSuite) TestVoteAndDeleteVotes() {
+	ctx := context.Background()
+	poll := suite.putTestPoll(time.Now().Add(time.Hour))
+	voter := suite.testAccounts["local_account_2"]
+
+	vote := &gtsmodel.PollVote{
+		ID:        "01GYGTVB6Q6P7RZ4K3ZJ0RJ9QM",
+		Choices:   []int{0, 2},
+		AccountID: voter.ID,
+		PollID:    poll.ID,
+	}
+	if err := suite.db.PutPollVote(ctx, vote); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbVote, err := suite.db.GetPollVoteBy(ctx, poll.ID, voter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{0, 2}, dbVote.Choices)
+	suite.Equal(voter.ID, dbVote.Account.ID)
+	suite.Equal([]int{1, 0, 1}, dbVote.Poll.Votes)
+	suite.Equal(1, *dbVote.Poll.Voters)
+
+	votes, err := suite.db.GetPollVotes(ctx, poll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(votes, 1)
+
+	// Deleting the voter's votes should
+	// decrement the counts on the open poll.
+	if err := suite.db.DeletePollVotesByAccountID(ctx, voter.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbPoll, err := suite.db.GetPollByID(ctx, poll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{0, 0, 0}, dbPoll.Votes)
+	suite.Equal(0, *dbPoll.Voters)
+
+	_, err = suite.db.GetPollVoteBy(ctx, poll.ID, voter.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *PollTestSuite) TestAddPollVoteChoices() {
+	ctx := context.Background()
+	poll := suite.putTestPoll(time.Now().Add(time.Hour))
+	voter := suite.testAccounts["local_account_2"]
+
+	vote := &gtsmodel.PollVote{
+		ID:        "01GYGTVB6Q6P7RZ4K3ZJ0RJ9QM",
+		Choices:   []int{0},
+		AccountID: voter.ID,
+		PollID:    poll.ID,
+		Poll:      poll,
+	}
+	if err := suite.db.PutPollVote(ctx, vote); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{1, 0, 0}, poll.Votes)
+
+	// Choice 0 is already counted, so
+	// only choice 2 should be added.
+	if err := suite.db.AddPollVoteChoices(ctx, vote, []int{0, 2}); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{0, 2}, vote.Choices)
+	suite.Equal([]int{1, 0, 1}, poll.Votes)
+
+	dbVote, err := suite.db.GetPollVoteBy(ctx, poll.ID, voter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{0, 2}, dbVote.Choices)
+	suite.Equal([]int{1, 0, 1}, dbVote.Poll.Votes)
+	suite.Equal(1, *dbVote.Poll.Voters)
+}
+
+func (suite *PollTestSuite) TestConcurrentVotes() {
+	ctx := context.Background()
+	poll := suite.putTestPoll(time.Now().Add(time.Hour))
+
+	voters := []*gtsmodel.Account{
+		suite.testAccounts["local_account_1"],
+		suite.testAccounts["local_account_2"],
+		suite.testAccounts["admin_account"],
+		suite.testAccounts["remote_account_1"],
+	}
+
+	// Vote from each account at once; none
+	// of the increments should get lost.
+	var wg sync.WaitGroup
+	for _, voter := range voters {
+		wg.Add(1)
+		go func(voter *gtsmodel.Account) {
+			defer wg.Done()
+			if err := suite.db.PutPollVote(ctx, &gtsmodel.PollVote{
+				ID:        id.NewULID(),
+				Choices:   []int{1},
+				AccountID: voter.ID,
+				PollID:    poll.ID,
+			}); err != nil {
+				suite.Fail(err.Error())
+			}
+		}(voter)
+	}
+	wg.Wait()
+
+	dbPoll, err := suite.db.GetPollByID(ctx, poll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{0, len(voters), 0}, dbPoll.Votes)
+	suite.Equal(len(voters), *dbPoll.Voters)
+}
+
+func (suite *PollTestSuite) TestGetOpenPollsExpiredBefore() {
+	ctx := context.Background()
+	now := time.Now()
+	poll := suite.putTestPoll(now.Add(-time.Minute))
+
+	polls, err := suite.db.GetOpenPollsExpiredBefore(ctx, now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(polls, 1) {
+		suite.Equal(poll.ID, polls[0].ID)
+	}
+
+	// Once closed, the poll shouldn't be returned again.
+	poll.ClosedAt = now
+	if err := suite.db.UpdatePoll(ctx, poll, "closed_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	polls, err = suite.db.GetOpenPollsExpiredBefore(ctx, now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(polls)
+}
+
+func (suite *PollTestSuite) TestDeletePollByID() {
+	ctx := context.Background()
+	poll := suite.putTestPoll(time.Now().Add(time.Hour))
+
+	vote := &gtsmodel.PollVote{
+		ID:        "01GYGTVB6Q6P7RZ4K3ZJ0RJ9QM",
+		Choices:   []int{1},
+		AccountID: suite.testAccounts["local_account_2"].ID,
+		PollID:    poll.ID,
+	}
+	if err := suite.db.PutPollVote(ctx, vote); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.DeletePollByID(ctx, poll.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetPollByID(ctx, poll.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	_, err = suite.db.GetPollVoteByID(ctx, vote.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestPollTestSuite(t *testing.T) {
+	suite.Run(t, new(PollTestSuite))
+}
@@ -22,9 +22,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/uptrace/bun"
 )
@@ -182,6 +184,220 @@ func (r *relationshipDB) DeleteBlocksByTargetAccountID(ctx context.Context, targ
 	return nil
 }
 
+func (r *relationshipDB) IsMuted(ctx context.Context, account1 string, account2 string, notifications bool) (bool, db.Error) {
+	mute, err := r.getMute(ctx, account1, account2)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// no mute exists
+			return false, nil
+		}
+		return false, err
+	}
+
+	if mute.Expired(time.Now()) {
+		// an expired mute is no mute
+		return false, nil
+	}
+
+	if notifications {
+		// only count the mute if it also mutes notifications
+		return *mute.Notifications, nil
+	}
+
+	return true, nil
+}
+
+func (r *relationshipDB) GetMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, db.Error) {
+	// Fetch mute from database
+	mute, err := r.getMute(ctx, account1, account2)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the mute originating account
+	mute.Account, err = r.state.DB.GetAccountByID(ctx, mute.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the mute target account
+	mute.TargetAccount, err = r.state.DB.GetAccountByID(ctx, mute.TargetAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mute, nil
+}
+
+func (r *relationshipDB) getMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, db.Error) {
+	return r.state.Caches.GTS.UserMute().Load("AccountID.TargetAccountID", func() (*gtsmodel.UserMute, error) {
+		var mute gtsmodel.UserMute
+
+		q := r.conn.NewSelect().Model(&mute).
+			Where("? = ?", bun.Ident("user_mute.account_id"), account1).
+			Where("? = ?", bun.Ident("user_mute.target_account_id"), account2)
+		if err := q.Scan(ctx); err != nil {
+			return nil, r.conn.ProcessError(err)
+		}
+
+		return &mute, nil
+	}, account1, account2)
+}
+
+func (r *relationshipDB) GetAccountMutes(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.UserMute, string, string, db.Error) {
+	muteIDs := []string{}
+
+	q := r.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+		Column("user_mute.id").
+		Where("? = ?", bun.Ident("user_mute.account_id"), accountID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("user_mute.expires_at")).
+				WhereOr("? > ?", bun.Ident("user_mute.expires_at"), time.Now())
+		}).
+		Order("user_mute.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("user_mute.id"), maxID)
+	}
+
+	if sinceID != "" {
+		q = q.Where("? > ?", bun.Ident("user_mute.id"), sinceID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &muteIDs); err != nil {
+		return nil, "", "", r.conn.ProcessError(err)
+	}
+
+	if len(muteIDs) == 0 {
+		return nil, "", "", db.ErrNoEntries
+	}
+
+	mutes := make([]*gtsmodel.UserMute, 0, len(muteIDs))
+	for _, muteID := range muteIDs {
+		mute, err := r.state.Caches.GTS.UserMute().Load("ID", func() (*gtsmodel.UserMute, error) {
+			var mute gtsmodel.UserMute
+
+			q := r.conn.NewSelect().Model(&mute).
+				Where("? = ?", bun.Ident("user_mute.id"), muteID)
+			if err := q.Scan(ctx); err != nil {
+				return nil, r.conn.ProcessError(err)
+			}
+
+			return &mute, nil
+		}, muteID)
+		if err != nil {
+			log.Errorf(ctx, "error getting mute %q: %v", muteID, err)
+			continue
+		}
+
+		// Set the mute target account
+		mute.TargetAccount, err = r.state.DB.GetAccountByID(ctx, mute.TargetAccountID)
+		if err != nil {
+			log.Errorf(ctx, "error getting mute target account %q: %v", mute.TargetAccountID, err)
+			continue
+		}
+
+		mutes = append(mutes, mute)
+	}
+
+	nextMaxID := muteIDs[len(muteIDs)-1]
+	prevMinID := muteIDs[0]
+	return mutes, nextMaxID, prevMinID, nil
+}
+
+func (r *relationshipDB) PutMute(ctx context.Context, mute *gtsmodel.UserMute) db.Error {
+	return r.state.Caches.GTS.UserMute().Store(mute, func() error {
+		_, err := r.conn.NewInsert().Model(mute).Exec(ctx)
+		return r.conn.ProcessError(err)
+	})
+}
+
+func (r *relationshipDB) UpdateMute(ctx context.Context, mute *gtsmodel.UserMute, columns ...string) db.Error {
+	mute.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	// Update mute in the database, invalidating the cache.
+	if _, err := r.conn.
+		NewUpdate().
+		Model(mute).
+		Where("? = ?", bun.Ident("user_mute.id"), mute.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	r.state.Caches.GTS.UserMute().Invalidate("ID", mute.ID)
+	return nil
+}
+
+func (r *relationshipDB) DeleteMuteByID(ctx context.Context, id string) db.Error {
+	if _, err := r.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+		Where("? = ?", bun.Ident("user_mute.id"), id).
+		Exec(ctx); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	// Drop any old value from cache by this ID
+	r.state.Caches.GTS.UserMute().Invalidate("ID", id)
+	return nil
+}
+
+func (r *relationshipDB) DeleteMutesByOriginAccountID(ctx context.Context, originAccountID string) db.Error {
+	muteIDs := []string{}
+
+	q := r.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+		Column("user_mute.id").
+		Where("? = ?", bun.Ident("user_mute.account_id"), originAccountID)
+
+	if err := q.Scan(ctx, &muteIDs); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	for _, muteID := range muteIDs {
+		if err := r.DeleteMuteByID(ctx, muteID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *relationshipDB) DeleteMutesByTargetAccountID(ctx context.Context, targetAccountID string) db.Error {
+	muteIDs := []string{}
+
+	q := r.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+		Column("user_mute.id").
+		Where("? = ?", bun.Ident("user_mute.target_account_id"), targetAccountID)
+
+	if err := q.Scan(ctx, &muteIDs); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	for _, muteID := range muteIDs {
+		if err := r.DeleteMuteByID(ctx, muteID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount string, targetAccount string) (*gtsmodel.Relationship, db.Error) {
 	rel := &gtsmodel.Relationship{
 		ID: targetAccount,
@@ -251,6 +467,16 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 	}
 	rel.BlockedBy = (blockT2A != nil)
 
+	// check if the requesting account is muting the target account
+	mute, err := r.getMute(ctx, requestingAccount, targetAccount)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("GetRelationship: error checking muting: %s", err)
+	}
+	if mute != nil && !mute.Expired(time.Now()) {
+		rel.Muting = true
+		rel.MutingNotifications = *mute.Notifications
+	}
+
 	return rel, nil
 }
 
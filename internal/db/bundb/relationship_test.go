@@ -20,10 +20,12 @@ package bundb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type RelationshipTestSuite struct {
@@ -241,6 +243,107 @@ func (suite *RelationshipTestSuite) TestGetRelationship() {
 	suite.Empty(relationship.Note)
 }
 
+func (suite *RelationshipTestSuite) TestIsMuted() {
+	ctx := context.Background()
+
+	account1 := suite.testAccounts["local_account_1"].ID
+	account2 := suite.testAccounts["local_account_2"].ID
+
+	// no mutes exist between account 1 and account 2
+	muted, err := suite.db.IsMuted(ctx, account1, account2, false)
+	suite.NoError(err)
+	suite.False(muted)
+
+	// have account1 mute account2, but not its notifications
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01GZ7NZ3F0X0VVW7RDN0EMXA4D",
+		AccountID:       account1,
+		TargetAccountID: account2,
+		Notifications:   testrig.FalseBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// account 1 now mutes account 2
+	muted, err = suite.db.IsMuted(ctx, account1, account2, false)
+	suite.NoError(err)
+	suite.True(muted)
+
+	// but not account 2's notifications
+	muted, err = suite.db.IsMuted(ctx, account1, account2, true)
+	suite.NoError(err)
+	suite.False(muted)
+
+	// account 2 doesn't mute account 1
+	muted, err = suite.db.IsMuted(ctx, account2, account1, false)
+	suite.NoError(err)
+	suite.False(muted)
+
+	relationship, err := suite.db.GetRelationship(ctx, account1, account2)
+	suite.NoError(err)
+	suite.True(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+}
+
+func (suite *RelationshipTestSuite) TestIsMutedExpired() {
+	ctx := context.Background()
+
+	account1 := suite.testAccounts["local_account_1"].ID
+	account2 := suite.testAccounts["local_account_2"].ID
+
+	// have account1 mute account2 with a mute that has already expired
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01GZ7NZ3F0X0VVW7RDN0EMXA4D",
+		ExpiresAt:       time.Now().Add(-1 * time.Hour),
+		AccountID:       account1,
+		TargetAccountID: account2,
+		Notifications:   testrig.TrueBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// an expired mute should have no effect
+	muted, err := suite.db.IsMuted(ctx, account1, account2, false)
+	suite.NoError(err)
+	suite.False(muted)
+
+	mutes, _, _, err := suite.db.GetAccountMutes(ctx, account1, "", "", 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(mutes)
+}
+
+func (suite *RelationshipTestSuite) TestGetAccountMutes() {
+	ctx := context.Background()
+
+	account1 := suite.testAccounts["local_account_1"].ID
+	account2 := suite.testAccounts["local_account_2"].ID
+
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01GZ7NZ3F0X0VVW7RDN0EMXA4D",
+		AccountID:       account1,
+		TargetAccountID: account2,
+		Notifications:   testrig.TrueBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	mutes, nextMaxID, prevMinID, err := suite.db.GetAccountMutes(ctx, account1, "", "", 0)
+	suite.NoError(err)
+	suite.Len(mutes, 1)
+	suite.Equal(account2, mutes[0].TargetAccount.ID)
+	suite.Equal("01GZ7NZ3F0X0VVW7RDN0EMXA4D", nextMaxID)
+	suite.Equal("01GZ7NZ3F0X0VVW7RDN0EMXA4D", prevMinID)
+
+	// delete the mute by ID
+	err = suite.db.DeleteMuteByID(ctx, "01GZ7NZ3F0X0VVW7RDN0EMXA4D")
+	suite.NoError(err)
+
+	// mute should be gone
+	mute, err := suite.db.GetMute(ctx, account1, account2)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(mute)
+}
+
 func (suite *RelationshipTestSuite) TestIsFollowingYes() {
 	requestingAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
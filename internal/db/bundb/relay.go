@@ -0,0 +1,117 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type relayDB struct {
+	conn *DBConn
+}
+
+func (r *relayDB) getRelay(ctx context.Context, column string, value string) (*gtsmodel.Relay, db.Error) {
+	relay := new(gtsmodel.Relay)
+
+	if err := r.conn.
+		NewSelect().
+		Model(relay).
+		Where("? = ?", bun.Ident("relay."+column), value).
+		Scan(ctx); err != nil {
+		return nil, r.conn.ProcessError(err)
+	}
+
+	return relay, nil
+}
+
+func (r *relayDB) GetRelayByID(ctx context.Context, id string) (*gtsmodel.Relay, db.Error) {
+	return r.getRelay(ctx, "id", id)
+}
+
+func (r *relayDB) GetRelayByFollowURI(ctx context.Context, followURI string) (*gtsmodel.Relay, db.Error) {
+	return r.getRelay(ctx, "follow_uri", followURI)
+}
+
+func (r *relayDB) GetRelayByActorURI(ctx context.Context, actorURI string) (*gtsmodel.Relay, db.Error) {
+	return r.getRelay(ctx, "actor_uri", actorURI)
+}
+
+func (r *relayDB) GetRelays(ctx context.Context) ([]*gtsmodel.Relay, db.Error) {
+	relays := []*gtsmodel.Relay{}
+
+	if err := r.conn.
+		NewSelect().
+		Model(&relays).
+		Order("relay.id ASC").
+		Scan(ctx); err != nil {
+		return nil, r.conn.ProcessError(err)
+	}
+
+	if len(relays) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return relays, nil
+}
+
+func (r *relayDB) PutRelay(ctx context.Context, relay *gtsmodel.Relay) db.Error {
+	if _, err := r.conn.
+		NewInsert().
+		Model(relay).
+		Exec(ctx); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (r *relayDB) UpdateRelay(ctx context.Context, relay *gtsmodel.Relay, columns ...string) db.Error {
+	relay.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := r.conn.
+		NewUpdate().
+		Model(relay).
+		Column(columns...).
+		Where("? = ?", bun.Ident("relay.id"), relay.ID).
+		Exec(ctx); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (r *relayDB) DeleteRelayByID(ctx context.Context, id string) db.Error {
+	if _, err := r.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("relays"), bun.Ident("relay")).
+		Where("? = ?", bun.Ident("relay.id"), id).
+		Exec(ctx); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	return nil
+}
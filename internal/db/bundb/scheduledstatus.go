@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type scheduledStatusDB struct {
+	conn *DBConn
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, db.Error) {
+	scheduledStatus := new(gtsmodel.ScheduledStatus)
+
+	if err := s.conn.
+		NewSelect().
+		Model(scheduledStatus).
+		Where("? = ?", bun.Ident("scheduled_status.id"), id).
+		Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	return scheduledStatus, nil
+}
+
+func (s *scheduledStatusDB) GetScheduledStatuses(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ScheduledStatus, db.Error) {
+	scheduledStatuses := []*gtsmodel.ScheduledStatus{}
+
+	q := s.conn.
+		NewSelect().
+		Model(&scheduledStatuses).
+		Where("? = ?", bun.Ident("scheduled_status.account_id"), accountID).
+		Order("scheduled_status.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("scheduled_status.id"), maxID)
+	}
+
+	if sinceID != "" {
+		q = q.Where("? > ?", bun.Ident("scheduled_status.id"), sinceID)
+	}
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("scheduled_status.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	if len(scheduledStatuses) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return scheduledStatuses, nil
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusesDueBy(ctx context.Context, t time.Time) ([]*gtsmodel.ScheduledStatus, db.Error) {
+	scheduledStatuses := []*gtsmodel.ScheduledStatus{}
+
+	if err := s.conn.
+		NewSelect().
+		Model(&scheduledStatuses).
+		Where("? <= ?", bun.Ident("scheduled_status.scheduled_at"), t).
+		Order("scheduled_status.scheduled_at ASC").
+		Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	if len(scheduledStatuses) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return scheduledStatuses, nil
+}
+
+func (s *scheduledStatusDB) PutScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) db.Error {
+	if _, err := s.conn.
+		NewInsert().
+		Model(scheduledStatus).
+		Exec(ctx); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (s *scheduledStatusDB) UpdateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus, columns ...string) db.Error {
+	scheduledStatus.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := s.conn.
+		NewUpdate().
+		Model(scheduledStatus).
+		Column(columns...).
+		Where("? = ?", bun.Ident("scheduled_status.id"), scheduledStatus.ID).
+		Exec(ctx); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (s *scheduledStatusDB) DeleteScheduledStatusByID(ctx context.Context, id string) db.Error {
+	if _, err := s.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("scheduled_statuses"), bun.Ident("scheduled_status")).
+		Where("? = ?", bun.Ident("scheduled_status.id"), id).
+		Exec(ctx); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	return nil
+}
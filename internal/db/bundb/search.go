@@ -0,0 +1,358 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// htmlTag matches any html tag, so that
+// tags can be stripped from status content
+// before it's put in the search index.
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+type searchDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+func (s *searchDB) SearchForAccounts(
+	ctx context.Context,
+	accountID string,
+	query string,
+	maxID string,
+	minID string,
+	limit int,
+	offset int,
+	following bool,
+) ([]*gtsmodel.Account, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	username, domain, _ := strings.Cut(strings.TrimPrefix(query, "@"), "@")
+	if username == "" {
+		return []*gtsmodel.Account{}, nil
+	}
+
+	// Make educated guess for slice size
+	accountIDs := make([]string, 0, limit)
+
+	q := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		Order("account.id DESC")
+
+	// Match the start of either the username, the display
+	// name, or any of the words within the display name.
+	prefix := escapeLike(strings.ToLower(username)) + "%"
+	q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.username"), prefix, `\`).
+			WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.display_name"), prefix, `\`).
+			WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.display_name"), "% "+prefix, `\`)
+	})
+
+	switch {
+	case domain == "":
+		// Any domain.
+	case domain == config.GetHost() || domain == config.GetAccountDomain():
+		// Local accounts only.
+		q = q.WhereGroup(" AND ", whereEmptyOrNull("account.domain"))
+	default:
+		q = q.Where("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.domain"), escapeLike(strings.ToLower(domain))+"%", `\`)
+	}
+
+	if following {
+		q = q.Join(
+			"JOIN ? AS ? ON ? = ? AND ? = ?",
+			bun.Ident("follows"), bun.Ident("follow"),
+			bun.Ident("follow.target_account_id"), bun.Ident("account.id"),
+			bun.Ident("follow.account_id"), accountID,
+		)
+	}
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account.id"), maxID)
+	}
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("account.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	accounts := make([]*gtsmodel.Account, 0, len(accountIDs))
+
+	for _, id := range accountIDs {
+		// Fetch account from db for ID
+		account, err := s.state.DB.GetAccountByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching account %q: %v", id, err)
+			continue
+		}
+
+		// Append account to slice
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+func (s *searchDB) SearchForStatuses(
+	ctx context.Context,
+	accountID string,
+	query string,
+	fromAccountID string,
+	maxID string,
+	minID string,
+	limit int,
+	offset int,
+) ([]*gtsmodel.Status, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return []*gtsmodel.Status{}, nil
+	}
+
+	// Make educated guess for slice size
+	statusIDs := make([]string, 0, limit)
+
+	q := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		WhereGroup(" AND ", whereEmptyOrNull("status.boost_of_id")).
+		Order("status.id DESC")
+
+	switch s.conn.Dialect().Name() {
+	case dialect.PG:
+		// This expression must stay in sync with
+		// the one used to create the search index.
+		q = q.Where(
+			"to_tsvector('simple', COALESCE(?, '') || ' ' || regexp_replace(COALESCE(?, ''), '<[^>]*>', ' ', 'g')) @@ plainto_tsquery('simple', ?)",
+			bun.Ident("status.content_warning"), bun.Ident("status.content"),
+			strings.Join(terms, " "),
+		)
+	case dialect.SQLite:
+		q = q.Where(
+			"? IN (SELECT ? FROM ? WHERE ? MATCH ?)",
+			bun.Ident("status.id"),
+			bun.Ident("status_fts.status_id"),
+			bun.Ident("status_fts"),
+			bun.Ident("status_fts"),
+			ftsMatchExpression(terms),
+		)
+	default:
+		log.Panic(ctx, "db dialect was neither pg nor sqlite")
+	}
+
+	// Only include statuses that the account can
+	// reasonably be expected to be able to see.
+	q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			WhereOr("? = ?", bun.Ident("status.account_id"), accountID).
+			WhereOr("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+			WhereOr("EXISTS (?)", s.conn.
+				NewSelect().
+				TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+				Column("status_fave.id").
+				Where("? = ?", bun.Ident("status_fave.status_id"), bun.Ident("status.id")).
+				Where("? = ?", bun.Ident("status_fave.account_id"), accountID),
+			).
+			WhereOr("EXISTS (?)", s.conn.
+				NewSelect().
+				TableExpr("? AS ?", bun.Ident("status_bookmarks"), bun.Ident("status_bookmark")).
+				Column("status_bookmark.id").
+				Where("? = ?", bun.Ident("status_bookmark.status_id"), bun.Ident("status.id")).
+				Where("? = ?", bun.Ident("status_bookmark.account_id"), accountID),
+			).
+			WhereOr("EXISTS (?)", s.conn.
+				NewSelect().
+				TableExpr("? AS ?", bun.Ident("mentions"), bun.Ident("mention")).
+				Column("mention.id").
+				Where("? = ?", bun.Ident("mention.status_id"), bun.Ident("status.id")).
+				Where("? = ?", bun.Ident("mention.target_account_id"), accountID),
+			).
+			WhereOr("EXISTS (?)", s.conn.
+				NewSelect().
+				TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("interaction")).
+				Column("interaction.id").
+				WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					// Replies to, or boosts of, the status.
+					return q.
+						WhereOr("? = ?", bun.Ident("interaction.in_reply_to_id"), bun.Ident("status.id")).
+						WhereOr("? = ?", bun.Ident("interaction.boost_of_id"), bun.Ident("status.id"))
+				}).
+				Where("? = ?", bun.Ident("interaction.account_id"), accountID),
+			)
+	})
+
+	if fromAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("status.account_id"), fromAccountID)
+	}
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
+
+	for _, id := range statusIDs {
+		// Fetch status from db for ID
+		status, err := s.state.DB.GetStatusByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching status %q: %v", id, err)
+			continue
+		}
+
+		// Append status to slice
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// indexStatus puts the text of the given status into the search index, replacing any
+// existing entry for it. Postgres indexes status text by itself, so this is only needed
+// for SQLite, which uses a separate FTS5 table to search through statuses.
+func indexStatus(ctx context.Context, tx bun.IDB, status *gtsmodel.Status) error {
+	if tx.Dialect().Name() != dialect.SQLite {
+		return nil
+	}
+
+	if err := unindexStatus(ctx, tx, status.ID); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx,
+		"INSERT INTO ? (?, ?) VALUES (?, ?)",
+		bun.Ident("status_fts"), bun.Ident("status_id"), bun.Ident("text"),
+		status.ID, statusSearchText(status.ContentWarning, status.Content),
+	)
+	return err
+}
+
+// unindexStatus removes the status with the given ID from the search index.
+func unindexStatus(ctx context.Context, tx bun.IDB, statusID string) error {
+	if tx.Dialect().Name() != dialect.SQLite {
+		return nil
+	}
+
+	// The status_id column is indexed by FTS5 too,
+	// so match against it rather than scanning.
+	_, err := tx.ExecContext(ctx,
+		"DELETE FROM ? WHERE ? MATCH ?",
+		bun.Ident("status_fts"), bun.Ident("status_fts"),
+		ftsColumnFilter("status_id", []string{statusID}),
+	)
+	return err
+}
+
+// statusSearchText returns the given status content warning
+// and content as plain text, suitable for searching through.
+func statusSearchText(contentWarning string, content string) string {
+	text := contentWarning + " " + htmlTag.ReplaceAllString(content, " ")
+	return html.UnescapeString(text)
+}
+
+// searchTerms splits the given query into terms to search
+// for, dropping any that contain no letters or numbers.
+func searchTerms(query string) []string {
+	fields := strings.Fields(query)
+	terms := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		if strings.IndexFunc(field, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsNumber(r)
+		}) == -1 {
+			continue
+		}
+		terms = append(terms, field)
+	}
+
+	return terms
+}
+
+// ftsMatchExpression returns an FTS5 expression matching
+// statuses whose text contains all of the given terms.
+func ftsMatchExpression(terms []string) string {
+	return ftsColumnFilter("text", terms)
+}
+
+// ftsColumnFilter returns an FTS5 expression matching rows where
+// the given column contains all of the given terms. Each term is
+// quoted, so that it can't be interpreted as FTS5 query syntax.
+func ftsColumnFilter(column string, terms []string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+	}
+	return column + " : (" + strings.Join(quoted, " ") + ")"
+}
+
+// escapeLike escapes the LIKE wildcard characters in the given
+// string, for use in a LIKE expression with `\` as the escape.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,222 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SearchTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *SearchTestSuite) putStatus(account *gtsmodel.Account, content string, visibility gtsmodel.Visibility) *gtsmodel.Status {
+	statusID := id.NewULID()
+	status := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 account.URI + "/statuses/" + statusID,
+		URL:                 account.URL + "/statuses/" + statusID,
+		Content:             content,
+		Local:               testrig.TrueBool(),
+		AccountURI:          account.URI,
+		AccountID:           account.ID,
+		Visibility:          visibility,
+		Sensitive:           testrig.FalseBool(),
+		Federated:           testrig.TrueBool(),
+		Boostable:           testrig.TrueBool(),
+		Replyable:           testrig.TrueBool(),
+		Likeable:            testrig.TrueBool(),
+		ActivityStreamsType: ap.ObjectNote,
+	}
+
+	if err := suite.db.PutStatus(context.Background(), status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return status
+}
+
+func (suite *SearchTestSuite) TestSearchForStatuses() {
+	var (
+		ctx     = context.Background()
+		author  = suite.testAccounts["local_account_1"]
+		account = suite.testAccounts["local_account_2"]
+		status  = suite.putStatus(author, `<p>Have you seen the <a href="https://example.org/giraffes">giraffes</a> at the zoo?</p>`, gtsmodel.VisibilityPublic)
+	)
+
+	statuses, err := suite.db.SearchForStatuses(ctx, account.ID, "Giraffes zoo", "", "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(statuses, 1) {
+		suite.Equal(status.ID, statuses[0].ID)
+	}
+
+	// Every term must match.
+	statuses, err = suite.db.SearchForStatuses(ctx, account.ID, "giraffes elephants", "", "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(statuses)
+
+	// Only statuses by the given author.
+	statuses, err = suite.db.SearchForStatuses(ctx, account.ID, "giraffes", account.ID, "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesMarkup() {
+	var (
+		ctx     = context.Background()
+		author  = suite.testAccounts["local_account_1"]
+		account = suite.testAccounts["local_account_2"]
+		status  = suite.putStatus(author, `<p><span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention">@<span>the_mighty_zork</span></a></span> look at this <a href="http://localhost:8080/tags/otters" class="mention hashtag" rel="tag">#<span>otters</span></a></p>`, gtsmodel.VisibilityPublic)
+	)
+
+	// Terms which only appear inside
+	// html tags shouldn't match anything.
+	for _, query := range []string{"href", "mention", "hashtag", "class"} {
+		statuses, err := suite.db.SearchForStatuses(ctx, account.ID, query, "", "", "", 20, 0)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Empty(statuses, query)
+	}
+
+	// The text itself should still match.
+	statuses, err := suite.db.SearchForStatuses(ctx, account.ID, "otters", "", "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(statuses, 1) {
+		suite.Equal(status.ID, statuses[0].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesNotVisible() {
+	var (
+		ctx    = context.Background()
+		author = suite.testAccounts["local_account_1"]
+		other  = suite.testAccounts["local_account_2"]
+		status = suite.putStatus(author, "<p>a secret about penguins</p>", gtsmodel.VisibilityFollowersOnly)
+	)
+
+	statuses, err := suite.db.SearchForStatuses(ctx, other.ID, "penguins", "", "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(statuses)
+
+	// The author can always find their own statuses.
+	statuses, err = suite.db.SearchForStatuses(ctx, author.ID, "penguins", "", "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(statuses, 1) {
+		suite.Equal(status.ID, statuses[0].ID)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesAfterUpdateAndDelete() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+		status  = suite.putStatus(account, "<p>the capybara is the largest rodent</p>", gtsmodel.VisibilityPublic)
+	)
+
+	status.Content = "<p>the beaver is quite a large rodent too</p>"
+	if err := suite.db.UpdateStatus(ctx, status, "content"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statuses, err := suite.db.SearchForStatuses(ctx, account.ID, "capybara", "", "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(statuses)
+
+	statuses, err = suite.db.SearchForStatuses(ctx, account.ID, "beaver", "", "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(statuses, 1)
+
+	if err := suite.db.DeleteStatusByID(ctx, status.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statuses, err = suite.db.SearchForStatuses(ctx, account.ID, "beaver", "", "", "", 20, 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchForAccounts() {
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		account   *gtsmodel.Account
+		query     string
+		following bool
+		expected  []string
+	}{
+		// Username prefix.
+		{account: suite.testAccounts["admin_account"], query: "1happy", expected: []string{"1happyturtle"}},
+		// Word in display name, ignoring case.
+		{account: suite.testAccounts["admin_account"], query: "Turtle", expected: []string{"1happyturtle"}},
+		// Local domain.
+		{account: suite.testAccounts["admin_account"], query: "@1happy@localhost:8080", expected: []string{"1happyturtle"}},
+		// Wrong domain.
+		{account: suite.testAccounts["admin_account"], query: "@1happy@example.org", expected: []string{}},
+		// Not followed.
+		{account: suite.testAccounts["admin_account"], query: "turtle", following: true, expected: []string{}},
+		// Followed.
+		{account: suite.testAccounts["local_account_1"], query: "turtle", following: true, expected: []string{"1happyturtle"}},
+		// Wildcards are escaped.
+		{account: suite.testAccounts["admin_account"], query: "%", expected: []string{}},
+	} {
+		accounts, err := suite.db.SearchForAccounts(ctx, test.account.ID, test.query, "", "", 20, 0, test.following)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		usernames := []string{}
+		for _, account := range accounts {
+			usernames = append(usernames, account.Username)
+		}
+
+		suite.Equal(test.expected, usernames, test.query)
+	}
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/uptrace/bun"
+	"golang.org/x/exp/slices"
 )
 
 type statusDB struct {
@@ -183,6 +184,15 @@ func (s *statusDB) getStatus(ctx context.Context, lookup string, dbQuery func(*g
 		}
 	}
 
+	if id := status.PollID; id != "" {
+		// Fetch status poll, pointing it back at this status
+		status.Poll, err = s.state.DB.GetPollByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting status poll: %w", err)
+		}
+		status.Poll.Status = status
+	}
+
 	return status, nil
 }
 
@@ -242,9 +252,24 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) db.Er
 				}
 			}
 
+			// insert the poll attached to this status, if any
+			if status.Poll != nil {
+				status.Poll.StatusID = status.ID
+				if _, err := tx.
+					NewInsert().
+					Model(status.Poll).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
 			// Finally, insert the status
-			_, err := tx.NewInsert().Model(status).Exec(ctx)
-			return err
+			if _, err := tx.NewInsert().Model(status).Exec(ctx); err != nil {
+				return err
+			}
+
+			// make the status text searchable
+			return indexStatus(ctx, tx, status)
 		})
 	})
 	if err != nil {
@@ -268,6 +293,30 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 	}
 
 	if err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// remove links between this status and any emojis it no longer uses
+		q := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("status_to_emojis"), bun.Ident("status_to_emoji")).
+			Where("? = ?", bun.Ident("status_to_emoji.status_id"), status.ID)
+		if len(status.EmojiIDs) > 0 {
+			q = q.Where("? NOT IN (?)", bun.Ident("status_to_emoji.emoji_id"), bun.In(status.EmojiIDs))
+		}
+		if _, err := q.Exec(ctx); err != nil {
+			return err
+		}
+
+		// remove links between this status and any tags it no longer uses
+		q = tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+			Where("? = ?", bun.Ident("status_to_tag.status_id"), status.ID)
+		if len(status.TagIDs) > 0 {
+			q = q.Where("? NOT IN (?)", bun.Ident("status_to_tag.tag_id"), bun.In(status.TagIDs))
+		}
+		if _, err := q.Exec(ctx); err != nil {
+			return err
+		}
+
 		// create links between this status and any emojis it uses
 		for _, i := range status.EmojiIDs {
 			if _, err := tx.
@@ -319,13 +368,23 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 		}
 
 		// Finally, update the status
-		_, err := tx.
+		if _, err := tx.
 			NewUpdate().
 			Model(status).
 			Column(columns...).
 			Where("? = ?", bun.Ident("status.id"), status.ID).
-			Exec(ctx)
-		return err
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// refresh the searchable status text, if it was updated
+		if len(columns) == 0 ||
+			slices.Contains(columns, "content") ||
+			slices.Contains(columns, "content_warning") {
+			return indexStatus(ctx, tx, status)
+		}
+
+		return nil
 	}); err != nil {
 		// already processed
 		return err
@@ -371,7 +430,8 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) db.Error {
 			return err
 		}
 
-		return nil
+		// remove the status text from search
+		return unindexStatus(ctx, tx, id)
 	}); err != nil {
 		return err
 	}
@@ -409,6 +469,26 @@ func (s *statusDB) GetStatusParents(ctx context.Context, status *gtsmodel.Status
 	return parents, nil
 }
 
+func (s *statusDB) GetStatusThreadRoot(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.Status, db.Error) {
+	root := status
+
+	for root.InReplyToID != "" {
+		parent, err := s.GetStatusByID(ctx, root.InReplyToID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// we don't know about this parent,
+				// so treat the current status as root
+				break
+			}
+			return nil, err
+		}
+
+		root = parent
+	}
+
+	return root, nil
+}
+
 func (s *statusDB) GetStatusChildren(ctx context.Context, status *gtsmodel.Status, onlyDirect bool, minID string) ([]*gtsmodel.Status, db.Error) {
 	foundStatuses := &list.List{}
 	foundStatuses.PushFront(status)
@@ -523,10 +603,17 @@ func (s *statusDB) IsStatusRebloggedBy(ctx context.Context, status *gtsmodel.Sta
 }
 
 func (s *statusDB) IsStatusMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, db.Error) {
+	// Thread mutes are stored against the root
+	// of the thread, so check that as well.
+	root, err := s.GetStatusThreadRoot(ctx, status)
+	if err != nil {
+		return false, err
+	}
+
 	q := s.conn.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("status_mutes"), bun.Ident("status_mute")).
-		Where("? = ?", bun.Ident("status_mute.status_id"), status.ID).
+		Where("? IN (?)", bun.Ident("status_mute.status_id"), bun.In([]string{status.ID, root.ID})).
 		Where("? = ?", bun.Ident("status_mute.account_id"), accountID)
 
 	return s.conn.Exists(ctx, q)
@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type statusEditDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+func (s *statusEditDB) GetStatusEditByID(ctx context.Context, id string) (*gtsmodel.StatusEdit, db.Error) {
+	return s.state.Caches.GTS.StatusEdit().Load("ID", func() (*gtsmodel.StatusEdit, error) {
+		var edit gtsmodel.StatusEdit
+
+		// Not cached! Perform database query.
+		if err := s.conn.
+			NewSelect().
+			Model(&edit).
+			Where("? = ?", bun.Ident("status_edit.id"), id).
+			Scan(ctx); err != nil {
+			return nil, s.conn.ProcessError(err)
+		}
+
+		return &edit, nil
+	}, id)
+}
+
+func (s *statusEditDB) GetStatusEditsByStatusID(ctx context.Context, statusID string) ([]*gtsmodel.StatusEdit, db.Error) {
+	var editIDs []string
+	if err := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_edits"), bun.Ident("status_edit")).
+		Column("status_edit.id").
+		Where("? = ?", bun.Ident("status_edit.status_id"), statusID).
+		Order("status_edit.created_at ASC").
+		Scan(ctx, &editIDs); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	if len(editIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each edit using its ID to ensure cache used.
+	edits := make([]*gtsmodel.StatusEdit, 0, len(editIDs))
+	for _, id := range editIDs {
+		edit, err := s.GetStatusEditByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching status edit %q: %v", id, err)
+			continue
+		}
+
+		// Append edit.
+		edits = append(edits, edit)
+	}
+
+	return edits, nil
+}
+
+func (s *statusEditDB) PutStatusEdit(ctx context.Context, edit *gtsmodel.StatusEdit) db.Error {
+	return s.state.Caches.GTS.StatusEdit().Store(edit, func() error {
+		_, err := s.conn.
+			NewInsert().
+			Model(edit).
+			Exec(ctx)
+		return s.conn.ProcessError(err)
+	})
+}
+
+func (s *statusEditDB) DeleteStatusEditsByStatusID(ctx context.Context, statusID string) db.Error {
+	// Gather the edits of this status so
+	// we can invalidate them from the cache.
+	edits, err := s.GetStatusEditsByStatusID(ctx, statusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	defer func() {
+		for _, edit := range edits {
+			s.state.Caches.GTS.StatusEdit().Invalidate("ID", edit.ID)
+		}
+	}()
+
+	_, err = s.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_edits"), bun.Ident("status_edit")).
+		Where("? = ?", bun.Ident("status_edit.status_id"), statusID).
+		Exec(ctx)
+	return s.conn.ProcessError(err)
+}
@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusEditTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) TestPutGetDeleteStatusEdits() {
+	ctx := context.Background()
+	status := suite.testStatuses["local_account_1_status_1"]
+	now := time.Now()
+
+	edits := []*gtsmodel.StatusEdit{
+		{
+			ID:        "01GYSXJ1V4N1AXW2FWZWWGRDZD",
+			CreatedAt: now.Add(-2 * time.Hour),
+			Content:   "first version",
+			Text:      "first version",
+			Sensitive: testrig.FalseBool(),
+			StatusID:  status.ID,
+		},
+		{
+			ID:             "01GYSXJK3QWJ6FEFF4AQ5YJ8RB",
+			CreatedAt:      now.Add(-time.Hour),
+			Content:        "second version",
+			ContentWarning: "spoilers",
+			Text:           "second version",
+			Sensitive:      testrig.TrueBool(),
+			PollOptions:    []string{"yes", "no"},
+			StatusID:       status.ID,
+		},
+	}
+
+	// put them in reverse order to make
+	// sure they come back oldest first
+	for i := len(edits) - 1; i >= 0; i-- {
+		if err := suite.db.PutStatusEdit(ctx, edits[i]); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	dbEdit, err := suite.db.GetStatusEditByID(ctx, edits[1].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("second version", dbEdit.Content)
+	suite.Equal("spoilers", dbEdit.ContentWarning)
+	suite.True(*dbEdit.Sensitive)
+	suite.Equal([]string{"yes", "no"}, dbEdit.PollOptions)
+
+	dbEdits, err := suite.db.GetStatusEditsByStatusID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(dbEdits, 2) {
+		suite.Equal(edits[0].ID, dbEdits[0].ID)
+		suite.Equal(edits[1].ID, dbEdits[1].ID)
+	}
+
+	if err := suite.db.DeleteStatusEditsByStatusID(ctx, status.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbEdits, err = suite.db.GetStatusEditsByStatusID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbEdits)
+
+	_, err = suite.db.GetStatusEditByID(ctx, edits[0].ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
@@ -0,0 +1,251 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type tagDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+func (t *tagDB) GetTagByID(ctx context.Context, id string) (*gtsmodel.Tag, db.Error) {
+	tag := new(gtsmodel.Tag)
+	if err := t.conn.
+		NewSelect().
+		Model(tag).
+		Where("? = ?", bun.Ident("tag.id"), id).
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return tag, nil
+}
+
+func (t *tagDB) GetTagByName(ctx context.Context, name string) (*gtsmodel.Tag, db.Error) {
+	tag := new(gtsmodel.Tag)
+	if err := t.conn.
+		NewSelect().
+		Model(tag).
+		Where("LOWER(?) = LOWER(?)", bun.Ident("tag.name"), name).
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return tag, nil
+}
+
+func (t *tagDB) GetTagsByNamePrefix(ctx context.Context, prefix string, limit int) ([]*gtsmodel.Tag, db.Error) {
+	tags := []*gtsmodel.Tag{}
+
+	q := t.conn.
+		NewSelect().
+		Model(&tags).
+		// Hashtags can only contain letters and numbers,
+		// so there's no need to escape the prefix here.
+		Where("LOWER(?) LIKE LOWER(?)", bun.Ident("tag.name"), prefix+"%").
+		Where("? = ?", bun.Ident("tag.listable"), true).
+		// Shortest (ie., closest) matches first.
+		OrderExpr("LENGTH(?) ASC", bun.Ident("tag.name")).
+		Order("tag.name ASC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return tags, nil
+}
+
+func (t *tagDB) GetFollowedTag(ctx context.Context, accountID string, tagID string) (*gtsmodel.FollowedTag, db.Error) {
+	followedTag := new(gtsmodel.FollowedTag)
+	if err := t.conn.
+		NewSelect().
+		Model(followedTag).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Where("? = ?", bun.Ident("followed_tag.tag_id"), tagID).
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	if err := t.populateFollowedTag(ctx, followedTag); err != nil {
+		return nil, err
+	}
+
+	return followedTag, nil
+}
+
+func (t *tagDB) GetFollowedTagsForAccountID(ctx context.Context,
+	accountID string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) ([]*gtsmodel.FollowedTag, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	var (
+		followedTags = make([]*gtsmodel.FollowedTag, 0, limit)
+		frontToBack  = true
+	)
+
+	q := t.conn.
+		NewSelect().
+		Model(&followedTags).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID)
+
+	if maxID != "" {
+		// return only follows LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("followed_tag.id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only follows HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("followed_tag.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only follows HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("followed_tag.id"), minID)
+
+		// page up
+		frontToBack = false
+	}
+
+	if limit > 0 {
+		// limit amount of follows returned
+		q = q.Limit(limit)
+	}
+
+	if frontToBack {
+		// Page down.
+		q = q.Order("followed_tag.id DESC")
+	} else {
+		// Page up.
+		q = q.Order("followed_tag.id ASC")
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	// If we're paging up, we still want follows
+	// to be sorted by ID desc, so reverse slice.
+	// https://zchee.github.io/golang-wiki/SliceTricks/#reversing
+	if !frontToBack {
+		for i, j := 0, len(followedTags)-1; i < j; i, j = i+1, j-1 {
+			followedTags[i], followedTags[j] = followedTags[j], followedTags[i]
+		}
+	}
+
+	populated := make([]*gtsmodel.FollowedTag, 0, len(followedTags))
+	for _, followedTag := range followedTags {
+		if err := t.populateFollowedTag(ctx, followedTag); err != nil {
+			log.Errorf(ctx, "error populating followed tag %q: %v", followedTag.ID, err)
+			continue
+		}
+		populated = append(populated, followedTag)
+	}
+
+	return populated, nil
+}
+
+func (t *tagDB) GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []string) ([]string, db.Error) {
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+
+	accountIDs := []string{}
+	if err := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Column("followed_tag.account_id").
+		Distinct().
+		Where("? IN (?)", bun.Ident("followed_tag.tag_id"), bun.In(tagIDs)).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return accountIDs, nil
+}
+
+func (t *tagDB) IsFollowingTag(ctx context.Context, accountID string, tagID string) (bool, db.Error) {
+	exists, err := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Where("? = ?", bun.Ident("followed_tag.tag_id"), tagID).
+		Exists(ctx)
+	if err != nil {
+		return false, t.conn.ProcessError(err)
+	}
+
+	return exists, nil
+}
+
+func (t *tagDB) PutFollowedTag(ctx context.Context, followedTag *gtsmodel.FollowedTag) db.Error {
+	_, err := t.conn.NewInsert().Model(followedTag).Exec(ctx)
+	return t.conn.ProcessError(err)
+}
+
+func (t *tagDB) DeleteFollowedTag(ctx context.Context, accountID string, tagID string) db.Error {
+	_, err := t.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Where("? = ?", bun.Ident("followed_tag.tag_id"), tagID).
+		Exec(ctx)
+	return t.conn.ProcessError(err)
+}
+
+func (t *tagDB) DeleteFollowedTagsByAccountID(ctx context.Context, accountID string) db.Error {
+	_, err := t.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Exec(ctx)
+	return t.conn.ProcessError(err)
+}
+
+// populateFollowedTag ensures that the tag of the given followedTag is set.
+func (t *tagDB) populateFollowedTag(ctx context.Context, followedTag *gtsmodel.FollowedTag) db.Error {
+	if followedTag.Tag == nil {
+		// Followed tag is not set, fetch from the database.
+		tag, err := t.GetTagByID(ctx, followedTag.TagID)
+		if err != nil {
+			return err
+		}
+		followedTag.Tag = tag
+	}
+
+	return nil
+}
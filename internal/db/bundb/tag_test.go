@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type TagTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *TagTestSuite) TestGetTagByNameCaseInsensitive() {
+	tag, err := suite.db.GetTagByName(context.Background(), "WELCOME")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(suite.testTags["welcome"].ID, tag.ID)
+}
+
+func (suite *TagTestSuite) TestGetTagsByNamePrefix() {
+	tags, err := suite.db.GetTagsByNamePrefix(context.Background(), "wel", 10)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(tags, 1)
+	suite.Equal("welcome", tags[0].Name)
+}
+
+func (suite *TagTestSuite) TestFollowUnfollowTag() {
+	var (
+		ctx         = context.Background()
+		account     = suite.testAccounts["local_account_1"]
+		tag         = suite.testTags["welcome"]
+		followedTag = &gtsmodel.FollowedTag{
+			ID:        "01HN5X2Y9FQ3M8K7W6V5T4S3R2",
+			AccountID: account.ID,
+			TagID:     tag.ID,
+		}
+	)
+
+	if err := suite.db.PutFollowedTag(ctx, followedTag); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	following, err := suite.db.IsFollowingTag(ctx, account.ID, tag.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(following)
+
+	followedTags, err := suite.db.GetFollowedTagsForAccountID(ctx, account.ID, "", "", "", 20)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(followedTags, 1)
+	suite.Equal(tag.Name, followedTags[0].Tag.Name)
+
+	accountIDs, err := suite.db.GetAccountIDsFollowingTagIDs(ctx, []string{tag.ID})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{account.ID}, accountIDs)
+
+	if err := suite.db.DeleteFollowedTag(ctx, account.ID, tag.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	following, err = suite.db.IsFollowingTag(ctx, account.ID, tag.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(following)
+}
+
+func (suite *TagTestSuite) TestGetTagTimeline() {
+	statuses, err := suite.db.GetTagTimeline(context.Background(), suite.testTags["welcome"].ID, "", "", "", 20, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(statuses, 1)
+	suite.Equal(suite.testStatuses["admin_account_status_1"].ID, statuses[0].ID)
+}
+
+func TestTagTestSuite(t *testing.T) {
+	suite.Run(t, new(TagTestSuite))
+}
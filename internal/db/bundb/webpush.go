@@ -0,0 +1,172 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+	"github.com/uptrace/bun"
+)
+
+type webPushDB struct {
+	conn *DBConn
+}
+
+func (w *webPushDB) GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, db.Error) {
+	subscription := new(gtsmodel.WebPushSubscription)
+
+	if err := w.conn.
+		NewSelect().
+		Model(subscription).
+		Where("? = ?", bun.Ident("web_push_subscription.token_id"), tokenID).
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	return subscription, nil
+}
+
+func (w *webPushDB) GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, db.Error) {
+	subscriptions := []*gtsmodel.WebPushSubscription{}
+
+	if err := w.conn.
+		NewSelect().
+		Model(&subscriptions).
+		Where("? = ?", bun.Ident("web_push_subscription.account_id"), accountID).
+		Order("web_push_subscription.id ASC").
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	if len(subscriptions) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return subscriptions, nil
+}
+
+func (w *webPushDB) PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) db.Error {
+	if _, err := w.conn.
+		NewInsert().
+		Model(subscription).
+		Exec(ctx); err != nil {
+		return w.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (w *webPushDB) UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) db.Error {
+	subscription.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := w.conn.
+		NewUpdate().
+		Model(subscription).
+		Column(columns...).
+		Where("? = ?", bun.Ident("web_push_subscription.id"), subscription.ID).
+		Exec(ctx); err != nil {
+		return w.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByID(ctx context.Context, id string) db.Error {
+	if _, err := w.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("web_push_subscriptions"), bun.Ident("web_push_subscription")).
+		Where("? = ?", bun.Ident("web_push_subscription.id"), id).
+		Exec(ctx); err != nil {
+		return w.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) db.Error {
+	if _, err := w.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("web_push_subscriptions"), bun.Ident("web_push_subscription")).
+		Where("? = ?", bun.Ident("web_push_subscription.token_id"), tokenID).
+		Exec(ctx); err != nil {
+		return w.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (w *webPushDB) GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, db.Error) {
+	keyPair := new(gtsmodel.VAPIDKeyPair)
+
+	if err := w.conn.
+		NewSelect().
+		Model(keyPair).
+		Order("vapid_key_pair.id ASC").
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	return keyPair, nil
+}
+
+func (w *webPushDB) CreateVAPIDKeyPair(ctx context.Context) db.Error {
+	exists, err := w.conn.Exists(ctx, w.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("vapid_key_pairs"), bun.Ident("vapid_key_pair")).
+		Column("vapid_key_pair.id"))
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Info(ctx, "vapid key pair already exists")
+		return nil
+	}
+
+	publicKey, privateKey, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		log.Errorf(ctx, "error creating new vapid key pair: %s", err)
+		return err
+	}
+
+	keyPair := &gtsmodel.VAPIDKeyPair{
+		ID:         id.NewULID(),
+		PublicKey:  publicKey,
+		PrivateKey: privateKey,
+	}
+
+	if _, err := w.conn.
+		NewInsert().
+		Model(keyPair).
+		Exec(ctx); err != nil {
+		return w.conn.ProcessError(err)
+	}
+
+	log.Infof(ctx, "vapid key pair created with public key %s", publicKey)
+	return nil
+}
@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Conversation contains functions for creating, getting, updating, and deleting direct message conversations.
+type Conversation interface {
+	// GetConversationByID gets one conversation with the given id.
+	GetConversationByID(ctx context.Context, id string) (*gtsmodel.Conversation, Error)
+
+	// GetConversationByThreadAndAccountIDs gets the conversation owned by the given accountID,
+	// in the given thread, between the owner and exactly the given set of other account IDs.
+	GetConversationByThreadAndAccountIDs(ctx context.Context, accountID string, threadID string, otherAccountIDs []string) (*gtsmodel.Conversation, Error)
+
+	// GetConversationsForAccountID gets conversations owned by the given accountID, newest last status first.
+	// Paging parameters refer to the ID of the last status in each conversation, rather than the conversation ID.
+	GetConversationsForAccountID(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Conversation, Error)
+
+	// PopulateConversation ensures that the conversation's struct fields are populated.
+	PopulateConversation(ctx context.Context, conversation *gtsmodel.Conversation) Error
+
+	// PutConversation puts a new conversation in the database.
+	PutConversation(ctx context.Context, conversation *gtsmodel.Conversation) Error
+
+	// UpdateConversation updates the given conversation.
+	// Columns is optional, if not specified all will be updated.
+	UpdateConversation(ctx context.Context, conversation *gtsmodel.Conversation, columns ...string) Error
+
+	// DeleteConversationByID deletes one conversation with the given ID, and all of its links to statuses.
+	DeleteConversationByID(ctx context.Context, id string) Error
+
+	// DeleteConversationsByOwnerAccountID deletes all conversations owned by the given accountID.
+	DeleteConversationsByOwnerAccountID(ctx context.Context, accountID string) Error
+
+	// LinkConversationToStatus records that the given status is a part of the given conversation.
+	LinkConversationToStatus(ctx context.Context, conversationID string, statusID string) Error
+
+	// DeleteStatusFromConversations removes the given status from any conversations it is a part of.
+	// Conversations for which it was the last status will have their last status recalculated,
+	// or will be deleted entirely if it was the only status in the conversation.
+	DeleteStatusFromConversations(ctx context.Context, statusID string) Error
+}
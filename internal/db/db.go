@@ -32,20 +32,33 @@ const (
 type DB interface {
 	Account
 	Admin
+	Application
 	Basic
+	Conversation
+	Delivery
 	Domain
 	Emoji
+	Filter
 	Instance
+	List
+	Marker
 	Media
 	Mention
 	Notification
+	Poll
 	Relationship
+	Relay
 	Report
+	ScheduledStatus
+	Search
 	Session
 	Status
+	StatusEdit
+	Tag
 	Timeline
 	User
 	Tombstone
+	WebPush
 
 	/*
 		USEFUL CONVERSION FUNCTIONS
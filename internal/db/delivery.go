@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Delivery handles getting/creation/deletion/updating of queued outgoing deliveries.
+type Delivery interface {
+	// GetDeliveryByID gets one queued delivery by its db id.
+	GetDeliveryByID(ctx context.Context, id string) (*gtsmodel.Delivery, Error)
+
+	// GetDueDeliveries gets up to limit queued deliveries whose next attempt is due by the given time, oldest first.
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*gtsmodel.Delivery, Error)
+
+	// IsDeliveryQueued returns true if the activity with the given id is already queued for delivery to the given inbox.
+	IsDeliveryQueued(ctx context.Context, activityID string, inboxURI string) (bool, Error)
+
+	// CountDeliveries returns the number of queued deliveries, and how many of those have failed at least once.
+	CountDeliveries(ctx context.Context) (total int, failing int, err Error)
+
+	// GetDeliveryHosts summarizes the queued deliveries which have failed at least
+	// once by host, returning up to limit hosts with the most failing deliveries.
+	GetDeliveryHosts(ctx context.Context, limit int) ([]*gtsmodel.DeliveryHost, Error)
+
+	// PutDelivery puts the given delivery in the database.
+	PutDelivery(ctx context.Context, delivery *gtsmodel.Delivery) Error
+
+	// UpdateDelivery updates one delivery by its db id.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateDelivery(ctx context.Context, delivery *gtsmodel.Delivery, columns ...string) Error
+
+	// DeleteDeliveryByID deletes the delivery with the given id.
+	DeleteDeliveryByID(ctx context.Context, id string) Error
+}
@@ -32,12 +32,23 @@ type Domain interface {
 	// GetDomainBlock ...
 	GetDomainBlock(ctx context.Context, domain string) (*gtsmodel.DomainBlock, Error)
 
+	// UpdateDomainBlock updates the given domain block.
+	// Columns is optional, if not specified all will be updated.
+	UpdateDomainBlock(ctx context.Context, block *gtsmodel.DomainBlock, columns ...string) Error
+
 	// DeleteDomainBlock ...
 	DeleteDomainBlock(ctx context.Context, domain string) Error
 
-	// IsDomainBlocked checks if an instance-level domain block exists for the given domain string (eg., `example.org`).
+	// IsDomainBlocked checks if an instance-level domain block with suspend severity exists for the given domain string (eg., `example.org`).
+	// In allowlist federation mode, domains without an instance-level domain allow are considered blocked too.
 	IsDomainBlocked(ctx context.Context, domain string) (bool, Error)
 
+	// IsDomainLimited checks if an instance-level domain block with limit severity exists for the given domain string (eg., `example.org`).
+	IsDomainLimited(ctx context.Context, domain string) (bool, Error)
+
+	// IsDomainMediaRejected checks if an instance-level domain block which rejects media exists for the given domain string (eg., `example.org`).
+	IsDomainMediaRejected(ctx context.Context, domain string) (bool, Error)
+
 	// AreDomainsBlocked checks if an instance-level domain block exists for any of the given domains strings, and returns true if even one is found.
 	AreDomainsBlocked(ctx context.Context, domains []string) (bool, Error)
 
@@ -46,4 +57,42 @@ type Domain interface {
 
 	// AreURIsBlocked checks if an instance-level domain block exists for any `host` in the given URI slice, and returns true if even one is found.
 	AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, Error)
+
+	// CreateDomainAllow puts the given instance-level domain allow into the database.
+	CreateDomainAllow(ctx context.Context, allow *gtsmodel.DomainAllow) Error
+
+	// GetDomainAllow returns the instance-level domain allow for the given domain, if it exists.
+	GetDomainAllow(ctx context.Context, domain string) (*gtsmodel.DomainAllow, Error)
+
+	// GetDomainAllowByID returns the instance-level domain allow with the given ID, if it exists.
+	GetDomainAllowByID(ctx context.Context, id string) (*gtsmodel.DomainAllow, Error)
+
+	// GetDomainAllows returns all instance-level domain allows, ordered by domain.
+	GetDomainAllows(ctx context.Context) ([]*gtsmodel.DomainAllow, Error)
+
+	// DeleteDomainAllow deletes the instance-level domain allow for the given domain, if it exists.
+	DeleteDomainAllow(ctx context.Context, domain string) Error
+
+	// IsDomainAllowed checks if an instance-level domain allow exists for the given domain string (eg., `example.org`).
+	IsDomainAllowed(ctx context.Context, domain string) (bool, Error)
+
+	// GetDomainBlocksBySubscriptionID gets all domain blocks created through the subscription with the given ID.
+	GetDomainBlocksBySubscriptionID(ctx context.Context, subscriptionID string) ([]*gtsmodel.DomainBlock, Error)
+
+	// GetDomainBlockSubscriptionByID gets one domain block subscription with the given ID.
+	GetDomainBlockSubscriptionByID(ctx context.Context, id string) (*gtsmodel.DomainBlockSubscription, Error)
+
+	// GetDomainBlockSubscriptions gets all domain block subscriptions, oldest first.
+	GetDomainBlockSubscriptions(ctx context.Context) ([]*gtsmodel.DomainBlockSubscription, Error)
+
+	// PutDomainBlockSubscription puts a new domain block subscription in the database.
+	PutDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) Error
+
+	// UpdateDomainBlockSubscription updates the given domain block subscription.
+	// If columns is empty, all columns will be updated.
+	UpdateDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription, columns ...string) Error
+
+	// DeleteDomainBlockSubscription deletes the domain block subscription with the given ID.
+	// It does not delete any domain blocks created through the subscription.
+	DeleteDomainBlockSubscription(ctx context.Context, id string) Error
 }
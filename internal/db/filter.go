@@ -0,0 +1,77 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Filter contains functions for creating, getting, updating, and deleting filters and filter keywords.
+type Filter interface {
+	// GetFilterByID gets one filter with the given id.
+	GetFilterByID(ctx context.Context, id string) (*gtsmodel.Filter, Error)
+
+	// GetFiltersForAccountID gets all filters owned by the given accountID.
+	GetFiltersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Filter, Error)
+
+	// GetFiltersExpiredBetween gets all filters which expired
+	// after the first given time, and before (or at) the second.
+	GetFiltersExpiredBetween(ctx context.Context, after time.Time, before time.Time) ([]*gtsmodel.Filter, Error)
+
+	// PopulateFilter ensures that the filter's struct fields are populated.
+	PopulateFilter(ctx context.Context, filter *gtsmodel.Filter) Error
+
+	// PutFilter puts a new filter in the database, along with any keywords it contains.
+	// It uses a transaction to ensure no partial updates.
+	PutFilter(ctx context.Context, filter *gtsmodel.Filter) Error
+
+	// UpdateFilter updates the given filter.
+	// Columns is optional, if not specified all will be updated.
+	UpdateFilter(ctx context.Context, filter *gtsmodel.Filter, columns ...string) Error
+
+	// DeleteFilterByID deletes one filter with the given ID, and all of its keywords.
+	DeleteFilterByID(ctx context.Context, id string) Error
+
+	// DeleteFiltersForAccountID deletes all filters owned by the given accountID, and all of their keywords.
+	DeleteFiltersForAccountID(ctx context.Context, accountID string) Error
+
+	// GetFilterKeywordByID gets one filter keyword with the given ID.
+	GetFilterKeywordByID(ctx context.Context, id string) (*gtsmodel.FilterKeyword, Error)
+
+	// GetFilterKeywordsForFilterID gets all filter keywords belonging to the given filterID.
+	GetFilterKeywordsForFilterID(ctx context.Context, filterID string) ([]*gtsmodel.FilterKeyword, Error)
+
+	// GetFilterKeywordsForAccountID gets all filter keywords owned by the given accountID.
+	GetFilterKeywordsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FilterKeyword, Error)
+
+	// PopulateFilterKeyword ensures that the filter keyword's struct fields are populated.
+	PopulateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) Error
+
+	// PutFilterKeyword puts a new filter keyword in the database.
+	PutFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) Error
+
+	// UpdateFilterKeyword updates the given filter keyword.
+	// Columns is optional, if not specified all will be updated.
+	UpdateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, columns ...string) Error
+
+	// DeleteFilterKeywordByID deletes one filter keyword with the given id.
+	DeleteFilterKeywordByID(ctx context.Context, id string) Error
+}
@@ -19,6 +19,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -37,13 +38,36 @@ type Instance interface {
 	// GetInstance returns the instance entry for the given domain, if it exists.
 	GetInstance(ctx context.Context, domain string) (*gtsmodel.Instance, Error)
 
+	// GetInstanceByInboxURI returns the instance entry for the domain of a known remote account
+	// which uses the given inbox or shared inbox URI, for when the inbox isn't hosted on the
+	// domain of the instance itself.
+	GetInstanceByInboxURI(ctx context.Context, inboxURI string) (*gtsmodel.Instance, Error)
+
 	// GetInstanceAccounts returns a slice of accounts from the given instance, arranged by ID.
 	GetInstanceAccounts(ctx context.Context, domain string, maxID string, limit int) ([]*gtsmodel.Account, Error)
 
 	// GetInstancePeers returns a slice of instances that the host instance knows about.
 	GetInstancePeers(ctx context.Context, includeSuspended bool) ([]*gtsmodel.Instance, Error)
 
+	// GetUnavailableInstances returns a slice of instances which have been
+	// marked as unavailable because delivery to them kept failing, by domain.
+	GetUnavailableInstances(ctx context.Context) ([]*gtsmodel.Instance, Error)
+
+	// UpdateInstance updates the given instance. The given columns will be updated;
+	// if no columns are provided, then all columns will be updated. updated_at will
+	// also be updated, no need to pass this as a specific column.
+	UpdateInstance(ctx context.Context, instance *gtsmodel.Instance, columns ...string) Error
+
+	// ClaimInstanceProbe claims the next probe delivery to the given instance, which has been marked as
+	// unavailable, by setting its last delivery failure time to now, provided that it wasn't already after
+	// the given time. It returns whether the probe was claimed, so that only one caller makes each probe.
+	ClaimInstanceProbe(ctx context.Context, instance *gtsmodel.Instance, notAfter time.Time) (bool, Error)
+
 	// GetInstanceModeratorAddresses returns a slice of email addresses belonging to active
 	// (as in, not suspended) moderators + admins on this instance.
 	GetInstanceModeratorAddresses(ctx context.Context) ([]string, Error)
+
+	// GetInstanceModerators returns a slice of users who are active
+	// (as in, not suspended) moderators + admins on this instance.
+	GetInstanceModerators(ctx context.Context) ([]*gtsmodel.User, Error)
 }
@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Poll contains functions for creating, getting, updating, and deleting polls and poll votes.
+type Poll interface {
+	// GetPollByID gets one poll with the given id. The poll's
+	// status is not populated here, to avoid recursing back into
+	// the status (which populates its poll); use PopulatePoll for that.
+	GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, Error)
+
+	// GetOpenPollsExpiredBefore gets all polls which are not yet closed,
+	// but which have an expiry time before (or equal to) the given time.
+	GetOpenPollsExpiredBefore(ctx context.Context, t time.Time) ([]*gtsmodel.Poll, Error)
+
+	// PopulatePoll ensures that the poll's struct fields are populated.
+	PopulatePoll(ctx context.Context, poll *gtsmodel.Poll) Error
+
+	// PutPoll puts a new poll in the database.
+	PutPoll(ctx context.Context, poll *gtsmodel.Poll) Error
+
+	// UpdatePoll updates the given poll.
+	// Columns is optional, if not specified all will be updated.
+	UpdatePoll(ctx context.Context, poll *gtsmodel.Poll, columns ...string) Error
+
+	// DeletePollByID deletes one poll with the given ID, and all of its votes.
+	DeletePollByID(ctx context.Context, id string) Error
+
+	// GetPollVoteByID gets one poll vote with the given ID.
+	GetPollVoteByID(ctx context.Context, id string) (*gtsmodel.PollVote, Error)
+
+	// GetPollVoteBy gets the vote of the given account in the given poll.
+	GetPollVoteBy(ctx context.Context, pollID string, accountID string) (*gtsmodel.PollVote, Error)
+
+	// GetPollVotes gets all votes in the given poll.
+	GetPollVotes(ctx context.Context, pollID string) ([]*gtsmodel.PollVote, Error)
+
+	// PopulatePollVote ensures that the poll vote's struct fields are populated.
+	PopulatePollVote(ctx context.Context, vote *gtsmodel.PollVote) Error
+
+	// PutPollVote puts a new poll vote in the database, and counts its
+	// choices in the vote counts of its poll, in a single transaction.
+	// If vote.Poll is set, its vote counts will be updated to match.
+	PutPollVote(ctx context.Context, vote *gtsmodel.PollVote) Error
+
+	// AddPollVoteChoices adds the given choices to an existing poll vote,
+	// and counts any which weren't already chosen in the vote counts of its
+	// poll, in a single transaction. If vote.Poll is set, its vote counts
+	// will be updated to match.
+	AddPollVoteChoices(ctx context.Context, vote *gtsmodel.PollVote, choices []int) Error
+
+	// UpdatePollVote updates the given poll vote.
+	// Columns is optional, if not specified all will be updated.
+	UpdatePollVote(ctx context.Context, vote *gtsmodel.PollVote, columns ...string) Error
+
+	// DeletePollVotesByAccountID deletes all votes made by the given account,
+	// decrementing the vote counts of any polls which are not yet closed.
+	DeletePollVotesByAccountID(ctx context.Context, accountID string) Error
+}
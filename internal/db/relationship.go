@@ -50,6 +50,33 @@ type Relationship interface {
 	// DeleteBlocksByTargetAccountID removes any blocks with given targetAccountID.
 	DeleteBlocksByTargetAccountID(ctx context.Context, targetAccountID string) Error
 
+	// IsMuted checks whether account1 has an unexpired mute in place against account2.
+	// If notifications is true, then only mutes which also mute notifications are taken into account.
+	IsMuted(ctx context.Context, account1 string, account2 string, notifications bool) (bool, Error)
+
+	// GetMute returns the mute from account1 targeting account2, if it exists, or an error if it doesn't.
+	// The returned mute may have expired, so callers should check this themselves if it matters.
+	GetMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, Error)
+
+	// GetAccountMutes returns the unexpired mutes created by the given accountID, paged by mute ID,
+	// along with the next max ID and previous min ID for paging. Target accounts will be populated.
+	GetAccountMutes(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.UserMute, string, string, Error)
+
+	// PutMute attempts to place the given account mute in the database.
+	PutMute(ctx context.Context, mute *gtsmodel.UserMute) Error
+
+	// UpdateMute updates the given account mute in the database, optionally limited to the given columns.
+	UpdateMute(ctx context.Context, mute *gtsmodel.UserMute, columns ...string) Error
+
+	// DeleteMuteByID removes mute with given ID from the database.
+	DeleteMuteByID(ctx context.Context, id string) Error
+
+	// DeleteMutesByOriginAccountID removes any mutes with accountID equal to originAccountID.
+	DeleteMutesByOriginAccountID(ctx context.Context, originAccountID string) Error
+
+	// DeleteMutesByTargetAccountID removes any mutes with given targetAccountID.
+	DeleteMutesByTargetAccountID(ctx context.Context, targetAccountID string) Error
+
 	// GetRelationship retrieves the relationship of the targetAccount to the requestingAccount.
 	GetRelationship(ctx context.Context, requestingAccount string, targetAccount string) (*gtsmodel.Relationship, Error)
 
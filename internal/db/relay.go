@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Relay handles getting/creation/deletion/updating of subscriptions to relays.
+type Relay interface {
+	// GetRelayByID gets one relay by its db id.
+	GetRelayByID(ctx context.Context, id string) (*gtsmodel.Relay, Error)
+
+	// GetRelayByFollowURI gets the relay which the Follow with the given URI was sent to.
+	GetRelayByFollowURI(ctx context.Context, followURI string) (*gtsmodel.Relay, Error)
+
+	// GetRelayByActorURI gets the relay with the given actor URI.
+	GetRelayByActorURI(ctx context.Context, actorURI string) (*gtsmodel.Relay, Error)
+
+	// GetRelays gets all relays, oldest first.
+	GetRelays(ctx context.Context) ([]*gtsmodel.Relay, Error)
+
+	// PutRelay puts a new relay in the database.
+	PutRelay(ctx context.Context, relay *gtsmodel.Relay) Error
+
+	// UpdateRelay updates the given relay. The given columns will be updated;
+	// if no columns are provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this as a specific column.
+	UpdateRelay(ctx context.Context, relay *gtsmodel.Relay, columns ...string) Error
+
+	// DeleteRelayByID deletes the relay with the given id.
+	DeleteRelayByID(ctx context.Context, id string) Error
+}
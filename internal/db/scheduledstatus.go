@@ -0,0 +1,50 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// ScheduledStatus handles getting/creation/deletion/updating of scheduled statuses.
+type ScheduledStatus interface {
+	// GetScheduledStatusByID gets one scheduled status with the given id.
+	GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, Error)
+
+	// GetScheduledStatuses gets limit n scheduled statuses of the account with the given id, using the given paging parameters.
+	// If no scheduled statuses are found, ErrNoEntries will be returned.
+	GetScheduledStatuses(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ScheduledStatus, Error)
+
+	// GetScheduledStatusesDueBy gets all scheduled statuses, of all accounts, that are scheduled to be published at or before the given time.
+	// If no scheduled statuses are found, ErrNoEntries will be returned.
+	GetScheduledStatusesDueBy(ctx context.Context, t time.Time) ([]*gtsmodel.ScheduledStatus, Error)
+
+	// PutScheduledStatus puts the given scheduled status in the database.
+	PutScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) Error
+
+	// UpdateScheduledStatus updates one scheduled status by its ID.
+	// If any columns are specified, these will be updated exclusively.
+	// Otherwise, the whole model will be updated.
+	UpdateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus, columns ...string) Error
+
+	// DeleteScheduledStatusByID deletes scheduled status with the given id.
+	DeleteScheduledStatusByID(ctx context.Context, id string) Error
+}
@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Search contains functions for searching through accounts and statuses.
+type Search interface {
+	// SearchForAccounts returns accounts whose username or display name starts with the given query, ignoring case.
+	// The query may also be given in the form username@domain, in which case the domain must match too.
+	//
+	// If following is true, only accounts followed by the given accountID will be returned.
+	SearchForAccounts(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, offset int, following bool) ([]*gtsmodel.Account, Error)
+
+	// SearchForStatuses returns statuses whose text contains every term of the given query, newest first.
+	//
+	// Only statuses that the given accountID authored, interacted with, or that are public are returned;
+	// callers should still check the visibility of each status before showing it to the account.
+	//
+	// If fromAccountID is set, only statuses authored by that account will be returned.
+	SearchForStatuses(ctx context.Context, accountID string, query string, fromAccountID string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, Error)
+}
@@ -37,7 +37,7 @@ type Status interface {
 	// GetStatusByURL returns one status from the database, with no rel fields populated, only their linking ID / URIs
 	GetStatusByURL(ctx context.Context, uri string) (*gtsmodel.Status, Error)
 
-	// PutStatus stores one status in the database.
+	// PutStatus stores one status in the database, along with its poll (if set).
 	PutStatus(ctx context.Context, status *gtsmodel.Status) Error
 
 	// UpdateStatus updates one status in the database.
@@ -65,13 +65,18 @@ type Status interface {
 	// If onlyDirect is true, only the immediate children will be returned.
 	GetStatusChildren(ctx context.Context, status *gtsmodel.Status, onlyDirect bool, minID string) ([]*gtsmodel.Status, Error)
 
+	// GetStatusThreadRoot gets the status at the root of the thread of the given status,
+	// ie., its topmost known ancestor. If the status is not a reply, or none of its
+	// ancestors are known, the status itself will be returned.
+	GetStatusThreadRoot(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.Status, Error)
+
 	// IsStatusFavedBy checks if a given status has been faved by a given account ID
 	IsStatusFavedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
 	// IsStatusRebloggedBy checks if a given status has been reblogged/boosted by a given account ID
 	IsStatusRebloggedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
-	// IsStatusMutedBy checks if a given status has been muted by a given account ID
+	// IsStatusMutedBy checks if a given status, or the thread it belongs to, has been muted by a given account ID
 	IsStatusMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
 	// IsStatusBookmarkedBy checks if a given status has been bookmarked by a given account ID
@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// StatusEdit contains functions for getting, creating, and deleting previous versions of edited statuses.
+type StatusEdit interface {
+	// GetStatusEditByID gets one status edit with the given id.
+	GetStatusEditByID(ctx context.Context, id string) (*gtsmodel.StatusEdit, Error)
+
+	// GetStatusEditsByStatusID gets all edits of the status with the given
+	// status id, ordered from oldest to newest (ie., in order of creation).
+	GetStatusEditsByStatusID(ctx context.Context, statusID string) ([]*gtsmodel.StatusEdit, Error)
+
+	// PutStatusEdit puts a new status edit in the database.
+	PutStatusEdit(ctx context.Context, edit *gtsmodel.StatusEdit) Error
+
+	// DeleteStatusEditsByStatusID deletes all edits of the status with the given status id.
+	DeleteStatusEditsByStatusID(ctx context.Context, statusID string) Error
+}
@@ -0,0 +1,58 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Tag contains functions for getting hashtags, and for following and unfollowing them.
+type Tag interface {
+	// GetTagByID gets one tag with the given id.
+	GetTagByID(ctx context.Context, id string) (*gtsmodel.Tag, Error)
+
+	// GetTagByName gets one tag with the given name, ignoring case.
+	GetTagByName(ctx context.Context, name string) (*gtsmodel.Tag, Error)
+
+	// GetTagsByNamePrefix gets up to limit listable tags whose name starts with the given prefix, ignoring case.
+	GetTagsByNamePrefix(ctx context.Context, prefix string, limit int) ([]*gtsmodel.Tag, Error)
+
+	// GetFollowedTag gets the follow of the given tagID by the given accountID.
+	GetFollowedTag(ctx context.Context, accountID string, tagID string) (*gtsmodel.FollowedTag, Error)
+
+	// GetFollowedTagsForAccountID gets tags followed by the given accountID, most recently followed first.
+	// Paging parameters refer to the ID of each follow of a tag, rather than the ID of the tag itself.
+	GetFollowedTagsForAccountID(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.FollowedTag, Error)
+
+	// GetAccountIDsFollowingTagIDs returns the IDs of accounts following at least one of the given tagIDs.
+	GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []string) ([]string, Error)
+
+	// IsFollowingTag returns true if the given accountID follows the given tagID.
+	IsFollowingTag(ctx context.Context, accountID string, tagID string) (bool, Error)
+
+	// PutFollowedTag puts a new follow of a tag in the database.
+	PutFollowedTag(ctx context.Context, followedTag *gtsmodel.FollowedTag) Error
+
+	// DeleteFollowedTag deletes the follow of the given tagID by the given accountID, if it exists.
+	DeleteFollowedTag(ctx context.Context, accountID string, tagID string) Error
+
+	// DeleteFollowedTagsByAccountID deletes all follows of tags by the given accountID.
+	DeleteFollowedTagsByAccountID(ctx context.Context, accountID string) Error
+}
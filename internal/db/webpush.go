@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// WebPush handles getting/creation/deletion/updating of Web Push subscriptions and the instance VAPID key pair.
+type WebPush interface {
+	// GetWebPushSubscriptionByTokenID gets the Web Push subscription created by the access token with the given db id.
+	GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, Error)
+
+	// GetWebPushSubscriptionsByAccountID gets all Web Push subscriptions owned by the account with the given db id.
+	GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, Error)
+
+	// PutWebPushSubscription puts a new Web Push subscription in the database.
+	PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) Error
+
+	// UpdateWebPushSubscription updates the given Web Push subscription. The given columns will be updated;
+	// if no columns are provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this as a specific column.
+	UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) Error
+
+	// DeleteWebPushSubscriptionByID deletes the Web Push subscription with the given db id.
+	DeleteWebPushSubscriptionByID(ctx context.Context, id string) Error
+
+	// DeleteWebPushSubscriptionByTokenID deletes the Web Push subscription created by the access token with the given db id, if it exists.
+	DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) Error
+
+	// GetVAPIDKeyPair gets the VAPID key pair of this instance.
+	GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, Error)
+
+	// CreateVAPIDKeyPair generates and stores a VAPID key pair for this instance, if one doesn't exist yet.
+	CreateVAPIDKeyPair(ctx context.Context) Error
+}
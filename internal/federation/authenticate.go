@@ -19,6 +19,7 @@ package federation
 
 import (
 	"context"
+	"crypto"
 	"crypto/x509"
 	"encoding/json"
 	"encoding/pem"
@@ -202,98 +203,133 @@ func (f *federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 		// REMOTE ACCOUNT REQUEST WITHOUT KEY CACHED LOCALLY
 		// the request is remote and we don't have the public key yet,
 		// so we need to authenticate the request properly by dereferencing the remote key
-		gone, err := f.CheckGone(ctx, requestingPublicKeyID)
-		if err != nil {
-			errWithCode := gtserror.NewErrorInternalError(fmt.Errorf("error checking for tombstone for %s: %s", requestingPublicKeyID, err))
-			log.Debug(ctx, errWithCode)
+		var errWithCode gtserror.WithCode
+		publicKey, pkOwnerURI, errWithCode = f.dereferencePublicKey(ctx, requestedUsername, requestingPublicKeyID)
+		if errWithCode != nil {
 			return nil, errWithCode
 		}
+	}
 
-		if gone {
-			errWithCode := gtserror.NewErrorGone(fmt.Errorf("account with public key %s is gone", requestingPublicKeyID))
-			log.Debug(ctx, errWithCode)
-			return nil, errWithCode
-		}
+	// after all that, public key should be defined
+	if publicKey == nil {
+		errWithCode := gtserror.NewErrorInternalError(errors.New("returned public key was empty"))
+		log.Debug(ctx, errWithCode)
+		return nil, errWithCode
+	}
 
-		log.Tracef(ctx, "proceeding with dereference for uncached public key %s", requestingPublicKeyID)
-		trans, err := f.transportController.NewTransportForUsername(transport.WithFastfail(ctx), requestedUsername)
-		if err != nil {
-			errWithCode := gtserror.NewErrorInternalError(fmt.Errorf("error creating transport for %s: %s", requestedUsername, err))
-			log.Debug(ctx, errWithCode)
-			return nil, errWithCode
+	// do the actual authentication here!
+	if verifySignature(ctx, verifier, publicKey, pkOwnerURI) {
+		return pkOwnerURI, nil
+	}
+
+	if requestingRemoteAccount != nil {
+		// the signature didn't verify with the key we have stored for this account,
+		// but the remote may have rotated their key since we last fetched it, so
+		// fetch the key again and retry with that (this is rate limited per key)
+		if newPublicKey := f.refreshPublicKey(ctx, requestedUsername, requestingRemoteAccount, requestingPublicKeyID); newPublicKey != nil &&
+			verifySignature(ctx, verifier, newPublicKey, pkOwnerURI) {
+			return pkOwnerURI, nil
 		}
+	}
 
-		// The actual http call to the remote server is made right here in the Dereference function.
-		b, err := trans.Dereference(ctx, requestingPublicKeyID)
-		if err != nil {
-			if gtserror.StatusCode(err) == http.StatusGone {
-				// if we get a 410 error it means the account that owns this public key has been deleted;
-				// we should add a tombstone to our database so that we can avoid trying to deref it in future
-				if err := f.HandleGone(ctx, requestingPublicKeyID); err != nil {
-					errWithCode := gtserror.NewErrorInternalError(fmt.Errorf("error marking account with public key %s as gone: %s", requestingPublicKeyID, err))
-					log.Debug(ctx, errWithCode)
-					return nil, errWithCode
-				}
-				errWithCode := gtserror.NewErrorGone(fmt.Errorf("account with public key %s is gone", requestingPublicKeyID))
+	errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("authentication not passed for public key owner %s; signature value was '%s'", pkOwnerURI, signature))
+	log.Debug(ctx, errWithCode)
+	return nil, errWithCode
+}
+
+// dereferencePublicKey dereferences the public key with the given ID, using a transport for the given username,
+// and returns the key along with the URI of its owner. If the owner of the key turns out to be gone, a tombstone
+// is stored for it.
+func (f *federator) dereferencePublicKey(ctx context.Context, requestedUsername string, requestingPublicKeyID *url.URL) (crypto.PublicKey, *url.URL, gtserror.WithCode) {
+	gone, err := f.CheckGone(ctx, requestingPublicKeyID)
+	if err != nil {
+		errWithCode := gtserror.NewErrorInternalError(fmt.Errorf("error checking for tombstone for %s: %s", requestingPublicKeyID, err))
+		log.Debug(ctx, errWithCode)
+		return nil, nil, errWithCode
+	}
+
+	if gone {
+		errWithCode := gtserror.NewErrorGone(fmt.Errorf("account with public key %s is gone", requestingPublicKeyID))
+		log.Debug(ctx, errWithCode)
+		return nil, nil, errWithCode
+	}
+
+	log.Tracef(ctx, "dereferencing public key %s", requestingPublicKeyID)
+	trans, err := f.transportController.NewTransportForUsername(transport.WithFastfail(ctx), requestedUsername)
+	if err != nil {
+		errWithCode := gtserror.NewErrorInternalError(fmt.Errorf("error creating transport for %s: %s", requestedUsername, err))
+		log.Debug(ctx, errWithCode)
+		return nil, nil, errWithCode
+	}
+
+	// The actual http call to the remote server is made right here in the Dereference function.
+	b, err := trans.Dereference(ctx, requestingPublicKeyID)
+	if err != nil {
+		if gtserror.StatusCode(err) == http.StatusGone {
+			// if we get a 410 error it means the account that owns this public key has been deleted;
+			// we should add a tombstone to our database so that we can avoid trying to deref it in future
+			if err := f.HandleGone(ctx, requestingPublicKeyID); err != nil {
+				errWithCode := gtserror.NewErrorInternalError(fmt.Errorf("error marking account with public key %s as gone: %s", requestingPublicKeyID, err))
 				log.Debug(ctx, errWithCode)
-				return nil, errWithCode
+				return nil, nil, errWithCode
 			}
-
-			errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("error dereferencing public key %s: %s", requestingPublicKeyID, err))
+			errWithCode := gtserror.NewErrorGone(fmt.Errorf("account with public key %s is gone", requestingPublicKeyID))
 			log.Debug(ctx, errWithCode)
-			return nil, errWithCode
+			return nil, nil, errWithCode
 		}
 
-		// if the key isn't in the response, we can't authenticate the request
-		requestingPublicKey, err := getPublicKeyFromResponse(ctx, b, requestingPublicKeyID)
-		if err != nil {
-			errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("error parsing public key %s: %s", requestingPublicKeyID, err))
-			log.Debug(ctx, errWithCode)
-			return nil, errWithCode
-		}
+		errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("error dereferencing public key %s: %s", requestingPublicKeyID, err))
+		log.Debug(ctx, errWithCode)
+		return nil, nil, errWithCode
+	}
 
-		// we should be able to get the actual key embedded in the vocab.W3IDSecurityV1PublicKey
-		pkPemProp := requestingPublicKey.GetW3IDSecurityV1PublicKeyPem()
-		if pkPemProp == nil || !pkPemProp.IsXMLSchemaString() {
-			errWithCode := gtserror.NewErrorUnauthorized(errors.New("publicKeyPem property is not provided or it is not embedded as a value"))
-			log.Debug(ctx, errWithCode)
-			return nil, errWithCode
-		}
+	// if the key isn't in the response, we can't authenticate the request
+	requestingPublicKey, err := getPublicKeyFromResponse(ctx, b, requestingPublicKeyID)
+	if err != nil {
+		errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("error parsing public key %s: %s", requestingPublicKeyID, err))
+		log.Debug(ctx, errWithCode)
+		return nil, nil, errWithCode
+	}
 
-		// and decode the PEM so that we can parse it as a golang public key
-		pubKeyPem := pkPemProp.Get()
-		block, _ := pem.Decode([]byte(pubKeyPem))
-		if block == nil || block.Type != "PUBLIC KEY" {
-			errWithCode := gtserror.NewErrorUnauthorized(errors.New("could not decode publicKeyPem to PUBLIC KEY pem block type"))
-			log.Debug(ctx, errWithCode)
-			return nil, errWithCode
-		}
+	// we should be able to get the actual key embedded in the vocab.W3IDSecurityV1PublicKey
+	pkPemProp := requestingPublicKey.GetW3IDSecurityV1PublicKeyPem()
+	if pkPemProp == nil || !pkPemProp.IsXMLSchemaString() {
+		errWithCode := gtserror.NewErrorUnauthorized(errors.New("publicKeyPem property is not provided or it is not embedded as a value"))
+		log.Debug(ctx, errWithCode)
+		return nil, nil, errWithCode
+	}
 
-		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
-		if err != nil {
-			errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("could not parse public key %s from block bytes: %s", requestingPublicKeyID, err))
-			log.Debug(ctx, errWithCode)
-			return nil, errWithCode
-		}
+	// and decode the PEM so that we can parse it as a golang public key
+	pubKeyPem := pkPemProp.Get()
+	block, _ := pem.Decode([]byte(pubKeyPem))
+	if block == nil || block.Type != "PUBLIC KEY" {
+		errWithCode := gtserror.NewErrorUnauthorized(errors.New("could not decode publicKeyPem to PUBLIC KEY pem block type"))
+		log.Debug(ctx, errWithCode)
+		return nil, nil, errWithCode
+	}
 
-		// all good! we just need the URI of the key owner to return
-		pkOwnerProp := requestingPublicKey.GetW3IDSecurityV1Owner()
-		if pkOwnerProp == nil || !pkOwnerProp.IsIRI() {
-			errWithCode := gtserror.NewErrorUnauthorized(errors.New("publicKeyOwner property is not provided or it is not embedded as a value"))
-			log.Debug(ctx, errWithCode)
-			return nil, errWithCode
-		}
-		pkOwnerURI = pkOwnerProp.GetIRI()
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("could not parse public key %s from block bytes: %s", requestingPublicKeyID, err))
+		log.Debug(ctx, errWithCode)
+		return nil, nil, errWithCode
 	}
 
-	// after all that, public key should be defined
-	if publicKey == nil {
-		errWithCode := gtserror.NewErrorInternalError(errors.New("returned public key was empty"))
+	// all good! we just need the URI of the key owner to return
+	pkOwnerProp := requestingPublicKey.GetW3IDSecurityV1Owner()
+	if pkOwnerProp == nil || !pkOwnerProp.IsIRI() {
+		errWithCode := gtserror.NewErrorUnauthorized(errors.New("publicKeyOwner property is not provided or it is not embedded as a value"))
 		log.Debug(ctx, errWithCode)
-		return nil, errWithCode
+		return nil, nil, errWithCode
 	}
 
-	// do the actual authentication here!
+	return publicKey, pkOwnerProp.GetIRI(), nil
+}
+
+// verifySignature checks the signature of the given verifier against the given public key,
+// trying each of the algorithms we accept. Note that rfc9421 verifiers determine the
+// algorithm from the signature and key, and ignore the one given.
+func verifySignature(ctx context.Context, verifier httpsig.Verifier, publicKey crypto.PublicKey, pkOwnerURI *url.URL) bool {
 	algos := []httpsig.Algorithm{
 		httpsig.RSA_SHA256,
 		httpsig.RSA_SHA512,
@@ -305,12 +341,10 @@ func (f *federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 		err := verifier.Verify(publicKey, algo)
 		if err == nil {
 			log.Tracef(ctx, "authentication for %s PASSED with algorithm %s", pkOwnerURI, algo)
-			return pkOwnerURI, nil
+			return true
 		}
 		log.Tracef(ctx, "authentication for %s NOT PASSED with algorithm %s: %s", pkOwnerURI, algo, err)
 	}
 
-	errWithCode := gtserror.NewErrorUnauthorized(fmt.Errorf("authentication not passed for public key owner %s; signature value was '%s'", pkOwnerURI, signature))
-	log.Debug(ctx, errWithCode)
-	return nil, errWithCode
+	return false
 }
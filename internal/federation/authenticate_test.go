@@ -0,0 +1,138 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-fed/httpsig"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AuthenticateTestSuite struct {
+	FederatorStandardTestSuite
+}
+
+func (suite *AuthenticateTestSuite) TestAuthenticateRotatedKey() {
+	ctx := context.Background()
+	activity := suite.testActivities["dm_for_zork"]
+	sendingAccount := suite.testAccounts["remote_account_1"]
+
+	// Serve the sending account, with its
+	// current public key, at its key URI.
+	person, err := suite.tc.AccountToAS(ctx, sendingAccount)
+	suite.NoError(err)
+	personI, err := streams.Serialize(person)
+	suite.NoError(err)
+	personJSON, err := json.Marshal(personI)
+	suite.NoError(err)
+
+	keyFetches := 0
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != sendingAccount.PublicKeyURI {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		}
+
+		keyFetches++
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Type": []string{"application/activity+json"}},
+			Body:          io.NopCloser(bytes.NewReader(personJSON)),
+			ContentLength: int64(len(personJSON)),
+		}, nil
+	}, "../../testrig/media")
+	tc := testrig.NewTestTransportController(&suite.state, httpClient)
+	federator := federation.NewFederator(suite.db, testrig.NewTestFederatingDB(&suite.state), tc, suite.tc, testrig.NewTestMediaManager(&suite.state))
+
+	// Pretend we have an old key stored for
+	// the account, from before it rotated.
+	rotateStoredKey := func() {
+		oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		suite.NoError(err)
+
+		account, err := suite.db.GetAccountByID(ctx, sendingAccount.ID)
+		suite.NoError(err)
+		account.PublicKey = &oldKey.PublicKey
+		suite.NoError(suite.db.UpdateAccount(ctx, account))
+	}
+	rotateStoredKey()
+
+	authenticate := func() error {
+		request := httptest.NewRequest(http.MethodPost, "http://localhost:8080/users/the_mighty_zork/inbox", nil)
+		request.Header.Set("Signature", activity.SignatureHeader)
+		request.Header.Set("Date", activity.DateHeader)
+		request.Header.Set("Digest", activity.DigestHeader)
+
+		verifier, err := httpsig.NewVerifier(request)
+		suite.NoError(err)
+
+		ctx := context.WithValue(ctx, ap.ContextRequestingPublicKeyVerifier, verifier)
+		ctx = context.WithValue(ctx, ap.ContextRequestingPublicKeySignature, activity.SignatureHeader)
+
+		_, errWithCode := federator.AuthenticateFederatedRequest(ctx, "the_mighty_zork")
+		if errWithCode != nil {
+			return errWithCode
+		}
+		return nil
+	}
+
+	// The signature doesn't verify with the stored key, so the
+	// key should be fetched again, stored, and the change recorded.
+	suite.NoError(authenticate())
+	suite.Equal(1, keyFetches)
+
+	account, err := suite.db.GetAccountByID(ctx, sendingAccount.ID)
+	suite.NoError(err)
+	suite.True(account.PublicKey.Equal(sendingAccount.PublicKey))
+
+	changes, err := suite.db.GetAccountKeyChanges(ctx, sendingAccount.ID, "", "", 0)
+	suite.NoError(err)
+	suite.Len(changes, 1)
+	suite.Equal(sendingAccount.PublicKeyURI, changes[0].PublicKeyURI)
+	suite.Contains(changes[0].NewPublicKey, "-----BEGIN PUBLIC KEY-----")
+	suite.NotEqual(changes[0].OldPublicKey, changes[0].NewPublicKey)
+
+	// Now the stored key is good, so no more fetching.
+	suite.NoError(authenticate())
+	suite.Equal(1, keyFetches)
+
+	// The key was refreshed recently, so a bad
+	// stored key shouldn't lead to another fetch.
+	rotateStoredKey()
+	suite.Error(authenticate())
+	suite.Equal(1, keyFetches)
+}
+
+func TestAuthenticateTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthenticateTestSuite))
+}
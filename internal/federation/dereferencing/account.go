@@ -256,10 +256,28 @@ func (d *deref) enrichAccount(ctx context.Context, requestUser string, uri *url.
 	latestAcc.ID = account.ID
 	latestAcc.FetchedAt = time.Now()
 
+	// Keep the existing moved-to account if this account still points to the
+	// same one, else leave it unset until we've processed a Move activity for it.
+	if latestAcc.MovedToURI != "" && latestAcc.MovedToURI == account.MovedToURI {
+		latestAcc.MovedToAccountID = account.MovedToAccountID
+	}
+
 	// Use the existing account media attachments by default.
 	latestAcc.AvatarMediaAttachmentID = account.AvatarMediaAttachmentID
 	latestAcc.HeaderMediaAttachmentID = account.HeaderMediaAttachmentID
 
+	rejectMedia, err := d.db.IsDomainMediaRejected(ctx, latestAcc.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("enrichAccount: error checking media rejection for %s: %w", latestAcc.Domain, err)
+	}
+
+	if rejectMedia {
+		// Don't fetch avatar or header from domains whose media we reject; treating
+		// them as removed also drops any we'd already fetched before the rejection.
+		latestAcc.AvatarRemoteURL = ""
+		latestAcc.HeaderRemoteURL = ""
+	}
+
 	if latestAcc.AvatarRemoteURL != account.AvatarRemoteURL {
 		// Reset the avatar media ID (handles removed).
 		latestAcc.AvatarMediaAttachmentID = ""
@@ -34,6 +34,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 )
 
@@ -230,6 +231,12 @@ func (d *deref) dereferenceStatusable(ctx context.Context, tsport transport.Tran
 			return nil, errors.New("DereferenceStatusable: error resolving type as ActivityStreamsProfile")
 		}
 		return p, nil
+	case ap.ActivityQuestion:
+		p, ok := t.(vocab.ActivityStreamsQuestion)
+		if !ok {
+			return nil, errors.New("DereferenceStatusable: error resolving type as ActivityStreamsQuestion")
+		}
+		return p, nil
 	}
 
 	return nil, newErrWrongType(fmt.Errorf("DereferenceStatusable: type name %s not supported as Statusable", t.GetTypeName()))
@@ -286,7 +293,9 @@ func (d *deref) populateStatusFields(ctx context.Context, status *gtsmodel.Statu
 	}
 
 	// 2. Hashtags
-	// TODO
+	if err := d.populateStatusTags(ctx, status); err != nil {
+		return fmt.Errorf("populateStatusFields: error populating status tags: %s", err)
+	}
 
 	// 3. Emojis
 	if err := d.populateStatusEmojis(ctx, status, requestingUsername); err != nil {
@@ -404,6 +413,28 @@ func (d *deref) populateStatusAttachments(ctx context.Context, status *gtsmodel.
 	// * the remote URL (a.RemoteURL)
 	// This should be enough to dereference the piece of media.
 
+	author := status.Account
+	if author == nil {
+		var err error
+		author, err = d.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("populateStatusAttachments: error getting status author %s: %w", status.AccountID, err)
+		}
+	}
+
+	rejectMedia, err := d.db.IsDomainMediaRejected(ctx, author.Domain)
+	if err != nil {
+		return fmt.Errorf("populateStatusAttachments: error checking media rejection for %s: %w", author.Domain, err)
+	}
+
+	if rejectMedia {
+		// Don't fetch anything from domains whose media we reject.
+		log.Debugf(ctx, "skipping %d attachment(s) of status %s, media from %s is rejected", len(status.Attachments), status.URI, author.Domain)
+		status.AttachmentIDs = []string{}
+		status.Attachments = []*gtsmodel.MediaAttachment{}
+		return nil
+	}
+
 	attachmentIDs := []string{}
 	attachments := []*gtsmodel.MediaAttachment{}
 
@@ -455,6 +486,58 @@ func (d *deref) populateStatusEmojis(ctx context.Context, status *gtsmodel.Statu
 	return nil
 }
 
+func (d *deref) populateStatusTags(ctx context.Context, status *gtsmodel.Status) error {
+	// At this point, tags will only have the name and href set on them,
+	// as extracted from the remote status. We use the name to find or
+	// create the corresponding tag on this instance.
+
+	tagIDs := make([]string, 0, len(status.Tags))
+	tags := make([]*gtsmodel.Tag, 0, len(status.Tags))
+	for _, t := range status.Tags {
+		if t.ID != "" {
+			// we've already populated this tag, since it has an ID
+			tagIDs = append(tagIDs, t.ID)
+			tags = append(tags, t)
+			continue
+		}
+
+		name, ok := text.NormalizeHashtag(t.Name)
+		if !ok {
+			log.Debugf(ctx, "skipping invalid hashtag %q", t.Name)
+			continue
+		}
+
+		tag, err := d.db.TagStringToTag(ctx, name, status.AccountID)
+		if err != nil {
+			// most likely the tag just isn't useable
+			log.Debugf(ctx, "skipping hashtag %q: %s", name, err)
+			continue
+		}
+
+		if err := d.db.Put(ctx, tag); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+			return fmt.Errorf("populateStatusTags: error putting tag %s in the db: %s", name, err)
+		}
+
+		// only append if it's not been listed yet
+		listed := false
+		for _, id := range tagIDs {
+			if id == tag.ID {
+				listed = true
+				break
+			}
+		}
+
+		if !listed {
+			tagIDs = append(tagIDs, tag.ID)
+			tags = append(tags, tag)
+		}
+	}
+
+	status.Tags = tags
+	status.TagIDs = tagIDs
+	return nil
+}
+
 func (d *deref) populateStatusRepliedTo(ctx context.Context, status *gtsmodel.Status, requestingUsername string) error {
 	if status.InReplyToURI != "" && status.InReplyToID == "" {
 		statusURI, err := url.Parse(status.InReplyToURI)
@@ -168,6 +168,32 @@ func (suite *StatusTestSuite) TestDereferenceStatusWithImageAndNoContent() {
 	suite.NoError(err)
 }
 
+func (suite *StatusTestSuite) TestDereferenceStatusWithImageRejectedMedia() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	err := suite.db.CreateDomainBlock(context.Background(), &gtsmodel.DomainBlock{
+		ID:                 "01H0P1WQ6S3X2J8N5C7K4B9D1E",
+		Domain:             "turnip.farm",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		Severity:           gtsmodel.DomainBlockSeverityLimit,
+		RejectMedia:        testrig.TrueBool(),
+	})
+	suite.NoError(err)
+
+	statusURL := testrig.URLMustParse("https://turnip.farm/users/turniplover6969/statuses/70c53e54-3146-42d5-a630-83c8b6c7c042")
+	status, _, err := suite.dereferencer.GetStatus(context.Background(), fetchingAccount.Username, statusURL, false, false)
+	suite.NoError(err)
+	suite.NotNil(status)
+
+	// limited domains are still dereferenced, but the attachment should have been skipped
+	suite.Empty(status.AttachmentIDs)
+	suite.Empty(status.Attachments)
+
+	a := &gtsmodel.MediaAttachment{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{{Key: "status_id", Value: status.ID}}, a)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
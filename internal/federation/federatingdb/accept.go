@@ -43,7 +43,7 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 		l.Debug("entering Accept")
 	}
 
-	receivingAccount, _ := extractFromCtx(ctx)
+	receivingAccount, requestingAccount := extractFromCtx(ctx)
 	if receivingAccount == nil {
 		// If the receiving account  wasn't set on the context, that means this request didn't pass
 		// through the API, but came from inside GtS as the result of another activity on this instance. That being so,
@@ -61,6 +61,13 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 		if iter.IsIRI() {
 			// we have just the URI of whatever is being accepted, so we need to find out what it is
 			acceptedObjectIRI := iter.GetIRI()
+
+			// ACCEPT RELAY FOLLOW
+			// (the instance account's username doesn't fit a follow path)
+			if isRelay, err := f.relayFollowResponse(ctx, acceptedObjectIRI, requestingAccount, gtsmodel.RelayStateAccepted); isRelay || err != nil {
+				return err
+			}
+
 			if uris.IsFollowPath(acceptedObjectIRI) {
 				// ACCEPT FOLLOW
 				gtsFollowRequest := &gtsmodel.FollowRequest{}
@@ -98,6 +105,12 @@ func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsA
 			if !ok {
 				return errors.New("ACCEPT: couldn't parse follow into vocab.ActivityStreamsFollow")
 			}
+			// ACCEPT RELAY FOLLOW
+			if id := asFollow.GetJSONLDId(); id != nil && id.IsIRI() {
+				if isRelay, err := f.relayFollowResponse(ctx, id.GetIRI(), requestingAccount, gtsmodel.RelayStateAccepted); isRelay || err != nil {
+					return err
+				}
+			}
 			// convert the follow to something we can understand
 			gtsFollow, err := f.typeConverter.ASFollowToFollow(ctx, asFollow)
 			if err != nil {
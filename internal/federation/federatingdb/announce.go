@@ -39,7 +39,7 @@ func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStre
 		l.Debug("entering Announce")
 	}
 
-	receivingAccount, _ := extractFromCtx(ctx)
+	receivingAccount, requestingAccount := extractFromCtx(ctx)
 	if receivingAccount == nil {
 		// If the receiving account wasn't set on the context, that means this request didn't pass
 		// through the API, but came from inside GtS as the result of another activity on this instance. That being so,
@@ -47,6 +47,30 @@ func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStre
 		return nil
 	}
 
+	relay, err := f.relayForActor(ctx, requestingAccount)
+	if err != nil {
+		return fmt.Errorf("Announce: %w", err)
+	}
+
+	if relay != nil {
+		// Relays Announce the statuses they distribute rather
+		// than boosting them, so treat the announced status as a
+		// forward, and have the processor dereference it by IRI.
+		statusIRI, err := ap.ExtractObject(announce)
+		if err != nil {
+			return fmt.Errorf("Announce: error getting object from relay announce: %w", err)
+		}
+
+		f.state.Workers.EnqueueFederator(ctx, messages.FromFederator{
+			APObjectType:     ap.ObjectNote,
+			APActivityType:   ap.ActivityCreate,
+			APIri:            statusIRI,
+			ReceivingAccount: receivingAccount,
+		})
+
+		return nil
+	}
+
 	boost, isNew, err := f.typeConverter.ASAnnounceToStatus(ctx, announce)
 	if err != nil {
 		return fmt.Errorf("Announce: error converting announce to boost: %s", err)
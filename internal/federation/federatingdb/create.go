@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
@@ -146,8 +147,25 @@ func (f *federatingDB) activityCreate(ctx context.Context, asType vocab.Type, re
 		asObjectTypeName := asObjectType.GetTypeName()
 		switch asObjectTypeName {
 		case ap.ObjectNote:
+			note := objectIter.GetActivityStreamsNote()
+
+			// CREATE A POLL VOTE
+			isVote, err := f.createPollVote(ctx, note, requestingAccount)
+			if err != nil {
+				errs = append(errs, err.Error())
+				continue
+			}
+			if isVote {
+				continue
+			}
+
 			// CREATE A NOTE
-			if err := f.createNote(ctx, objectIter.GetActivityStreamsNote(), receivingAccount, requestingAccount); err != nil {
+			if err := f.createStatusable(ctx, note, receivingAccount, requestingAccount); err != nil {
+				errs = append(errs, err.Error())
+			}
+		case ap.ActivityQuestion:
+			// CREATE A QUESTION
+			if err := f.createStatusable(ctx, objectIter.GetActivityStreamsQuestion(), receivingAccount, requestingAccount); err != nil {
 				errs = append(errs, err.Error())
 			}
 		default:
@@ -162,8 +180,8 @@ func (f *federatingDB) activityCreate(ctx context.Context, asType vocab.Type, re
 	return nil
 }
 
-// createNote handles a Create activity with a Note type.
-func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStreamsNote, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) error {
+// createStatusable handles a Create activity with a Statusable type, such as a Note or a Question.
+func (f *federatingDB) createStatusable(ctx context.Context, statusable ap.Statusable, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) error {
 	l := log.WithContext(ctx).
 		WithFields(kv.Fields{
 			{"receivingAccount", receivingAccount.URI},
@@ -175,9 +193,9 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	forward := true
 
 	// note should have an attributedTo
-	noteAttributedTo := note.GetActivityStreamsAttributedTo()
+	noteAttributedTo := statusable.GetActivityStreamsAttributedTo()
 	if noteAttributedTo == nil {
-		return errors.New("createNote: note had no attributedTo")
+		return errors.New("createStatusable: note had no attributedTo")
 	}
 
 	// compare the attributedTo(s) with the actor who posted this to our inbox
@@ -195,7 +213,7 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	// If we do have a forward, we should ignore the content for now and just dereference based on the URL/ID of the note instead, to get the note straight from the horse's mouth
 	if forward {
 		l.Trace("note is a forward")
-		id := note.GetJSONLDId()
+		id := statusable.GetJSONLDId()
 		if !id.IsIRI() {
 			// if the note id isn't an IRI, there's nothing we can do here
 			return nil
@@ -213,9 +231,9 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 
 	// if we reach this point, we know it's not a forwarded status, so proceed with processing it as normal
 
-	status, err := f.typeConverter.ASStatusToStatus(ctx, note)
+	status, err := f.typeConverter.ASStatusToStatus(ctx, statusable)
 	if err != nil {
-		return fmt.Errorf("createNote: error converting note to status: %s", err)
+		return fmt.Errorf("createStatusable: error converting note to status: %s", err)
 	}
 
 	// id the status based on the time it was created
@@ -232,7 +250,7 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 			return nil
 		}
 		// an actual error has happened
-		return fmt.Errorf("createNote: database error inserting status: %s", err)
+		return fmt.Errorf("createStatusable: database error inserting status: %s", err)
 	}
 
 	f.state.Workers.EnqueueFederator(ctx, messages.FromFederator{
@@ -245,6 +263,124 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	return nil
 }
 
+// createPollVote handles a Create activity with a Note type which
+// may be a vote in a poll of one of our local statuses. A vote is a
+// Note with a name (the chosen option) but no content, which is in
+// reply to the status with the poll.
+//
+// If the note is not a poll vote, false is returned, and the caller
+// should handle the note as a status instead.
+func (f *federatingDB) createPollVote(ctx context.Context, note vocab.ActivityStreamsNote, requestingAccount *gtsmodel.Account) (bool, error) {
+	name := ap.ExtractName(note)
+	if name == "" || ap.ExtractContent(note) != "" {
+		return false, nil
+	}
+
+	inReplyToURI := ap.ExtractInReplyToURI(note)
+	if inReplyToURI == nil {
+		return false, nil
+	}
+
+	status, err := f.state.DB.GetStatusByURI(ctx, inReplyToURI.String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not a status we know of
+			return false, nil
+		}
+		return false, fmt.Errorf("createPollVote: database error getting status %s: %w", inReplyToURI, err)
+	}
+
+	if status.PollID == "" || status.Poll == nil || !*status.Local {
+		// votes are only sent to the
+		// author of a poll, ie., us
+		return false, nil
+	}
+
+	// from here on in this is definitely a vote
+	l := log.WithContext(ctx).
+		WithFields(kv.Fields{
+			{"requestingAccount", requestingAccount.URI},
+			{"status", status.URI},
+		}...)
+
+	if attributedTo, err := ap.ExtractAttributedTo(note); err != nil || attributedTo.String() != requestingAccount.URI {
+		l.Debug("ignoring poll vote not attributed to requesting account")
+		return true, nil
+	}
+
+	poll := status.Poll
+	if poll.Closed() || poll.Expired(time.Now()) {
+		l.Debug("ignoring vote in closed poll")
+		return true, nil
+	}
+
+	choice := -1
+	for i, option := range poll.Options {
+		if option == name {
+			choice = i
+			break
+		}
+	}
+	if choice == -1 {
+		l.Debugf("ignoring vote for unknown poll option %s", name)
+		return true, nil
+	}
+
+	vote, err := f.state.DB.GetPollVoteBy(ctx, poll.ID, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return true, fmt.Errorf("createPollVote: database error getting poll vote: %w", err)
+	}
+
+	if vote != nil {
+		// multiple choice votes are sent
+		// as one Note per chosen option
+		if !*poll.Multiple {
+			l.Debug("ignoring repeat vote in single choice poll")
+			return true, nil
+		}
+
+		for _, c := range vote.Choices {
+			if c == choice {
+				// already counted
+				return true, nil
+			}
+		}
+
+		if err := f.state.DB.AddPollVoteChoices(ctx, vote, []int{choice}); err != nil {
+			return true, fmt.Errorf("createPollVote: database error updating poll vote: %w", err)
+		}
+	} else {
+		vote = &gtsmodel.PollVote{
+			ID:        id.NewULID(),
+			Choices:   []int{choice},
+			AccountID: requestingAccount.ID,
+			Account:   requestingAccount,
+			PollID:    poll.ID,
+			Poll:      poll,
+		}
+
+		err := f.state.DB.PutPollVote(ctx, vote)
+		if errors.Is(err, db.ErrAlreadyExists) && *poll.Multiple {
+			// another Note for this multiple choice
+			// vote was stored in the meantime, add to it
+			vote, err = f.state.DB.GetPollVoteBy(ctx, poll.ID, requestingAccount.ID)
+			if err != nil {
+				return true, fmt.Errorf("createPollVote: database error getting poll vote: %w", err)
+			}
+			err = f.state.DB.AddPollVoteChoices(ctx, vote, []int{choice})
+		}
+
+		if err != nil {
+			if errors.Is(err, db.ErrAlreadyExists) {
+				return true, nil
+			}
+			return true, fmt.Errorf("createPollVote: database error storing poll vote: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
 /*
 	FOLLOW HANDLERS
 */
@@ -21,11 +21,13 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type CreateTestSuite struct {
@@ -128,6 +130,80 @@ func (suite *CreateTestSuite) TestCreateFlag1() {
 	}
 }
 
+func (suite *CreateTestSuite) TestCreatePollVote() {
+	ctx := context.Background()
+	pollAuthor := suite.testAccounts["local_account_1"]
+	voter := suite.testAccounts["remote_account_1"]
+	pollStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// attach a poll to the status
+	poll := &gtsmodel.Poll{
+		ID:         "01GYGTSV8K2ZMTQKFJ9J4YMPZQ",
+		Multiple:   testrig.FalseBool(),
+		HideCounts: testrig.FalseBool(),
+		Options:    []string{"tea", "coffee"},
+		Votes:      []int{0, 0},
+		Voters:     new(int),
+		StatusID:   pollStatus.ID,
+		ExpiresAt:  time.Now().Add(time.Hour),
+	}
+	if err := suite.db.PutPoll(ctx, poll); err != nil {
+		suite.FailNow(err.Error())
+	}
+	pollStatus.PollID = poll.ID
+	if err := suite.db.UpdateStatus(ctx, pollStatus, "poll_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "` + voter.URI + `",
+  "id": "` + voter.URI + `#votes/1/activity",
+  "object": {
+    "attributedTo": "` + voter.URI + `",
+    "id": "` + voter.URI + `#votes/1",
+    "inReplyTo": "` + pollStatus.URI + `",
+    "name": "coffee",
+    "to": "` + pollAuthor.URI + `",
+    "type": "Note"
+  },
+  "to": "` + pollAuthor.URI + `",
+  "type": "Create"
+}`
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(ctx, m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.federatingDB.Create(createTestContext(pollAuthor, voter), t); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// the vote should be in the database
+	vote, err := suite.db.GetPollVoteBy(ctx, poll.ID, voter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{1}, vote.Choices)
+
+	// and counted in the poll
+	dbPoll, err := suite.db.GetPollByID(ctx, poll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{0, 1}, dbPoll.Votes)
+	suite.Equal(1, *dbPoll.Voters)
+
+	// no status should have been created for the vote
+	suite.Empty(suite.fromFederator)
+}
+
 func TestCreateTestSuite(t *testing.T) {
 	suite.Run(t, &CreateTestSuite{})
 }
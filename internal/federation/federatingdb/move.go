@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"codeberg.org/gruf/go-logger/v2/level"
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+func (f *federatingDB) Move(ctx context.Context, move vocab.ActivityStreamsMove) error {
+	if log.Level() >= level.DEBUG {
+		i, err := marshalItem(move)
+		if err != nil {
+			return err
+		}
+		l := log.WithContext(ctx).
+			WithField("move", i)
+		l.Debug("entering Move")
+	}
+
+	receivingAccount, requestingAccount := extractFromCtx(ctx)
+	if receivingAccount == nil || requestingAccount == nil {
+		// If the receiving account wasn't set on the context, that means this request didn't pass
+		// through the API, but came from inside GtS as the result of another activity on this instance. That being so,
+		// we can safely just ignore this activity, since we know we've already processed it elsewhere.
+		return nil
+	}
+
+	// an account can only move itself, so the object
+	// of the move must be the account that sent it
+	objectProp := move.GetActivityStreamsObject()
+	if objectProp == nil || objectProp.Len() != 1 {
+		return errors.New("Move: move object was not set or had more than one value")
+	}
+
+	objectIRI, err := pub.ToId(objectProp.At(0))
+	if err != nil {
+		return fmt.Errorf("Move: error getting move object id: %w", err)
+	}
+
+	if objectIRI.String() != requestingAccount.URI {
+		return fmt.Errorf("Move: move object %s was not the requesting account %s", objectIRI, requestingAccount.URI)
+	}
+
+	targetProp := move.GetActivityStreamsTarget()
+	if targetProp == nil || targetProp.Len() != 1 {
+		return errors.New("Move: move target was not set or had more than one value")
+	}
+
+	targetIRI, err := pub.ToId(targetProp.At(0))
+	if err != nil {
+		return fmt.Errorf("Move: error getting move target id: %w", err)
+	}
+
+	if targetIRI.String() == requestingAccount.URI {
+		return errors.New("Move: account cannot move to itself")
+	}
+
+	if requestingAccount.MovedToURI == targetIRI.String() && requestingAccount.MovedToAccountID != "" {
+		// we've already processed this move, likely
+		// because it was delivered to more than one inbox
+		return nil
+	}
+
+	// pass the move back to the processor async for verifying the target and moving followers
+	f.state.Workers.EnqueueFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ObjectProfile,
+		APActivityType:   ap.ActivityMove,
+		APIri:            targetIRI,
+		GTSModel:         requestingAccount,
+		ReceivingAccount: receivingAccount,
+	})
+
+	return nil
+}
@@ -42,7 +42,7 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 		l.Debug("entering Reject")
 	}
 
-	receivingAccount, _ := extractFromCtx(ctx)
+	receivingAccount, requestingAccount := extractFromCtx(ctx)
 	if receivingAccount == nil {
 		// If the receiving account or federator channel wasn't set on the context, that means this request didn't pass
 		// through the API, but came from inside GtS as the result of another activity on this instance. That being so,
@@ -60,6 +60,13 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 		if iter.IsIRI() {
 			// we have just the URI of whatever is being rejected, so we need to find out what it is
 			rejectedObjectIRI := iter.GetIRI()
+
+			// REJECT RELAY FOLLOW
+			// (the instance account's username doesn't fit a follow path)
+			if isRelay, err := f.relayFollowResponse(ctx, rejectedObjectIRI, requestingAccount, gtsmodel.RelayStateRejected); isRelay || err != nil {
+				return err
+			}
+
 			if uris.IsFollowPath(rejectedObjectIRI) {
 				// REJECT FOLLOW
 				gtsFollowRequest := &gtsmodel.FollowRequest{}
@@ -92,6 +99,12 @@ func (f *federatingDB) Reject(ctx context.Context, reject vocab.ActivityStreamsR
 			if !ok {
 				return errors.New("Reject: couldn't parse follow into vocab.ActivityStreamsFollow")
 			}
+			// REJECT RELAY FOLLOW
+			if id := asFollow.GetJSONLDId(); id != nil && id.IsIRI() {
+				if isRelay, err := f.relayFollowResponse(ctx, id.GetIRI(), requestingAccount, gtsmodel.RelayStateRejected); isRelay || err != nil {
+					return err
+				}
+			}
 			// convert the follow to something we can understand
 			gtsFollow, err := f.typeConverter.ASFollowToFollow(ctx, asFollow)
 			if err != nil {
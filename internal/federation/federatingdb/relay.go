@@ -0,0 +1,94 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// relayFollowResponse handles an Accept or Reject by requestingAccount
+// of the Follow with the given IRI, if that Follow was sent to a relay,
+// setting the relay to the given state. If the Follow wasn't sent to a
+// relay, false is returned, so that the Follow can be handled as usual.
+func (f *federatingDB) relayFollowResponse(ctx context.Context, followIRI *url.URL, requestingAccount *gtsmodel.Account, state gtsmodel.RelayState) (bool, error) {
+	relay, err := f.state.DB.GetRelayByFollowURI(ctx, followIRI.String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Not a relay Follow.
+			return false, nil
+		}
+		return false, fmt.Errorf("relayFollowResponse: db error getting relay: %w", err)
+	}
+
+	if requestingAccount == nil {
+		return true, errors.New("relayFollowResponse: no requesting account")
+	}
+
+	// Relay actors usually live on the same host as
+	// the relay inbox; don't let anyone else respond
+	// to the Follow on behalf of the relay.
+	inboxURI, err := url.Parse(relay.InboxURI)
+	if err != nil {
+		return true, fmt.Errorf("relayFollowResponse: error parsing relay inbox uri: %w", err)
+	}
+
+	actorURI, err := url.Parse(requestingAccount.URI)
+	if err != nil {
+		return true, fmt.Errorf("relayFollowResponse: error parsing requesting account uri: %w", err)
+	}
+
+	if actorURI.Host != inboxURI.Host {
+		return true, fmt.Errorf("relayFollowResponse: %s is not an actor of relay %s", requestingAccount.URI, relay.InboxURI)
+	}
+
+	relay.State = state
+	relay.ActorURI = requestingAccount.URI
+	if err := f.state.DB.UpdateRelay(ctx, relay, "state", "actor_uri"); err != nil {
+		return true, fmt.Errorf("relayFollowResponse: db error updating relay: %w", err)
+	}
+
+	return true, nil
+}
+
+// relayForActor returns the relay which requestingAccount is the actor of,
+// if the relay has accepted this instance's subscription, or nil otherwise.
+func (f *federatingDB) relayForActor(ctx context.Context, requestingAccount *gtsmodel.Account) (*gtsmodel.Relay, error) {
+	if requestingAccount == nil || requestingAccount.Domain == "" {
+		return nil, nil
+	}
+
+	relay, err := f.state.DB.GetRelayByActorURI(ctx, requestingAccount.URI)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("relayForActor: db error getting relay: %w", err)
+	}
+
+	if relay.State != gtsmodel.RelayStateAccepted {
+		return nil, nil
+	}
+
+	return relay, nil
+}
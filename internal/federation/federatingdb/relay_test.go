@@ -0,0 +1,131 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RelayTestSuite struct {
+	FederatingDBTestSuite
+}
+
+// putRelay puts a pending relay, with its inbox
+// on the host of remote_account_1, in the database.
+func (suite *RelayTestSuite) putRelay(instanceAccount *gtsmodel.Account) *gtsmodel.Relay {
+	relay := &gtsmodel.Relay{
+		ID:                 "01H0G6WQ52VX2EW1TC7J5S9B4F",
+		InboxURI:           "http://fossbros-anonymous.io/inbox",
+		FollowURI:          uris.GenerateURIForFollow(instanceAccount.Username, "01H0G6WQ52VX2EW1TC7J5S9B4F"),
+		State:              gtsmodel.RelayStatePending,
+		Publish:            testrig.FalseBool(),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.db.PutRelay(context.Background(), relay); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return relay
+}
+
+func (suite *RelayTestSuite) acceptOf(followURI string) vocab.ActivityStreamsAccept {
+	followIRI, err := url.Parse(followURI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	accept := streams.NewActivityStreamsAccept()
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(followIRI)
+	accept.SetActivityStreamsObject(objectProp)
+
+	return accept
+}
+
+func (suite *RelayTestSuite) TestAcceptThenAnnounce() {
+	instanceAccount, err := suite.db.GetInstanceAccount(context.Background(), "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	relayActor := suite.testAccounts["remote_account_1"]
+	relay := suite.putRelay(instanceAccount)
+
+	ctx := createTestContext(instanceAccount, relayActor)
+	err = suite.federatingDB.Accept(ctx, suite.acceptOf(relay.FollowURI))
+	suite.NoError(err)
+
+	relay, err = suite.db.GetRelayByID(context.Background(), relay.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.RelayStateAccepted, relay.State)
+	suite.Equal(relayActor.URI, relay.ActorURI)
+
+	// Now the relay has accepted, announces from the relay actor
+	// should be handled as forwarded statuses, rather than boosts.
+	announce := suite.testActivities["announce_forwarded_1_zork"].Activity.(vocab.ActivityStreamsAnnounce)
+	err = suite.federatingDB.Announce(ctx, announce)
+	suite.NoError(err)
+
+	announced, err := ap.ExtractObject(announce)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+	suite.Nil(msg.GTSModel)
+	suite.Equal(announced.String(), msg.APIri.String())
+	suite.Equal(instanceAccount.ID, msg.ReceivingAccount.ID)
+}
+
+func (suite *RelayTestSuite) TestAcceptFromWrongHost() {
+	instanceAccount, err := suite.db.GetInstanceAccount(context.Background(), "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	relay := suite.putRelay(instanceAccount)
+
+	// remote_account_2 doesn't live on the relay's host.
+	ctx := createTestContext(instanceAccount, suite.testAccounts["remote_account_2"])
+	err = suite.federatingDB.Accept(ctx, suite.acceptOf(relay.FollowURI))
+	suite.Error(err)
+
+	relay, err = suite.db.GetRelayByID(context.Background(), relay.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.RelayStatePending, relay.State)
+	suite.Empty(relay.ActorURI)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, &RelayTestSuite{})
+}
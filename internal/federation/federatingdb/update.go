@@ -21,12 +21,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 )
@@ -145,5 +148,140 @@ func (f *federatingDB) Update(ctx context.Context, asType vocab.Type) error {
 		})
 	}
 
+	if typeName == ap.ObjectNote || typeName == ap.ActivityQuestion {
+		// it's an UPDATE to a status, either an edit of
+		// its content, or new vote counts of its poll
+		statusable, ok := asType.(ap.Statusable)
+		if !ok {
+			return errors.New("UPDATE: could not convert type to statusable")
+		}
+
+		return f.updateStatusable(ctx, statusable, requestingAcct, receivingAccount)
+	}
+
+	return nil
+}
+
+// updateStatusable updates a remote status we already know about using
+// the given statusable, storing the previous version of the status in
+// its edit history if the content, content warning or sensitivity of
+// the status changed. If the statusable is a question, the poll of the
+// status is updated too.
+func (f *federatingDB) updateStatusable(ctx context.Context, statusable ap.Statusable, requestingAcct *gtsmodel.Account, receivingAccount *gtsmodel.Account) error {
+	idProp := statusable.GetJSONLDId()
+	if idProp == nil || !idProp.IsIRI() {
+		return errors.New("UPDATE: status had no id")
+	}
+
+	status, err := f.state.DB.GetStatusByURI(ctx, idProp.GetIRI().String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// we don't know this status,
+			// so nothing to update
+			return nil
+		}
+		return fmt.Errorf("UPDATE: database error getting status: %w", err)
+	}
+
+	if status.AccountURI != requestingAcct.URI {
+		return fmt.Errorf("UPDATE: update for status %s was requested by account %s, this is not valid", status.URI, requestingAcct.URI)
+	}
+
+	if *status.Local {
+		// no need to update local statuses
+		return nil
+	}
+
+	if pollable, ok := statusable.(ap.Pollable); ok && status.Poll != nil {
+		if err := f.updatePoll(ctx, status.Poll, pollable); err != nil {
+			return err
+		}
+	}
+
+	content := ap.ExtractContent(statusable)
+	contentWarning := ap.ExtractSummary(statusable)
+	sensitive := ap.ExtractSensitive(statusable)
+
+	if content == status.Content &&
+		contentWarning == status.ContentWarning &&
+		sensitive == *status.Sensitive {
+		// nothing was edited
+		return nil
+	}
+
+	// store the current version of
+	// the status in its edit history
+	edit := &gtsmodel.StatusEdit{
+		ID:             id.NewULID(),
+		CreatedAt:      status.EditedAt,
+		Content:        status.Content,
+		ContentWarning: status.ContentWarning,
+		Text:           status.Text,
+		Language:       status.Language,
+		Sensitive:      status.Sensitive,
+		AttachmentIDs:  status.AttachmentIDs,
+		StatusID:       status.ID,
+	}
+	if edit.CreatedAt.IsZero() {
+		edit.CreatedAt = status.CreatedAt
+	}
+	if status.Poll != nil {
+		edit.PollOptions = status.Poll.Options
+	}
+
+	if err := f.state.DB.PutStatusEdit(ctx, edit); err != nil {
+		return fmt.Errorf("UPDATE: database error putting status edit: %w", err)
+	}
+
+	status.Content = content
+	status.ContentWarning = contentWarning
+	status.Sensitive = &sensitive
+	status.EditedAt = ap.ExtractUpdated(statusable)
+	if status.EditedAt.IsZero() {
+		status.EditedAt = time.Now()
+	}
+
+	if err := f.state.DB.UpdateStatus(ctx, status, "content", "content_warning", "sensitive", "edited_at"); err != nil {
+		return fmt.Errorf("UPDATE: database error updating status: %w", err)
+	}
+
+	// pass to the processor for updating of timelines and streams
+	f.state.Workers.EnqueueFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ObjectNote,
+		APActivityType:   ap.ActivityUpdate,
+		GTSModel:         status,
+		ReceivingAccount: receivingAccount,
+	})
+
+	return nil
+}
+
+// updatePoll updates the given poll of a remote status, using
+// the vote counts and closed state of the given pollable.
+// Updates to the poll options themselves are ignored.
+func (f *federatingDB) updatePoll(ctx context.Context, poll *gtsmodel.Poll, pollable ap.Pollable) error {
+	updated, err := ap.ExtractPoll(pollable)
+	if err != nil {
+		return fmt.Errorf("UPDATE: error extracting poll: %w", err)
+	}
+
+	if poll.Closed() || len(updated.Options) != len(poll.Options) {
+		return nil
+	}
+
+	poll.Votes = updated.Votes
+	poll.Voters = updated.Voters
+
+	if updated.Closed() && (poll.ExpiresAt.IsZero() || updated.ClosedAt.Before(poll.ExpiresAt)) {
+		// poll was closed early by the remote; bring expiry
+		// forward so it gets closed by us too, and any local
+		// voters get notified of the results
+		poll.ExpiresAt = updated.ClosedAt
+	}
+
+	if err := f.state.DB.UpdatePoll(ctx, poll, "votes", "voters", "expires_at"); err != nil {
+		return fmt.Errorf("UPDATE: database error updating poll: %w", err)
+	}
+
 	return nil
 }
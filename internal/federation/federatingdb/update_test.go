@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type UpdateTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *UpdateTestSuite) TestUpdateNote() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	asStatus, err := suite.tc.StatusToAS(context.Background(), targetStatus)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	note := asStatus.(vocab.ActivityStreamsNote)
+
+	contentProp := streams.NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString("dark souls status bot: \"thoughts of dog\" (edited)")
+	note.SetActivityStreamsContent(contentProp)
+
+	editedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	updatedProp := streams.NewActivityStreamsUpdatedProperty()
+	updatedProp.Set(editedAt)
+	note.SetActivityStreamsUpdated(updatedProp)
+
+	if err := suite.federatingDB.Update(ctx, note); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// should be a message heading to the processor now, which we can intercept here
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+
+	status := msg.GTSModel.(*gtsmodel.Status)
+	suite.Equal(targetStatus.ID, status.ID)
+	suite.Equal("dark souls status bot: \"thoughts of dog\" (edited)", status.Content)
+	suite.WithinDuration(editedAt, status.EditedAt, time.Second)
+
+	// the previous version should be stored
+	edits, err := suite.db.GetStatusEditsByStatusID(context.Background(), targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(edits, 1) {
+		suite.Equal(targetStatus.Content, edits[0].Content)
+		suite.Equal(targetStatus.AttachmentIDs, edits[0].AttachmentIDs)
+	}
+}
+
+func (suite *UpdateTestSuite) TestUpdateNoteWrongAccount() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_2"]
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	asStatus, err := suite.tc.StatusToAS(context.Background(), targetStatus)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	note := asStatus.(vocab.ActivityStreamsNote)
+
+	contentProp := streams.NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString("not my status to edit")
+	note.SetActivityStreamsContent(contentProp)
+
+	suite.Error(suite.federatingDB.Update(ctx, note))
+
+	dbStatus, err := suite.db.GetStatusByID(context.Background(), targetStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(targetStatus.Content, dbStatus.Content)
+	suite.True(dbStatus.EditedAt.IsZero())
+}
+
+func TestUpdateTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateTestSuite))
+}
@@ -117,6 +117,19 @@ func (f *federatingDB) NewID(ctx context.Context, t vocab.Type) (idURL *url.URL,
 				return idProp.GetIRI(), nil
 			}
 		}
+	case ap.ActivityQuestion:
+		// QUESTION aka STATUS WITH A POLL
+		// ID might already be set on a question we've created, so check it here and return it if it is
+		question, ok := t.(vocab.ActivityStreamsQuestion)
+		if !ok {
+			return nil, errors.New("newid: question couldn't be parsed into vocab.ActivityStreamsQuestion")
+		}
+		idProp := question.GetJSONLDId()
+		if idProp != nil {
+			if idProp.IsIRI() {
+				return idProp.GetIRI(), nil
+			}
+		}
 	case ap.ActivityLike:
 		// LIKE aka FAVE
 		// ID might already be set on a fave we've created, so check it here and return it if it is
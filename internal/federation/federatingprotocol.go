@@ -372,6 +372,9 @@ func (f *federator) FederatingCallbacks(ctx context.Context) (wrapped pub.Federa
 		func(ctx context.Context, announce vocab.ActivityStreamsAnnounce) error {
 			return f.FederatingDB().Announce(ctx, announce)
 		},
+		func(ctx context.Context, move vocab.ActivityStreamsMove) error {
+			return f.FederatingDB().Move(ctx, move)
+		},
 	}
 
 	return
@@ -398,8 +401,7 @@ func (f *federator) DefaultCallback(ctx context.Context, activity pub.Activity)
 //
 // Zero or negative numbers indicate infinite recursion.
 func (f *federator) MaxInboxForwardingRecursionDepth(ctx context.Context) int {
-	// TODO
-	return 4
+	return maxInboxForwardingRecursionDepth
 }
 
 // MaxDeliveryRecursionDepth determines how deep to search within
@@ -408,8 +410,7 @@ func (f *federator) MaxInboxForwardingRecursionDepth(ctx context.Context) int {
 //
 // Zero or negative numbers indicate infinite recursion.
 func (f *federator) MaxDeliveryRecursionDepth(ctx context.Context) int {
-	// TODO
-	return 4
+	return maxDeliveryRecursionDepth
 }
 
 // FilterForwarding allows the implementation to apply business logic
@@ -419,8 +420,42 @@ func (f *federator) MaxDeliveryRecursionDepth(ctx context.Context) int {
 //
 // The activity is provided as a reference for more intelligent
 // logic to be used, but the implementation must not modify it.
+//
+// go-fed delivers a forwarded activity straight to the items of the
+// returned collections, but the items of our followers collections are
+// actor IRIs rather than inboxes. So for GoToSocial, we resolve and
+// filter the inboxes ourselves and do the forwarding here, returning
+// no collections for go-fed to deliver to.
 func (f *federator) FilterForwarding(ctx context.Context, potentialRecipients []*url.URL, a pub.Activity) ([]*url.URL, error) {
-	// TODO
+	receivingAccount, requestingAccount := receivingAndRequesting(ctx)
+	if receivingAccount == nil || requestingAccount == nil {
+		// Not an inbox POST that went through
+		// AuthenticatePostInbox, nothing to do.
+		return []*url.URL{}, nil
+	}
+
+	inboxes, err := f.forwardingRecipients(ctx, potentialRecipients, receivingAccount, requestingAccount)
+	if err != nil {
+		return nil, fmt.Errorf("FilterForwarding: %w", err)
+	}
+
+	if len(inboxes) == 0 {
+		return []*url.URL{}, nil
+	}
+
+	log.WithContext(ctx).
+		WithFields(kv.Fields{
+			{"activity", a.GetJSONLDId().Get()},
+			{"inboxes", len(inboxes)},
+		}...).
+		Debug("forwarding activity to followers")
+
+	if err := f.forwardActivity(ctx, a, receivingAccount, inboxes); err != nil {
+		// Don't fail the whole inbox POST, the
+		// activity itself was handled just fine.
+		log.Errorf(ctx, "error forwarding activity: %v", err)
+	}
+
 	return []*url.URL{}, nil
 }
 
@@ -26,6 +26,7 @@ import (
 
 	"github.com/go-fed/httpsig"
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -374,6 +375,164 @@ func (suite *FederatingProtocolTestSuite) TestBlocked4() {
 	suite.True(blocked)
 }
 
+func (suite *FederatingProtocolTestSuite) forwardingTestActivity(sendingAccount *gtsmodel.Account, inboxAccount *gtsmodel.Account) vocab.ActivityStreamsCreate {
+	// a reply from sendingAccount to a status of
+	// inboxAccount, addressed to inboxAccount's followers
+	note := testrig.NewAPNote(
+		testrig.URLMustParse(sendingAccount.URI+"/statuses/01H0AXWQ6B2TFYRVZQYCSCMMTE"),
+		testrig.URLMustParse(sendingAccount.URL+"/statuses/01H0AXWQ6B2TFYRVZQYCSCMMTE"),
+		testrig.TimeMustParse("2023-05-15T12:00:00+02:00"),
+		"i'm replying to you, and everyone following you gets to see it",
+		"",
+		testrig.URLMustParse(sendingAccount.URI),
+		[]*url.URL{testrig.URLMustParse(inboxAccount.URI)},
+		[]*url.URL{testrig.URLMustParse(inboxAccount.FollowersURI)},
+		false,
+		nil,
+		nil,
+	)
+	return testrig.WrapAPNoteInCreate(
+		testrig.URLMustParse(sendingAccount.URI+"/statuses/01H0AXWQ6B2TFYRVZQYCSCMMTE/activity"),
+		testrig.URLMustParse(sendingAccount.URI),
+		testrig.TimeMustParse("2023-05-15T12:00:00+02:00"),
+		note,
+	)
+}
+
+func (suite *FederatingProtocolTestSuite) followForForwarding(follower *gtsmodel.Account, target *gtsmodel.Account, id string) {
+	if err := suite.db.Put(context.Background(), &gtsmodel.Follow{
+		ID:              id,
+		AccountID:       follower.ID,
+		TargetAccountID: target.ID,
+		ShowReblogs:     testrig.TrueBool(),
+		URI:             follower.URI + "/follows/" + id,
+		Notify:          testrig.FalseBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *FederatingProtocolTestSuite) TestFilterForwarding() {
+	httpClient := testrig.NewMockHTTPClient(nil, "../../testrig/media")
+	tc := testrig.NewTestTransportController(&suite.state, httpClient)
+	federator := federation.NewFederator(suite.db, testrig.NewTestFederatingDB(&suite.state), tc, suite.tc, testrig.NewTestMediaManager(&suite.state))
+
+	sendingAccount := suite.testAccounts["remote_account_1"]
+	inboxAccount := suite.testAccounts["local_account_1"]
+	remoteFollower := suite.testAccounts["remote_account_2"]
+	suite.followForForwarding(remoteFollower, inboxAccount, "01H0AY3Y5X1D9XJ1QY1VJ0C5ZN")
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, ap.ContextReceivingAccount, inboxAccount)
+	ctx = context.WithValue(ctx, ap.ContextRequestingAccount, sendingAccount)
+
+	activity := suite.forwardingTestActivity(sendingAccount, inboxAccount)
+	potentialRecipients := []*url.URL{
+		testrig.URLMustParse(inboxAccount.FollowersURI),
+		// not ours to forward to
+		testrig.URLMustParse(suite.testAccounts["admin_account"].FollowersURI),
+	}
+
+	// we do the forwarding ourselves, so
+	// nothing should be left for go-fed
+	toSend, err := federator.FilterForwarding(ctx, potentialRecipients, activity)
+	suite.NoError(err)
+	suite.Empty(toSend)
+
+	// the remote follower should get the reply
+	if !testrig.WaitFor(func() bool {
+		_, ok := httpClient.SentMessages.Load(remoteFollower.InboxURI)
+		return ok
+	}) {
+		suite.FailNow("timed out waiting for forwarded activity")
+	}
+
+	// but the origin instance shouldn't get it back
+	_, ok := httpClient.SentMessages.Load(sendingAccount.InboxURI)
+	suite.False(ok)
+	_, ok = httpClient.SentMessages.Load(*sendingAccount.SharedInboxURI)
+	suite.False(ok)
+}
+
+func (suite *FederatingProtocolTestSuite) TestFilterForwardingBlockedDomain() {
+	httpClient := testrig.NewMockHTTPClient(nil, "../../testrig/media")
+	tc := testrig.NewTestTransportController(&suite.state, httpClient)
+	federator := federation.NewFederator(suite.db, testrig.NewTestFederatingDB(&suite.state), tc, suite.tc, testrig.NewTestMediaManager(&suite.state))
+
+	sendingAccount := suite.testAccounts["remote_account_1"]
+	inboxAccount := suite.testAccounts["local_account_1"]
+	blockedFollower := suite.testAccounts["remote_account_2"]
+	otherFollower := suite.testAccounts["remote_account_3"]
+	suite.followForForwarding(blockedFollower, inboxAccount, "01H0AY3Y5X1D9XJ1QY1VJ0C5ZN")
+	suite.followForForwarding(otherFollower, inboxAccount, "01H0AY8GQ8JXD0C8RX7S3B1V3T")
+
+	if err := suite.db.CreateDomainBlock(context.Background(), &gtsmodel.DomainBlock{
+		ID:                 "01H0AYA7YTB6WM4Y7D1A9QXQ9P",
+		Domain:             blockedFollower.Domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, ap.ContextReceivingAccount, inboxAccount)
+	ctx = context.WithValue(ctx, ap.ContextRequestingAccount, sendingAccount)
+
+	activity := suite.forwardingTestActivity(sendingAccount, inboxAccount)
+	potentialRecipients := []*url.URL{testrig.URLMustParse(inboxAccount.FollowersURI)}
+
+	toSend, err := federator.FilterForwarding(ctx, potentialRecipients, activity)
+	suite.NoError(err)
+	suite.Empty(toSend)
+
+	if !testrig.WaitFor(func() bool {
+		_, ok := httpClient.SentMessages.Load(otherFollower.InboxURI)
+		return ok
+	}) {
+		suite.FailNow("timed out waiting for forwarded activity")
+	}
+
+	// follower on the blocked domain should be left out
+	_, ok := httpClient.SentMessages.Load(blockedFollower.InboxURI)
+	suite.False(ok)
+}
+
+func (suite *FederatingProtocolTestSuite) TestFilterForwardingBlockedSender() {
+	httpClient := testrig.NewMockHTTPClient(nil, "../../testrig/media")
+	tc := testrig.NewTestTransportController(&suite.state, httpClient)
+	federator := federation.NewFederator(suite.db, testrig.NewTestFederatingDB(&suite.state), tc, suite.tc, testrig.NewTestMediaManager(&suite.state))
+
+	sendingAccount := suite.testAccounts["remote_account_1"]
+	inboxAccount := suite.testAccounts["local_account_1"]
+	suite.followForForwarding(suite.testAccounts["remote_account_2"], inboxAccount, "01H0AY3Y5X1D9XJ1QY1VJ0C5ZN")
+
+	// insert a block from inboxAccount targeting sendingAccount
+	if err := suite.db.PutBlock(context.Background(), &gtsmodel.Block{
+		ID:              "01H0AYF5TKJ6R3C4QAH4H1QEBS",
+		URI:             "whatever",
+		AccountID:       inboxAccount.ID,
+		TargetAccountID: sendingAccount.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, ap.ContextReceivingAccount, inboxAccount)
+	ctx = context.WithValue(ctx, ap.ContextRequestingAccount, sendingAccount)
+
+	activity := suite.forwardingTestActivity(sendingAccount, inboxAccount)
+	potentialRecipients := []*url.URL{testrig.URLMustParse(inboxAccount.FollowersURI)}
+
+	toSend, err := federator.FilterForwarding(ctx, potentialRecipients, activity)
+	suite.NoError(err)
+	suite.Empty(toSend)
+
+	// nothing should have been queued for delivery at all
+	depth, _, err := suite.db.CountDeliveries(context.Background())
+	suite.NoError(err)
+	suite.Zero(depth)
+}
+
 func TestFederatingProtocolTestSuite(t *testing.T) {
 	suite.Run(t, new(FederatingProtocolTestSuite))
 }
@@ -20,12 +20,15 @@ package federation
 import (
 	"context"
 	"net/url"
+	"time"
 
+	"codeberg.org/gruf/go-cache/v3"
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
@@ -63,6 +66,7 @@ type federator struct {
 	transportController transport.Controller
 	mediaManager        media.Manager
 	actor               pub.FederatingActor
+	publicKeyRefreshes  cache.Cache[string, struct{}]
 	dereferencing.Dereferencer
 }
 
@@ -78,8 +82,16 @@ func NewFederator(db db.DB, federatingDB federatingdb.DB, transportController tr
 		typeConverter:       typeConverter,
 		transportController: transportController,
 		mediaManager:        mediaManager,
+		publicKeyRefreshes:  cache.New[string, struct{}](0, 1000, 0),
 		Dereferencer:        dereferencer,
 	}
+
+	// Public key refreshes cache has TTL=publicKeyRefreshInterval freq=1min
+	f.publicKeyRefreshes.SetTTL(publicKeyRefreshInterval, false)
+	if !f.publicKeyRefreshes.Start(time.Minute) {
+		log.Panic(nil, "failed to start federator public key refreshes cache")
+	}
+
 	actor := newFederatingActor(f, f, federatingDB, clock)
 	f.actor = actor
 	return f
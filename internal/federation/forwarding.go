@@ -0,0 +1,181 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// maxInboxForwardingRecursionDepth is how deep into an incoming
+	// activity we look for something we own (eg., a local status being
+	// replied to), before deciding whether to forward it. Each level
+	// beyond embedded objects may mean dereferencing a remote IRI, so
+	// keep this fairly shallow; Create -> Note -> inReplyTo is depth 2.
+	maxInboxForwardingRecursionDepth = 3
+
+	// maxDeliveryRecursionDepth is how deep we'll look into collections
+	// owned by peers (eg., a remote followers collection containing
+	// another collection) when they're addressed in an outgoing activity.
+	maxDeliveryRecursionDepth = 4
+)
+
+// forwardingRecipients returns the inboxes which the activity received
+// in receivingAccount's inbox from requestingAccount should be forwarded
+// to, when it addressed the given collections owned by this instance.
+//
+// Only followers collections of the receiving account are considered,
+// so each local account forwards only to its own followers. Followers
+// on the origin instance, suspended followers, and followers on blocked
+// domains are left out, as is everyone if the receiving account blocks
+// (or is blocked by) the requesting account.
+func (f *federator) forwardingRecipients(ctx context.Context, collections []*url.URL, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) ([]*url.URL, error) {
+	var forwardToFollowers bool
+	for _, iri := range collections {
+		if iri.String() == receivingAccount.FollowersURI {
+			forwardToFollowers = true
+			break
+		}
+	}
+
+	if !forwardToFollowers {
+		// Nothing we're willing to forward to.
+		return nil, nil
+	}
+
+	blocked, err := f.db.IsBlocked(ctx, receivingAccount.ID, requestingAccount.ID, true)
+	if err != nil {
+		return nil, fmt.Errorf("error checking block between %s and %s: %w", receivingAccount.ID, requestingAccount.ID, err)
+	}
+
+	if blocked {
+		// Don't help spread the words
+		// of someone we've blocked.
+		return nil, nil
+	}
+
+	follows, err := f.db.GetAccountFollowedBy(ctx, receivingAccount.ID, false)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("error getting followers of %s: %w", receivingAccount.ID, err)
+	}
+
+	var (
+		inboxes = make([]*url.URL, 0, len(follows))
+		seen    = make(map[string]struct{}, len(follows))
+		domains = make(map[string]bool)
+	)
+
+	for _, follow := range follows {
+		follower := follow.Account
+		if follower == nil {
+			follower, err = f.db.GetAccountByID(ctx, follow.AccountID)
+			if err != nil {
+				if errors.Is(err, db.ErrNoEntries) {
+					// Follower has probably been
+					// deleted, nothing to do.
+					continue
+				}
+				return nil, fmt.Errorf("error getting follower %s: %w", follow.AccountID, err)
+			}
+		}
+
+		if follower.Domain == "" ||
+			follower.Domain == requestingAccount.Domain ||
+			!follower.SuspendedAt.IsZero() {
+			// Local followers already see the activity, and the
+			// origin instance sent it, so it already has it.
+			continue
+		}
+
+		blocked, ok := domains[follower.Domain]
+		if !ok {
+			blocked, err = f.db.IsDomainBlocked(ctx, follower.Domain)
+			if err != nil {
+				return nil, fmt.Errorf("error checking domain block for %s: %w", follower.Domain, err)
+			}
+			domains[follower.Domain] = blocked
+		}
+
+		if blocked {
+			continue
+		}
+
+		// Deliver to a shared inbox if we have that option.
+		inbox := follower.InboxURI
+		if config.GetInstanceDeliverToSharedInboxes() &&
+			follower.SharedInboxURI != nil && *follower.SharedInboxURI != "" {
+			inbox = *follower.SharedInboxURI
+		}
+
+		if _, ok := seen[inbox]; ok {
+			continue
+		}
+		seen[inbox] = struct{}{}
+
+		inboxIRI, err := url.Parse(inbox)
+		if err != nil {
+			log.Errorf(ctx, "error parsing inbox %s of follower %s: %v", inbox, follower.ID, err)
+			continue
+		}
+
+		inboxes = append(inboxes, inboxIRI)
+	}
+
+	return inboxes, nil
+}
+
+// forwardActivity forwards the activity received in receivingAccount's
+// inbox to the given inboxes, signed on behalf of receivingAccount.
+func (f *federator) forwardActivity(ctx context.Context, activity pub.Activity, receivingAccount *gtsmodel.Account, inboxes []*url.URL) error {
+	m, err := streams.Serialize(activity)
+	if err != nil {
+		return fmt.Errorf("error serializing activity: %w", err)
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("error marshaling activity: %w", err)
+	}
+
+	tp, err := f.transportController.NewTransportForUsername(ctx, receivingAccount.Username)
+	if err != nil {
+		return fmt.Errorf("error creating transport for %s: %w", receivingAccount.Username, err)
+	}
+
+	return tp.BatchDeliver(ctx, b, inboxes)
+}
+
+// receivingAndRequesting extracts the receiving and requesting
+// accounts set on the context by AuthenticatePostInbox.
+func receivingAndRequesting(ctx context.Context) (receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) {
+	receivingAccount, _ = ctx.Value(ap.ContextReceivingAccount).(*gtsmodel.Account)
+	requestingAccount, _ = ctx.Value(ap.ContextRequestingAccount).(*gtsmodel.Account)
+	return
+}
@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// publicKeyRefreshInterval is the minimum time between
+// refreshes of the same public key, so that requests with
+// bad signatures can't make us fetch a key over and over.
+const publicKeyRefreshInterval = 5 * time.Minute
+
+// refreshPublicKey dereferences the public key with the given ID again, in case the given remote account
+// has rotated its key since we last fetched it. If the fetched key differs from the one stored for the
+// account, the stored key is updated and the change is recorded, so that admins can spot suspicious key
+// changes. Each key is refreshed at most once per publicKeyRefreshInterval.
+//
+// The fetched key is returned, or nil if it couldn't be fetched, or is the same as the stored key.
+func (f *federator) refreshPublicKey(ctx context.Context, requestedUsername string, account *gtsmodel.Account, keyID *url.URL) crypto.PublicKey {
+	if f.publicKeyRefreshes.Has(keyID.String()) {
+		log.Debugf(ctx, "not refreshing public key %s, it was refreshed recently", keyID)
+		return nil
+	}
+	f.publicKeyRefreshes.Set(keyID.String(), struct{}{})
+
+	publicKey, pkOwnerURI, errWithCode := f.dereferencePublicKey(ctx, requestedUsername, keyID)
+	if errWithCode != nil {
+		log.Debugf(ctx, "couldn't refresh public key %s: %v", keyID, errWithCode)
+		return nil
+	}
+
+	if pkOwnerURI.String() != account.URI {
+		log.Warnf(ctx, "refreshed public key %s is owned by %s, not by account %s", keyID, pkOwnerURI, account.URI)
+		return nil
+	}
+
+	newKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		// We only store rsa keys for accounts,
+		// so just use this one for verification.
+		return publicKey
+	}
+
+	if account.PublicKey != nil && account.PublicKey.Equal(newKey) {
+		// Key hasn't changed, so
+		// there's no point retrying.
+		return nil
+	}
+
+	log.Warnf(ctx, "public key %s of account %s has changed", keyID, account.URI)
+
+	oldPEM, err := publicKeyPEM(account.PublicKey)
+	if err != nil {
+		log.Errorf(ctx, "error encoding old public key %s: %v", keyID, err)
+	}
+
+	newPEM, err := publicKeyPEM(newKey)
+	if err != nil {
+		log.Errorf(ctx, "error encoding new public key %s: %v", keyID, err)
+	}
+
+	if err := f.db.PutAccountKeyChange(ctx, &gtsmodel.AccountKeyChange{
+		ID:           id.NewULID(),
+		AccountID:    account.ID,
+		PublicKeyURI: keyID.String(),
+		OldPublicKey: oldPEM,
+		NewPublicKey: newPEM,
+	}); err != nil {
+		log.Errorf(ctx, "error storing key change for account %s: %v", account.URI, err)
+	}
+
+	account.PublicKey = newKey
+	if err := f.db.UpdateAccount(ctx, account); err != nil {
+		log.Errorf(ctx, "error updating public key of account %s: %v", account.URI, err)
+	}
+
+	return newKey
+}
+
+// publicKeyPEM returns the given public key as a PEM encoded PUBLIC KEY block.
+func publicKeyPEM(publicKey *rsa.PublicKey) (string, error) {
+	if publicKey == nil {
+		return "", nil
+	}
+
+	b, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: b,
+	})), nil
+}
@@ -50,8 +50,9 @@ type Account struct {
 	Note                    string           `validate:"-" bun:""`                                                                                                   // A note that this account has on their profile (ie., the account's bio/description of themselves)
 	NoteRaw                 string           `validate:"-" bun:""`                                                                                                   // The raw contents of .Note without conversion to HTML, only available when requester = target
 	Memorial                *bool            `validate:"-" bun:",default:false"`                                                                                     // Is this a memorial account, ie., has the user passed away?
-	AlsoKnownAs             string           `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                // This account is associated with x account id (TODO: migrate to be AlsoKnownAsID)
-	MovedToAccountID        string           `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                // This account has moved this account id in the database
+	AlsoKnownAsURIs         []string         `validate:"omitempty,dive,url" bun:"also_known_as_uris,array"`                                                          // ActivityPub URIs of other accounts this account is also known as, ie., aliases of this account which may move to it
+	MovedToURI              string           `validate:"omitempty,url" bun:",nullzero"`                                                                              // ActivityPub URI of the account this account has moved to, if any
+	MovedToAccountID        string           `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                // Database ID of the account this account has moved to, if known
 	Bot                     *bool            `validate:"-" bun:",default:false"`                                                                                     // Does this account identify itself as a bot?
 	Reason                  string           `validate:"-" bun:""`                                                                                                   // What reason was given for signing up when this account was created?
 	Locked                  *bool            `validate:"-" bun:",default:true"`                                                                                      // Does this account need an approval for new followers?
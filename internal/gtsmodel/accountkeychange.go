@@ -0,0 +1,33 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// AccountKeyChange records a change to the public key of a remote account (or instance actor),
+// noticed when an http signature didn't verify with the stored key but did with a freshly fetched one.
+// Admins can review these to spot accounts whose keys change suspiciously often, which may be impersonation.
+type AccountKeyChange struct {
+	ID           string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt    time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	AccountID    string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // ID of the account whose key changed
+	Account      *Account  `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to accountID
+	PublicKeyURI string    `validate:"required,url" bun:",nullzero,notnull"`                                // URI of the key that changed
+	OldPublicKey string    `validate:"required" bun:",nullzero,notnull"`                                    // PEM encoded public key from before the change
+	NewPublicKey string    `validate:"required" bun:",nullzero,notnull"`                                    // PEM encoded public key from after the change
+}
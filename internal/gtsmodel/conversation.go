@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Conversation represents a thread of direct messages, as seen by one local
+// account, between that account and a given set of other accounts.
+type Conversation struct {
+	ID               string     `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt        time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item created
+	UpdatedAt        time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item last updated
+	AccountID        string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:conversationthreadkey"` // id of the local account that owns this conversation
+	Account          *Account   `validate:"-" bun:"-"`                                                                       // account corresponding to accountID
+	OtherAccountIDs  []string   `validate:"dive,ulid" bun:"other_account_ids,array"`                                         // ids of all the other accounts participating in this conversation
+	OtherAccounts    []*Account `validate:"-" bun:"-"`                                                                       // accounts corresponding to otherAccountIDs
+	OtherAccountsKey string     `validate:"-" bun:",notnull,unique:conversationthreadkey"`                                   // sorted, comma-separated otherAccountIDs, used to look up the conversation for a given set of participants
+	ThreadID         string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:conversationthreadkey"` // id of the status at the root of the thread of this conversation
+	LastStatusID     string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                              // id of the most recent status in this conversation
+	LastStatus       *Status    `validate:"-" bun:"-"`                                                                       // status corresponding to lastStatusID
+	Read             *bool      `validate:"-" bun:",default:false"`                                                          // has the owning account read the latest status in this conversation?
+}
+
+// ConversationToStatus is an intermediate struct to facilitate the
+// many-to-many relationship between conversations and statuses.
+type ConversationToStatus struct {
+	ConversationID string        `validate:"ulid,required" bun:"type:CHAR(26),unique:conversationstatus,nullzero,notnull"`
+	Conversation   *Conversation `validate:"-" bun:"rel:belongs-to"`
+	StatusID       string        `validate:"ulid,required" bun:"type:CHAR(26),unique:conversationstatus,nullzero,notnull"`
+	Status         *Status       `validate:"-" bun:"rel:belongs-to"`
+}
@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Delivery represents one outgoing activity queued for delivery to a remote inbox.
+type Delivery struct {
+	ID            string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	PubKeyID      string    `validate:"required,url" bun:",nullzero,notnull"`                                // URI of the public key of the local account sending the activity, used to sign the request
+	ActivityID    string    `validate:"-" bun:",nullzero"`                                                   // id of the activity being delivered, if it has one
+	InboxURI      string    `validate:"required,url" bun:",nullzero,notnull"`                                // inbox (or shared inbox) to deliver the activity to
+	Host          string    `validate:"required" bun:",nullzero,notnull"`                                    // host of the inbox
+	Domain        string    `validate:"-" bun:",nullzero"`                                                   // domain of the instance the inbox belongs to, if known, which may differ from the host
+	Body          []byte    `validate:"required" bun:",nullzero,notnull"`                                    // serialized activity
+	Attempts      int       `validate:"-" bun:",notnull,default:0"`                                          // number of failed delivery attempts so far
+	NextAttemptAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull"`                           // when should delivery next be attempted
+	LastAttemptAt time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when was delivery last attempted
+	LastError     string    `validate:"-" bun:""`                                                            // error encountered during the last delivery attempt, if any
+}
+
+// DeliveryHost summarizes the queued deliveries to one host which have failed at least once.
+type DeliveryHost struct {
+	Host          string    // host of the inboxes
+	Deliveries    int       // number of failing deliveries queued for the host
+	FailingSince  time.Time // when was the oldest failing delivery to the host created
+	LastAttemptAt time.Time // when was delivery to the host last attempted
+}
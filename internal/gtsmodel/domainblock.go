@@ -21,14 +21,25 @@ import "time"
 
 // DomainBlock represents a federation block against a particular domain
 type DomainBlock struct {
-	ID                 string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
-	CreatedAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	Domain             string    `validate:"required,fqdn" bun:",nullzero,notnull"`                               // domain to block. Eg. 'whatever.com'
-	CreatedByAccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account ID of the creator of this block
-	CreatedByAccount   *Account  `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
-	PrivateComment     string    `validate:"-" bun:""`                                                            // Private comment on this block, viewable to admins
-	PublicComment      string    `validate:"-" bun:""`                                                            // Public comment on this block, viewable (optionally) by everyone
-	Obfuscate          *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // whether the domain name should appear obfuscated when displaying it publicly
-	SubscriptionID     string    `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // if this block was created through a subscription, what's the subscription ID?
+	ID                 string              `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt          time.Time           `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time           `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain             string              `validate:"required,fqdn" bun:",nullzero,notnull"`                               // domain to block. Eg. 'whatever.com'
+	CreatedByAccountID string              `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account ID of the creator of this block
+	CreatedByAccount   *Account            `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+	PrivateComment     string              `validate:"-" bun:""`                                                            // Private comment on this block, viewable to admins
+	PublicComment      string              `validate:"-" bun:""`                                                            // Public comment on this block, viewable (optionally) by everyone
+	Obfuscate          *bool               `validate:"-" bun:",nullzero,notnull,default:false"`                             // whether the domain name should appear obfuscated when displaying it publicly
+	SubscriptionID     string              `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // if this block was created through a subscription, what's the subscription ID?
+	Severity           DomainBlockSeverity `validate:"oneof=suspend limit" bun:",nullzero,notnull,default:'suspend'"`       // how severely the domain is blocked
+	RejectMedia        *bool               `validate:"-" bun:",nullzero,notnull,default:false"`                             // whether media (attachments, avatars, headers) from the domain should be skipped
 }
+
+// DomainBlockSeverity denotes how severely a domain is blocked.
+type DomainBlockSeverity string
+
+// DomainBlockSeverity values.
+const (
+	DomainBlockSeveritySuspend DomainBlockSeverity = "suspend" // DomainBlockSeveritySuspend defederates from the domain entirely, and suspends its accounts.
+	DomainBlockSeverityLimit   DomainBlockSeverity = "limit"   // DomainBlockSeverityLimit keeps federating with the domain, but hides its statuses from public timelines and turns follows from it into follow requests.
+)
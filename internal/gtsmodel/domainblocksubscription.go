@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DomainBlockSubscription represents a subscription to a remote list of domains to block.
+type DomainBlockSubscription struct {
+	ID                    string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt             time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt             time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Title                 string    `validate:"-" bun:""`                                                            // title of this subscription, viewable to admins
+	URI                   string    `validate:"required,url" bun:",nullzero,notnull,unique"`                         // URI of the list of domains to block
+	ContentType           string    `validate:"required" bun:",nullzero,notnull"`                                    // content type of the list of domains: text/plain, text/csv or application/json
+	Obfuscate             *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // whether blocks created by this subscription should be obfuscated when displayed publicly
+	CreatedByAccountID    string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account ID of the creator of this subscription
+	CreatedByAccount      *Account  `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+	FetchedAt             time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when was the list of domains last fetched
+	SuccessfullyFetchedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when was the list of domains last fetched and synced without error
+	Error                 string    `validate:"-" bun:""`                                                            // error encountered during the last fetch, if any
+	AddedDomains          []string  `validate:"-" bun:"added_domains,array"`                                         // domains blocked by the last successful sync
+	RemovedDomains        []string  `validate:"-" bun:"removed_domains,array"`                                       // domains unblocked by the last successful sync
+}
+
+// Content types of lists of domains which can be subscribed to.
+const (
+	DomainBlockSubscriptionPlain = "text/plain"       // one domain per line
+	DomainBlockSubscriptionCSV   = "text/csv"         // Mastodon domain block export format
+	DomainBlockSubscriptionJSON  = "application/json" // GoToSocial domain block export format
+)
@@ -0,0 +1,133 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import (
+	"regexp"
+	"time"
+)
+
+// Filter stores a filter created by a local account, which
+// hides or warns about statuses containing certain keywords.
+type Filter struct {
+	ID                   string           `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	ExpiresAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Time filter should expire. If null, should not expire.
+	AccountID            string           `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                  // ID of the local account that created the filter.
+	Title                string           `validate:"required" bun:",nullzero,notnull"`                                    // The name of the filter.
+	Action               FilterAction     `validate:"required" bun:",nullzero,notnull,default:'warn'"`                     // The action to take when a status matches the filter.
+	Keywords             []*FilterKeyword `validate:"-" bun:"-"`                                                           // Keywords for this filter.
+	ContextHome          *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to home timeline and lists.
+	ContextNotifications *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to notifications.
+	ContextPublic        *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to public timelines.
+	ContextThread        *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter when viewing a status's associated thread.
+	ContextAccount       *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter when viewing an account profile.
+}
+
+// Expired returns true if the filter has an expiry time which has passed at the given time.
+func (f *Filter) Expired(now time.Time) bool {
+	return !f.ExpiresAt.IsZero() && !now.Before(f.ExpiresAt)
+}
+
+// AppliesTo returns true if the filter should be applied in the given context.
+func (f *Filter) AppliesTo(context FilterContext) bool {
+	var b *bool
+	switch context {
+	case FilterContextHome:
+		b = f.ContextHome
+	case FilterContextNotifications:
+		b = f.ContextNotifications
+	case FilterContextPublic:
+		b = f.ContextPublic
+	case FilterContextThread:
+		b = f.ContextThread
+	case FilterContextAccount:
+		b = f.ContextAccount
+	}
+	return b != nil && *b
+}
+
+// FilterKeyword stores a single keyword to filter statuses against.
+type FilterKeyword struct {
+	ID        string         `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                     // id of this item in the database
+	CreatedAt time.Time      `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                              // when was item created
+	UpdatedAt time.Time      `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                              // when was item last updated
+	AccountID string         `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                                               // ID of the local account that created the filter keyword.
+	FilterID  string         `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero,unique:filter_keywords_filter_id_keyword_uniq"` // ID of the filter that this keyword belongs to.
+	Filter    *Filter        `validate:"-" bun:"-"`                                                                                        // Filter corresponding to FilterID
+	Keyword   string         `validate:"required" bun:",nullzero,notnull,unique:filter_keywords_filter_id_keyword_uniq"`                   // The keyword or phrase to filter against.
+	WholeWord *bool          `validate:"-" bun:",nullzero,notnull,default:false"`                                                          // Should the filter consider word boundaries?
+	Regexp    *regexp.Regexp `validate:"-" bun:"-"`                                                                                        // pre-prepared regular expression
+}
+
+// Compile will compile this FilterKeyword as a prepared regular expression,
+// matching case-insensitively and respecting the WholeWord setting.
+func (k *FilterKeyword) Compile() (err error) {
+	var prefix, suffix string
+
+	if k.WholeWord != nil && *k.WholeWord && k.Keyword != "" {
+		// Only match on word boundaries at each end
+		// of the keyword if it starts / ends with a
+		// word character; otherwise \b would never match.
+		if isWordRune(k.Keyword[0]) {
+			prefix = `\b`
+		}
+		if isWordRune(k.Keyword[len(k.Keyword)-1]) {
+			suffix = `\b`
+		}
+	}
+
+	k.Regexp, err = regexp.Compile(`(?i)` + prefix + regexp.QuoteMeta(k.Keyword) + suffix)
+	return
+}
+
+// isWordRune returns whether the given byte is a regexp word character.
+func isWordRune(b byte) bool {
+	return b == '_' ||
+		('0' <= b && b <= '9') ||
+		('a' <= b && b <= 'z') ||
+		('A' <= b && b <= 'Z')
+}
+
+// FilterResult describes a filter that a status
+// matched, and which of its keywords were matched.
+// It is not stored in the database.
+type FilterResult struct {
+	Filter         *Filter  // Filter that was matched.
+	KeywordMatches []string // Keywords of the filter that were matched.
+}
+
+// FilterAction represents the action to take when a status matches a filter.
+type FilterAction string
+
+const (
+	FilterActionWarn FilterAction = "warn" // Show the status behind a warning that it matched the filter.
+	FilterActionHide FilterAction = "hide" // Do not show the status at all.
+)
+
+// FilterContext represents a place in which a filter can be applied.
+type FilterContext string
+
+const (
+	FilterContextHome          FilterContext = "home"          // Home timeline and lists.
+	FilterContextNotifications FilterContext = "notifications" // Notifications timeline.
+	FilterContextPublic        FilterContext = "public"        // Public timelines.
+	FilterContextThread        FilterContext = "thread"        // Expanded thread of a detailed status.
+	FilterContextAccount       FilterContext = "account"       // Account profiles.
+)
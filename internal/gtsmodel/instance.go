@@ -21,22 +21,36 @@ import "time"
 
 // Instance represents a federated instance, either local or remote.
 type Instance struct {
-	ID                     string       `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                     // id of this item in the database
-	CreatedAt              time.Time    `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item created
-	UpdatedAt              time.Time    `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item last updated
-	Domain                 string       `validate:"required,fqdn" bun:",nullzero,notnull,unique"`                                     // Instance domain eg example.org
-	Title                  string       `validate:"-" bun:""`                                                                         // Title of this instance as it would like to be displayed.
-	URI                    string       `validate:"required,url" bun:",nullzero,notnull,unique"`                                      // base URI of this instance eg https://example.org
-	SuspendedAt            time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When was this instance suspended, if at all?
-	DomainBlockID          string       `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                      // ID of any existing domain block for this instance in the database
-	DomainBlock            *DomainBlock `validate:"-" bun:"rel:belongs-to"`                                                           // Domain block corresponding to domainBlockID
-	ShortDescription       string       `validate:"-" bun:""`                                                                         // Short description of this instance
-	Description            string       `validate:"-" bun:""`                                                                         // Longer description of this instance
-	Terms                  string       `validate:"-" bun:""`                                                                         // Terms and conditions of this instance
-	ContactEmail           string       `validate:"omitempty,email" bun:""`                                                           // Contact email address for this instance
-	ContactAccountUsername string       `validate:"required_with=ContactAccountID" bun:",nullzero"`                                   // Username of the contact account for this instance
-	ContactAccountID       string       `validate:"required_with=ContactAccountUsername,omitempty,ulid" bun:"type:CHAR(26),nullzero"` // Contact account ID in the database for this instance
-	ContactAccount         *Account     `validate:"-" bun:"rel:belongs-to"`                                                           // account corresponding to contactAccountID
-	Reputation             int64        `validate:"-" bun:",notnull,default:0"`                                                       // Reputation score of this instance
-	Version                string       `validate:"-" bun:",nullzero"`                                                                // Version of the software used on this instance
+	ID                     string          `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                     // id of this item in the database
+	CreatedAt              time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item created
+	UpdatedAt              time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item last updated
+	Domain                 string          `validate:"required,fqdn" bun:",nullzero,notnull,unique"`                                     // Instance domain eg example.org
+	Title                  string          `validate:"-" bun:""`                                                                         // Title of this instance as it would like to be displayed.
+	URI                    string          `validate:"required,url" bun:",nullzero,notnull,unique"`                                      // base URI of this instance eg https://example.org
+	SuspendedAt            time.Time       `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When was this instance suspended, if at all?
+	DomainBlockID          string          `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                      // ID of any existing domain block for this instance in the database
+	DomainBlock            *DomainBlock    `validate:"-" bun:"rel:belongs-to"`                                                           // Domain block corresponding to domainBlockID
+	ShortDescription       string          `validate:"-" bun:""`                                                                         // Short description of this instance
+	Description            string          `validate:"-" bun:""`                                                                         // Longer description of this instance
+	Terms                  string          `validate:"-" bun:""`                                                                         // Terms and conditions of this instance
+	ContactEmail           string          `validate:"omitempty,email" bun:""`                                                           // Contact email address for this instance
+	ContactAccountUsername string          `validate:"required_with=ContactAccountID" bun:",nullzero"`                                   // Username of the contact account for this instance
+	ContactAccountID       string          `validate:"required_with=ContactAccountUsername,omitempty,ulid" bun:"type:CHAR(26),nullzero"` // Contact account ID in the database for this instance
+	ContactAccount         *Account        `validate:"-" bun:"rel:belongs-to"`                                                           // account corresponding to contactAccountID
+	Reputation             int64           `validate:"-" bun:",notnull,default:0"`                                                       // Reputation score of this instance
+	Version                string          `validate:"-" bun:",nullzero"`                                                                // Version of the software used on this instance
+	LastDeliverySuccessAt  time.Time       `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When did delivery of a message to this instance last succeed?
+	LastDeliveryFailureAt  time.Time       `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When did delivery of a message to this instance last fail?
+	DeliveryFailingSince   time.Time       `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When did delivery to this instance start failing, if it's currently failing?
+	UnavailableAt          time.Time       `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When was this instance marked as unavailable, if at all?
+	SignatureScheme        SignatureScheme `validate:"omitempty,oneof=cavage rfc9421" bun:",nullzero"`                                   // Which http signature scheme does this instance accept on requests we send it, if known?
 }
+
+// SignatureScheme is an http signature scheme used to sign requests.
+type SignatureScheme string
+
+// SignatureScheme values.
+const (
+	SignatureSchemeCavage  SignatureScheme = "cavage"  // draft-cavage-http-signatures
+	SignatureSchemeRFC9421 SignatureScheme = "rfc9421" // RFC 9421 http message signatures
+)
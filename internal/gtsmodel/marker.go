@@ -0,0 +1,39 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Marker represents the last read position of one account within one of its timelines.
+type Marker struct {
+	AccountID  string     `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull"`               // Account that owns this marker
+	Name       MarkerName `validate:"oneof=home notifications" bun:",pk,nullzero,notnull"`                 // Name of the timeline this marker is for
+	UpdatedAt  time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Version    int        `validate:"min=0" bun:",notnull,default:0"`                                      // Incremented on each update, so clients can detect write conflicts
+	LastReadID string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // ID of the most recently read status or notification in the timeline
+}
+
+// MarkerName is the name of one of the timelines of
+// an account in which read position can be marked.
+type MarkerName string
+
+// Marker names.
+const (
+	MarkerNameHome          MarkerName = "home"          // home timeline, marking the last read status
+	MarkerNameNotifications MarkerName = "notifications" // notifications timeline, marking the last read notification
+)
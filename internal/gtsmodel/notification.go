@@ -24,13 +24,15 @@ type Notification struct {
 	ID               string           `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                                                                                                    // id of this item in the database
 	CreatedAt        time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                                                                                                             // when was item created
 	UpdatedAt        time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                                                                                                             // when was item last updated
-	NotificationType NotificationType `validate:"oneof=follow follow_request mention reblog favourite poll status" bun:",nullzero,notnull"`                                                                                                        // Type of this notification
+	NotificationType NotificationType `validate:"oneof=follow follow_request mention reblog favourite poll status admin.report" bun:",nullzero,notnull"`                                                                                           // Type of this notification
 	TargetAccountID  string           `validate:"ulid" bun:"type:CHAR(26),nullzero,notnull"`                                                                                                                                                       // ID of the account targeted by the notification (ie., who will receive the notification?)
 	TargetAccount    *Account         `validate:"-" bun:"-"`                                                                                                                                                                                       // Account corresponding to TargetAccountID. Can be nil, always check first + select using ID if necessary.
 	OriginAccountID  string           `validate:"ulid" bun:"type:CHAR(26),nullzero,notnull"`                                                                                                                                                       // ID of the account that performed the action that created the notification.
 	OriginAccount    *Account         `validate:"-" bun:"-"`                                                                                                                                                                                       // Account corresponding to OriginAccountID. Can be nil, always check first + select using ID if necessary.
 	StatusID         string           `validate:"required_if=NotificationType mention,required_if=NotificationType reblog,required_if=NotificationType favourite,required_if=NotificationType status,omitempty,ulid" bun:"type:CHAR(26),nullzero"` // If the notification pertains to a status, what is the database ID of that status?
 	Status           *Status          `validate:"-" bun:"-"`                                                                                                                                                                                       // Status corresponding to StatusID. Can be nil, always check first + select using ID if necessary.
+	ReportID         string           `validate:"required_if=NotificationType admin.report,omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                                                           // If the notification pertains to a report, what is the database ID of that report?
+	Report           *Report          `validate:"-" bun:"-"`                                                                                                                                                                                       // Report corresponding to ReportID. Can be nil, always check first + select using ID if necessary.
 	Read             *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                                                                                                                                                         // Notification has been seen/read
 }
 
@@ -46,4 +48,5 @@ const (
 	NotificationFave          NotificationType = "favourite"      // NotificationFave -- someone faved/liked one of your statuses
 	NotificationPoll          NotificationType = "poll"           // NotificationPoll -- a poll you voted in or created has ended
 	NotificationStatus        NotificationType = "status"         // NotificationStatus -- someone you enabled notifications for has posted a status.
+	NotificationAdminReport   NotificationType = "admin.report"   // NotificationAdminReport -- someone has reported an account (only sent to moderators and admins)
 )
@@ -0,0 +1,128 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Poll represents an attached (to) Status poll, i.e. a questionaire. Can be remote / local.
+type Poll struct {
+	ID         string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Multiple   *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Is this a multiple choice poll? i.e. can you vote on multiple options.
+	HideCounts *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Hides vote counts until poll ends.
+	Options    []string  `validate:"min=1,dive,required" bun:",array,nullzero,notnull"`                   // The available options for this poll.
+	Votes      []int     `validate:"-" bun:",array,nullzero,notnull"`                                     // Vote counts per choice.
+	Voters     *int      `validate:"-" bun:",nullzero,notnull,default:0"`                                 // Total no. voters count.
+	StatusID   string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`           // Status ID of which this Poll is attached to.
+	Status     *Status   `validate:"-" bun:"-"`                                                           // The related Status for StatusID (not always set).
+	ExpiresAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // The expiry date of this Poll, zero if it never expires.
+	ClosedAt   time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // The closure date of this poll, zero if it has not yet closed.
+}
+
+// Expired returns whether the Poll is expired (i.e. date is BEFORE now).
+func (p *Poll) Expired(now time.Time) bool {
+	return !p.ExpiresAt.IsZero() && !now.Before(p.ExpiresAt)
+}
+
+// Closed returns whether the Poll has been closed,
+// and no longer accepts any new votes.
+func (p *Poll) Closed() bool {
+	return !p.ClosedAt.IsZero()
+}
+
+// CheckChoices validates a set of vote choice
+// indices against this poll's options, ensuring
+// they are in range and non-repeating, and that
+// only one was given if the poll isn't multiple.
+func (p *Poll) CheckChoices(choices []int) bool {
+	if len(choices) == 0 {
+		return false
+	}
+	if len(choices) > 1 && (p.Multiple == nil || !*p.Multiple) {
+		return false
+	}
+	seen := make(map[int]struct{}, len(choices))
+	for _, choice := range choices {
+		if choice < 0 || choice >= len(p.Options) {
+			return false
+		}
+		if _, ok := seen[choice]; ok {
+			return false
+		}
+		seen[choice] = struct{}{}
+	}
+	return true
+}
+
+// IncrementVotes increments the Poll vote counts for the given
+// choices. If isNew is set, i.e. these are the choices of a
+// new voter, the Poll's total voter count is incremented too.
+func (p *Poll) IncrementVotes(choices []int, isNew bool) {
+	p.ensureVotes()
+	for _, choice := range choices {
+		p.Votes[choice]++
+	}
+	if !isNew {
+		return
+	}
+	voters := 1
+	if p.Voters != nil {
+		voters += *p.Voters
+	}
+	p.Voters = &voters
+}
+
+// DecrementVotes decrements the Poll vote counts for
+// the given choices, and the Poll's total voter count.
+func (p *Poll) DecrementVotes(choices []int) {
+	p.ensureVotes()
+	for _, choice := range choices {
+		if p.Votes[choice] > 0 {
+			p.Votes[choice]--
+		}
+	}
+	voters := 0
+	if p.Voters != nil && *p.Voters > 0 {
+		voters = *p.Voters - 1
+	}
+	p.Voters = &voters
+}
+
+// ensureVotes makes sure the Votes slice
+// has an entry for each of the Poll's options.
+func (p *Poll) ensureVotes() {
+	if len(p.Votes) == len(p.Options) {
+		return
+	}
+	votes := make([]int, len(p.Options))
+	copy(votes, p.Votes)
+	p.Votes = votes
+}
+
+// PollVote represents a single instance of vote(s) in a Poll by an account.
+// If the Poll is single-choice, len(.Choices) = 1, if multiple-choice, len(.Choices) = [1,len(.Poll.Options)].
+type PollVote struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                 // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                          // when was item created
+	Choices   []int     `validate:"min=1" bun:",array,nullzero,notnull"`                                                          // The Poll's option indices of which these are votes for.
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:poll_votes_poll_id_account_id_uniq"` // Account ID from which this vote originated.
+	Account   *Account  `validate:"-" bun:"-"`                                                                                    // The related Account for AccountID (not always set).
+	PollID    string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:poll_votes_poll_id_account_id_uniq"` // Poll ID of which this is a vote in.
+	Poll      *Poll     `validate:"-" bun:"-"`                                                                                    // The related Poll for PollID (not always set).
+}
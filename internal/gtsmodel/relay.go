@@ -0,0 +1,44 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Relay represents a subscription of this instance to an ActivityPub relay.
+type Relay struct {
+	ID                 string     `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt          time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	InboxURI           string     `validate:"required,url" bun:",nullzero,notnull,unique"`                         // inbox of the relay, which the Follow is delivered to
+	ActorURI           string     `validate:"omitempty,url" bun:",nullzero"`                                       // URI of the relay actor, set once the relay has accepted the Follow
+	FollowURI          string     `validate:"required,url" bun:",nullzero,notnull,unique"`                         // URI of the Follow sent to the relay by the instance account
+	State              RelayState `validate:"required" bun:",nullzero,notnull"`                                    // state of the subscription
+	Publish            *bool      `validate:"-" bun:",nullzero,notnull,default:false"`                             // whether public posts from this instance should be delivered to the relay
+	CreatedByAccountID string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account ID of the creator of this relay subscription
+	CreatedByAccount   *Account   `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+}
+
+// RelayState is the state of a subscription to a relay.
+type RelayState string
+
+// States of a subscription to a relay.
+const (
+	RelayStatePending  RelayState = "pending"  // Follow sent, waiting for the relay to Accept or Reject it
+	RelayStateAccepted RelayState = "accepted" // relay accepted the Follow
+	RelayStateRejected RelayState = "rejected" // relay rejected the Follow
+)
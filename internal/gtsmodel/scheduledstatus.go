@@ -0,0 +1,48 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// ScheduledStatus represents the params of a status that a local account has
+// created to be published at a later time. When the time comes, the status is
+// created from these params as though it had just been posted.
+type ScheduledStatus struct {
+	ID             string     `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                       // id of this item in the database
+	CreatedAt      time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                // when was item created
+	UpdatedAt      time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                // when was item last updated
+	ScheduledAt    time.Time  `validate:"required" bun:"type:timestamptz,nullzero,notnull"`                                   // when should the status be published
+	AccountID      string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                                 // which account will post this status
+	ApplicationID  string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                                 // which application was used to schedule this status
+	Text           string     `validate:"-" bun:""`                                                                           // text of the status, as submitted
+	ContentType    string     `validate:"-" bun:",nullzero"`                                                                  // content type to parse the text with, as submitted
+	MediaIDs       []string   `validate:"dive,ulid" bun:"attachments,array"`                                                  // Database IDs of any media attachments to attach to the status
+	PollOptions    []string   `validate:"-" bun:",array"`                                                                     // Options of the poll to attach to the status, if any
+	PollExpiresIn  int        `validate:"-" bun:",nullzero"`                                                                  // Duration in seconds that the poll should be open after publishing
+	PollMultiple   *bool      `validate:"-" bun:",nullzero,notnull,default:false"`                                            // Allow multiple choices on the poll
+	PollHideTotals *bool      `validate:"-" bun:",nullzero,notnull,default:false"`                                            // Hide vote counts of the poll until it ends
+	InReplyToID    string     `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                        // id of the status this status will reply to
+	SpoilerText    string     `validate:"-" bun:",nullzero"`                                                                  // cw string for the status
+	Sensitive      *bool      `validate:"-" bun:",nullzero,notnull,default:false"`                                            // mark the status as sensitive?
+	Visibility     Visibility `validate:"omitempty,oneof=public unlocked followers_only mutuals_only direct" bun:",nullzero"` // visibility of the status; empty means the account default at publish time
+	Language       string     `validate:"-" bun:",nullzero"`                                                                  // language of the status; empty means the account default at publish time
+	Federated      *bool      `validate:"-" bun:",nullzero"`                                                                  // Federated advanced visibility flag, if set
+	Boostable      *bool      `validate:"-" bun:",nullzero"`                                                                  // Boostable advanced visibility flag, if set
+	Replyable      *bool      `validate:"-" bun:",nullzero"`                                                                  // Replyable advanced visibility flag, if set
+	Likeable       *bool      `validate:"-" bun:",nullzero"`                                                                  // Likeable advanced visibility flag, if set
+}
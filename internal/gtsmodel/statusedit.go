@@ -0,0 +1,35 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// StatusEdit represents one previous version of a Status,
+// stored when the Status was edited, either locally or remotely.
+type StatusEdit struct {
+	ID             string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt      time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when this version of the status was created, ie., the time of the status creation or previous edit
+	Content        string    `validate:"-" bun:""`                                                            // content of this version of the status; likely html-formatted but not guaranteed
+	ContentWarning string    `validate:"-" bun:",nullzero"`                                                   // cw string for this version of the status
+	Text           string    `validate:"-" bun:""`                                                            // Original text of this version of the status without formatting
+	Language       string    `validate:"-" bun:",nullzero"`                                                   // what language was this version of the status written in?
+	Sensitive      *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // was this version of the status marked as sensitive?
+	AttachmentIDs  []string  `validate:"dive,ulid" bun:"attachments,array"`                                   // Database IDs of any media attachments associated with this version of the status
+	PollOptions    []string  `validate:"-" bun:",array"`                                                      // Options of the poll attached to this version of the status, if any
+	StatusID       string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // ID of the status of which this is a previous version
+}
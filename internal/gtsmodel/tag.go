@@ -31,3 +31,15 @@ type Tag struct {
 	Listable               *bool     `validate:"-" bun:",nullzero,notnull,default:true"`                              // can our instance users look up this tag?
 	LastStatusAt           time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was this tag last used?
 }
+
+// FollowedTag represents a local account following a hashtag,
+// so that public statuses using the tag show up in their home timeline.
+type FollowedTag struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                 // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`          // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`          // when was item last updated
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:followedtagaccount"` // id of the local account following the tag
+	Account   *Account  `validate:"-" bun:"-"`                                                                    // account corresponding to accountID
+	TagID     string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:followedtagaccount"` // id of the tag being followed
+	Tag       *Tag      `validate:"-" bun:"-"`                                                                    // tag corresponding to tagID
+}
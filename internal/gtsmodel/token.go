@@ -17,7 +17,10 @@
 
 package gtsmodel
 
-import "time"
+import (
+	"net"
+	"time"
+)
 
 // Token is a translation of the gotosocial token with the ExpiresIn fields replaced with ExpiresAt.
 type Token struct {
@@ -39,4 +42,6 @@ type Token struct {
 	Refresh             string    `validate:"-" bun:",pk,nullzero,notnull,default:''"`                             // Refresh token, if present
 	RefreshCreateAt     time.Time `validate:"required_with=Refresh" bun:"type:timestamptz,nullzero"`               // Refresh created at, if refresh present
 	RefreshExpiresAt    time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Refresh expires at -- null means the refresh token never expires
+	LastUsedAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When was this token last used to authorize a request?
+	LastUsedIP          net.IP    `validate:"-" bun:",nullzero"`                                                   // From what IP was this token last used?
 }
@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// WebPushSubscription represents a subscription of one access token to
+// Web Push notifications, which are delivered to the given push endpoint.
+type WebPushSubscription struct {
+	ID                 string        `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`           // id of this item in the database
+	CreatedAt          time.Time     `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`    // when was item created
+	UpdatedAt          time.Time     `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`    // when was item last updated
+	AccountID          string        `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                     // Account that owns this subscription
+	TokenID            string        `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`              // Access token that created this subscription; there's at most one subscription per token
+	Endpoint           string        `validate:"required,url" bun:",nullzero,notnull"`                                   // URL of the push service endpoint to deliver messages to
+	P256dh             string        `validate:"required" bun:",nullzero,notnull"`                                       // base64url-encoded ECDH public key of the user agent
+	Auth               string        `validate:"required" bun:",nullzero,notnull"`                                       // base64url-encoded auth secret of the user agent
+	Policy             WebPushPolicy `validate:"oneof=all followed follower none" bun:",nullzero,notnull,default:'all'"` // Which accounts to receive push notifications from
+	AlertFollow        *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about new followers?
+	AlertFollowRequest *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about new follow requests?
+	AlertFavourite     *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about favourites?
+	AlertMention       *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about mentions?
+	AlertReblog        *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about boosts?
+	AlertPoll          *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about ended polls?
+	AlertStatus        *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about new statuses from accounts with notifications enabled?
+	AlertUpdate        *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about edits to boosted statuses?
+	AlertAdminReport   *bool         `validate:"-" bun:",nullzero,notnull,default:false"`                                // Push notifications about new reports (moderators and admins only)?
+}
+
+// WebPushPolicy determines from which
+// accounts push notifications are sent.
+type WebPushPolicy string
+
+// Web push policies.
+const (
+	WebPushPolicyAll      WebPushPolicy = "all"      // push notifications from all accounts
+	WebPushPolicyFollowed WebPushPolicy = "followed" // push notifications from accounts the subscriber follows
+	WebPushPolicyFollower WebPushPolicy = "follower" // push notifications from accounts that follow the subscriber
+	WebPushPolicyNone     WebPushPolicy = "none"     // don't push any notifications
+)
+
+// Alert returns whether the subscription wants
+// pushes for notifications of the given type.
+func (w *WebPushSubscription) Alert(notificationType NotificationType) bool {
+	var alert *bool
+	switch notificationType {
+	case NotificationFollow:
+		alert = w.AlertFollow
+	case NotificationFollowRequest:
+		alert = w.AlertFollowRequest
+	case NotificationFave:
+		alert = w.AlertFavourite
+	case NotificationMention:
+		alert = w.AlertMention
+	case NotificationReblog:
+		alert = w.AlertReblog
+	case NotificationPoll:
+		alert = w.AlertPoll
+	case NotificationStatus:
+		alert = w.AlertStatus
+	case NotificationAdminReport:
+		alert = w.AlertAdminReport
+	}
+	return alert != nil && *alert
+}
+
+// VAPIDKeyPair is the key pair this instance uses to identify
+// itself to push services when delivering Web Push messages.
+// There is only ever one key pair, generated at first start.
+type VAPIDKeyPair struct {
+	ID         string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	PublicKey  string    `validate:"required" bun:",nullzero,notnull"`                                    // base64url-encoded uncompressed P-256 public key
+	PrivateKey string    `validate:"required" bun:",nullzero,notnull"`                                    // base64url-encoded P-256 private key scalar
+}
@@ -127,7 +127,7 @@ type Manager interface {
 	PruneUnusedRemote(ctx context.Context, dry bool) (int, error)
 	// PruneUnusedLocal prunes unused media attachments that were uploaded by
 	// a user on this instance, but never actually attached to a status, or attached but
-	// later detached.
+	// later detached. Media attached to a scheduled status is not considered unused.
 	//
 	// The returned int is the amount of media that was pruned by this function.
 	PruneUnusedLocal(ctx context.Context, dry bool) (int, error)
@@ -92,6 +92,24 @@ func (suite *PruneTestSuite) TestPruneUnusedLocal() {
 	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
+func (suite *PruneTestSuite) TestPruneUnusedLocalScheduled() {
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+	suite.True(*testAttachment.Cached)
+
+	// Attach the media to a scheduled status,
+	// which should exempt it from pruning.
+	testAttachment.ScheduledStatusID = "01H1MTNY2V4XGB4P6QJ3YDEXF8"
+	err := suite.db.UpdateAttachment(context.Background(), testAttachment, "scheduled_status_id")
+	suite.NoError(err)
+
+	totalPruned, err := suite.manager.PruneUnusedLocal(context.Background(), false)
+	suite.NoError(err)
+	suite.Equal(0, totalPruned)
+
+	_, err = suite.db.GetAttachmentByID(context.Background(), testAttachment.ID)
+	suite.NoError(err)
+}
+
 func (suite *PruneTestSuite) TestPruneUnusedLocalDry() {
 	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
 	suite.True(*testAttachment.Cached)
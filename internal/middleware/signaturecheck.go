@@ -24,8 +24,10 @@ import (
 	"net/url"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/rfc9421"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-fed/httpsig"
@@ -42,7 +44,8 @@ var (
 
 // SignatureCheck returns a gin middleware for checking http signatures.
 //
-// The middleware first checks whether an incoming http request has been http-signed with a well-formed signature.
+// The middleware first checks whether an incoming http request has been http-signed with a well-formed signature,
+// either an RFC 9421 http message signature or a draft-cavage http signature.
 //
 // If so, it will check if the domain that signed the request is permitted to access the server, using the provided isURIBlocked function.
 //
@@ -56,16 +59,32 @@ func SignatureCheck(isURIBlocked func(context.Context, *url.URL) (bool, db.Error
 		// Acquire ctx from gin request.
 		ctx := c.Request.Context()
 
-		// create the verifier from the request, this will error if the request wasn't signed
-		verifier, err := httpsig.NewVerifier(c.Request)
-		if err != nil {
-			// Something went wrong, so we need to return regardless, but only actually
-			// *abort* the request with 401 if a signature was present but malformed
-			if err.Error() != noSignatureError {
-				log.Debugf(ctx, "http signature was present but invalid: %s", err)
+		var (
+			verifier httpsig.Verifier
+			err      error
+		)
+
+		if rfc9421.Signed(c.Request) {
+			// the request was signed with an RFC 9421 http message signature, so create
+			// the verifier for that; any error here means the signature was malformed
+			verifier, err = rfc9421.NewVerifier(c.Request, config.GetProtocol())
+			if err != nil {
+				log.Debugf(ctx, "rfc9421 http signature was present but invalid: %s", err)
 				c.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+		} else {
+			// create the verifier from the request, this will error if the request wasn't signed
+			verifier, err = httpsig.NewVerifier(c.Request)
+			if err != nil {
+				// Something went wrong, so we need to return regardless, but only actually
+				// *abort* the request with 401 if a signature was present but malformed
+				if err.Error() != noSignatureError {
+					log.Debugf(ctx, "http signature was present but invalid: %s", err)
+					c.AbortWithStatus(http.StatusUnauthorized)
+				}
+				return
 			}
-			return
 		}
 
 		// The request was signed!
@@ -18,8 +18,12 @@
 package middleware
 
 import (
+	"crypto/sha256"
+	"net"
 	"net/http"
+	"time"
 
+	"codeberg.org/gruf/go-cache/v3/ttl"
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -28,13 +32,18 @@ import (
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
+// tokenLastUsedInterval is how often the
+// last used time of a token is written.
+const tokenLastUsedInterval = time.Hour
+
 // TokenCheck returns a new gin middleware for validating oauth tokens in requests.
 //
 // The middleware checks the request Authorization header for a valid oauth Bearer token.
 //
 // If no token was set in the Authorization header, or the token was invalid, the handler will return.
 //
-// If a valid oauth Bearer token was provided, it will be set on the gin context for further use.
+// If a valid oauth Bearer token was provided, it will be set on the gin context for further use,
+// and the time and IP address at which the token was last used will be updated, at most once an hour.
 //
 // Then, it will check which *gtsmodel.User the token belongs to. If the user is not confirmed, not approved,
 // or has been disabled, then the middleware will return early. Otherwise, the User will be set on the
@@ -50,6 +59,18 @@ import (
 // won't abort the request, since the server might want to still allow public requests that don't have a
 // Bearer token set (eg., for public instance information and so on).
 func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.TokenInfo, error)) func(*gin.Context) {
+	// lastUsed holds when the last used time of each token
+	// was written, keyed by a hash of the access token so the
+	// token itself isn't kept around, so that the database
+	// doesn't have to be written to on every single request.
+	lastUsed := ttl.New[[sha256.Size]byte, time.Time](0, 10000, 0)
+
+	// Last used cache has TTL=tokenLastUsedInterval freq=1min
+	lastUsed.SetTTL(tokenLastUsedInterval, false)
+	if !lastUsed.Start(time.Minute) {
+		log.Panic(nil, "failed to start token last used cache")
+	}
+
 	return func(c *gin.Context) {
 		// Acquire context from gin request.
 		ctx := c.Request.Context()
@@ -66,6 +87,20 @@ func TokenCheck(dbConn db.DB, validateBearerToken func(r *http.Request) (oauth2.
 		}
 		c.Set(oauth.SessionAuthorizedToken, ti)
 
+		// record when and from where this token was last
+		// used, so the user can see it in their authorized apps
+		if access := ti.GetAccess(); access != "" {
+			now := time.Now()
+			key := sha256.Sum256([]byte(access))
+			if last, ok := lastUsed.Get(key); !ok || now.Sub(last) >= tokenLastUsedInterval {
+				if err := dbConn.UpdateTokenLastUsed(ctx, access, net.ParseIP(c.ClientIP())); err != nil {
+					log.Errorf(ctx, "database error updating last used time of token: %s", err)
+				} else {
+					lastUsed.Set(key, now)
+				}
+			}
+		}
+
 		// check for user-level token
 		if userID := ti.GetUserID(); userID != "" {
 			log.Tracef(ctx, "authenticated user %s with bearer token, scope is %s", userID, ti.GetScope())
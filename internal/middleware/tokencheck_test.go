@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+	"github.com/superseriousbusiness/oauth2/v4"
+	"github.com/superseriousbusiness/oauth2/v4/models"
+)
+
+// lastUsedCountingDB counts writes
+// of the last used time of tokens.
+type lastUsedCountingDB struct {
+	db.DB
+	lastUsedWrites int
+}
+
+func (d *lastUsedCountingDB) UpdateTokenLastUsed(ctx context.Context, access string, ip net.IP) db.Error {
+	d.lastUsedWrites++
+	return d.DB.UpdateTokenLastUsed(ctx, access, ip)
+}
+
+type TokenCheckTestSuite struct {
+	suite.Suite
+	db         *lastUsedCountingDB
+	state      state.State
+	testTokens map[string]*gtsmodel.Token
+}
+
+func (suite *TokenCheckTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.state.Caches.Init()
+	suite.db = &lastUsedCountingDB{DB: testrig.NewTestDB(&suite.state)}
+	suite.testTokens = testrig.NewTestTokens()
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *TokenCheckTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+func (suite *TokenCheckTestSuite) TestLastUsedWrittenOnce() {
+	token := suite.testTokens["local_account_1"]
+	validate := func(r *http.Request) (oauth2.TokenInfo, error) {
+		return &models.Token{
+			ClientID: token.ClientID,
+			UserID:   token.UserID,
+			Access:   token.Access,
+		}, nil
+	}
+
+	engine := gin.New()
+	engine.Use(middleware.TokenCheck(suite.db, validate))
+	engine.GET("/", func(c *gin.Context) {
+		_, ok := c.Get(oauth.SessionAuthorizedAccount)
+		suite.True(ok)
+		c.Status(http.StatusOK)
+	})
+
+	for i := 0; i < 2; i++ {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Bearer "+token.Access)
+		engine.ServeHTTP(httptest.NewRecorder(), r)
+	}
+
+	suite.Equal(1, suite.db.lastUsedWrites)
+
+	dbToken, err := suite.db.GetTokenByAccess(context.Background(), token.Access)
+	suite.NoError(err)
+	suite.NotZero(dbToken.LastUsedAt)
+}
+
+func TestTokenCheckTestSuite(t *testing.T) {
+	suite.Run(t, &TokenCheckTestSuite{})
+}
@@ -23,3 +23,13 @@ import "github.com/superseriousbusiness/oauth2/v4/errors"
 func InvalidRequest() error {
 	return errors.New("invalid_request")
 }
+
+// InvalidClient returns an oauth spec compliant 'invalid_client' error.
+func InvalidClient() error {
+	return errors.New("invalid_client")
+}
+
+// UnauthorizedClient returns an oauth spec compliant 'unauthorized_client' error.
+func UnauthorizedClient() error {
+	return errors.New("unauthorized_client")
+}
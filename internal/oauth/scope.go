@@ -0,0 +1,205 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scope is an OAuth scope, which can be requested by an
+// application, and granted to a token when a user authorizes
+// that application. Scopes are either top-level (eg., "read"),
+// or granular (eg., "read:statuses"), where a granted top-level
+// scope permits everything allowed by its granular scopes.
+type Scope string
+
+// Scopes understood by this instance. These match the scopes of the
+// Mastodon API, with the addition of a few used only by GoToSocial.
+const (
+	ScopeRead              Scope = "read"
+	ScopeReadAccounts      Scope = "read:accounts"
+	ScopeReadBlocks        Scope = "read:blocks"
+	ScopeReadBookmarks     Scope = "read:bookmarks"
+	ScopeReadFavourites    Scope = "read:favourites"
+	ScopeReadFilters       Scope = "read:filters"
+	ScopeReadFollows       Scope = "read:follows"
+	ScopeReadLists         Scope = "read:lists"
+	ScopeReadMutes         Scope = "read:mutes"
+	ScopeReadNotifications Scope = "read:notifications"
+	ScopeReadReports       Scope = "read:reports" // GoToSocial only
+	ScopeReadSearch        Scope = "read:search"
+	ScopeReadStatuses      Scope = "read:statuses"
+
+	ScopeWrite              Scope = "write"
+	ScopeWriteAccounts      Scope = "write:accounts"
+	ScopeWriteBlocks        Scope = "write:blocks"
+	ScopeWriteBookmarks     Scope = "write:bookmarks"
+	ScopeWriteConversations Scope = "write:conversations"
+	ScopeWriteFavourites    Scope = "write:favourites"
+	ScopeWriteFilters       Scope = "write:filters"
+	ScopeWriteFollows       Scope = "write:follows"
+	ScopeWriteLists         Scope = "write:lists"
+	ScopeWriteMedia         Scope = "write:media"
+	ScopeWriteMutes         Scope = "write:mutes"
+	ScopeWriteNotifications Scope = "write:notifications"
+	ScopeWriteReports       Scope = "write:reports"
+	ScopeWriteStatuses      Scope = "write:statuses"
+
+	ScopeFollow Scope = "follow"
+	ScopePush   Scope = "push"
+
+	ScopeAdmin Scope = "admin" // GoToSocial only
+
+	ScopeAdminRead                     Scope = "admin:read"
+	ScopeAdminReadAccounts             Scope = "admin:read:accounts"
+	ScopeAdminReadReports              Scope = "admin:read:reports"
+	ScopeAdminReadDomainAllows         Scope = "admin:read:domain_allows"
+	ScopeAdminReadDomainBlocks         Scope = "admin:read:domain_blocks"
+	ScopeAdminReadIPBlocks             Scope = "admin:read:ip_blocks"
+	ScopeAdminReadEmailDomainBlocks    Scope = "admin:read:email_domain_blocks"
+	ScopeAdminReadCanonicalEmailBlocks Scope = "admin:read:canonical_email_blocks"
+
+	ScopeAdminWrite                     Scope = "admin:write"
+	ScopeAdminWriteAccounts             Scope = "admin:write:accounts"
+	ScopeAdminWriteReports              Scope = "admin:write:reports"
+	ScopeAdminWriteDomainAllows         Scope = "admin:write:domain_allows"
+	ScopeAdminWriteDomainBlocks         Scope = "admin:write:domain_blocks"
+	ScopeAdminWriteIPBlocks             Scope = "admin:write:ip_blocks"
+	ScopeAdminWriteEmailDomainBlocks    Scope = "admin:write:email_domain_blocks"
+	ScopeAdminWriteCanonicalEmailBlocks Scope = "admin:write:canonical_email_blocks"
+
+	// ScopeDefault is the scope assumed when
+	// an application or token doesn't specify any.
+	ScopeDefault = ScopeRead
+)
+
+// knownScopes contains all scopes
+// that can be requested and granted.
+var knownScopes = func() map[Scope]struct{} {
+	m := make(map[Scope]struct{})
+	for _, s := range []Scope{
+		ScopeRead, ScopeReadAccounts, ScopeReadBlocks, ScopeReadBookmarks,
+		ScopeReadFavourites, ScopeReadFilters, ScopeReadFollows, ScopeReadLists,
+		ScopeReadMutes, ScopeReadNotifications, ScopeReadReports, ScopeReadSearch,
+		ScopeReadStatuses,
+		ScopeWrite, ScopeWriteAccounts, ScopeWriteBlocks, ScopeWriteBookmarks,
+		ScopeWriteConversations, ScopeWriteFavourites, ScopeWriteFilters,
+		ScopeWriteFollows, ScopeWriteLists, ScopeWriteMedia, ScopeWriteMutes,
+		ScopeWriteNotifications, ScopeWriteReports, ScopeWriteStatuses,
+		ScopeFollow, ScopePush, ScopeAdmin,
+		ScopeAdminRead, ScopeAdminReadAccounts, ScopeAdminReadReports,
+		ScopeAdminReadDomainAllows, ScopeAdminReadDomainBlocks, ScopeAdminReadIPBlocks,
+		ScopeAdminReadEmailDomainBlocks, ScopeAdminReadCanonicalEmailBlocks,
+		ScopeAdminWrite, ScopeAdminWriteAccounts, ScopeAdminWriteReports,
+		ScopeAdminWriteDomainAllows, ScopeAdminWriteDomainBlocks, ScopeAdminWriteIPBlocks,
+		ScopeAdminWriteEmailDomainBlocks, ScopeAdminWriteCanonicalEmailBlocks,
+	} {
+		m[s] = struct{}{}
+	}
+	return m
+}()
+
+// followScopes are the granular scopes permitted
+// by the deprecated top-level "follow" scope.
+var followScopes = map[Scope]struct{}{
+	ScopeReadBlocks:   {},
+	ScopeWriteBlocks:  {},
+	ScopeReadFollows:  {},
+	ScopeWriteFollows: {},
+	ScopeReadMutes:    {},
+	ScopeWriteMutes:   {},
+}
+
+// ParseScopes parses the given space-separated string of scopes,
+// as provided by a client when creating an application or requesting
+// authorization. An error will be returned if any of the scopes are
+// not known. An empty string is parsed as the default scope.
+func ParseScopes(scopes string) ([]Scope, error) {
+	fields := strings.Fields(scopes)
+	if len(fields) == 0 {
+		return []Scope{ScopeDefault}, nil
+	}
+
+	parsed := make([]Scope, 0, len(fields))
+	for _, field := range fields {
+		scope := Scope(field)
+		if _, ok := knownScopes[scope]; !ok {
+			return nil, fmt.Errorf("scope %q not recognised", field)
+		}
+		parsed = append(parsed, scope)
+	}
+
+	return parsed, nil
+}
+
+// Permits returns true if this scope, when granted,
+// permits an action that requires the wanted scope.
+func (s Scope) Permits(wanted Scope) bool {
+	if s == wanted {
+		return true
+	}
+
+	if s == ScopeFollow {
+		_, ok := followScopes[wanted]
+		return ok
+	}
+
+	// A scope permits all scopes
+	// nested beneath it, eg., "read"
+	// permits "read:statuses", and
+	// "admin" permits "admin:read:reports".
+	return strings.HasPrefix(string(wanted), string(s)+":")
+}
+
+// ScopesPermit returns true if any of the given space-separated
+// granted scopes permits an action that requires the wanted scope.
+// Unrecognised granted scopes are ignored.
+func ScopesPermit(granted string, wanted Scope) bool {
+	fields := strings.Fields(granted)
+	if len(fields) == 0 {
+		return ScopeDefault.Permits(wanted)
+	}
+
+	for _, field := range fields {
+		if Scope(field).Permits(wanted) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ScopesSubset returns true if every one of the requested
+// space-separated scopes is permitted by the granted scopes,
+// eg., when checking that the scopes requested during
+// authorization were registered by the application.
+func ScopesSubset(granted string, requested string) bool {
+	requestedScopes, err := ParseScopes(requested)
+	if err != nil {
+		return false
+	}
+
+	for _, scope := range requestedScopes {
+		if !ScopesPermit(granted, scope) {
+			return false
+		}
+	}
+
+	return true
+}
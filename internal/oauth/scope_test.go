@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type ScopeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopeTestSuite) TestParseScopes() {
+	scopes, err := oauth.ParseScopes("read write:statuses  admin:read:reports")
+	suite.NoError(err)
+	suite.Equal([]oauth.Scope{
+		oauth.ScopeRead,
+		oauth.ScopeWriteStatuses,
+		oauth.ScopeAdminReadReports,
+	}, scopes)
+}
+
+func (suite *ScopeTestSuite) TestParseScopesEmpty() {
+	scopes, err := oauth.ParseScopes("")
+	suite.NoError(err)
+	suite.Equal([]oauth.Scope{oauth.ScopeRead}, scopes)
+}
+
+func (suite *ScopeTestSuite) TestParseScopesUnknown() {
+	scopes, err := oauth.ParseScopes("read user")
+	suite.EqualError(err, `scope "user" not recognised`)
+	suite.Nil(scopes)
+}
+
+func (suite *ScopeTestSuite) TestScopesPermit() {
+	for _, test := range []struct {
+		granted string
+		wanted  oauth.Scope
+		permit  bool
+	}{
+		{"read", oauth.ScopeRead, true},
+		{"read", oauth.ScopeReadStatuses, true},
+		{"read", oauth.ScopeWriteStatuses, false},
+		{"", oauth.ScopeReadAccounts, true},
+		{"", oauth.ScopeWriteAccounts, false},
+		{"read:statuses", oauth.ScopeRead, false},
+		{"read:statuses", oauth.ScopeReadStatuses, true},
+		{"read:statuses", oauth.ScopeReadAccounts, false},
+		{"read write", oauth.ScopeWriteMedia, true},
+		{"follow", oauth.ScopeWriteFollows, true},
+		{"follow", oauth.ScopeReadMutes, true},
+		{"follow", oauth.ScopeReadStatuses, false},
+		{"push", oauth.ScopePush, true},
+		{"read write", oauth.ScopePush, false},
+		{"admin", oauth.ScopeAdminReadReports, true},
+		{"admin:read", oauth.ScopeAdminReadReports, true},
+		{"admin:read", oauth.ScopeAdminWriteReports, false},
+		{"read write", oauth.ScopeAdminRead, false},
+	} {
+		suite.Equal(test.permit, oauth.ScopesPermit(test.granted, test.wanted), "granted %q wanted %q", test.granted, test.wanted)
+	}
+}
+
+func (suite *ScopeTestSuite) TestScopesSubset() {
+	suite.True(oauth.ScopesSubset("read write follow push", "read write:statuses"))
+	suite.True(oauth.ScopesSubset("read write", ""))
+	suite.False(oauth.ScopesSubset("read", "read write"))
+	suite.False(oauth.ScopesSubset("read write", "read admin"))
+	suite.False(oauth.ScopesSubset("read write", "read user"))
+}
+
+func TestScopeTestSuite(t *testing.T) {
+	suite.Run(t, &ScopeTestSuite{})
+}
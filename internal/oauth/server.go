@@ -26,6 +26,7 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/oauth2/v4"
 	oautherr "github.com/superseriousbusiness/oauth2/v4/errors"
@@ -120,6 +121,30 @@ func New(ctx context.Context, database db.Basic) Server {
 		return userID, nil
 	})
 	srv.SetClientInfoHandler(server.ClientFormHandler)
+	srv.SetClientScopeHandler(func(tgr *oauth2.TokenGenerateRequest) (bool, error) {
+		// Use the context of the request
+		// being handled, where available.
+		ctx := context.Background()
+		if tgr.Request != nil {
+			ctx = tgr.Request.Context()
+		}
+
+		app := &gtsmodel.Application{}
+		if err := database.GetWhere(ctx, []db.Where{{Key: "client_id", Value: tgr.ClientID}}, app); err != nil {
+			return false, err
+		}
+
+		// If no scope was requested, grant
+		// the scopes registered by the application.
+		if strings.TrimSpace(tgr.Scope) == "" && app.Scopes != "" {
+			tgr.Scope = app.Scopes
+			return true, nil
+		}
+
+		// Only allow scopes that were
+		// registered by the application.
+		return ScopesSubset(app.Scopes, tgr.Scope), nil
+	})
 	return &s{
 		server: srv,
 	}
@@ -1,7 +1,10 @@
 package oauth
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/oauth2/v4"
 	"github.com/superseriousbusiness/oauth2/v4/errors"
@@ -88,3 +91,21 @@ func Authed(c *gin.Context, requireToken bool, requireApp bool, requireUser bool
 
 	return a, nil
 }
+
+// AuthedScope is like Authed, but additionally requires that the token of the
+// request, if one is present, was granted the given scope. If one of the
+// requirements of Authed is not met, an error with code 401 will be returned;
+// if the token doesn't have the given scope, the error will have code 403.
+func AuthedScope(c *gin.Context, requireToken bool, requireApp bool, requireUser bool, requireAccount bool, scope Scope) (*Auth, gtserror.WithCode) {
+	a, err := Authed(c, requireToken, requireApp, requireUser, requireAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorUnauthorized(err, err.Error())
+	}
+
+	if a.Token != nil && !ScopesPermit(a.Token.GetScope(), scope) {
+		err := fmt.Errorf("token with scope %q does not have required scope %q", a.Token.GetScope(), scope)
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	return a, nil
+}
@@ -67,7 +67,17 @@ func (p *Processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error creating block in db: %s", err))
 	}
 
-	// clear any follows or follow requests from the blocked account to the target account -- this is a simple delete
+	// clear any follows or follow requests from the blocked account to the target account -- this is a simple delete,
+	// but we need to clear any list entries pointing to the follow first
+	targetFollow := &gtsmodel.Follow{}
+	if err := p.state.DB.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: targetAccountID},
+		{Key: "target_account_id", Value: requestingAccount.ID},
+	}, targetFollow); err == nil {
+		if err := p.state.DB.DeleteListEntriesForFollowID(ctx, targetFollow.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing list entries from db: %s", err))
+		}
+	}
 	if err := p.state.DB.DeleteWhere(ctx, []db.Where{
 		{Key: "account_id", Value: targetAccountID},
 		{Key: "target_account_id", Value: requestingAccount.ID},
@@ -111,6 +121,9 @@ func (p *Processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 		if err := p.state.DB.DeleteByID(ctx, f.ID, f); err != nil {
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing follow from db: %s", err))
 		}
+		if err := p.state.DB.DeleteListEntriesForFollowID(ctx, f.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing list entries from db: %s", err))
+		}
 		fChanged = true
 	}
 
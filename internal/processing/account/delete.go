@@ -88,6 +88,16 @@ func (p *Processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 		l.Errorf("error deleting blocks targeting account: %s", err)
 	}
 
+	// delete any mutes this account created, or that target this account;
+	// mutes are local-only so there's nothing to federate here
+	if err := p.state.DB.DeleteMutesByOriginAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting mutes created by account: %s", err)
+	}
+
+	if err := p.state.DB.DeleteMutesByTargetAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting mutes targeting account: %s", err)
+	}
+
 	// 3. Delete account's emoji
 	// nothing to do here
 
@@ -106,8 +116,31 @@ func (p *Processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 
 	// 5. Delete account's follows
 	// TODO: federate these if necessary
+	// first delete any lists owned by this account, and any
+	// list entries in other accounts' lists that refer to it
+	l.Trace("deleting account lists")
+	if lists, err := p.state.DB.GetListsForAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error fetching lists created by account: %s", err)
+	} else {
+		for _, list := range lists {
+			if err := p.state.DB.DeleteListByID(ctx, list.ID); err != nil {
+				l.Errorf("error deleting list %s created by account: %s", list.ID, err)
+			}
+		}
+	}
+
+	if followedBy, err := p.state.DB.GetAccountFollowedBy(ctx, account.ID, false); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		l.Errorf("error fetching follows targeting account: %s", err)
+	} else {
+		for _, follow := range followedBy {
+			if err := p.state.DB.DeleteListEntriesForFollowID(ctx, follow.ID); err != nil {
+				l.Errorf("error deleting list entries for follow %s: %s", follow.ID, err)
+			}
+		}
+	}
+
 	l.Trace("deleting account follows")
-	// first delete any follows that this account created
+	// now delete any follows that this account created
 	if err := p.state.DB.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.Follow{}); err != nil {
 		l.Errorf("error deleting follows created by account: %s", err)
 	}
@@ -218,12 +251,32 @@ func (p *Processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 		l.Errorf("error deleting faves created by account: %s", err)
 	}
 
+	l.Trace("deleting account poll votes")
+	if err := p.state.DB.DeletePollVotesByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting poll votes created by account: %s", err)
+	}
+
 	// 13. Delete account's mutes
 	l.Trace("deleting account mutes")
 	if err := p.state.DB.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.StatusMute{}); err != nil {
 		l.Errorf("error deleting status mutes created by account: %s", err)
 	}
 
+	l.Trace("deleting account conversations")
+	if err := p.state.DB.DeleteConversationsByOwnerAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting conversations owned by account: %s", err)
+	}
+
+	l.Trace("deleting account followed tags")
+	if err := p.state.DB.DeleteFollowedTagsByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting tags followed by account: %s", err)
+	}
+
+	l.Trace("deleting account filters")
+	if err := p.state.DB.DeleteFiltersForAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting filters created by account: %s", err)
+	}
+
 	// 14. Delete account's streams
 	// TODO
 
@@ -166,6 +166,9 @@ func (p *Processor) FollowRemove(ctx context.Context, requestingAccount *gtsmode
 		if err := p.state.DB.DeleteByID(ctx, f.ID, f); err != nil {
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountFollowRemove: error removing follow from db: %s", err))
 		}
+		if err := p.state.DB.DeleteListEntriesForFollowID(ctx, f.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountFollowRemove: error removing list entries from db: %s", err))
+		}
 		fChanged = true
 	}
 
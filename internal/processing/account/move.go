@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Alias sets the aliases (also known as URIs) of the given local account, replacing any
+// existing aliases. An account must be listed as an alias of the account it is moved to.
+func (p *Processor) Alias(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode) {
+	alsoKnownAsURIs := make([]string, 0, len(form.AlsoKnownAsURIs))
+	for _, uriStr := range form.AlsoKnownAsURIs {
+		uri, err := url.Parse(uriStr)
+		if err != nil || !uri.IsAbs() || (uri.Scheme != "http" && uri.Scheme != "https") {
+			err := fmt.Errorf("alias %s was not a valid http(s) uri", uriStr)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if uri.String() == account.URI {
+			err := errors.New("account cannot be an alias of itself")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if !contains(alsoKnownAsURIs, uri.String()) {
+			alsoKnownAsURIs = append(alsoKnownAsURIs, uri.String())
+		}
+	}
+
+	account.AlsoKnownAsURIs = alsoKnownAsURIs
+	if err := p.state.DB.UpdateAccount(ctx, account); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("Alias: could not update account %s: %w", account.ID, err))
+	}
+
+	// aliases are part of the account profile, so federate them as a profile update
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       account,
+		OriginAccount:  account,
+	})
+
+	acctSensitive, err := p.tc.AccountToAPIAccountSensitive(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("Alias: could not convert account into apisensitive account: %w", err))
+	}
+
+	return acctSensitive, nil
+}
+
+// MoveSelf moves the given local account to the account with the given moved to uri,
+// which must already list the given account as one of its aliases. Followers of the
+// moved account will be transferred to the target account asynchronously.
+func (p *Processor) MoveSelf(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountMoveRequest) gtserror.WithCode {
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// make sure a password is actually set and bail if not
+	if user.EncryptedPassword == "" {
+		return gtserror.NewErrorForbidden(errors.New("user password was not set"))
+	}
+
+	// compare the provided password with the encrypted one from the db, bail if they don't match
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(form.Password)); err != nil {
+		return gtserror.NewErrorForbidden(errors.New("invalid password"))
+	}
+
+	if account.MovedToURI != "" {
+		err := fmt.Errorf("account has already moved to %s", account.MovedToURI)
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	movedToURI, err := url.Parse(form.MovedToURI)
+	if err != nil || !movedToURI.IsAbs() || (movedToURI.Scheme != "http" && movedToURI.Scheme != "https") {
+		err := fmt.Errorf("moved_to_uri %s was not a valid http(s) uri", form.MovedToURI)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if movedToURI.String() == account.URI {
+		err := errors.New("account cannot be moved to itself")
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	targetAccount, err := p.federator.GetAccountByURI(ctx, account.Username, movedToURI, false)
+	if err != nil {
+		err = fmt.Errorf("could not get account %s to move to: %w", movedToURI, err)
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if !contains(targetAccount.AlsoKnownAsURIs, account.URI) && targetAccount.Domain != "" {
+		// the target account may have set its aliases
+		// only recently, so make sure we're up to date
+		targetAccount, err = p.federator.UpdateAccount(ctx, account.Username, targetAccount, true)
+		if err != nil {
+			err = fmt.Errorf("could not update account %s to move to: %w", movedToURI, err)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+	}
+
+	if !contains(targetAccount.AlsoKnownAsURIs, account.URI) {
+		err := fmt.Errorf("account %s does not list %s as one of its aliases", targetAccount.URI, account.URI)
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if targetAccount.MovedToURI != "" {
+		err := fmt.Errorf("account %s has itself moved to %s", targetAccount.URI, targetAccount.MovedToURI)
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	account.MovedToURI = targetAccount.URI
+	account.MovedToAccountID = targetAccount.ID
+	if err := p.state.DB.UpdateAccount(ctx, account); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("MoveSelf: could not update account %s: %w", account.ID, err))
+	}
+
+	// federate the move and transfer followers asynchronously
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityMove,
+		GTSModel:       account,
+		OriginAccount:  account,
+		TargetAccount:  targetAccount,
+	})
+
+	return nil
+}
+
+func contains(uris []string, uri string) bool {
+	for _, u := range uris {
+		if u == uri {
+			return true
+		}
+	}
+	return false
+}
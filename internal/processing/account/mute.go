@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// MuteCreate handles the creation or updating of a mute from requestingAccount to the target account in form.
+// Mutes are local-only, so unlike blocks they are never federated.
+func (p *Processor) MuteCreate(ctx context.Context, requestingAccount *gtsmodel.Account, form *apimodel.UserMuteCreateUpdateRequest) (*apimodel.Relationship, gtserror.WithCode) {
+	// make sure the target account actually exists in our db
+	targetAccount, err := p.state.DB.GetAccountByID(ctx, form.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("MuteCreate: account %s not found in the db: %s", form.ID, err))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteCreate: error getting account %s from the db: %s", form.ID, err))
+	}
+
+	// don't mute yourself, silly
+	if requestingAccount.ID == targetAccount.ID {
+		return nil, gtserror.NewErrorNotAcceptable(fmt.Errorf("MuteCreate: account %s cannot mute itself", requestingAccount.ID))
+	}
+
+	// notifications are muted too unless otherwise specified
+	notifications := true
+	if form.Notifications != nil {
+		notifications = *form.Notifications
+	}
+
+	var expiresAt time.Time
+	if form.Duration != nil {
+		if *form.Duration < 0 {
+			err := errors.New("duration must not be negative")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		if *form.Duration > 0 {
+			expiresAt = time.Now().Add(time.Duration(*form.Duration) * time.Second)
+		}
+	}
+
+	// if a mute exists already (expired or not), just update it with the new values
+	mute, err := p.state.DB.GetMute(ctx, requestingAccount.ID, targetAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteCreate: error checking existence of mute: %s", err))
+	}
+
+	if mute != nil {
+		mute.Notifications = &notifications
+		mute.ExpiresAt = expiresAt
+		if err := p.state.DB.UpdateMute(ctx, mute, "notifications", "expires_at"); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteCreate: error updating mute in db: %s", err))
+		}
+	} else {
+		mute = &gtsmodel.UserMute{
+			ID:              id.NewULID(),
+			ExpiresAt:       expiresAt,
+			AccountID:       requestingAccount.ID,
+			Account:         requestingAccount,
+			TargetAccountID: targetAccount.ID,
+			TargetAccount:   targetAccount,
+			Notifications:   &notifications,
+		}
+		if err := p.state.DB.PutMute(ctx, mute); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteCreate: error creating mute in db: %s", err))
+		}
+	}
+
+	// remove the muted account's statuses from the muting account's timelines asynchronously
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityIgnore,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       mute,
+		OriginAccount:  requestingAccount,
+		TargetAccount:  targetAccount,
+	})
+
+	return p.RelationshipGet(ctx, requestingAccount, targetAccount.ID)
+}
+
+// MuteRemove handles the removal of a mute from requestingAccount to targetAccountID.
+func (p *Processor) MuteRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode) {
+	// make sure the target account actually exists in our db
+	targetAccount, err := p.state.DB.GetAccountByID(ctx, targetAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("MuteRemove: account %s not found in the db: %s", targetAccountID, err))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteRemove: error getting account %s from the db: %s", targetAccountID, err))
+	}
+
+	mute, err := p.state.DB.GetMute(ctx, requestingAccount.ID, targetAccount.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// no mute exists so there's nothing to do
+			return p.RelationshipGet(ctx, requestingAccount, targetAccount.ID)
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteRemove: error checking existence of mute: %s", err))
+	}
+
+	if err := p.state.DB.DeleteMuteByID(ctx, mute.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteRemove: error removing mute from db: %s", err))
+	}
+
+	return p.RelationshipGet(ctx, requestingAccount, targetAccount.ID)
+}
+
+// MutesGet returns a pageable response of accounts that are muted by requestingAccount.
+// Paging for this response is done based on mute ID rather than account ID.
+func (p *Processor) MutesGet(ctx context.Context, requestingAccount *gtsmodel.Account, limit int, maxID string, sinceID string) (*apimodel.PageableResponse, gtserror.WithCode) {
+	mutes, nextMaxIDValue, prevMinIDValue, err := p.state.DB.GetAccountMutes(ctx, requestingAccount.ID, maxID, sinceID, limit)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// there are just no entries
+			return util.EmptyPageableResponse(), nil
+		}
+		// there's an actual error
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]interface{}, 0, len(mutes))
+	for _, mute := range mutes {
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, mute.TargetAccount)
+		if err != nil {
+			log.Errorf(ctx, "error converting muted account to api: %s", err)
+			continue
+		}
+
+		if !mute.ExpiresAt.IsZero() {
+			apiAccount.MuteExpiresAt = util.FormatISO8601(mute.ExpiresAt)
+		}
+
+		items = append(items, apiAccount)
+	}
+
+	if len(items) == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "/api/v1/mutes",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDKey:   "since_id",
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
@@ -60,11 +60,20 @@ func (p *Processor) StatusesGet(ctx context.Context, requestingAccount *gtsmodel
 	// Filtering + serialization process is the same for
 	// either pinned status queries or 'normal' ones.
 	filtered := make([]*gtsmodel.Status, 0, len(statuses))
+	filterResults := make(map[string][]*gtsmodel.FilterResult, len(statuses))
 	for _, s := range statuses {
 		visible, err := p.filter.StatusVisible(ctx, s, requestingAccount)
-		if err == nil && visible {
-			filtered = append(filtered, s)
+		if err != nil || !visible {
+			continue
 		}
+
+		hide, results, err := p.filter.StatusFiltered(ctx, s, requestingAccount, gtsmodel.FilterContextAccount)
+		if err != nil || hide {
+			continue
+		}
+
+		filtered = append(filtered, s)
+		filterResults[s.ID] = results
 	}
 
 	count := len(filtered)
@@ -81,6 +90,11 @@ func (p *Processor) StatusesGet(ctx context.Context, requestingAccount *gtsmodel
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status to api: %s", err))
 		}
 
+		item.Filtered, err = p.tc.FilterResultsToAPIFilterResults(ctx, filterResults[s.ID])
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter results to api: %s", err))
+		}
+
 		if i == count-1 {
 			nextMaxIDValue = item.GetID()
 		}
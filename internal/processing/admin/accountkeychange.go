@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// AccountKeyChangesGet returns recorded public key changes of remote accounts,
+// newest first, optionally only those of the account with the given ID.
+func (p *Processor) AccountKeyChangesGet(ctx context.Context, accountID string, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	changes, err := p.state.DB.GetAccountKeyChanges(ctx, accountID, maxID, minID, limit)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return util.EmptyPageableResponse(), nil
+		}
+		err = fmt.Errorf("AccountKeyChangesGet: db error getting key changes: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(changes)
+	items := make([]interface{}, 0, count)
+	for _, change := range changes {
+		item, err := p.tc.AccountKeyChangeToAdminAPIAccountKeyChange(ctx, change)
+		if err != nil {
+			err = fmt.Errorf("AccountKeyChangesGet: error converting key change %s: %w", change.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		items = append(items, item)
+	}
+
+	extraQueryParams := []string{}
+	if accountID != "" {
+		extraQueryParams = append(extraQueryParams, "account_id="+accountID)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:            items,
+		Path:             "/api/v1/admin/key_changes",
+		NextMaxIDValue:   changes[count-1].ID,
+		PrevMinIDValue:   changes[0].ID,
+		Limit:            limit,
+		ExtraQueryParams: extraQueryParams,
+	})
+}
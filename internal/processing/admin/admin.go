@@ -33,13 +33,18 @@ type Processor struct {
 	emailSender         email.Sender
 }
 
-// New returns a new admin processor.
+// New returns a new admin processor, and schedules
+// the job for syncing domain block subscriptions.
 func New(state *state.State, tc typeutils.TypeConverter, mediaManager media.Manager, transportController transport.Controller, emailSender email.Sender) Processor {
-	return Processor{
+	p := Processor{
 		state:               state,
 		tc:                  tc,
 		mediaManager:        mediaManager,
 		transportController: transportController,
 		emailSender:         emailSender,
 	}
+
+	scheduleSyncDomainBlockSubscriptions(&p)
+
+	return p
 }
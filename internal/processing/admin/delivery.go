@@ -0,0 +1,65 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DeliveryQueueGet returns the depth of the outgoing delivery queue,
+// and up to limit hosts which deliveries are currently failing to.
+func (p *Processor) DeliveryQueueGet(ctx context.Context, limit int) (*apimodel.AdminDeliveryQueue, gtserror.WithCode) {
+	depth, failing, err := p.state.DB.CountDeliveries(ctx)
+	if err != nil {
+		err = fmt.Errorf("DeliveryQueueGet: db error counting deliveries: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	hosts, err := p.state.DB.GetDeliveryHosts(ctx, limit)
+	if err != nil {
+		err = fmt.Errorf("DeliveryQueueGet: db error getting failing hosts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	queue := &apimodel.AdminDeliveryQueue{
+		Depth:        depth,
+		Failing:      failing,
+		FailingHosts: make([]apimodel.AdminDeliveryHost, 0, len(hosts)),
+	}
+
+	for _, host := range hosts {
+		apiHost := apimodel.AdminDeliveryHost{
+			Host:         host.Host,
+			Deliveries:   host.Deliveries,
+			FailingSince: util.FormatISO8601(host.FailingSince),
+		}
+
+		if !host.LastAttemptAt.IsZero() {
+			apiHost.LastAttemptAt = util.FormatISO8601(host.LastAttemptAt)
+		}
+
+		queue.FailingHosts = append(queue.FailingHosts, apiHost)
+	}
+
+	return queue, nil
+}
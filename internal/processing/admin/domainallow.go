@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// DomainAllowCreate creates an allow for the given domain, if one doesn't exist already,
+// and returns it. Domain allows only have an effect when running in allowlist federation mode.
+func (p *Processor) DomainAllowCreate(ctx context.Context, account *gtsmodel.Account, domain string, publicComment string, privateComment string) (*apimodel.DomainAllow, gtserror.WithCode) {
+	// domain allows will always be lowercase
+	domain = strings.ToLower(domain)
+
+	// first check if we already have an allow -- if err == nil we already had one so we can skip creation
+	allow, err := p.state.DB.GetDomainAllow(ctx, domain)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// something went wrong in the DB
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error checking for existence of domain allow %s: %s", domain, err))
+		}
+
+		// there's no allow for this domain yet so create one
+		allow = &gtsmodel.DomainAllow{
+			ID:                 id.NewULID(),
+			Domain:             domain,
+			CreatedByAccountID: account.ID,
+			PrivateComment:     text.SanitizePlaintext(privateComment),
+			PublicComment:      text.SanitizePlaintext(publicComment),
+		}
+
+		// Insert the new allow into the database
+		if err := p.state.DB.CreateDomainAllow(ctx, allow); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting new domain allow %s: %s", domain, err))
+		}
+	}
+
+	// Convert our gts model domain allow into an API model
+	apiDomainAllow, err := p.tc.DomainAllowToAPIDomainAllow(ctx, allow)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting domain allow to frontend/api representation %s: %s", domain, err))
+	}
+
+	return apiDomainAllow, nil
+}
+
+// DomainAllowsGet returns all existing domain allows.
+func (p *Processor) DomainAllowsGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.DomainAllow, gtserror.WithCode) {
+	domainAllows, err := p.state.DB.GetDomainAllows(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// something has gone really wrong
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiDomainAllows := []*apimodel.DomainAllow{}
+	for _, a := range domainAllows {
+		apiDomainAllow, err := p.tc.DomainAllowToAPIDomainAllow(ctx, a)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiDomainAllows = append(apiDomainAllows, apiDomainAllow)
+	}
+
+	return apiDomainAllows, nil
+}
+
+// DomainAllowGet returns one domain allow with the given id.
+func (p *Processor) DomainAllowGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.DomainAllow, gtserror.WithCode) {
+	domainAllow, err := p.state.DB.GetDomainAllowByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// something has gone really wrong
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		// there are no entries for this ID
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("no entry for ID %s", id))
+	}
+
+	apiDomainAllow, err := p.tc.DomainAllowToAPIDomainAllow(ctx, domainAllow)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDomainAllow, nil
+}
+
+// DomainAllowDelete removes one domain allow with the given ID.
+func (p *Processor) DomainAllowDelete(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.DomainAllow, gtserror.WithCode) {
+	domainAllow, err := p.state.DB.GetDomainAllowByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// something has gone really wrong
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		// there are no entries for this ID
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("no entry for ID %s", id))
+	}
+
+	// prepare the domain allow to return
+	apiDomainAllow, err := p.tc.DomainAllowToAPIDomainAllow(ctx, domainAllow)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Delete the domain allow
+	if err := p.state.DB.DeleteDomainAllow(ctx, domainAllow.Domain); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiDomainAllow, nil
+}
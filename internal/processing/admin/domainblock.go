@@ -40,11 +40,17 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 )
 
-func (p *Processor) DomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string, obfuscate bool, publicComment string, privateComment string, subscriptionID string) (*apimodel.DomainBlock, gtserror.WithCode) {
+func (p *Processor) DomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string, obfuscate bool, publicComment string, privateComment string, subscriptionID string, severity gtsmodel.DomainBlockSeverity, rejectMedia bool) (*apimodel.DomainBlock, gtserror.WithCode) {
 	// domain blocks will always be lowercase
 	domain = strings.ToLower(domain)
 
-	// first check if we already have a block -- if err == nil we already had a block so we can skip a whole lot of work
+	// domain blocks are suspensions unless stated otherwise
+	if severity == "" {
+		severity = gtsmodel.DomainBlockSeveritySuspend
+	}
+
+	// first check if we already have a block -- if err == nil we already had a block so we can skip a whole lot of work,
+	// unless its severity or media setting needs changing
 	block, err := p.state.DB.GetDomainBlock(ctx, domain)
 	if err != nil {
 		if !errors.Is(err, db.ErrNoEntries) {
@@ -61,6 +67,8 @@ func (p *Processor) DomainBlockCreate(ctx context.Context, account *gtsmodel.Acc
 			PublicComment:      text.SanitizePlaintext(publicComment),
 			Obfuscate:          &obfuscate,
 			SubscriptionID:     subscriptionID,
+			Severity:           severity,
+			RejectMedia:        &rejectMedia,
 		}
 
 		// Insert the new block into the database
@@ -71,10 +79,32 @@ func (p *Processor) DomainBlockCreate(ctx context.Context, account *gtsmodel.Acc
 		// Set the newly created block
 		block = newBlock
 
-		// Process the side effects of the domain block asynchronously since it might take a while
-		go func() {
-			p.initiateDomainBlockSideEffects(context.Background(), account, block)
-		}()
+		// Limiting a domain has no side effects on existing accounts, but
+		// suspending does; process them asynchronously since it might take a while
+		if block.Severity == gtsmodel.DomainBlockSeveritySuspend {
+			go func() {
+				p.initiateDomainBlockSideEffects(context.Background(), account, block)
+			}()
+		}
+	} else if block.Severity != severity || *block.RejectMedia != rejectMedia {
+		// we already had a block, but with a different severity
+		// or media setting, so update it to match this request
+		escalated := block.Severity != gtsmodel.DomainBlockSeveritySuspend &&
+			severity == gtsmodel.DomainBlockSeveritySuspend
+
+		block.Severity = severity
+		block.RejectMedia = &rejectMedia
+		if err := p.state.DB.UpdateDomainBlock(ctx, block, "severity", "reject_media"); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error updating domain block %s: %s", domain, err))
+		}
+
+		// a limit that's escalated to a suspension needs the
+		// same side effects as a suspension created from scratch
+		if escalated {
+			go func() {
+				p.initiateDomainBlockSideEffects(context.Background(), account, block)
+			}()
+		}
 	}
 
 	// Convert our gts model domain block into an API model
@@ -196,7 +226,12 @@ func (p *Processor) DomainBlocksImport(ctx context.Context, account *gtsmodel.Ac
 
 	blocks := []*apimodel.DomainBlock{}
 	for _, d := range d {
-		block, err := p.DomainBlockCreate(ctx, account, d.Domain.Domain, false, d.PublicComment, "", "")
+		severity := gtsmodel.DomainBlockSeverity(d.Severity)
+		if severity != "" && severity != gtsmodel.DomainBlockSeveritySuspend && severity != gtsmodel.DomainBlockSeverityLimit {
+			return nil, gtserror.NewErrorBadRequest(fmt.Errorf("DomainBlocksImport: invalid severity %s for domain %s", d.Severity, d.Domain.Domain))
+		}
+
+		block, err := p.DomainBlockCreate(ctx, account, d.Domain.Domain, false, d.PublicComment, "", "", severity, d.RejectMedia)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,587 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"codeberg.org/gruf/go-runners"
+	"codeberg.org/gruf/go-sched"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"golang.org/x/net/idna"
+)
+
+const (
+	// syncDomainBlockSubscriptionsInterval is how
+	// often domain block subscriptions are synced.
+	syncDomainBlockSubscriptionsInterval = time.Hour
+
+	// maxDomainBlockListSize is the maximum size
+	// of a list of domains to block, in bytes.
+	maxDomainBlockListSize = 10 * 1024 * 1024
+)
+
+// domainBlockEntry is one domain to block,
+// as parsed from a list of domains to block.
+type domainBlockEntry struct {
+	Domain        string
+	PublicComment string
+	Obfuscate     bool
+}
+
+// DomainBlockSubscriptionCreate creates a new domain block subscription,
+// and syncs it straight away so that the list of domains can be checked.
+func (p *Processor) DomainBlockSubscriptionCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.DomainBlockSubscriptionCreateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	uri, err := url.Parse(form.URI)
+	if err != nil || (uri.Scheme != "https" && uri.Scheme != "http") || uri.Host == "" {
+		err := fmt.Errorf("uri %s was not a valid http or https uri", form.URI)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	switch form.ContentType {
+	case gtsmodel.DomainBlockSubscriptionPlain,
+		gtsmodel.DomainBlockSubscriptionCSV,
+		gtsmodel.DomainBlockSubscriptionJSON:
+		// fine
+	default:
+		err := fmt.Errorf(
+			"content_type must be one of %s, %s or %s",
+			gtsmodel.DomainBlockSubscriptionPlain,
+			gtsmodel.DomainBlockSubscriptionCSV,
+			gtsmodel.DomainBlockSubscriptionJSON,
+		)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	subscription := &gtsmodel.DomainBlockSubscription{
+		ID:                 id.NewULID(),
+		Title:              text.SanitizePlaintext(form.Title),
+		URI:                uri.String(),
+		ContentType:        form.ContentType,
+		Obfuscate:          &form.Obfuscate,
+		CreatedByAccountID: account.ID,
+		CreatedByAccount:   account,
+	}
+
+	if err := p.state.DB.PutDomainBlockSubscription(ctx, subscription); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := fmt.Errorf("a subscription to %s already exists", subscription.URI)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting domain block subscription: %w", err))
+	}
+
+	// Errors are stored on the subscription,
+	// so that they can be shown to the admin.
+	if err := p.syncDomainBlockSubscription(ctx, subscription); err != nil {
+		log.Warnf(ctx, "error syncing new domain block subscription %s: %v", subscription.ID, err)
+	}
+
+	return p.apiDomainBlockSubscription(ctx, subscription)
+}
+
+// DomainBlockSubscriptionsGet returns all domain block subscriptions.
+func (p *Processor) DomainBlockSubscriptionsGet(ctx context.Context) ([]*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscriptions, err := p.state.DB.GetDomainBlockSubscriptions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiSubscriptions := make([]*apimodel.DomainBlockSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		apiSubscription, errWithCode := p.apiDomainBlockSubscription(ctx, subscription)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiSubscriptions = append(apiSubscriptions, apiSubscription)
+	}
+
+	return apiSubscriptions, nil
+}
+
+// DomainBlockSubscriptionGet returns one domain block subscription with the given id.
+func (p *Processor) DomainBlockSubscriptionGet(ctx context.Context, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getDomainBlockSubscription(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDomainBlockSubscription(ctx, subscription)
+}
+
+// DomainBlockSubscriptionSync fetches the list of domains for the domain block subscription
+// with the given id straight away, and creates or removes domain blocks to match it.
+func (p *Processor) DomainBlockSubscriptionSync(ctx context.Context, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getDomainBlockSubscription(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Errors are stored on the subscription,
+	// so that they can be shown to the admin.
+	if err := p.syncDomainBlockSubscription(ctx, subscription); err != nil {
+		log.Warnf(ctx, "error syncing domain block subscription %s: %v", subscription.ID, err)
+	}
+
+	return p.apiDomainBlockSubscription(ctx, subscription)
+}
+
+// DomainBlockSubscriptionDelete removes the domain block subscription with the given id.
+// Unless keepBlocks is true, the domain blocks created through the subscription are removed too.
+func (p *Processor) DomainBlockSubscriptionDelete(ctx context.Context, account *gtsmodel.Account, id string, keepBlocks bool) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getDomainBlockSubscription(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// prepare the subscription to return
+	apiSubscription, errWithCode := p.apiDomainBlockSubscription(ctx, subscription)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	blocks, err := p.state.DB.GetDomainBlocksBySubscriptionID(ctx, subscription.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, block := range blocks {
+		if keepBlocks {
+			// Keep the block, it's just not
+			// managed by a subscription anymore.
+			block.SubscriptionID = ""
+			block.UpdatedAt = time.Now()
+			if err := p.state.DB.UpdateByID(ctx, block, block.ID, "subscription_id", "updated_at"); err != nil {
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+			continue
+		}
+
+		if _, errWithCode := p.DomainBlockDelete(ctx, account, block.ID); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	if err := p.state.DB.DeleteDomainBlockSubscription(ctx, subscription.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiSubscription, nil
+}
+
+// SyncDomainBlockSubscriptions syncs every domain block subscription in turn,
+// creating or removing domain blocks to match each subscribed list of domains.
+func (p *Processor) SyncDomainBlockSubscriptions(ctx context.Context) error {
+	subscriptions, err := p.state.DB.GetDomainBlockSubscriptions(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil
+		}
+		return fmt.Errorf("SyncDomainBlockSubscriptions: db error getting subscriptions: %w", err)
+	}
+
+	for _, subscription := range subscriptions {
+		if err := p.syncDomainBlockSubscription(ctx, subscription); err != nil {
+			log.Warnf(ctx, "error syncing domain block subscription %s: %v", subscription.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Processor) getDomainBlockSubscription(ctx context.Context, id string) (*gtsmodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, err := p.state.DB.GetDomainBlockSubscriptionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("no domain block subscription with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return subscription, nil
+}
+
+func (p *Processor) apiDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	apiSubscription, err := p.tc.DomainBlockSubscriptionToAPIDomainBlockSubscription(ctx, subscription)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiSubscription, nil
+}
+
+// syncDomainBlockSubscription fetches the list of domains for the given subscription,
+// then creates and removes domain blocks to match it, storing the result of the sync
+// (or the error which prevented it) on the subscription.
+func (p *Processor) syncDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) error {
+	subscription.FetchedAt = time.Now()
+
+	added, removed, err := p.fetchAndApplyDomainBlockList(ctx, subscription)
+	if err != nil {
+		subscription.Error = err.Error()
+		if dbErr := p.state.DB.UpdateDomainBlockSubscription(ctx, subscription, "fetched_at", "error"); dbErr != nil {
+			log.Errorf(ctx, "db error updating domain block subscription %s: %v", subscription.ID, dbErr)
+		}
+		return err
+	}
+
+	subscription.Error = ""
+	subscription.SuccessfullyFetchedAt = subscription.FetchedAt
+	subscription.AddedDomains = added
+	subscription.RemovedDomains = removed
+
+	if err := p.state.DB.UpdateDomainBlockSubscription(ctx, subscription,
+		"fetched_at",
+		"successfully_fetched_at",
+		"error",
+		"added_domains",
+		"removed_domains",
+	); err != nil {
+		return fmt.Errorf("db error updating domain block subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Processor) fetchAndApplyDomainBlockList(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) ([]string, []string, error) {
+	uri, err := url.Parse(subscription.URI)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing uri: %w", err)
+	}
+
+	// Use the instance account to fetch the list.
+	tsport, err := p.transportController.NewTransportForUsername(ctx, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting transport: %w", err)
+	}
+
+	rc, err := tsport.DereferenceDomainBlocks(transport.WithFastfail(ctx), uri, subscription.ContentType)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rc.Close()
+
+	entries, err := parseDomainBlockList(io.LimitReader(rc, maxDomainBlockListSize), subscription.ContentType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(entries) == 0 {
+		// Most likely the list is broken rather than actually empty;
+		// don't remove all of the subscription's blocks because of it.
+		return nil, nil, errors.New("list contained no valid domains")
+	}
+
+	return p.applyDomainBlockList(ctx, subscription, entries)
+}
+
+// applyDomainBlockList creates and removes domain blocks for the given subscription, so
+// that they match the given entries. Domains which are already blocked manually, or by
+// another subscription, are left alone. It returns the blocked and unblocked domains.
+func (p *Processor) applyDomainBlockList(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription, entries []domainBlockEntry) ([]string, []string, error) {
+	account := subscription.CreatedByAccount
+	if account == nil {
+		var err error
+		account, err = p.state.DB.GetAccountByID(ctx, subscription.CreatedByAccountID)
+		if err != nil {
+			// The admin who created the subscription might
+			// be gone, so do it as the instance account.
+			account, err = p.state.DB.GetInstanceAccount(ctx, "")
+			if err != nil {
+				return nil, nil, fmt.Errorf("error getting instance account: %w", err)
+			}
+		}
+		subscription.CreatedByAccount = account
+	}
+
+	existing, err := p.state.DB.GetDomainBlocksBySubscriptionID(ctx, subscription.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, nil, fmt.Errorf("db error getting subscription blocks: %w", err)
+	}
+
+	current := make(map[string]*gtsmodel.DomainBlock, len(existing))
+	for _, block := range existing {
+		current[block.Domain] = block
+	}
+
+	wanted := make(map[string]struct{}, len(entries))
+
+	privateComment := "Created by domain block subscription to " + subscription.URI
+	if subscription.Title != "" {
+		privateComment = "Created by domain block subscription " + subscription.Title + " (" + subscription.URI + ")"
+	}
+
+	var (
+		added   = []string{}
+		removed = []string{}
+	)
+
+	for _, entry := range entries {
+		wanted[entry.Domain] = struct{}{}
+
+		if _, ok := current[entry.Domain]; ok {
+			// Already blocked by us.
+			continue
+		}
+
+		if _, err := p.state.DB.GetDomainBlock(ctx, entry.Domain); err == nil {
+			// Already blocked some other way.
+			continue
+		} else if !errors.Is(err, db.ErrNoEntries) {
+			return nil, nil, fmt.Errorf("db error checking for domain block %s: %w", entry.Domain, err)
+		}
+
+		if _, errWithCode := p.DomainBlockCreate(ctx,
+			account,
+			entry.Domain,
+			*subscription.Obfuscate || entry.Obfuscate,
+			entry.PublicComment,
+			privateComment,
+			subscription.ID,
+			gtsmodel.DomainBlockSeveritySuspend,
+			false,
+		); errWithCode != nil {
+			return nil, nil, fmt.Errorf("error blocking %s: %w", entry.Domain, errWithCode)
+		}
+
+		added = append(added, entry.Domain)
+	}
+
+	for _, block := range existing {
+		if _, ok := wanted[block.Domain]; ok {
+			continue
+		}
+
+		if _, errWithCode := p.DomainBlockDelete(ctx, account, block.ID); errWithCode != nil {
+			return nil, nil, fmt.Errorf("error unblocking %s: %w", block.Domain, errWithCode)
+		}
+
+		removed = append(removed, block.Domain)
+	}
+
+	// Make sure the block caches reflect the changes.
+	p.state.Caches.GTS.DomainBlock().Clear()
+	p.state.Caches.GTS.DomainLimit().Clear()
+	p.state.Caches.GTS.DomainRejectMedia().Clear()
+
+	return added, removed, nil
+}
+
+// parseDomainBlockList parses a list of domains to block in the given
+// content type, skipping over any invalid or duplicate domains.
+func parseDomainBlockList(r io.Reader, contentType string) ([]domainBlockEntry, error) {
+	var (
+		entries []domainBlockEntry
+		err     error
+	)
+
+	switch contentType {
+	case gtsmodel.DomainBlockSubscriptionPlain:
+		entries, err = parseDomainBlockListPlain(r)
+	case gtsmodel.DomainBlockSubscriptionCSV:
+		entries, err = parseDomainBlockListCSV(r)
+	case gtsmodel.DomainBlockSubscriptionJSON:
+		entries, err = parseDomainBlockListJSON(r)
+	default:
+		err = fmt.Errorf("unsupported content type %s", contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	valid := make([]domainBlockEntry, 0, len(entries))
+	seen := make(map[string]struct{}, len(entries))
+
+	for _, entry := range entries {
+		domain, ok := normalizeBlockedDomain(entry.Domain)
+		if !ok {
+			continue
+		}
+
+		if _, ok := seen[domain]; ok {
+			continue
+		}
+		seen[domain] = struct{}{}
+
+		entry.Domain = domain
+		entry.PublicComment = text.SanitizePlaintext(entry.PublicComment)
+		valid = append(valid, entry)
+	}
+
+	return valid, nil
+}
+
+// parseDomainBlockListPlain parses a list with one domain
+// per line. Empty lines and lines starting with # are skipped.
+func parseDomainBlockListPlain(r io.Reader) ([]domainBlockEntry, error) {
+	entries := []domainBlockEntry{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		entries = append(entries, domainBlockEntry{Domain: fields[0]})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading list: %w", err)
+	}
+
+	return entries, nil
+}
+
+// parseDomainBlockListCSV parses a list in Mastodon's export format, ie., a CSV file
+// with the header "#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate".
+// Only suspensions are imported. If there's no header, the first column is taken as the domain.
+func parseDomainBlockListCSV(r io.Reader) ([]domainBlockEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading csv: %w", err)
+	}
+
+	if len(records) == 0 {
+		return []domainBlockEntry{}, nil
+	}
+
+	columns := map[string]int{"domain": 0}
+	if header := records[0]; strings.HasPrefix(header[0], "#") {
+		columns = make(map[string]int, len(header))
+		for i, column := range header {
+			columns[strings.TrimPrefix(strings.TrimSpace(column), "#")] = i
+		}
+		records = records[1:]
+	}
+
+	if _, ok := columns["domain"]; !ok {
+		return nil, errors.New("csv header had no domain column")
+	}
+
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	entries := make([]domainBlockEntry, 0, len(records))
+	for _, record := range records {
+		switch field(record, "severity") {
+		case "", "suspend":
+			// we can handle these
+		default:
+			// we can't silence or noop
+			continue
+		}
+
+		obfuscate, _ := strconv.ParseBool(field(record, "obfuscate"))
+		entries = append(entries, domainBlockEntry{
+			Domain:        field(record, "domain"),
+			PublicComment: field(record, "public_comment"),
+			Obfuscate:     obfuscate,
+		})
+	}
+
+	return entries, nil
+}
+
+// parseDomainBlockListJSON parses a list in the format used for
+// exporting domain blocks from GoToSocial, ie., a JSON array
+// of objects with domain, public_comment and obfuscate keys.
+func parseDomainBlockListJSON(r io.Reader) ([]domainBlockEntry, error) {
+	blocks := []struct {
+		Domain        string `json:"domain"`
+		PublicComment string `json:"public_comment"`
+		Obfuscate     bool   `json:"obfuscate"`
+		Severity      string `json:"severity"`
+	}{}
+
+	if err := json.NewDecoder(r).Decode(&blocks); err != nil {
+		return nil, fmt.Errorf("error decoding json: %w", err)
+	}
+
+	entries := make([]domainBlockEntry, 0, len(blocks))
+	for _, block := range blocks {
+		if block.Severity != "" && block.Severity != "suspend" {
+			continue
+		}
+
+		entries = append(entries, domainBlockEntry{
+			Domain:        block.Domain,
+			PublicComment: block.PublicComment,
+			Obfuscate:     block.Obfuscate,
+		})
+	}
+
+	return entries, nil
+}
+
+// normalizeBlockedDomain lowercases the given domain and converts it to punycode,
+// returning false if it's not a valid domain, or if it's the domain of this instance.
+func normalizeBlockedDomain(domain string) (string, bool) {
+	domain, err := idna.Lookup.ToASCII(strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), "."))
+	if err != nil || domain == "" || !strings.Contains(domain, ".") {
+		return "", false
+	}
+
+	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+		return "", false
+	}
+
+	return domain, true
+}
+
+func scheduleSyncDomainBlockSubscriptions(p *Processor) {
+	// Get ctx associated with scheduler run state.
+	done := p.state.Workers.Scheduler.Done()
+	doneCtx := runners.CancelCtx(done)
+
+	// Schedule the SyncDomainBlockSubscriptions task to execute every hour.
+	p.state.Workers.Scheduler.Schedule(sched.NewJob(func(now time.Time) {
+		if err := p.SyncDomainBlockSubscriptions(doneCtx); err != nil {
+			log.Errorf(nil, "error syncing domain block subscriptions: %v", err)
+		}
+		log.Infof(nil, "finished syncing domain block subscriptions in %s", time.Since(now))
+	}).Every(syncDomainBlockSubscriptionsInterval))
+}
@@ -0,0 +1,225 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// RelayCreate subscribes to the relay with the given inbox, by
+// sending it a Follow of the public collection from the instance
+// account. The relay is pending until the relay accepts the Follow.
+func (p *Processor) RelayCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.AdminRelayCreateRequest) (*apimodel.AdminRelay, gtserror.WithCode) {
+	inboxURI, err := url.Parse(form.InboxURI)
+	if err != nil || (inboxURI.Scheme != "https" && inboxURI.Scheme != "http") || inboxURI.Host == "" {
+		err := fmt.Errorf("inbox_uri %s was not a valid http or https uri", form.InboxURI)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	blocked, err := p.state.DB.IsURIBlocked(ctx, inboxURI)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error checking domain block: %w", err))
+	}
+
+	if blocked {
+		err := fmt.Errorf("domain %s is blocked", inboxURI.Host)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	instanceAccount, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting instance account: %w", err))
+	}
+
+	relayID := id.NewULID()
+	relay := &gtsmodel.Relay{
+		ID:                 relayID,
+		InboxURI:           inboxURI.String(),
+		FollowURI:          uris.GenerateURIForFollow(instanceAccount.Username, relayID),
+		State:              gtsmodel.RelayStatePending,
+		Publish:            &form.Publish,
+		CreatedByAccountID: account.ID,
+		CreatedByAccount:   account,
+	}
+
+	if err := p.state.DB.PutRelay(ctx, relay); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := fmt.Errorf("a subscription to relay %s already exists", relay.InboxURI)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting relay: %w", err))
+	}
+
+	follow, err := p.tc.RelayToASFollow(ctx, relay, instanceAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.deliverToRelay(ctx, relay, instanceAccount, follow); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelaysGet returns all relays.
+func (p *Processor) RelaysGet(ctx context.Context) ([]*apimodel.AdminRelay, gtserror.WithCode) {
+	relays, err := p.state.DB.GetRelays(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRelays := make([]*apimodel.AdminRelay, 0, len(relays))
+	for _, relay := range relays {
+		apiRelay, errWithCode := p.apiRelay(ctx, relay)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiRelays = append(apiRelays, apiRelay)
+	}
+
+	return apiRelays, nil
+}
+
+// RelayGet returns one relay with the given id.
+func (p *Processor) RelayGet(ctx context.Context, id string) (*apimodel.AdminRelay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiRelay(ctx, relay)
+}
+
+// RelayDelete unsubscribes from the relay with the given id, by sending
+// it an Undo of the Follow from the instance account, and removes it.
+func (p *Processor) RelayDelete(ctx context.Context, id string) (*apimodel.AdminRelay, gtserror.WithCode) {
+	relay, errWithCode := p.getRelay(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// prepare the relay to return
+	apiRelay, errWithCode := p.apiRelay(ctx, relay)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if relay.State != gtsmodel.RelayStateRejected {
+		instanceAccount, err := p.state.DB.GetInstanceAccount(ctx, "")
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting instance account: %w", err))
+		}
+
+		// recreate the follow
+		follow, err := p.tc.RelayToASFollow(ctx, relay, instanceAccount)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// create an Undo and set the appropriate actor on it
+		undo := streams.NewActivityStreamsUndo()
+		undo.SetActivityStreamsActor(follow.GetActivityStreamsActor())
+		undo.SetActivityStreamsTo(follow.GetActivityStreamsTo())
+
+		// Set the recreated follow as the 'object' property.
+		undoObject := streams.NewActivityStreamsObjectProperty()
+		undoObject.AppendActivityStreamsFollow(follow)
+		undo.SetActivityStreamsObject(undoObject)
+
+		// Give the Undo an id of its own, derived from the follow.
+		undoID, err := url.Parse(relay.FollowURI + "/undo")
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		undoIDProp := streams.NewJSONLDIdProperty()
+		undoIDProp.SetIRI(undoID)
+		undo.SetJSONLDId(undoIDProp)
+
+		if err := p.deliverToRelay(ctx, relay, instanceAccount, undo); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if err := p.state.DB.DeleteRelayByID(ctx, relay.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRelay, nil
+}
+
+func (p *Processor) getRelay(ctx context.Context, id string) (*gtsmodel.Relay, gtserror.WithCode) {
+	relay, err := p.state.DB.GetRelayByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("no relay with id %s", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return relay, nil
+}
+
+func (p *Processor) apiRelay(ctx context.Context, relay *gtsmodel.Relay) (*apimodel.AdminRelay, gtserror.WithCode) {
+	apiRelay, err := p.tc.RelayToAdminAPIRelay(ctx, relay)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRelay, nil
+}
+
+// deliverToRelay queues the given activity for delivery to the inbox
+// of the given relay, signed on behalf of the instance account.
+func (p *Processor) deliverToRelay(ctx context.Context, relay *gtsmodel.Relay, instanceAccount *gtsmodel.Account, activity vocab.Type) error {
+	m, err := streams.Serialize(activity)
+	if err != nil {
+		return fmt.Errorf("deliverToRelay: error serializing activity: %w", err)
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("deliverToRelay: error marshaling activity: %w", err)
+	}
+
+	inboxURI, err := url.Parse(relay.InboxURI)
+	if err != nil {
+		return fmt.Errorf("deliverToRelay: error parsing inbox uri %s: %w", relay.InboxURI, err)
+	}
+
+	tp, err := p.transportController.NewTransportForUsername(ctx, instanceAccount.Username)
+	if err != nil {
+		return fmt.Errorf("deliverToRelay: error creating transport: %w", err)
+	}
+
+	return tp.BatchDeliver(ctx, b, []*url.URL{inboxURI})
+}
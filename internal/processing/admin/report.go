@@ -159,3 +159,58 @@ func (p *Processor) ReportResolve(ctx context.Context, account *gtsmodel.Account
 
 	return apimodelReport, nil
 }
+
+// ReportForward forwards a report with the given id to the
+// instance of the report target account, using the instance
+// actor so that the report creator stays anonymous. Reports
+// targeting local accounts, or reports that have already been
+// forwarded, cannot be forwarded.
+func (p *Processor) ReportForward(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.AdminReport, gtserror.WithCode) {
+	report, err := p.state.DB.GetReportByID(ctx, id)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if report.TargetAccount == nil {
+		report.TargetAccount, err = p.state.DB.GetAccountByID(ctx, report.TargetAccountID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !report.TargetAccount.IsRemote() {
+		err := fmt.Errorf("report %s targets local account %s, cannot forward", report.ID, report.TargetAccountID)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if *report.Forwarded {
+		err := fmt.Errorf("report %s has already been forwarded", report.ID)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	forwarded := true
+	report.Forwarded = &forwarded
+	updatedReport, err := p.state.DB.UpdateReport(ctx, report, "forwarded")
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Federate the report to the target's instance.
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityFlag,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       report,
+		OriginAccount:  account,
+		TargetAccount:  report.TargetAccount,
+	})
+
+	apimodelReport, err := p.tc.ReportToAdminAPIReport(ctx, updatedReport, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apimodelReport, nil
+}
@@ -32,11 +32,15 @@ func (p *Processor) AppCreate(ctx context.Context, authed *oauth.Auth, form *api
 	// set default 'read' for scopes if it's not set
 	var scopes string
 	if form.Scopes == "" {
-		scopes = "read"
+		scopes = string(oauth.ScopeDefault)
 	} else {
 		scopes = form.Scopes
 	}
 
+	if _, err := oauth.ParseScopes(scopes); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
 	// generate new IDs for this application and its associated client
 	clientID, err := id.NewRandomULID()
 	if err != nil {
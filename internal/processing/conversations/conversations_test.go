@@ -0,0 +1,161 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/conversations"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ConversationsTestSuite struct {
+	suite.Suite
+	db    db.DB
+	state state.State
+
+	testAccounts map[string]*gtsmodel.Account
+	testStatuses map[string]*gtsmodel.Status
+
+	conversations conversations.Processor
+}
+
+func (suite *ConversationsTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
+}
+
+func (suite *ConversationsTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.StartWorkers(&suite.state)
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+
+	suite.conversations = conversations.New(
+		&suite.state,
+		testrig.NewTestTypeConverter(suite.db),
+		visibility.NewFilter(suite.db),
+	)
+
+	testrig.StandardDBSetup(suite.db, suite.testAccounts)
+}
+
+func (suite *ConversationsTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StopWorkers(&suite.state)
+}
+
+func (suite *ConversationsTestSuite) TestUpdateConversationsForStatus() {
+	var (
+		ctx      = context.Background()
+		author   = suite.testAccounts["local_account_2"]
+		target   = suite.testAccounts["local_account_1"]
+		dmStatus = suite.testStatuses["local_account_2_status_6"]
+	)
+
+	updated, err := suite.conversations.UpdateConversationsForStatus(ctx, dmStatus)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// One conversation each for the author and the mentioned account.
+	suite.Len(updated, 2)
+
+	// The author's conversation should already be read.
+	resp, errWithCode := suite.conversations.GetAll(ctx, author, "", "", "", 20)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if suite.Len(resp.Items, 1) {
+		apiConversation := resp.Items[0].(*apimodel.Conversation)
+		suite.False(apiConversation.Unread)
+		suite.Equal(dmStatus.ID, apiConversation.LastStatus.ID)
+		if suite.Len(apiConversation.Accounts, 1) {
+			suite.Equal(target.ID, apiConversation.Accounts[0].ID)
+		}
+	}
+
+	// The target's conversation should be unread until marked read.
+	resp, errWithCode = suite.conversations.GetAll(ctx, target, "", "", "", 20)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if !suite.Len(resp.Items, 1) {
+		suite.FailNow("")
+	}
+	apiConversation := resp.Items[0].(*apimodel.Conversation)
+	suite.True(apiConversation.Unread)
+
+	apiConversation, errWithCode = suite.conversations.Read(ctx, target, apiConversation.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(apiConversation.Unread)
+
+	// Updating again with the same status should not duplicate anything.
+	if _, err := suite.conversations.UpdateConversationsForStatus(ctx, dmStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+	resp, errWithCode = suite.conversations.GetAll(ctx, target, "", "", "", 20)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(resp.Items, 1)
+
+	// The author can't delete the target's conversation.
+	errWithCode = suite.conversations.Delete(ctx, author, apiConversation.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// But the target can.
+	if errWithCode := suite.conversations.Delete(ctx, target, apiConversation.ID); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	resp, errWithCode = suite.conversations.GetAll(ctx, target, "", "", "", 20)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(resp.Items)
+}
+
+func (suite *ConversationsTestSuite) TestUpdateConversationsForPublicStatus() {
+	updated, err := suite.conversations.UpdateConversationsForStatus(
+		context.Background(),
+		suite.testStatuses["local_account_1_status_1"],
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(updated)
+}
+
+func TestConversationsTestSuite(t *testing.T) {
+	suite.Run(t, new(ConversationsTestSuite))
+}
@@ -0,0 +1,143 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// GetAll returns conversations owned by the given account, sorted by
+// last status ID DESC (most recently active first). The additional
+// parameters can be used for paging, and refer to last status IDs.
+func (p *Processor) GetAll(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	conversations, err := p.state.DB.GetConversationsForAccountID(ctx, account.ID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetAll: error getting conversations: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(conversations)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	var (
+		items = make([]interface{}, 0, count)
+
+		// Set next + prev values before API converting,
+		// so caller can still page properly.
+		nextMaxIDValue = conversations[count-1].LastStatusID
+		prevMinIDValue = conversations[0].LastStatusID
+	)
+
+	for _, conversation := range conversations {
+		apiConversation, err := p.tc.ConversationToAPIConversation(ctx, conversation, account)
+		if err != nil {
+			log.Errorf(ctx, "error converting conversation %s to api: %v", conversation.ID, err)
+			continue
+		}
+
+		items = append(items, apiConversation)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/conversations",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
+// Read marks the conversation with the given ID, owned by the given account, as read.
+func (p *Processor) Read(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Conversation, gtserror.WithCode) {
+	conversation, errWithCode := p.getConversation(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if conversation.Read == nil || !*conversation.Read {
+		read := true
+		conversation.Read = &read
+		if err := p.state.DB.UpdateConversation(ctx, conversation, "read"); err != nil {
+			err = fmt.Errorf("Read: error updating conversation %s: %w", id, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	apiConversation, err := p.tc.ConversationToAPIConversation(ctx, conversation, account)
+	if err != nil {
+		err = fmt.Errorf("Read: error converting conversation %s to api: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiConversation, nil
+}
+
+// Delete removes the conversation with the given ID, owned by the given account.
+// Only the account's view of the conversation is removed; statuses are untouched.
+func (p *Processor) Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	if _, errWithCode := p.getConversation(ctx, account.ID, id); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteConversationByID(ctx, id); err != nil {
+		err = fmt.Errorf("Delete: error deleting conversation %s: %w", id, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// getConversation is a shortcut to get one conversation from the database and
+// check that it's owned by the given accountID. Will return appropriate errors
+// so caller doesn't need to bother.
+func (p *Processor) getConversation(ctx context.Context, accountID string, id string) (*gtsmodel.Conversation, gtserror.WithCode) {
+	conversation, err := p.state.DB.GetConversationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Conversation doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if conversation.AccountID != accountID {
+		err = fmt.Errorf("conversation with id %s does not belong to account %s", conversation.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return conversation, nil
+}
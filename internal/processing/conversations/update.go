@@ -0,0 +1,170 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// UpdateConversationsForStatus adds the given status to the conversations of each of
+// its local participants (its author and the accounts it mentions), creating those
+// conversations if necessary. The status is only added to conversations of accounts
+// that can see it, and will only be marked as unread for accounts other than the author.
+//
+// Conversations are grouped by thread, and by the set of participants in the thread,
+// so the same thread may result in more than one conversation if participants change.
+//
+// The updated conversations are returned, so that the caller can stream them. If the
+// status is not a direct message, this function is a no-op.
+func (p *Processor) UpdateConversationsForStatus(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.Conversation, error) {
+	if status.Visibility != gtsmodel.VisibilityDirect {
+		// Only direct messages are
+		// part of conversations.
+		return nil, nil
+	}
+
+	participants, err := p.participants(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := p.state.DB.GetStatusThreadRoot(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateConversationsForStatus: error getting thread root of status %s: %w", status.ID, err)
+	}
+
+	conversations := make([]*gtsmodel.Conversation, 0, len(participants))
+	for _, participant := range participants {
+		if participant.Domain != "" {
+			// Only local accounts have conversations.
+			continue
+		}
+
+		if participant.ID != status.AccountID {
+			visible, err := p.filter.StatusVisible(ctx, status, participant)
+			if err != nil {
+				log.Errorf(ctx, "error checking visibility of status %s to account %s: %v", status.ID, participant.ID, err)
+				continue
+			}
+
+			if !visible {
+				continue
+			}
+		}
+
+		otherAccountIDs := make([]string, 0, len(participants)-1)
+		for _, other := range participants {
+			if other.ID != participant.ID {
+				otherAccountIDs = append(otherAccountIDs, other.ID)
+			}
+		}
+
+		// The author has obviously read their own status.
+		read := participant.ID == status.AccountID
+
+		conversation, err := p.state.DB.GetConversationByThreadAndAccountIDs(ctx, participant.ID, root.ID, otherAccountIDs)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("UpdateConversationsForStatus: error getting conversation: %w", err)
+		}
+
+		if conversation == nil {
+			// First status in this conversation.
+			conversation = &gtsmodel.Conversation{
+				ID:              id.NewULID(),
+				AccountID:       participant.ID,
+				Account:         participant,
+				OtherAccountIDs: otherAccountIDs,
+				ThreadID:        root.ID,
+				LastStatusID:    status.ID,
+				LastStatus:      status,
+				Read:            &read,
+			}
+
+			if err := p.state.DB.PutConversation(ctx, conversation); err != nil {
+				return nil, fmt.Errorf("UpdateConversationsForStatus: error putting conversation: %w", err)
+			}
+		} else if status.ID > conversation.LastStatusID {
+			// Newer status in an existing conversation.
+			conversation.LastStatusID = status.ID
+			conversation.LastStatus = status
+			conversation.Read = &read
+
+			if err := p.state.DB.UpdateConversation(ctx, conversation, "last_status_id", "read"); err != nil {
+				return nil, fmt.Errorf("UpdateConversationsForStatus: error updating conversation: %w", err)
+			}
+		}
+
+		if err := p.state.DB.LinkConversationToStatus(ctx, conversation.ID, status.ID); err != nil {
+			return nil, fmt.Errorf("UpdateConversationsForStatus: error linking conversation to status: %w", err)
+		}
+
+		conversations = append(conversations, conversation)
+	}
+
+	return conversations, nil
+}
+
+// participants returns the author of the given status, and
+// every account mentioned in it, without any duplicates.
+func (p *Processor) participants(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.Account, error) {
+	if status.Account == nil {
+		account, err := p.state.DB.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("participants: error getting author account %s: %w", status.AccountID, err)
+		}
+		status.Account = account
+	}
+
+	if status.Mentions == nil && len(status.MentionIDs) != 0 {
+		mentions, err := p.state.DB.GetMentions(ctx, status.MentionIDs)
+		if err != nil {
+			return nil, fmt.Errorf("participants: error getting mentions of status %s: %w", status.ID, err)
+		}
+		status.Mentions = mentions
+	}
+
+	participants := []*gtsmodel.Account{status.Account}
+	seen := map[string]bool{status.AccountID: true}
+
+	for _, mention := range status.Mentions {
+		if seen[mention.TargetAccountID] {
+			continue
+		}
+		seen[mention.TargetAccountID] = true
+
+		if mention.TargetAccount == nil {
+			account, err := p.state.DB.GetAccountByID(ctx, mention.TargetAccountID)
+			if err != nil {
+				log.Errorf(ctx, "error getting mentioned account %s: %v", mention.TargetAccountID, err)
+				continue
+			}
+			mention.TargetAccount = account
+		}
+
+		participants = append(participants, mention.TargetAccount)
+	}
+
+	return participants, nil
+}
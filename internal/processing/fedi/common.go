@@ -20,13 +20,19 @@ package fedi
 import (
 	"context"
 	"fmt"
-	"net/url"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 )
 
+// authenticate authenticates a request for a resource belonging to the local account
+// with the given username, returning that account and the account that signed the request.
+//
+// If the request wasn't signed and authorized fetch is turned off, then the request is let
+// through anonymously, with a nil requestingAccount; callers should only serve public stuff.
 func (p *Processor) authenticate(ctx context.Context, requestedUsername string) (requestedAccount, requestingAccount *gtsmodel.Account, errWithCode gtserror.WithCode) {
 	requestedAccount, err := p.state.DB.GetAccountByUsernameDomain(ctx, requestedUsername, "")
 	if err != nil {
@@ -34,14 +40,12 @@ func (p *Processor) authenticate(ctx context.Context, requestedUsername string)
 		return
 	}
 
-	var requestingAccountURI *url.URL
-	requestingAccountURI, errWithCode = p.federator.AuthenticateFederatedRequest(ctx, requestedUsername)
-	if errWithCode != nil {
+	if !requestSigned(ctx) && !config.GetInstanceAuthorizedFetch() {
 		return
 	}
 
-	if requestingAccount, err = p.federator.GetAccountByURI(transport.WithFastfail(ctx), requestedUsername, requestingAccountURI, false); err != nil {
-		errWithCode = gtserror.NewErrorUnauthorized(err)
+	requestingAccount, errWithCode = p.authenticateRequester(ctx, requestedUsername)
+	if errWithCode != nil {
 		return
 	}
 
@@ -57,3 +61,54 @@ func (p *Processor) authenticate(ctx context.Context, requestedUsername string)
 
 	return
 }
+
+// authenticateRequester checks the http signature of a request, and returns the account that signed it,
+// dereferencing it using the transport of the given username if necessary (empty for the instance account).
+func (p *Processor) authenticateRequester(ctx context.Context, requestedUsername string) (*gtsmodel.Account, gtserror.WithCode) {
+	requestingAccountURI, errWithCode := p.federator.AuthenticateFederatedRequest(ctx, requestedUsername)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	requestingAccount, err := p.federator.GetAccountByURI(transport.WithFastfail(ctx), requestedUsername, requestingAccountURI, false)
+	if err != nil {
+		return nil, gtserror.NewErrorUnauthorized(err)
+	}
+
+	if errWithCode := p.checkRequester(ctx, requestingAccount); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return requestingAccount, nil
+}
+
+// checkRequester refuses requests signed by suspended accounts, or by accounts on blocked domains.
+// The signature check middleware only looks at the host of the public key, which may not be the
+// same as the domain of the account that owns it.
+func (p *Processor) checkRequester(ctx context.Context, requestingAccount *gtsmodel.Account) gtserror.WithCode {
+	if !requestingAccount.SuspendedAt.IsZero() {
+		return gtserror.NewErrorForbidden(fmt.Errorf("requesting account %s is suspended", requestingAccount.ID))
+	}
+
+	if requestingAccount.Domain == "" {
+		return nil
+	}
+
+	blocked, err := p.state.DB.IsDomainBlocked(ctx, requestingAccount.Domain)
+	if err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		return gtserror.NewErrorForbidden(fmt.Errorf("domain %s of requesting account %s is blocked", requestingAccount.Domain, requestingAccount.ID))
+	}
+
+	return nil
+}
+
+// requestSigned returns whether the request carried an
+// http signature, as set on the context by the signature
+// check middleware.
+func requestSigned(ctx context.Context) bool {
+	return ctx.Value(ap.ContextRequestingPublicKeyVerifier) != nil
+}
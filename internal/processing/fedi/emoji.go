@@ -22,13 +22,16 @@ import (
 	"fmt"
 
 	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 )
 
 // EmojiGet handles the GET for a federated emoji originating from this instance.
 func (p *Processor) EmojiGet(ctx context.Context, requestedEmojiID string) (interface{}, gtserror.WithCode) {
-	if _, errWithCode := p.federator.AuthenticateFederatedRequest(ctx, ""); errWithCode != nil {
-		return nil, errWithCode
+	if requestSigned(ctx) || config.GetInstanceAuthorizedFetch() {
+		if _, errWithCode := p.authenticateRequester(ctx, ""); errWithCode != nil {
+			return nil, errWithCode
+		}
 	}
 
 	requestedEmoji, err := p.state.DB.GetEmojiByID(ctx, requestedEmojiID)
@@ -49,7 +49,7 @@ func (p *Processor) StatusGet(ctx context.Context, requestedUsername string, req
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 	if !visible {
-		return nil, gtserror.NewErrorNotFound(fmt.Errorf("status with id %s not visible to user with id %s", status.ID, requestingAccount.ID))
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("status with id %s not visible to requester", status.ID))
 	}
 
 	asStatus, err := p.tc.StatusToAS(ctx, status)
@@ -87,7 +87,7 @@ func (p *Processor) StatusRepliesGet(ctx context.Context, requestedUsername stri
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 	if !visible {
-		return nil, gtserror.NewErrorNotFound(fmt.Errorf("status with id %s not visible to user with id %s", status.ID, requestingAccount.ID))
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("status with id %s not visible to requester", status.ID))
 	}
 
 	var data map[string]interface{}
@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"codeberg.org/gruf/go-runners"
+	"codeberg.org/gruf/go-sched"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// expireInterval is how often the job
+// for handling expired filters should run.
+const expireInterval = time.Minute
+
+// UnloadExpired unloads the timelines of all accounts with a filter that expired
+// after the first given time and before (or at) the second, so that any statuses
+// which the filter hid when they were indexed can be shown in them again.
+func (p *Processor) UnloadExpired(ctx context.Context, after time.Time, before time.Time) error {
+	filters, err := p.state.DB.GetFiltersExpiredBetween(ctx, after, before)
+	if err != nil {
+		return fmt.Errorf("UnloadExpired: db error getting expired filters: %w", err)
+	}
+
+	unloaded := make(map[string]struct{}, len(filters))
+	for _, filter := range filters {
+		if _, ok := unloaded[filter.AccountID]; ok {
+			continue
+		}
+
+		p.unloadTimelines(ctx, filter.AccountID)
+		unloaded[filter.AccountID] = struct{}{}
+	}
+
+	return nil
+}
+
+func scheduleUnloadExpired(p *Processor) {
+	// Get ctx associated with scheduler run state.
+	done := p.state.Workers.Scheduler.Done()
+	doneCtx := runners.CancelCtx(done)
+
+	// No timelines are loaded before startup,
+	// so start the expiry window from now.
+	last := time.Now()
+
+	// Schedule the UnloadExpired task to execute every minute.
+	p.state.Workers.Scheduler.Schedule(sched.NewJob(func(now time.Time) {
+		if err := p.UnloadExpired(doneCtx, last, now); err != nil {
+			log.Errorf(nil, "error unloading timelines for expired filters: %v", err)
+		}
+		last = now
+	}).Every(expireInterval))
+}
@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor struct {
+	state           *state.State
+	tc              typeutils.TypeConverter
+	statusTimelines timeline.Manager
+	listTimelines   timeline.Manager
+}
+
+// New returns a new filters processor, and schedules
+// the job for unloading timelines when filters expire.
+func New(state *state.State, tc typeutils.TypeConverter, statusTimelines timeline.Manager, listTimelines timeline.Manager) Processor {
+	p := Processor{
+		state:           state,
+		tc:              tc,
+		statusTimelines: statusTimelines,
+		listTimelines:   listTimelines,
+	}
+
+	scheduleUnloadExpired(&p)
+
+	return p
+}
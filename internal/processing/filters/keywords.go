@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// GetKeywords returns all keywords of the given filter, if it exists and is owned by the given account.
+func (p *Processor) GetKeywords(ctx context.Context, account *gtsmodel.Account, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiFilterKeywords := make([]*apimodel.FilterKeyword, 0, len(filter.Keywords))
+	for _, filterKeyword := range filter.Keywords {
+		apiFilterKeyword, errWithCode := p.apiFilterKeyword(ctx, filterKeyword)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiFilterKeywords = append(apiFilterKeywords, apiFilterKeyword)
+	}
+
+	return apiFilterKeywords, nil
+}
+
+// GetKeyword returns one filter keyword with the given ID, if it exists and is owned by the given account.
+func (p *Processor) GetKeyword(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterKeyword(ctx, filterKeyword)
+}
+
+// CreateKeyword adds a new keyword to the given filter, using the provided parameters.
+// These params should have already been validated by the time they reach this function.
+func (p *Processor) CreateKeyword(ctx context.Context, account *gtsmodel.Account, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	filterKeyword := &gtsmodel.FilterKeyword{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		FilterID:  filter.ID,
+		Keyword:   form.Keyword,
+		WholeWord: wholeWord(form.WholeWord),
+	}
+
+	if err := p.state.DB.PutFilterKeyword(ctx, filterKeyword); err != nil {
+		return nil, processPutError(err)
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	return p.apiFilterKeyword(ctx, filterKeyword)
+}
+
+// UpdateKeyword updates one filter keyword for the given account, using the provided parameters.
+// These params should have already been validated by the time they reach this function.
+func (p *Processor) UpdateKeyword(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.updateKeyword(ctx, filterKeyword, *form); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	return p.apiFilterKeyword(ctx, filterKeyword)
+}
+
+// updateKeyword updates the given filter keyword in the database using the given form.
+func (p *Processor) updateKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, form apimodel.FilterKeywordCreateUpdateRequest) gtserror.WithCode {
+	columns := []string{"keyword"}
+	filterKeyword.Keyword = form.Keyword
+
+	if form.WholeWord != nil {
+		filterKeyword.WholeWord = form.WholeWord
+		columns = append(columns, "whole_word")
+	}
+
+	if err := p.state.DB.UpdateFilterKeyword(ctx, filterKeyword, columns...); err != nil {
+		return processPutError(err)
+	}
+
+	return nil
+}
+
+// DeleteKeyword deletes one filter keyword for the given account.
+func (p *Processor) DeleteKeyword(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteFilterKeywordByID(ctx, filterKeyword.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	return nil
+}
@@ -0,0 +1,191 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// getFilter is a shortcut to get one filter from the database and
+// check that it's owned by the given accountID. Will return
+// appropriate errors so caller doesn't need to bother.
+func (p *Processor) getFilter(ctx context.Context, accountID string, filterID string) (*gtsmodel.Filter, gtserror.WithCode) {
+	filter, err := p.state.DB.GetFilterByID(ctx, filterID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Filter doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if filter.AccountID != accountID {
+		err = fmt.Errorf("filter with id %s does not belong to account %s", filter.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return filter, nil
+}
+
+// getFilterKeyword is a shortcut to get one filter keyword from the
+// database and check that it's owned by the given accountID. Will
+// return appropriate errors so caller doesn't need to bother.
+func (p *Processor) getFilterKeyword(ctx context.Context, accountID string, filterKeywordID string) (*gtsmodel.FilterKeyword, gtserror.WithCode) {
+	filterKeyword, err := p.state.DB.GetFilterKeywordByID(ctx, filterKeywordID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Filter keyword doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if filterKeyword.AccountID != accountID {
+		err = fmt.Errorf("filter keyword with id %s does not belong to account %s", filterKeyword.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return filterKeyword, nil
+}
+
+// apiFilterV1 is a shortcut to return the API v1 version of the given
+// filter keyword, or return an appropriate error if conversion fails.
+func (p *Processor) apiFilterV1(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) (*apimodel.FilterV1, gtserror.WithCode) {
+	apiFilter, err := p.tc.FilterKeywordToAPIFilterV1(ctx, filterKeyword)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter keyword to api v1 filter: %w", err))
+	}
+
+	return apiFilter, nil
+}
+
+// apiFilterV2 is a shortcut to return the API v2 version of the given
+// filter, or return an appropriate error if conversion fails.
+func (p *Processor) apiFilterV2(ctx context.Context, filter *gtsmodel.Filter) (*apimodel.FilterV2, gtserror.WithCode) {
+	apiFilter, err := p.tc.FilterToAPIFilterV2(ctx, filter)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter to api v2 filter: %w", err))
+	}
+
+	return apiFilter, nil
+}
+
+// apiFilterKeyword is a shortcut to return the API version of the given
+// filter keyword, or return an appropriate error if conversion fails.
+func (p *Processor) apiFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	apiFilterKeyword, err := p.tc.FilterKeywordToAPIFilterKeyword(ctx, filterKeyword)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter keyword to api: %w", err))
+	}
+
+	return apiFilterKeyword, nil
+}
+
+// applyContexts sets the context flags of the
+// given filter from the given api contexts.
+func applyContexts(filter *gtsmodel.Filter, contexts []string) {
+	var home, notifications, public, thread, account bool
+	for _, context := range contexts {
+		switch gtsmodel.FilterContext(context) {
+		case gtsmodel.FilterContextHome:
+			home = true
+		case gtsmodel.FilterContextNotifications:
+			notifications = true
+		case gtsmodel.FilterContextPublic:
+			public = true
+		case gtsmodel.FilterContextThread:
+			thread = true
+		case gtsmodel.FilterContextAccount:
+			account = true
+		}
+	}
+
+	filter.ContextHome = &home
+	filter.ContextNotifications = &notifications
+	filter.ContextPublic = &public
+	filter.ContextThread = &thread
+	filter.ContextAccount = &account
+}
+
+// contextColumns are the database columns updated by applyContexts.
+var contextColumns = []string{
+	"context_home",
+	"context_notifications",
+	"context_public",
+	"context_thread",
+	"context_account",
+}
+
+// expiresAt returns the time that is the given number
+// of seconds from now, or the zero time if expiresIn
+// is nil or not greater than zero.
+func expiresAt(expiresIn *int) time.Time {
+	if expiresIn == nil || *expiresIn <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(*expiresIn) * time.Second)
+}
+
+// processPutError returns an appropriate error
+// for an error returned when inserting a filter
+// or filter keyword into the database.
+func processPutError(err error) gtserror.WithCode {
+	if errors.Is(err, db.ErrAlreadyExists) {
+		err = errors.New("duplicate keyword in filter")
+		return gtserror.NewErrorConflict(err, err.Error())
+	}
+	return gtserror.NewErrorInternalError(err)
+}
+
+// unloadTimelines unloads the home timeline and any list timelines
+// of the given account, so that they're reindexed with the account's
+// current filters the next time they're accessed.
+func (p *Processor) unloadTimelines(ctx context.Context, accountID string) {
+	p.statusTimelines.UnloadTimeline(ctx, accountID)
+
+	lists, err := p.state.DB.GetListsForAccountID(ctx, accountID)
+	if err != nil {
+		log.Errorf(ctx, "error getting lists for account %s: %v", accountID, err)
+		return
+	}
+
+	for _, list := range lists {
+		p.listTimelines.UnloadTimeline(ctx, list.ID)
+	}
+}
+
+// wholeWord returns the given whole word setting for a new
+// filter keyword, defaulting to true if it wasn't provided.
+func wholeWord(setting *bool) *bool {
+	if setting == nil {
+		b := true
+		return &b
+	}
+	return setting
+}
@@ -0,0 +1,176 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// Each v1 filter corresponds to a single keyword of a v2 filter,
+// so v1 filters are looked up by the ID of their filter keyword.
+
+// GetAllV1 returns all v1 filters owned by the given account.
+func (p *Processor) GetAllV1(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FilterV1, gtserror.WithCode) {
+	filterKeywords, err := p.state.DB.GetFilterKeywordsForAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFilters := make([]*apimodel.FilterV1, 0, len(filterKeywords))
+	for _, filterKeyword := range filterKeywords {
+		apiFilter, errWithCode := p.apiFilterV1(ctx, filterKeyword)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiFilters = append(apiFilters, apiFilter)
+	}
+
+	return apiFilters, nil
+}
+
+// GetV1 returns one v1 filter with the given ID, if it exists and is owned by the given account.
+func (p *Processor) GetV1(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterV1, gtserror.WithCode) {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterV1(ctx, filterKeyword)
+}
+
+// CreateV1 creates a new filter with a single keyword for the given account, using the provided parameters.
+// These params should have already been validated by the time they reach this function.
+func (p *Processor) CreateV1(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.FilterV1, gtserror.WithCode) {
+	filter := &gtsmodel.Filter{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Title:     form.Phrase,
+		Action:    gtsmodel.FilterActionWarn,
+		ExpiresAt: expiresAt(form.ExpiresIn),
+	}
+	applyContexts(filter, form.Context)
+
+	if form.Irreversible != nil && *form.Irreversible {
+		filter.Action = gtsmodel.FilterActionHide
+	}
+
+	filterKeyword := &gtsmodel.FilterKeyword{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		FilterID:  filter.ID,
+		Filter:    filter,
+		Keyword:   form.Phrase,
+		WholeWord: wholeWord(form.WholeWord),
+	}
+	filter.Keywords = []*gtsmodel.FilterKeyword{filterKeyword}
+
+	if err := p.state.DB.PutFilter(ctx, filter); err != nil {
+		return nil, processPutError(err)
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	return p.apiFilterV1(ctx, filterKeyword)
+}
+
+// UpdateV1 updates one v1 filter for the given account, using the provided parameters.
+// Since v1 filters are really filter keywords, changes to contexts, expiry and
+// irreversibility apply to the whole v2 filter that the keyword belongs to.
+// These params should have already been validated by the time they reach this function.
+func (p *Processor) UpdateV1(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.FilterV1, gtserror.WithCode) {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Fetch the fully populated parent filter.
+	filter, errWithCode := p.getFilter(ctx, account.ID, filterKeyword.FilterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	filterColumns := append([]string{"expires_at", "action"}, contextColumns...)
+	filter.ExpiresAt = expiresAt(form.ExpiresIn)
+	filter.Action = gtsmodel.FilterActionWarn
+	if form.Irreversible != nil && *form.Irreversible {
+		filter.Action = gtsmodel.FilterActionHide
+	}
+	applyContexts(filter, form.Context)
+
+	if len(filter.Keywords) == 1 {
+		// Filter was created via the v1 API,
+		// so keep its title in step with phrase.
+		filter.Title = form.Phrase
+		filterColumns = append(filterColumns, "title")
+	}
+
+	filterKeyword.Keyword = form.Phrase
+	keywordColumns := []string{"keyword"}
+	if form.WholeWord != nil {
+		filterKeyword.WholeWord = form.WholeWord
+		keywordColumns = append(keywordColumns, "whole_word")
+	}
+
+	if err := p.state.DB.UpdateFilter(ctx, filter, filterColumns...); err != nil {
+		err = fmt.Errorf("db error updating filter: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.UpdateFilterKeyword(ctx, filterKeyword, keywordColumns...); err != nil {
+		return nil, processPutError(err)
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	filterKeyword.Filter = filter
+	return p.apiFilterV1(ctx, filterKeyword)
+}
+
+// DeleteV1 deletes one v1 filter for the given account. If this was the last
+// keyword of the v2 filter it belonged to, that filter is deleted too.
+func (p *Processor) DeleteV1(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	filter, errWithCode := p.getFilter(ctx, account.ID, filterKeyword.FilterID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if len(filter.Keywords) <= 1 {
+		// Last keyword; remove the whole filter.
+		if err := p.state.DB.DeleteFilterByID(ctx, filter.ID); err != nil {
+			return gtserror.NewErrorInternalError(err)
+		}
+	} else if err := p.state.DB.DeleteFilterKeywordByID(ctx, filterKeyword.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	return nil
+}
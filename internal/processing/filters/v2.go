@@ -0,0 +1,208 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package filters
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// GetAllV2 returns all v2 filters owned by the given account.
+func (p *Processor) GetAllV2(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FilterV2, gtserror.WithCode) {
+	filters, err := p.state.DB.GetFiltersForAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFilters := make([]*apimodel.FilterV2, 0, len(filters))
+	for _, filter := range filters {
+		apiFilter, errWithCode := p.apiFilterV2(ctx, filter)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiFilters = append(apiFilters, apiFilter)
+	}
+
+	return apiFilters, nil
+}
+
+// GetV2 returns one v2 filter with the given ID, if it exists and is owned by the given account.
+func (p *Processor) GetV2(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterV2(ctx, filter)
+}
+
+// CreateV2 creates a new filter for the given account, using the provided parameters.
+// These params should have already been validated by the time they reach this function.
+func (p *Processor) CreateV2(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter := &gtsmodel.Filter{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Title:     form.Title,
+		Action:    gtsmodel.FilterActionWarn,
+		ExpiresAt: expiresAt(form.ExpiresIn),
+	}
+	applyContexts(filter, form.Context)
+
+	if form.FilterAction != nil && *form.FilterAction != "" {
+		filter.Action = gtsmodel.FilterAction(*form.FilterAction)
+	}
+
+	filter.Keywords = make([]*gtsmodel.FilterKeyword, 0, len(form.Keywords))
+	for _, keywordForm := range form.Keywords {
+		filter.Keywords = append(filter.Keywords, &gtsmodel.FilterKeyword{
+			ID:        id.NewULID(),
+			AccountID: account.ID,
+			FilterID:  filter.ID,
+			Filter:    filter,
+			Keyword:   keywordForm.Keyword,
+			WholeWord: wholeWord(keywordForm.WholeWord),
+		})
+	}
+
+	if err := p.state.DB.PutFilter(ctx, filter); err != nil {
+		return nil, processPutError(err)
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	return p.apiFilterV2(ctx, filter)
+}
+
+// UpdateV2 updates one v2 filter for the given account, using the provided parameters.
+// Any keywords given in the form are created, updated, or destroyed as appropriate.
+// These params should have already been validated by the time they reach this function.
+func (p *Processor) UpdateV2(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Only update columns we're told to update.
+	columns := []string{}
+
+	if form.Title != nil {
+		filter.Title = *form.Title
+		columns = append(columns, "title")
+	}
+
+	if form.Context != nil {
+		applyContexts(filter, *form.Context)
+		columns = append(columns, contextColumns...)
+	}
+
+	if form.FilterAction != nil && *form.FilterAction != "" {
+		filter.Action = gtsmodel.FilterAction(*form.FilterAction)
+		columns = append(columns, "action")
+	}
+
+	if form.ExpiresIn != nil {
+		filter.ExpiresAt = expiresAt(form.ExpiresIn)
+		columns = append(columns, "expires_at")
+	}
+
+	if len(columns) > 0 {
+		if err := p.state.DB.UpdateFilter(ctx, filter, columns...); err != nil {
+			err = fmt.Errorf("db error updating filter: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	for _, keywordForm := range form.Keywords {
+		if errWithCode := p.updateKeywordFromForm(ctx, account, filter, keywordForm); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	// Refetch the filter, as its keywords may have changed.
+	filter, errWithCode = p.getFilter(ctx, account.ID, filter.ID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterV2(ctx, filter)
+}
+
+// updateKeywordFromForm creates, updates or destroys one keyword of the given filter,
+// depending on whether the form gives an ID and whether it's marked for destruction.
+func (p *Processor) updateKeywordFromForm(ctx context.Context, account *gtsmodel.Account, filter *gtsmodel.Filter, form apimodel.FilterKeywordCreateUpdateRequest) gtserror.WithCode {
+	if form.ID == "" {
+		// New keyword for this filter.
+		filterKeyword := &gtsmodel.FilterKeyword{
+			ID:        id.NewULID(),
+			AccountID: account.ID,
+			FilterID:  filter.ID,
+			Keyword:   form.Keyword,
+			WholeWord: wholeWord(form.WholeWord),
+		}
+
+		if err := p.state.DB.PutFilterKeyword(ctx, filterKeyword); err != nil {
+			return processPutError(err)
+		}
+
+		return nil
+	}
+
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, form.ID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if filterKeyword.FilterID != filter.ID {
+		err := fmt.Errorf("filter keyword %s does not belong to filter %s", filterKeyword.ID, filter.ID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if form.Destroy {
+		if err := p.state.DB.DeleteFilterKeywordByID(ctx, filterKeyword.ID); err != nil {
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		return nil
+	}
+
+	return p.updateKeyword(ctx, filterKeyword, form)
+}
+
+// DeleteV2 deletes one v2 filter for the given account, along with all of its keywords.
+func (p *Processor) DeleteV2(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	filter, errWithCode := p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteFilterByID(ctx, filter.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.unloadTimelines(ctx, account.ID)
+
+	return nil
+}
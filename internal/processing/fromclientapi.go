@@ -74,6 +74,15 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ActivityBlock:
 			// CREATE BLOCK
 			return p.processCreateBlockFromClientAPI(ctx, clientMsg)
+		case ap.ActivityQuestion:
+			// CREATE POLL VOTE
+			return p.processCreatePollVoteFromClientAPI(ctx, clientMsg)
+		case ap.ActivityIgnore:
+			// CREATE MUTE
+			return p.processCreateMuteFromClientAPI(ctx, clientMsg)
+		case ap.ActivityFlag:
+			// CREATE FLAG (forward existing report)
+			return p.processForwardReportFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityUpdate:
 		// UPDATE
@@ -84,6 +93,12 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ActivityFlag:
 			// UPDATE A FLAG/REPORT (mark as resolved/closed)
 			return p.processUpdateReportFromClientAPI(ctx, clientMsg)
+		case ap.ActivityQuestion:
+			// UPDATE POLL (closed)
+			return p.processUpdatePollFromClientAPI(ctx, clientMsg)
+		case ap.ObjectNote:
+			// UPDATE STATUS (edited)
+			return p.processUpdateStatusFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityAccept:
 		// ACCEPT
@@ -129,6 +144,12 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 			// FLAG/REPORT A PROFILE
 			return p.processReportAccountFromClientAPI(ctx, clientMsg)
 		}
+	case ap.ActivityMove:
+		// MOVE
+		if clientMsg.APObjectType == ap.ObjectProfile {
+			// MOVE ACCOUNT/PROFILE
+			return p.processMoveAccountFromClientAPI(ctx, clientMsg)
+		}
 	}
 	return nil
 }
@@ -168,9 +189,53 @@ func (p *Processor) processCreateStatusFromClientAPI(ctx context.Context, client
 		return err
 	}
 
+	if err := p.updateConversations(ctx, status); err != nil {
+		return err
+	}
+
 	return p.federateStatus(ctx, status)
 }
 
+func (p *Processor) processCreatePollVoteFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	vote, ok := clientMsg.GTSModel.(*gtsmodel.PollVote)
+	if !ok {
+		return errors.New("vote was not parseable as *gtsmodel.PollVote")
+	}
+
+	return p.federatePollVote(ctx, vote)
+}
+
+func (p *Processor) processUpdatePollFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("poll status was not parseable as *gtsmodel.Status")
+	}
+
+	if err := p.notifyPollClosed(ctx, status); err != nil {
+		return err
+	}
+
+	return p.federateStatusUpdate(ctx, status)
+}
+
+func (p *Processor) processUpdateStatusFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("note was not parseable as *gtsmodel.Status")
+	}
+
+	if err := p.updateStatusInTimelines(ctx, status); err != nil {
+		return err
+	}
+
+	// notify any new mentions added by the edit
+	if err := p.notifyStatus(ctx, status); err != nil {
+		return err
+	}
+
+	return p.federateStatusUpdate(ctx, status)
+}
+
 func (p *Processor) processCreateFollowRequestFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	followRequest, ok := clientMsg.GTSModel.(*gtsmodel.FollowRequest)
 	if !ok {
@@ -228,12 +293,36 @@ func (p *Processor) processCreateBlockFromClientAPI(ctx context.Context, clientM
 		return err
 	}
 
+	// same with any list timelines owned by either account
+	if err := p.wipeItemsFromListTimelines(ctx, block.AccountID, block.TargetAccountID); err != nil {
+		return err
+	}
+	if err := p.wipeItemsFromListTimelines(ctx, block.TargetAccountID, block.AccountID); err != nil {
+		return err
+	}
+
 	// TODO: same with notifications
 	// TODO: same with bookmarks
 
 	return p.federateBlock(ctx, block)
 }
 
+func (p *Processor) processCreateMuteFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	mute, ok := clientMsg.GTSModel.(*gtsmodel.UserMute)
+	if !ok {
+		return errors.New("mute was not parseable as *gtsmodel.UserMute")
+	}
+
+	// remove any of the muted account's statuses from the muting account's timeline;
+	// unlike with blocks, the muted account doesn't know about the mute so that's it
+	if err := p.statusTimelines.WipeItemsFromAccountID(ctx, mute.AccountID, mute.TargetAccountID); err != nil {
+		return err
+	}
+
+	// same with any list timelines owned by the muting account
+	return p.wipeItemsFromListTimelines(ctx, mute.AccountID, mute.TargetAccountID)
+}
+
 func (p *Processor) processUpdateAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	account, ok := clientMsg.GTSModel.(*gtsmodel.Account)
 	if !ok {
@@ -243,6 +332,32 @@ func (p *Processor) processUpdateAccountFromClientAPI(ctx context.Context, clien
 	return p.federateAccountUpdate(ctx, account, clientMsg.OriginAccount)
 }
 
+func (p *Processor) processMoveAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	account, ok := clientMsg.GTSModel.(*gtsmodel.Account)
+	if !ok {
+		return errors.New("account was not parseable as *gtsmodel.Account")
+	}
+
+	if clientMsg.TargetAccount == nil {
+		return errors.New("target account of move was nil")
+	}
+
+	// let remote instances know about the new
+	// movedTo value of the profile, and the move
+	if err := p.federateAccountUpdate(ctx, account, clientMsg.OriginAccount); err != nil {
+		return err
+	}
+
+	if err := p.federateMove(ctx, account, clientMsg.TargetAccount); err != nil {
+		return err
+	}
+
+	// remote followers will move themselves when
+	// they receive the move; local followers we
+	// have to move ourselves
+	return p.moveFollowers(ctx, account, clientMsg.TargetAccount)
+}
+
 func (p *Processor) processUpdateReportFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	report, ok := clientMsg.GTSModel.(*gtsmodel.Report)
 	if !ok {
@@ -361,6 +476,19 @@ func (p *Processor) processDeleteAccountFromClientAPI(ctx context.Context, clien
 	return p.account.Delete(ctx, clientMsg.TargetAccount, origin)
 }
 
+func (p *Processor) processForwardReportFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	report, ok := clientMsg.GTSModel.(*gtsmodel.Report)
+	if !ok {
+		return errors.New("report was not parseable as *gtsmodel.Report")
+	}
+
+	if err := p.federateReport(ctx, report); err != nil {
+		return fmt.Errorf("processForwardReportFromClientAPI: error federating report: %w", err)
+	}
+
+	return nil
+}
+
 func (p *Processor) processReportAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	report, ok := clientMsg.GTSModel.(*gtsmodel.Report)
 	if !ok {
@@ -373,6 +501,10 @@ func (p *Processor) processReportAccountFromClientAPI(ctx context.Context, clien
 		}
 	}
 
+	if err := p.notifyReportModerators(ctx, report); err != nil {
+		return fmt.Errorf("processReportAccountFromClientAPI: error notifying moderators: %w", err)
+	}
+
 	if err := p.notifyReport(ctx, report); err != nil {
 		return fmt.Errorf("processReportAccountFromClientAPI: error notifying report: %w", err)
 	}
@@ -459,7 +591,7 @@ func (p *Processor) federateStatus(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("federateStatus: error converting status to as format: %s", err)
 	}
 
-	create, err := p.tc.WrapNoteInCreate(asStatus, false)
+	create, err := p.tc.WrapStatusableInCreate(asStatus, false)
 	if err != nil {
 		return fmt.Errorf("federateStatus: error wrapping status in create: %s", err)
 	}
@@ -469,7 +601,43 @@ func (p *Processor) federateStatus(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("federateStatus: error parsing outboxURI %s: %s", status.Account.OutboxURI, err)
 	}
 
-	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, create)
+	if _, err := p.federator.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
+		return err
+	}
+
+	return p.federateToRelays(ctx, status, create)
+}
+
+func (p *Processor) federateStatusUpdate(ctx context.Context, status *gtsmodel.Status) error {
+	if status.Account == nil {
+		statusAccount, err := p.state.DB.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("federateStatusUpdate: error fetching status author account: %s", err)
+		}
+		status.Account = statusAccount
+	}
+
+	// do nothing if this isn't our status, or it's not federated
+	if status.Account.Domain != "" || !*status.Federated {
+		return nil
+	}
+
+	asStatus, err := p.tc.StatusToAS(ctx, status)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error converting status to as format: %s", err)
+	}
+
+	update, err := p.tc.WrapStatusableInUpdate(asStatus, status.Account)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error wrapping status in update: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(status.Account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error parsing outboxURI %s: %s", status.Account.OutboxURI, err)
+	}
+
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, update)
 	return err
 }
 
@@ -497,8 +665,11 @@ func (p *Processor) federateStatusDelete(ctx context.Context, status *gtsmodel.S
 		return fmt.Errorf("federateStatusDelete: error parsing outboxURI %s: %w", status.Account.OutboxURI, err)
 	}
 
-	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, delete)
-	return err
+	if _, err := p.federator.FederatingActor().Send(ctx, outboxIRI, delete); err != nil {
+		return err
+	}
+
+	return p.federateToRelays(ctx, status, delete)
 }
 
 func (p *Processor) federateFollow(ctx context.Context, followRequest *gtsmodel.FollowRequest, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
@@ -805,6 +976,32 @@ func (p *Processor) federateFave(ctx context.Context, fave *gtsmodel.StatusFave,
 	return err
 }
 
+func (p *Processor) federatePollVote(ctx context.Context, vote *gtsmodel.PollVote) error {
+	creates, err := p.tc.PollVoteToASCreates(ctx, vote)
+	if err != nil {
+		return fmt.Errorf("federatePollVote: error converting vote to as format: %s", err)
+	}
+
+	// votes are only sent to the author of the
+	// poll, so if they're local there's nothing to do
+	if vote.Poll.Status.Account.Domain == "" {
+		return nil
+	}
+
+	outboxIRI, err := url.Parse(vote.Account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federatePollVote: error parsing outboxURI %s: %s", vote.Account.OutboxURI, err)
+	}
+
+	for _, create := range creates {
+		if _, err := p.federator.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
+			return fmt.Errorf("federatePollVote: error sending vote: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (p *Processor) federateAnnounce(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) error {
 	announce, err := p.tc.BoostToAS(ctx, boostWrapperStatus, boostingAccount, boostedAccount)
 	if err != nil {
@@ -840,6 +1037,21 @@ func (p *Processor) federateAccountUpdate(ctx context.Context, updatedAccount *g
 	return err
 }
 
+func (p *Processor) federateMove(ctx context.Context, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
+	move, err := p.tc.AccountToASMove(ctx, originAccount, targetAccount)
+	if err != nil {
+		return fmt.Errorf("federateMove: error converting account to move: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(originAccount.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateMove: error parsing outboxURI %s: %s", originAccount.OutboxURI, err)
+	}
+
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, move)
+	return err
+}
+
 func (p *Processor) federateBlock(ctx context.Context, block *gtsmodel.Block) error {
 	if block.Account == nil {
 		blockAccount, err := p.state.DB.GetAccountByID(ctx, block.AccountID)
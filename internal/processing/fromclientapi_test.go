@@ -159,6 +159,65 @@ func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessFaveWebPush() {
+	ctx := context.Background()
+
+	favingAccount := suite.testAccounts["admin_account"]
+	receivingAccount := suite.testAccounts["local_account_1"]
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	// zork has a push subscription which wants faves
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:             "01H1G8RXPVDH8E3YV8K0GN4S2Q",
+		AccountID:      receivingAccount.ID,
+		TokenID:        suite.testTokens["local_account_1"].ID,
+		Endpoint:       "https://push.example.org/push/01H1G8RXPVDH8E3YV8K0GN4S2Q",
+		P256dh:         "BHtyye27n_ObWVB5xBivu1fbwEL7Fey0-xPSXQtZK6nwC_iRHZ1AETnEQyXeG-cr_2HU-LtEVYRhGF8DXZNulJo",
+		Auth:           "NwpU7I5e-6GFB4FMCNkSJQ",
+		Policy:         gtsmodel.WebPushPolicyAll,
+		AlertFavourite: testrig.TrueBool(),
+	}
+	suite.NoError(suite.db.PutWebPushSubscription(ctx, subscription))
+
+	fave := &gtsmodel.StatusFave{
+		ID:              "01H1G8WBQ6SW3X3JS3Z3JM8R4Y",
+		URI:             "http://localhost:8080/users/admin/liked/01H1G8WBQ6SW3X3JS3Z3JM8R4Y",
+		AccountID:       favingAccount.ID,
+		Account:         favingAccount,
+		TargetAccountID: receivingAccount.ID,
+		TargetAccount:   receivingAccount,
+		StatusID:        status.ID,
+		Status:          status,
+	}
+	suite.NoError(suite.db.Put(ctx, fave))
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       fave,
+		OriginAccount:  favingAccount,
+		TargetAccount:  receivingAccount,
+	})
+	suite.NoError(err)
+
+	// an encrypted push message should have been sent to the endpoint
+	var sent [][]byte
+	if !testrig.WaitFor(func() bool {
+		sentI, ok := suite.httpClient.SentMessages.Load(subscription.Endpoint)
+		if ok {
+			sent, _ = sentI.([][]byte)
+		}
+		return ok
+	}) {
+		suite.FailNow("timed out waiting for push message to be sent")
+	}
+
+	if suite.Len(sent, 1) {
+		suite.NotEmpty(sent[0])
+		suite.NotContains(string(sent[0]), "favourited your post")
+	}
+}
+
 func TestFromClientAPITestSuite(t *testing.T) {
 	suite.Run(t, &FromClientAPITestSuite{})
 }
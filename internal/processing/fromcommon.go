@@ -24,11 +24,13 @@ import (
 	"strings"
 	"sync"
 
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
 
@@ -63,6 +65,20 @@ func (p *Processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) e
 			continue
 		}
 
+		// make sure the mentioned account hasn't muted notifications from the author
+		if muted, err := p.state.DB.IsMuted(ctx, m.TargetAccountID, status.AccountID, true); err != nil {
+			return fmt.Errorf("notifyStatus: error checking mute of %s by %s: %s", status.AccountID, m.TargetAccountID, err)
+		} else if muted {
+			continue
+		}
+
+		// make sure the mentioned account hasn't muted the thread
+		if muted, err := p.state.DB.IsStatusMutedBy(ctx, status, m.TargetAccountID); err != nil {
+			return fmt.Errorf("notifyStatus: error checking thread mute of status %s by %s: %s", status.ID, m.TargetAccountID, err)
+		} else if muted {
+			continue
+		}
+
 		// make sure a notif doesn't already exist for this mention
 		if err := p.state.DB.GetWhere(ctx, []db.Where{
 			{Key: "notification_type", Value: gtsmodel.NotificationMention},
@@ -99,7 +115,7 @@ func (p *Processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) e
 			return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 		}
 
-		if err := p.stream.Notify(apiNotif, m.TargetAccount); err != nil {
+		if err := p.notify(ctx, notif, apiNotif); err != nil {
 			return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 		}
 	}
@@ -124,6 +140,13 @@ func (p *Processor) notifyFollowRequest(ctx context.Context, followRequest *gtsm
 		return nil
 	}
 
+	// return if the target has muted notifications from the requester
+	if muted, err := p.state.DB.IsMuted(ctx, followRequest.TargetAccountID, followRequest.AccountID, true); err != nil {
+		return fmt.Errorf("notifyFollowRequest: error checking mute: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	notif := &gtsmodel.Notification{
 		ID:               id.NewULID(),
 		NotificationType: gtsmodel.NotificationFollowRequest,
@@ -141,7 +164,7 @@ func (p *Processor) notifyFollowRequest(ctx context.Context, followRequest *gtsm
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
-	if err := p.stream.Notify(apiNotif, targetAccount); err != nil {
+	if err := p.notify(ctx, notif, apiNotif); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
@@ -163,6 +186,13 @@ func (p *Processor) notifyFollow(ctx context.Context, follow *gtsmodel.Follow, t
 		return fmt.Errorf("notifyFollow: error removing old follow request notification from database: %s", err)
 	}
 
+	// return if the target has muted notifications from the follower
+	if muted, err := p.state.DB.IsMuted(ctx, follow.TargetAccountID, follow.AccountID, true); err != nil {
+		return fmt.Errorf("notifyFollow: error checking mute: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	// now create the new follow notification
 	notif := &gtsmodel.Notification{
 		ID:               id.NewULID(),
@@ -182,7 +212,7 @@ func (p *Processor) notifyFollow(ctx context.Context, follow *gtsmodel.Follow, t
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
-	if err := p.stream.Notify(apiNotif, targetAccount); err != nil {
+	if err := p.notify(ctx, notif, apiNotif); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
@@ -209,6 +239,28 @@ func (p *Processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 		return nil
 	}
 
+	// just return if target has muted notifications from the faver
+	if muted, err := p.state.DB.IsMuted(ctx, fave.TargetAccountID, fave.AccountID, true); err != nil {
+		return fmt.Errorf("notifyFave: error checking mute: %s", err)
+	} else if muted {
+		return nil
+	}
+
+	if fave.Status == nil {
+		s, err := p.state.DB.GetStatusByID(ctx, fave.StatusID)
+		if err != nil {
+			return err
+		}
+		fave.Status = s
+	}
+
+	// just return if target has muted the thread of the faved status
+	if muted, err := p.state.DB.IsStatusMutedBy(ctx, fave.Status, fave.TargetAccountID); err != nil {
+		return fmt.Errorf("notifyFave: error checking thread mute: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	notif := &gtsmodel.Notification{
 		ID:               id.NewULID(),
 		NotificationType: gtsmodel.NotificationFave,
@@ -230,13 +282,110 @@ func (p *Processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
-	if err := p.stream.Notify(apiNotif, targetAccount); err != nil {
+	if err := p.notify(ctx, notif, apiNotif); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
 	return nil
 }
 
+// notifyPollClosed notifies all local voters in the
+// poll of the given status, and its author if local,
+// that the poll has closed and the results are in.
+func (p *Processor) notifyPollClosed(ctx context.Context, status *gtsmodel.Status) error {
+	if status.Account == nil {
+		a, err := p.state.DB.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return err
+		}
+		status.Account = a
+	}
+
+	votes, err := p.state.DB.GetPollVotes(ctx, status.PollID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("notifyPollClosed: error getting poll votes: %w", err)
+	}
+
+	targetAccounts := make([]*gtsmodel.Account, 0, len(votes)+1)
+	if status.Account.Domain == "" {
+		targetAccounts = append(targetAccounts, status.Account)
+	}
+
+	for _, vote := range votes {
+		if vote.Account == nil {
+			a, err := p.state.DB.GetAccountByID(ctx, vote.AccountID)
+			if err != nil {
+				log.Errorf(ctx, "error getting poll voter %s: %v", vote.AccountID, err)
+				continue
+			}
+			vote.Account = a
+		}
+
+		if vote.Account.Domain == "" {
+			targetAccounts = append(targetAccounts, vote.Account)
+		}
+	}
+
+	for _, targetAccount := range targetAccounts {
+		if targetAccount.ID != status.AccountID {
+			// skip voters who've muted notifications from the poll author
+			if muted, err := p.state.DB.IsMuted(ctx, targetAccount.ID, status.AccountID, true); err != nil {
+				return fmt.Errorf("notifyPollClosed: error checking mute: %w", err)
+			} else if muted {
+				continue
+			}
+		}
+
+		// skip accounts who've muted the thread of the poll
+		if muted, err := p.state.DB.IsStatusMutedBy(ctx, status, targetAccount.ID); err != nil {
+			return fmt.Errorf("notifyPollClosed: error checking thread mute: %w", err)
+		} else if muted {
+			continue
+		}
+
+		notif := &gtsmodel.Notification{
+			ID:               id.NewULID(),
+			NotificationType: gtsmodel.NotificationPoll,
+			TargetAccountID:  targetAccount.ID,
+			TargetAccount:    targetAccount,
+			OriginAccountID:  status.AccountID,
+			OriginAccount:    status.Account,
+			StatusID:         status.ID,
+			Status:           status,
+		}
+
+		if err := p.state.DB.Put(ctx, notif); err != nil {
+			return fmt.Errorf("notifyPollClosed: error putting notification in database: %s", err)
+		}
+
+		// now stream the notification to the user
+		apiNotif, err := p.tc.NotificationToAPINotification(ctx, notif)
+		if err != nil {
+			return fmt.Errorf("notifyPollClosed: error converting notification to api representation: %s", err)
+		}
+
+		if err := p.notify(ctx, notif, apiNotif); err != nil {
+			return fmt.Errorf("notifyPollClosed: error streaming notification to account: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// notify streams the given notification to its target account,
+// and queues it for delivery to the account's push subscriptions.
+func (p *Processor) notify(ctx context.Context, notif *gtsmodel.Notification, apiNotif *apimodel.Notification) error {
+	if err := p.stream.Notify(apiNotif, notif.TargetAccount); err != nil {
+		return err
+	}
+
+	if err := p.push.Notify(ctx, notif, apiNotif); err != nil {
+		log.Errorf(ctx, "error pushing notification %s: %v", notif.ID, err)
+	}
+
+	return nil
+}
+
 func (p *Processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status) error {
 	if status.BoostOfID == "" {
 		// not a boost, nothing to do
@@ -270,6 +419,20 @@ func (p *Processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status)
 		return nil
 	}
 
+	if muted, err := p.state.DB.IsMuted(ctx, status.BoostOfAccountID, status.AccountID, true); err != nil {
+		return fmt.Errorf("notifyAnnounce: error checking mute: %s", err)
+	} else if muted {
+		// boosted account has muted notifications from the booster, nothing to do
+		return nil
+	}
+
+	if muted, err := p.state.DB.IsStatusMutedBy(ctx, status.BoostOf, status.BoostOfAccountID); err != nil {
+		return fmt.Errorf("notifyAnnounce: error checking thread mute: %s", err)
+	} else if muted {
+		// boosted account has muted the thread of the boosted status, nothing to do
+		return nil
+	}
+
 	// make sure a notif doesn't already exist for this announce
 	err := p.state.DB.GetWhere(ctx, []db.Where{
 		{Key: "notification_type", Value: gtsmodel.NotificationReblog},
@@ -304,13 +467,65 @@ func (p *Processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
-	if err := p.stream.Notify(apiNotif, status.BoostOfAccount); err != nil {
+	if err := p.notify(ctx, notif, apiNotif); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
 	return nil
 }
 
+// notifyReportModerators creates an admin.report notification
+// for each active moderator and admin of this instance, and
+// streams the notification to them.
+func (p *Processor) notifyReportModerators(ctx context.Context, report *gtsmodel.Report) error {
+	moderators, err := p.state.DB.GetInstanceModerators(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// No active moderators.
+			return nil
+		}
+		return fmt.Errorf("notifyReportModerators: error getting instance moderators: %w", err)
+	}
+
+	for _, moderator := range moderators {
+		if moderator.AccountID == report.AccountID {
+			// Don't notify a moderator of their own report.
+			continue
+		}
+
+		moderatorAccount, err := p.state.DB.GetAccountByID(ctx, moderator.AccountID)
+		if err != nil {
+			return fmt.Errorf("notifyReportModerators: error getting account %s: %w", moderator.AccountID, err)
+		}
+
+		notif := &gtsmodel.Notification{
+			ID:               id.NewULID(),
+			NotificationType: gtsmodel.NotificationAdminReport,
+			TargetAccountID:  moderatorAccount.ID,
+			TargetAccount:    moderatorAccount,
+			OriginAccountID:  report.AccountID,
+			OriginAccount:    report.Account,
+			ReportID:         report.ID,
+			Report:           report,
+		}
+
+		if err := p.state.DB.Put(ctx, notif); err != nil {
+			return fmt.Errorf("notifyReportModerators: error putting notification in database: %w", err)
+		}
+
+		apiNotif, err := p.tc.NotificationToAPINotification(ctx, notif)
+		if err != nil {
+			return fmt.Errorf("notifyReportModerators: error converting notification to api representation: %w", err)
+		}
+
+		if err := p.notify(ctx, notif, apiNotif); err != nil {
+			return fmt.Errorf("notifyReportModerators: error streaming notification to account: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (p *Processor) notifyReport(ctx context.Context, report *gtsmodel.Report) error {
 	instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
 	if err != nil {
@@ -432,7 +647,7 @@ func (p *Processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 	errors := make(chan error, len(follows))
 
 	for _, f := range follows {
-		go p.timelineStatusForAccount(ctx, status, f.AccountID, errors, &wg)
+		go p.timelineStatusForFollow(ctx, status, f, errors, &wg)
 	}
 
 	// read any errors that come in from the async functions
@@ -449,6 +664,10 @@ func (p *Processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 	wg.Wait()
 	close(errors)
 
+	// also timeline the status for local accounts following any
+	// of its tags, and stream it to any open streams of its tags
+	p.timelineStatusForTags(ctx, status, follows)
+
 	if len(errs) != 0 {
 		// we have at least one error
 		return fmt.Errorf("timelineStatus: one or more errors timelining statuses: %s", strings.Join(errs, ";"))
@@ -457,25 +676,28 @@ func (p *Processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 	return nil
 }
 
-// timelineStatusForAccount puts the given status in the HOME timeline
-// of the account with given accountID, if it's hometimelineable.
+// timelineStatusForFollow puts the given status in the HOME timeline
+// of the account that owns the given follow, and in the timelines of
+// any lists that the follow is an entry in, if the status is timelineable.
 //
-// If the status was inserted into the home timeline of the given account,
-// it will also be streamed via websockets to the user.
-func (p *Processor) timelineStatusForAccount(ctx context.Context, status *gtsmodel.Status, accountID string, errors chan error, wg *sync.WaitGroup) {
+// If the status was inserted into any of these timelines, it will also
+// be streamed via websockets to the user.
+func (p *Processor) timelineStatusForFollow(ctx context.Context, status *gtsmodel.Status, follow *gtsmodel.Follow, errors chan error, wg *sync.WaitGroup) {
 	defer wg.Done()
 
+	accountID := follow.AccountID
+
 	// get the timeline owner account
 	timelineAccount, err := p.state.DB.GetAccountByID(ctx, accountID)
 	if err != nil {
-		errors <- fmt.Errorf("timelineStatusForAccount: error getting account for timeline with id %s: %s", accountID, err)
+		errors <- fmt.Errorf("timelineStatusForFollow: error getting account for timeline with id %s: %s", accountID, err)
 		return
 	}
 
 	// make sure the status is timelineable
 	timelineable, err := p.filter.StatusHometimelineable(ctx, status, timelineAccount)
 	if err != nil {
-		errors <- fmt.Errorf("timelineStatusForAccount: error getting timelineability for status for timeline with id %s: %s", accountID, err)
+		errors <- fmt.Errorf("timelineStatusForFollow: error getting timelineability for status for timeline with id %s: %s", accountID, err)
 		return
 	}
 
@@ -486,22 +708,227 @@ func (p *Processor) timelineStatusForAccount(ctx context.Context, status *gtsmod
 	// stick the status in the timeline for the account and then immediately prepare it so they can see it right away
 	inserted, err := p.statusTimelines.IngestAndPrepare(ctx, status, timelineAccount.ID)
 	if err != nil {
-		errors <- fmt.Errorf("timelineStatusForAccount: error ingesting status %s: %s", status.ID, err)
+		errors <- fmt.Errorf("timelineStatusForFollow: error ingesting status %s: %s", status.ID, err)
 		return
 	}
 
 	// the status was inserted so stream it to the user
 	if inserted {
-		apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, timelineAccount)
+		apiStatus, hide, err := filteredAPIStatus(ctx, p.tc, p.filter, status, timelineAccount, gtsmodel.FilterContextHome)
 		if err != nil {
-			errors <- fmt.Errorf("timelineStatusForAccount: error converting status %s to frontend representation: %s", status.ID, err)
+			errors <- fmt.Errorf("timelineStatusForFollow: error converting status %s to frontend representation: %s", status.ID, err)
+			return
+		}
+
+		if hide {
 			return
 		}
 
 		if err := p.stream.Update(apiStatus, timelineAccount, stream.TimelineHome); err != nil {
-			errors <- fmt.Errorf("timelineStatusForAccount: error streaming status %s: %s", status.ID, err)
+			errors <- fmt.Errorf("timelineStatusForFollow: error streaming status %s: %s", status.ID, err)
+		}
+	}
+
+	if follow.ID == "" {
+		// this is the fake follow for the status author,
+		// so there won't be any lists that include it
+		return
+	}
+
+	// now do the same for any lists this follow is an entry in
+	listEntries, err := p.state.DB.GetListEntriesForFollowID(ctx, follow.ID)
+	if err != nil {
+		errors <- fmt.Errorf("timelineStatusForFollow: error getting list entries for follow %s: %s", follow.ID, err)
+		return
+	}
+
+	for _, listEntry := range listEntries {
+		p.timelineStatusForList(ctx, status, timelineAccount, listEntry.ListID, errors)
+	}
+}
+
+// timelineStatusForList puts the given status in the timeline of the
+// list with the given listID, if it's listtimelineable, and streams it
+// to the list owner if it was inserted.
+func (p *Processor) timelineStatusForList(ctx context.Context, status *gtsmodel.Status, timelineAccount *gtsmodel.Account, listID string, errors chan error) {
+	list, err := p.state.DB.GetListByID(ctx, listID)
+	if err != nil {
+		errors <- fmt.Errorf("timelineStatusForList: error getting list with id %s: %s", listID, err)
+		return
+	}
+
+	timelineable, err := listTimelineable(ctx, p.state.DB, p.filter, list, status)
+	if err != nil {
+		errors <- fmt.Errorf("timelineStatusForList: error getting timelineability for status for list with id %s: %s", listID, err)
+		return
+	}
+
+	if !timelineable {
+		return
+	}
+
+	inserted, err := p.listTimelines.IngestAndPrepare(ctx, status, listID)
+	if err != nil {
+		errors <- fmt.Errorf("timelineStatusForList: error ingesting status %s: %s", status.ID, err)
+		return
+	}
+
+	if inserted {
+		apiStatus, hide, err := filteredAPIStatus(ctx, p.tc, p.filter, status, timelineAccount, gtsmodel.FilterContextHome)
+		if err != nil {
+			errors <- fmt.Errorf("timelineStatusForList: error converting status %s to frontend representation: %s", status.ID, err)
+			return
+		}
+
+		if hide {
+			return
+		}
+
+		if err := p.stream.Update(apiStatus, timelineAccount, stream.TimelineList+":"+listID); err != nil {
+			errors <- fmt.Errorf("timelineStatusForList: error streaming status %s: %s", status.ID, err)
+		}
+	}
+}
+
+// timelineStatusForTags puts the given status in the HOME timelines of local
+// accounts following any of the tags it uses, excluding the given follows of
+// the status author, whose timelines have already been taken care of. It also
+// streams the status to any open streams for those tags.
+//
+// Only public statuses are put in tag timelines. Errors are logged rather
+// than returned, since this is a best-effort extra on top of timelining.
+func (p *Processor) timelineStatusForTags(ctx context.Context, status *gtsmodel.Status, follows []*gtsmodel.Follow) {
+	if status.Visibility != gtsmodel.VisibilityPublic || len(status.TagIDs) == 0 {
+		return
+	}
+
+	// accounts whose home timelines already had a go at the status
+	timelined := make(map[string]struct{}, len(follows))
+	for _, f := range follows {
+		timelined[f.AccountID] = struct{}{}
+	}
+
+	accountIDs, err := p.state.DB.GetAccountIDsFollowingTagIDs(ctx, status.TagIDs)
+	if err != nil {
+		log.Errorf(ctx, "error getting accounts following tags of status %s: %v", status.ID, err)
+	}
+
+	for _, accountID := range accountIDs {
+		if _, ok := timelined[accountID]; ok {
+			continue
 		}
+
+		if err := p.timelineStatusForTagFollower(ctx, status, accountID); err != nil {
+			log.Errorf(ctx, "error timelining status %s for tag follower %s: %v", status.ID, accountID, err)
+		}
+	}
+
+	for _, tag := range p.statusTags(ctx, status) {
+		// streams are keyed by lowercase tag name
+		name := strings.ToLower(tag.Name)
+		timelines := []string{stream.TimelineHashtag + ":" + name}
+		if status.Local != nil && *status.Local {
+			timelines = append(timelines, stream.TimelineHashtagLocal+":"+name)
+		}
+
+		for _, timeline := range timelines {
+			for _, accountID := range p.stream.SubscribedAccountIDs(timeline) {
+				if err := p.streamStatusToTagTimeline(ctx, status, accountID, timeline); err != nil {
+					log.Errorf(ctx, "error streaming status %s to %s for account %s: %v", status.ID, timeline, accountID, err)
+				}
+			}
+		}
+	}
+}
+
+// timelineStatusForTagFollower puts the given status in the HOME timeline
+// of the account with the given accountID, which follows one of its tags,
+// if the status is publicly timelineable for the account.
+//
+// If the status was inserted, it will also be streamed via websockets to the user.
+func (p *Processor) timelineStatusForTagFollower(ctx context.Context, status *gtsmodel.Status, accountID string) error {
+	timelineAccount, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("timelineStatusForTagFollower: error getting account for timeline with id %s: %w", accountID, err)
+	}
+
+	timelineable, err := p.filter.StatusPublictimelineable(ctx, status, timelineAccount)
+	if err != nil {
+		return fmt.Errorf("timelineStatusForTagFollower: error getting timelineability for status for timeline with id %s: %w", accountID, err)
+	}
+
+	if !timelineable {
+		return nil
 	}
+
+	inserted, err := p.statusTimelines.IngestAndPrepare(ctx, status, timelineAccount.ID)
+	if err != nil {
+		return fmt.Errorf("timelineStatusForTagFollower: error ingesting status %s: %w", status.ID, err)
+	}
+
+	if !inserted {
+		return nil
+	}
+
+	apiStatus, hide, err := filteredAPIStatus(ctx, p.tc, p.filter, status, timelineAccount, gtsmodel.FilterContextHome)
+	if err != nil {
+		return fmt.Errorf("timelineStatusForTagFollower: error converting status %s to frontend representation: %w", status.ID, err)
+	}
+
+	if hide {
+		return nil
+	}
+
+	return p.stream.Update(apiStatus, timelineAccount, stream.TimelineHome)
+}
+
+// streamStatusToTagTimeline streams the given status on the given hashtag timeline
+// to the account with the given accountID, if it's publicly timelineable for them.
+func (p *Processor) streamStatusToTagTimeline(ctx context.Context, status *gtsmodel.Status, accountID string, timeline string) error {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("streamStatusToTagTimeline: error getting account with id %s: %w", accountID, err)
+	}
+
+	timelineable, err := p.filter.StatusPublictimelineable(ctx, status, account)
+	if err != nil {
+		return fmt.Errorf("streamStatusToTagTimeline: error getting timelineability of status %s: %w", status.ID, err)
+	}
+
+	if !timelineable {
+		return nil
+	}
+
+	apiStatus, hide, err := filteredAPIStatus(ctx, p.tc, p.filter, status, account, gtsmodel.FilterContextPublic)
+	if err != nil {
+		return fmt.Errorf("streamStatusToTagTimeline: error converting status %s to frontend representation: %w", status.ID, err)
+	}
+
+	if hide {
+		return nil
+	}
+
+	return p.stream.Update(apiStatus, account, timeline)
+}
+
+// statusTags returns the tags used by the given status,
+// fetching them from the database if they're not populated.
+func (p *Processor) statusTags(ctx context.Context, status *gtsmodel.Status) []*gtsmodel.Tag {
+	if len(status.Tags) == len(status.TagIDs) {
+		return status.Tags
+	}
+
+	tags := make([]*gtsmodel.Tag, 0, len(status.TagIDs))
+	for _, id := range status.TagIDs {
+		tag, err := p.state.DB.GetTagByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting tag %s of status %s: %v", id, status.ID, err)
+			continue
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags
 }
 
 // deleteStatusFromTimelines completely removes the given status from all timelines.
@@ -511,9 +938,96 @@ func (p *Processor) deleteStatusFromTimelines(ctx context.Context, status *gtsmo
 		return err
 	}
 
+	if err := p.listTimelines.WipeItemFromAllTimelines(ctx, status.ID); err != nil {
+		return err
+	}
+
 	return p.stream.Delete(status.ID)
 }
 
+// updateStatusInTimelines prepares the given edited status again in all timelines,
+// and streams the edit to any local accounts that can see it: the status author,
+// local followers of the author, and any local accounts mentioned in the status.
+func (p *Processor) updateStatusInTimelines(ctx context.Context, status *gtsmodel.Status) error {
+	if err := p.statusTimelines.ReprepareItemInAllTimelines(ctx, status.ID); err != nil {
+		return err
+	}
+
+	if err := p.listTimelines.ReprepareItemInAllTimelines(ctx, status.ID); err != nil {
+		return err
+	}
+
+	// get local followers of the account that posted the status
+	follows, err := p.state.DB.GetAccountFollowedBy(ctx, status.AccountID, true)
+	if err != nil {
+		return fmt.Errorf("updateStatusInTimelines: error getting followers for account id %s: %s", status.AccountID, err)
+	}
+
+	accountIDs := make([]string, 0, 1+len(follows)+len(status.MentionIDs))
+	accountIDs = append(accountIDs, status.AccountID)
+	for _, f := range follows {
+		accountIDs = append(accountIDs, f.AccountID)
+	}
+
+	mentions, err := p.state.DB.GetMentions(ctx, status.MentionIDs)
+	if err != nil {
+		return fmt.Errorf("updateStatusInTimelines: error getting mentions for status %s: %s", status.ID, err)
+	}
+	for _, m := range mentions {
+		accountIDs = append(accountIDs, m.TargetAccountID)
+	}
+
+	errs := []string{}
+	streamed := make(map[string]struct{}, len(accountIDs))
+	for _, accountID := range accountIDs {
+		if _, ok := streamed[accountID]; ok {
+			continue
+		}
+		streamed[accountID] = struct{}{}
+
+		account, err := p.state.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+
+		if account.Domain != "" {
+			// only local accounts have streams
+			continue
+		}
+
+		visible, err := p.filter.StatusVisible(ctx, status, account)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+
+		if !visible {
+			continue
+		}
+
+		apiStatus, hide, err := filteredAPIStatus(ctx, p.tc, p.filter, status, account, gtsmodel.FilterContextHome)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+
+		if hide {
+			continue
+		}
+
+		if err := p.stream.StatusUpdate(apiStatus, account, stream.AllStatusTimelines); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("updateStatusInTimelines: one or more errors streaming status %s: %s", status.ID, strings.Join(errs, ";"))
+	}
+
+	return nil
+}
+
 // wipeStatus contains common logic used to totally delete a status
 // + all its attachments, notifications, boosts, and timeline entries.
 func (p *Processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Status, deleteAttachments bool) error {
@@ -553,6 +1067,28 @@ func (p *Processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 		return err
 	}
 
+	// delete all thread mutes that point to this status
+	if err := p.state.DB.DeleteWhere(ctx, []db.Where{{Key: "status_id", Value: statusToDelete.ID}}, &[]*gtsmodel.StatusMute{}); err != nil {
+		return err
+	}
+
+	// remove this status from any direct message conversations
+	if err := p.state.DB.DeleteStatusFromConversations(ctx, statusToDelete.ID); err != nil {
+		return err
+	}
+
+	// delete the poll attached to this status, and all votes in it
+	if statusToDelete.PollID != "" {
+		if err := p.state.DB.DeletePollByID(ctx, statusToDelete.PollID); err != nil {
+			return err
+		}
+	}
+
+	// delete the edit history of this status
+	if err := p.state.DB.DeleteStatusEditsByStatusID(ctx, statusToDelete.ID); err != nil {
+		return err
+	}
+
 	// delete all boosts for this status + remove them from timelines
 	if boosts, err := p.state.DB.GetStatusReblogs(ctx, statusToDelete); err == nil {
 		for _, b := range boosts {
@@ -577,3 +1113,68 @@ func (p *Processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 
 	return nil
 }
+
+// updateConversations adds the given status to the direct message
+// conversations of its local participants, streaming any updated
+// conversations to their owners. It is a no-op for non-direct statuses.
+func (p *Processor) updateConversations(ctx context.Context, status *gtsmodel.Status) error {
+	conversations, err := p.conversations.UpdateConversationsForStatus(ctx, status)
+	if err != nil {
+		return fmt.Errorf("updateConversations: %w", err)
+	}
+
+	for _, conversation := range conversations {
+		apiConversation, err := p.tc.ConversationToAPIConversation(ctx, conversation, conversation.Account)
+		if err != nil {
+			return fmt.Errorf("updateConversations: error converting conversation to api representation: %w", err)
+		}
+
+		if err := p.stream.Conversation(apiConversation, conversation.Account); err != nil {
+			return fmt.Errorf("updateConversations: error streaming conversation to account: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// moveFollowers transfers local followers of the given origin account to the given
+// target account, by following the target on their behalf and then unfollowing the
+// origin. Follows of the target that already exist or are pending are left as they are.
+func (p *Processor) moveFollowers(ctx context.Context, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
+	follows, err := p.state.DB.GetAccountFollowedBy(ctx, originAccount.ID, true)
+	if err != nil {
+		return fmt.Errorf("moveFollowers: error getting local followers of account %s: %w", originAccount.ID, err)
+	}
+
+	for _, follow := range follows {
+		if follow.AccountID == targetAccount.ID {
+			// the target follows the origin account,
+			// it can't follow itself so just leave it
+			continue
+		}
+
+		follower, err := p.state.DB.GetAccountByID(ctx, follow.AccountID)
+		if err != nil {
+			log.Errorf(ctx, "error getting follower %s: %v", follow.AccountID, err)
+			continue
+		}
+
+		if _, errWithCode := p.account.FollowCreate(ctx, follower, &apimodel.AccountFollowRequest{
+			ID:      targetAccount.ID,
+			Reblogs: follow.ShowReblogs,
+			Notify:  follow.Notify,
+		}); errWithCode != nil {
+			// this may fail for perfectly good reasons,
+			// eg., the follower has blocked the target,
+			// so leave the existing follow where it is
+			log.Errorf(ctx, "error following %s on behalf of %s: %v", targetAccount.ID, follower.ID, errWithCode)
+			continue
+		}
+
+		if _, errWithCode := p.account.FollowRemove(ctx, follower, originAccount.ID); errWithCode != nil {
+			log.Errorf(ctx, "error unfollowing %s on behalf of %s: %v", originAccount.ID, follower.ID, errWithCode)
+		}
+	}
+
+	return nil
+}
@@ -86,9 +86,13 @@ func (p *Processor) ProcessFromFederator(ctx context.Context, federatorMsg messa
 		}
 	case ap.ActivityUpdate:
 		// UPDATE SOMETHING
-		if federatorMsg.APObjectType == ap.ObjectProfile {
+		switch federatorMsg.APObjectType {
+		case ap.ObjectProfile:
 			// UPDATE AN ACCOUNT
 			return p.processUpdateAccountFromFederator(ctx, federatorMsg)
+		case ap.ObjectNote:
+			// UPDATE A STATUS (edited)
+			return p.processUpdateStatusFromFederator(ctx, federatorMsg)
 		}
 	case ap.ActivityDelete:
 		// DELETE SOMETHING
@@ -100,6 +104,12 @@ func (p *Processor) ProcessFromFederator(ctx context.Context, federatorMsg messa
 			// DELETE A PROFILE/ACCOUNT
 			return p.processDeleteAccountFromFederator(ctx, federatorMsg)
 		}
+	case ap.ActivityMove:
+		// MOVE SOMETHING
+		if federatorMsg.APObjectType == ap.ObjectProfile {
+			// MOVE A PROFILE/ACCOUNT
+			return p.processMoveAccountFromFederator(ctx, federatorMsg)
+		}
 	}
 
 	// not a combination we can/need to process
@@ -172,7 +182,7 @@ func (p *Processor) processCreateStatusFromFederator(ctx context.Context, federa
 		return err
 	}
 
-	return nil
+	return p.updateConversations(ctx, status)
 }
 
 // processCreateFaveFromFederator handles Activity Create and Object Like
@@ -260,8 +270,14 @@ func (p *Processor) processCreateFollowRequestFromFederator(ctx context.Context,
 		followRequest.TargetAccount = a
 	}
 
-	if *followRequest.TargetAccount.Locked {
-		// if the account is locked just notify the follow request and nothing else
+	limited, err := p.state.DB.IsDomainLimited(ctx, followRequest.Account.Domain)
+	if err != nil {
+		return err
+	}
+
+	if *followRequest.TargetAccount.Locked || limited {
+		// if the account is locked, or the follow comes from a limited
+		// domain, just notify the follow request and nothing else
 		return p.notifyFollowRequest(ctx, followRequest)
 	}
 
@@ -352,6 +368,14 @@ func (p *Processor) processCreateBlockFromFederator(ctx context.Context, federat
 	if err := p.statusTimelines.WipeItemsFromAccountID(ctx, block.TargetAccountID, block.AccountID); err != nil {
 		return err
 	}
+
+	// same with any list timelines owned by either account
+	if err := p.wipeItemsFromListTimelines(ctx, block.AccountID, block.TargetAccountID); err != nil {
+		return err
+	}
+	if err := p.wipeItemsFromListTimelines(ctx, block.TargetAccountID, block.AccountID); err != nil {
+		return err
+	}
 	// TODO: same with notifications
 	// TODO: same with bookmarks
 
@@ -364,10 +388,15 @@ func (p *Processor) processCreateFlagFromFederator(ctx context.Context, federato
 		return errors.New("flag was not parseable as *gtsmodel.Report")
 	}
 
-	// TODO: handle additional side effects of flag creation:
-	// - notify admins by dm / notification
+	if err := p.notifyReportModerators(ctx, incomingReport); err != nil {
+		return fmt.Errorf("processCreateFlagFromFederator: error notifying moderators: %w", err)
+	}
 
-	return p.notifyReport(ctx, incomingReport)
+	if err := p.notifyReport(ctx, incomingReport); err != nil {
+		return fmt.Errorf("processCreateFlagFromFederator: error emailing moderators: %w", err)
+	}
+
+	return nil
 }
 
 // processUpdateAccountFromFederator handles Activity Update and Object Profile
@@ -394,6 +423,72 @@ func (p *Processor) processUpdateAccountFromFederator(ctx context.Context, feder
 	return nil
 }
 
+// processMoveAccountFromFederator handles Activity Move and Object Profile
+func (p *Processor) processMoveAccountFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
+	originAccount, ok := federatorMsg.GTSModel.(*gtsmodel.Account)
+	if !ok {
+		return errors.New("profile was not parseable as *gtsmodel.Account")
+	}
+
+	if federatorMsg.APIri == nil {
+		return errors.New("move target iri was nil")
+	}
+
+	requestUser := federatorMsg.ReceivingAccount.Username
+
+	targetAccount, err := p.federator.GetAccountByURI(ctx, requestUser, federatorMsg.APIri, false)
+	if err != nil {
+		return fmt.Errorf("error getting move target account %s: %w", federatorMsg.APIri, err)
+	}
+
+	if !isAlias(targetAccount, originAccount.URI) && targetAccount.Domain != "" {
+		// the target account may have set its aliases
+		// only recently, so make sure we're up to date
+		targetAccount, err = p.federator.UpdateAccount(ctx, requestUser, targetAccount, true)
+		if err != nil {
+			return fmt.Errorf("error updating move target account %s: %w", federatorMsg.APIri, err)
+		}
+	}
+
+	// only accept the move if the target account
+	// confirms that it is also the origin account
+	if !isAlias(targetAccount, originAccount.URI) {
+		return fmt.Errorf("move target account %s does not list %s as one of its aliases", targetAccount.URI, originAccount.URI)
+	}
+
+	if targetAccount.MovedToURI != "" {
+		return fmt.Errorf("move target account %s has itself moved to %s", targetAccount.URI, targetAccount.MovedToURI)
+	}
+
+	originAccount.MovedToURI = targetAccount.URI
+	originAccount.MovedToAccountID = targetAccount.ID
+	if err := p.state.DB.UpdateAccount(ctx, originAccount); err != nil {
+		return fmt.Errorf("error updating moved account %s: %w", originAccount.ID, err)
+	}
+
+	return p.moveFollowers(ctx, originAccount, targetAccount)
+}
+
+// isAlias returns whether the given account lists the given uri as one of its aliases.
+func isAlias(account *gtsmodel.Account, uri string) bool {
+	for _, alias := range account.AlsoKnownAsURIs {
+		if alias == uri {
+			return true
+		}
+	}
+	return false
+}
+
+// processUpdateStatusFromFederator handles Activity Update and Object Note
+func (p *Processor) processUpdateStatusFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
+	status, ok := federatorMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("note was not parseable as *gtsmodel.Status")
+	}
+
+	return p.updateStatusInTimelines(ctx, status)
+}
+
 // processDeleteStatusFromFederator handles Activity Delete and Object Note
 func (p *Processor) processDeleteStatusFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
 	statusToDelete, ok := federatorMsg.GTSModel.(*gtsmodel.Status)
@@ -542,6 +542,71 @@ func (suite *FromFederatorTestSuite) TestProcessFollowRequestUnlocked() {
 	suite.Equal(originAccount.ID, notif.Account.ID)
 }
 
+func (suite *FromFederatorTestSuite) TestProcessFollowRequestUnlockedLimitedDomain() {
+	ctx := context.Background()
+
+	originAccount := suite.testAccounts["remote_account_1"]
+
+	// target is an unlocked account
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	// but the origin account's domain is limited
+	err := suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01H0P1R3J5KX6GQ4B4N9R0W2ZC",
+		Domain:             originAccount.Domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		Severity:           gtsmodel.DomainBlockSeverityLimit,
+	})
+	suite.NoError(err)
+
+	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), targetAccount, stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	// put the follow request in the database as though it had passed through the federating db already
+	satanFollowRequestTurtle := &gtsmodel.FollowRequest{
+		ID:              "01FGRYAVAWWPP926J175QGM0WV",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		AccountID:       originAccount.ID,
+		Account:         originAccount,
+		TargetAccountID: targetAccount.ID,
+		TargetAccount:   targetAccount,
+		ShowReblogs:     testrig.TrueBool(),
+		URI:             fmt.Sprintf("%s/follows/01FGRYAVAWWPP926J175QGM0WV", originAccount.URI),
+		Notify:          testrig.FalseBool(),
+	}
+
+	err = suite.db.Put(ctx, satanFollowRequestTurtle)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActivityFollow,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         satanFollowRequestTurtle,
+		ReceivingAccount: targetAccount,
+	})
+	suite.NoError(err)
+
+	// a follow request notification should be streamed
+	var msg *stream.Message
+	select {
+	case msg = <-wssStream.Messages:
+		// fine
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no message from wssStream")
+	}
+	notif := &apimodel.Notification{}
+	err = json.Unmarshal([]byte(msg.Payload), notif)
+	suite.NoError(err)
+	suite.Equal("follow_request", notif.Type)
+	suite.Equal(originAccount.ID, notif.Account.ID)
+
+	// the follow shouldn't have been accepted
+	following, err := suite.db.IsFollowing(ctx, originAccount, targetAccount)
+	suite.NoError(err)
+	suite.False(following)
+}
+
 // TestCreateStatusFromIRI checks if a forwarded status can be dereferenced by the processor.
 func (suite *FromFederatorTestSuite) TestCreateStatusFromIRI() {
 	ctx := context.Background()
@@ -564,6 +629,85 @@ func (suite *FromFederatorTestSuite) TestCreateStatusFromIRI() {
 	suite.Equal(statusCreator.URI, s.AccountURI)
 }
 
+func (suite *FromFederatorTestSuite) TestProcessAccountMove() {
+	ctx := context.Background()
+
+	movingAccount := &gtsmodel.Account{}
+	*movingAccount = *suite.testAccounts["remote_account_1"]
+	targetAccount := &gtsmodel.Account{}
+	*targetAccount = *suite.testAccounts["local_account_2"]
+	followingAccount := suite.testAccounts["admin_account"]
+
+	// the target account lists the moving account as an alias
+	targetAccount.AlsoKnownAsURIs = []string{movingAccount.URI}
+	err := suite.db.UpdateAccount(ctx, targetAccount)
+	suite.NoError(err)
+
+	// admin follows the moving account
+	adminFollowSatan := &gtsmodel.Follow{
+		ID:              "01GZ5VQ8P0Z4QYCHYFXX8TH6SN",
+		CreatedAt:       time.Now().Add(-1 * time.Hour),
+		UpdatedAt:       time.Now().Add(-1 * time.Hour),
+		AccountID:       followingAccount.ID,
+		TargetAccountID: movingAccount.ID,
+		ShowReblogs:     testrig.TrueBool(),
+		URI:             fmt.Sprintf("%s/follows/01GZ5VQ8P0Z4QYCHYFXX8TH6SN", followingAccount.URI),
+		Notify:          testrig.TrueBool(),
+	}
+	err = suite.db.Put(ctx, adminFollowSatan)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ObjectProfile,
+		APActivityType:   ap.ActivityMove,
+		APIri:            testrig.URLMustParse(targetAccount.URI),
+		GTSModel:         movingAccount,
+		ReceivingAccount: followingAccount,
+	})
+	suite.NoError(err)
+
+	// the moving account should now be marked as moved
+	dbAccount, err := suite.db.GetAccountByID(ctx, movingAccount.ID)
+	suite.NoError(err)
+	suite.Equal(targetAccount.URI, dbAccount.MovedToURI)
+	suite.Equal(targetAccount.ID, dbAccount.MovedToAccountID)
+
+	// admin should no longer follow the moving account
+	follows, err := suite.db.IsFollowing(ctx, followingAccount, movingAccount)
+	suite.NoError(err)
+	suite.False(follows)
+
+	// admin should have requested to follow the (locked) target account instead
+	followRequested, err := suite.db.IsFollowRequested(ctx, followingAccount, targetAccount)
+	suite.NoError(err)
+	suite.True(followRequested)
+}
+
+func (suite *FromFederatorTestSuite) TestProcessAccountMoveNotAlias() {
+	ctx := context.Background()
+
+	movingAccount := &gtsmodel.Account{}
+	*movingAccount = *suite.testAccounts["remote_account_1"]
+	targetAccount := suite.testAccounts["local_account_2"]
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	// the target account doesn't list the moving account as an alias
+	err := suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ObjectProfile,
+		APActivityType:   ap.ActivityMove,
+		APIri:            testrig.URLMustParse(targetAccount.URI),
+		GTSModel:         movingAccount,
+		ReceivingAccount: receivingAccount,
+	})
+	suite.Error(err)
+
+	// so the moving account should not be marked as moved
+	dbAccount, err := suite.db.GetAccountByID(ctx, movingAccount.ID)
+	suite.NoError(err)
+	suite.Empty(dbAccount.MovedToURI)
+	suite.Empty(dbAccount.MovedToAccountID)
+}
+
 func TestFromFederatorTestSuite(t *testing.T) {
 	suite.Run(t, &FromFederatorTestSuite{})
 }
@@ -80,6 +80,9 @@ func (p *Processor) InstancePeersGet(ctx context.Context, includeSuspended bool,
 
 		for _, i := range instances {
 			domain := &apimodel.Domain{Domain: i.Domain}
+			if !i.UnavailableAt.IsZero() {
+				domain.UnavailableAt = util.FormatISO8601(i.UnavailableAt)
+			}
 			domains = append(domains, domain)
 		}
 	}
@@ -97,9 +100,14 @@ func (p *Processor) InstancePeersGet(ctx context.Context, includeSuspended bool,
 
 			domain := &apimodel.Domain{
 				Domain:        d.Domain,
-				SuspendedAt:   util.FormatISO8601(d.CreatedAt),
 				PublicComment: d.PublicComment,
 			}
+
+			if d.Severity == gtsmodel.DomainBlockSeverityLimit {
+				domain.SilencedAt = util.FormatISO8601(d.CreatedAt)
+			} else {
+				domain.SuspendedAt = util.FormatISO8601(d.CreatedAt)
+			}
 			domains = append(domains, domain)
 		}
 	}
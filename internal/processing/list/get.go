@@ -0,0 +1,172 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Get returns the api model of one list with the given ID.
+func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.List, gtserror.WithCode) {
+	list, errWithCode := p.getList(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiList(ctx, list)
+}
+
+// GetAll returns multiple lists created by the given account, sorted by list ID DESC (newest first).
+func (p *Processor) GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.List, gtserror.WithCode) {
+	lists, err := p.state.DB.GetListsForAccountID(ctx, account.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// No lists owned by this account.
+			return []*apimodel.List{}, nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiLists := make([]*apimodel.List, 0, len(lists))
+	for _, list := range lists {
+		apiList, errWithCode := p.apiList(ctx, list)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiLists = append(apiLists, apiList)
+	}
+
+	return apiLists, nil
+}
+
+// GetAllListAccounts returns all accounts that are in the given list,
+// owned by the given account. There's no pagination for this endpoint.
+//
+// See https://docs.joinmastodon.org/methods/lists/#query-parameters:
+//
+//	Limit: Integer. Maximum number of results. Defaults to 40 accounts.
+//	Max 80 accounts. Set to 0 in order to get all accounts without pagination.
+func (p *Processor) GetAllListAccounts(ctx context.Context, account *gtsmodel.Account, listID string) ([]*apimodel.Account, gtserror.WithCode) {
+	// Ensure list exists + is owned by requesting account.
+	if _, errWithCode := p.getList(ctx, account.ID, listID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Get all entries for this list.
+	listEntries, err := p.state.DB.GetListEntries(ctx, listID, "", "", "", 0)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetAllListAccounts: error getting list entries: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Extract accounts from list entries + add them to response.
+	accounts := make([]*apimodel.Account, 0, len(listEntries))
+	p.accountsFromListEntries(ctx, listEntries, func(acc *apimodel.Account) {
+		accounts = append(accounts, acc)
+	})
+
+	return accounts, nil
+}
+
+// GetListAccounts returns accounts that are in the given list, owned by the given account.
+// The additional parameters can be used for paging.
+func (p *Processor) GetListAccounts(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	listID string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	// Ensure list exists + is owned by requesting account.
+	if _, errWithCode := p.getList(ctx, account.ID, listID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// To know which accounts are in the list,
+	// we need to first get requested list entries.
+	listEntries, err := p.state.DB.GetListEntries(ctx, listID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetListAccounts: error getting list entries: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(listEntries)
+	if count == 0 {
+		// No list entries means no accounts.
+		return util.EmptyPageableResponse(), nil
+	}
+
+	var (
+		items = make([]interface{}, 0, count)
+
+		// Set next + prev values before filtering and API
+		// converting, so caller can still page properly.
+		nextMaxIDValue = listEntries[count-1].ID
+		prevMinIDValue = listEntries[0].ID
+	)
+
+	// Extract accounts from list entries + add them to response.
+	p.accountsFromListEntries(ctx, listEntries, func(acc *apimodel.Account) {
+		items = append(items, acc)
+	})
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/lists/" + listID + "/accounts",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
+// accountsFromListEntries converts the target accounts of the
+// given list entries to their api representation, passing each
+// one to appendAcc. Entries that can't be converted are skipped.
+func (p *Processor) accountsFromListEntries(ctx context.Context, listEntries []*gtsmodel.ListEntry, appendAcc func(*apimodel.Account)) {
+	for _, listEntry := range listEntries {
+		if listEntry.Follow == nil {
+			// Skip invalid list entry.
+			continue
+		}
+
+		if listEntry.Follow.TargetAccount == nil {
+			// Skip invalid list entry.
+			continue
+		}
+
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, listEntry.Follow.TargetAccount)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s to api: %v", listEntry.Follow.TargetAccountID, err)
+			continue
+		}
+
+		appendAcc(apiAccount)
+	}
+}
@@ -0,0 +1,73 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package list
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Update updates one list for the given account, using the provided parameters.
+// These params should have already been validated by the time they reach this function.
+func (p *Processor) Update(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	id string,
+	title *string,
+	repliesPolicy *gtsmodel.RepliesPolicy,
+) (*apimodel.List, gtserror.WithCode) {
+	list, errWithCode := p.getList(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Only update columns we're told to update.
+	columns := make([]string, 0, 2)
+
+	if title != nil {
+		list.Title = *title
+		columns = append(columns, "title")
+	}
+
+	if repliesPolicy != nil {
+		list.RepliesPolicy = *repliesPolicy
+		columns = append(columns, "replies_policy")
+	}
+
+	if len(columns) == 0 {
+		// Nothing to update.
+		return p.apiList(ctx, list)
+	}
+
+	if err := p.state.DB.UpdateList(ctx, list, columns...); err != nil {
+		err = fmt.Errorf("db error updating list: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if repliesPolicy != nil {
+		// Replies policy changed, so the indexed
+		// timeline for this list is no longer valid.
+		p.listTimelines.UnloadTimeline(ctx, list.ID)
+	}
+
+	return p.apiList(ctx, list)
+}
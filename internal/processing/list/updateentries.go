@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// AddToList adds targetAccountIDs to the given list, if valid.
+func (p *Processor) AddToList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode {
+	// Ensure this list exists + account owns it.
+	list, errWithCode := p.getList(ctx, account.ID, listID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// Pre-assemble list of entries to add. We *could* add these
+	// one by one as we iterate through accountIDs, but according
+	// to the Mastodon API we should only add them all once we know
+	// they're all valid, no partial updates.
+	listEntries := make([]*gtsmodel.ListEntry, 0, len(targetAccountIDs))
+
+	// Check each targetAccountID is valid.
+	//   - Follow must exist.
+	//   - Follow must not already be in the given list.
+	for _, targetAccountID := range targetAccountIDs {
+		// Ensure follow exists.
+		follow := &gtsmodel.Follow{}
+		if err := p.state.DB.GetWhere(ctx, []db.Where{
+			{Key: "account_id", Value: account.ID},
+			{Key: "target_account_id", Value: targetAccountID},
+		}, follow); err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				err = fmt.Errorf("you do not follow account %s", targetAccountID)
+				return gtserror.NewErrorNotFound(err, err.Error())
+			}
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		// Ensure followID not already in list.
+		// This particular call to ListIncludesAccount
+		// won't error with ErrNoEntries.
+		includes, err := p.state.DB.ListIncludesAccount(ctx, listID, targetAccountID)
+		if err != nil {
+			err = fmt.Errorf("error checking existence of follow %s in list %s: %w", follow.ID, listID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if includes {
+			err = fmt.Errorf("account %s is already in list %s", targetAccountID, listID)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		// Entry wasn't in the list, we can add it.
+		listEntries = append(listEntries, &gtsmodel.ListEntry{
+			ID:       id.NewULID(),
+			ListID:   listID,
+			FollowID: follow.ID,
+		})
+	}
+
+	// If we get to here we can assume all
+	// entries are valid, so try to add them.
+	if err := p.state.DB.PutListEntries(ctx, listEntries); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("one or more errors inserting list entries: %w", err)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Statuses from the newly added accounts aren't in the indexed
+	// timeline for this list yet; unload it so it gets reindexed.
+	p.listTimelines.UnloadTimeline(ctx, list.ID)
+
+	return nil
+}
+
+// RemoveFromList removes targetAccountIDs from the given list, if valid.
+func (p *Processor) RemoveFromList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode {
+	// Ensure this list exists + account owns it.
+	list, errWithCode := p.getList(ctx, account.ID, listID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// For each targetAccountID, we want to check if
+	// a follow with that targetAccountID is in the
+	// given list. If it is in there, we want to remove
+	// it from the list.
+	for _, targetAccountID := range targetAccountIDs {
+		// Check if targetAccountID is
+		// on a follow in the list.
+		entryID, ok := func() (string, bool) {
+			for _, listEntry := range list.ListEntries {
+				if listEntry.Follow == nil {
+					continue
+				}
+
+				if listEntry.Follow.TargetAccountID == targetAccountID {
+					return listEntry.ID, true
+				}
+			}
+			return "", false
+		}()
+
+		if !ok {
+			// Target account is
+			// not in this list.
+			continue
+		}
+
+		// Entry is in the list, remove it.
+		if err := p.state.DB.DeleteListEntry(ctx, entryID); err != nil {
+			err = fmt.Errorf("error removing list entry %s from list %s: %w", entryID, listID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		// Remove the account's statuses from the list timeline.
+		if err := p.listTimelines.WipeItemsFromAccountID(ctx, list.ID, targetAccountID); err != nil {
+			err = fmt.Errorf("error removing statuses of account %s from list timeline %s: %w", targetAccountID, listID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+)
+
+// ListGrabFunction returns a function that satisfies the GrabFunction interface in internal/timeline.
+func ListGrabFunction(database db.DB) timeline.GrabFunction {
+	return func(ctx context.Context, timelineListID string, maxID string, sinceID string, minID string, limit int) ([]timeline.Timelineable, bool, error) {
+		statuses, err := database.GetListTimeline(ctx, timelineListID, maxID, sinceID, minID, limit)
+		if err != nil {
+			if err == db.ErrNoEntries {
+				return nil, true, nil // we just don't have enough statuses left in the db so return stop = true
+			}
+			return nil, false, fmt.Errorf("listGrabFunction: error getting statuses from db: %s", err)
+		}
+
+		items := []timeline.Timelineable{}
+		for _, s := range statuses {
+			items = append(items, s)
+		}
+
+		return items, false, nil
+	}
+}
+
+// ListFilterFunction returns a function that satisfies the FilterFunction interface in internal/timeline.
+func ListFilterFunction(database db.DB, filter visibility.Filter) timeline.FilterFunction {
+	return func(ctx context.Context, timelineListID string, item timeline.Timelineable) (shouldIndex bool, err error) {
+		status, ok := item.(*gtsmodel.Status)
+		if !ok {
+			return false, errors.New("listFilterFunction: could not convert item to *gtsmodel.Status")
+		}
+
+		list, err := database.GetListByID(ctx, timelineListID)
+		if err != nil {
+			return false, fmt.Errorf("listFilterFunction: error getting list with id %s", timelineListID)
+		}
+
+		timelineable, err := listTimelineable(ctx, database, filter, list, status)
+		if err != nil {
+			log.Warnf(ctx, "error checking listtimelineability of status %s for list %s: %s", status.ID, timelineListID, err)
+		}
+
+		if !timelineable {
+			return false, nil // we don't return the error here because we want to just skip this item if something goes wrong
+		}
+
+		hide, _, err := filter.StatusFiltered(ctx, status, list.Account, gtsmodel.FilterContextHome)
+		if err != nil {
+			log.Warnf(ctx, "error checking filters of account %s against status %s: %s", list.AccountID, status.ID, err)
+		}
+
+		return !hide, nil
+	}
+}
+
+// ListPrepareFunction returns a function that satisfies the PrepareFunction interface in internal/timeline.
+func ListPrepareFunction(database db.DB, tc typeutils.TypeConverter, filter visibility.Filter) timeline.PrepareFunction {
+	return func(ctx context.Context, timelineListID string, itemID string) (timeline.Preparable, error) {
+		status, err := database.GetStatusByID(ctx, itemID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting status with id %s", itemID)
+		}
+
+		list, err := database.GetListByID(ctx, timelineListID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting list with id %s", timelineListID)
+		}
+
+		// Hide filters are applied when the status is indexed, but the
+		// account's filters may have changed since, so check them again.
+		apiStatus, hide, err := filteredAPIStatus(ctx, tc, filter, status, list.Account, gtsmodel.FilterContextHome)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: %w", err)
+		}
+
+		if hide {
+			// Treat hidden statuses as missing,
+			// so they're skipped when preparing.
+			return nil, db.ErrNoEntries
+		}
+
+		return apiStatus, nil
+	}
+}
+
+// listTimelineable checks whether the given status should be shown in the
+// given list's timeline, taking account of the list owner's view of the
+// status and the replies policy of the list.
+func listTimelineable(ctx context.Context, database db.DB, filter visibility.Filter, list *gtsmodel.List, status *gtsmodel.Status) (bool, error) {
+	// The status must be visible on the home timeline of the list owner.
+	timelineable, err := filter.StatusHometimelineable(ctx, status, list.Account)
+	if err != nil || !timelineable {
+		return false, err
+	}
+
+	if status.InReplyToAccountID == "" || status.InReplyToAccountID == status.AccountID {
+		// Not a reply, or a reply to self, so
+		// replies policy isn't relevant here.
+		return true, nil
+	}
+
+	switch list.RepliesPolicy {
+	case gtsmodel.RepliesPolicyNone:
+		// Don't show any replies.
+		return false, nil
+	case gtsmodel.RepliesPolicyList:
+		// Only show replies to the list owner or to other list members.
+		if status.InReplyToAccountID == list.AccountID {
+			return true, nil
+		}
+		return database.ListIncludesAccount(ctx, list.ID, status.InReplyToAccountID)
+	default:
+		// Show replies to any followed account; this
+		// was already checked by the hometimeline filter.
+		return true, nil
+	}
+}
+
+// wipeItemsFromListTimelines removes all items by the given accountID
+// from the timelines of any lists owned by the given listOwnerAccountID.
+func (p *Processor) wipeItemsFromListTimelines(ctx context.Context, listOwnerAccountID string, accountID string) error {
+	lists, err := p.state.DB.GetListsForAccountID(ctx, listOwnerAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("wipeItemsFromListTimelines: error getting lists for account %s: %w", listOwnerAccountID, err)
+	}
+
+	for _, list := range lists {
+		if err := p.listTimelines.WipeItemsFromAccountID(ctx, list.ID, accountID); err != nil {
+			return fmt.Errorf("wipeItemsFromListTimelines: error wiping items from list %s: %w", list.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Processor) ListTimelineGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	// Ensure list exists + is owned by this account.
+	list, err := p.state.DB.GetListByID(ctx, listID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if list.AccountID != authed.Account.ID {
+		err = fmt.Errorf("list with id %s does not belong to account %s", list.ID, authed.Account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	preparedItems, err := p.listTimelines.GetTimeline(ctx, listID, maxID, sinceID, minID, limit, false)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(preparedItems)
+
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := []interface{}{}
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+	for i, item := range preparedItems {
+		if i == count-1 {
+			nextMaxIDValue = item.GetID()
+		}
+
+		if i == 0 {
+			prevMinIDValue = item.GetID()
+		}
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/timelines/list/" + listID,
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package markers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Update updates the given markers of the given account, and streams the updated
+// markers to the account's other clients. The params in the markers should have
+// already been validated by the time they reach this function.
+func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, markers []*gtsmodel.Marker) (*apimodel.Marker, gtserror.WithCode) {
+	for _, marker := range markers {
+		marker.AccountID = account.ID
+		if err := p.state.DB.UpdateMarker(ctx, marker); err != nil {
+			if errors.Is(err, db.ErrAlreadyExists) {
+				err := fmt.Errorf("%s marker was updated concurrently by another client, please retry", marker.Name)
+				return nil, gtserror.NewErrorConflict(err, err.Error())
+			}
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error updating %s marker: %w", marker.Name, err))
+		}
+	}
+
+	apiMarker, err := p.tc.MarkersToAPIMarker(ctx, markers)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.stream.Marker(apiMarker, account); err != nil {
+		log.Errorf(ctx, "error streaming markers to account %s: %v", account.ID, err)
+	}
+
+	return apiMarker, nil
+}
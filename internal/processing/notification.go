@@ -22,6 +22,7 @@ import (
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -43,23 +44,59 @@ func (p *Processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ex
 	nextMaxIDValue := ""
 	prevMinIDValue := ""
 	for i, n := range notifs {
+		// Set paging values before any skipping, so
+		// that filtered-out notifications don't break
+		// the next / prev links.
+		if i == count-1 {
+			nextMaxIDValue = n.ID
+		}
+
+		if i == 0 {
+			prevMinIDValue = n.ID
+		}
+
+		// Skip notifications from accounts whose
+		// notifications have been muted by the requester.
+		muted, err := p.state.DB.IsMuted(ctx, authed.Account.ID, n.OriginAccountID, true)
+		if err != nil {
+			log.Debugf(ctx, "got an error checking mute of notification origin account, will skip it: %s", err)
+			continue
+		}
+
+		if muted {
+			continue
+		}
+
 		item, err := p.tc.NotificationToAPINotification(ctx, n)
 		if err != nil {
 			log.Debugf(ctx, "got an error converting a notification to api, will skip it: %s", err)
 			continue
 		}
 
-		if i == count-1 {
-			nextMaxIDValue = item.GetID()
-		}
+		if n.Status != nil && item.Status != nil {
+			hide, results, err := p.filter.StatusFiltered(ctx, n.Status, authed.Account, gtsmodel.FilterContextNotifications)
+			if err != nil {
+				log.Debugf(ctx, "got an error checking filters against notification status, will skip it: %s", err)
+				continue
+			}
 
-		if i == 0 {
-			prevMinIDValue = item.GetID()
+			if hide {
+				continue
+			}
+
+			if item.Status.Filtered, err = p.tc.FilterResultsToAPIFilterResults(ctx, results); err != nil {
+				log.Debugf(ctx, "got an error converting filter results for notification status, will skip it: %s", err)
+				continue
+			}
 		}
 
 		items = append(items, item)
 	}
 
+	if len(items) == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
 	return util.PackagePageableResponse(util.PageableResponseParams{
 		Items:          items,
 		Path:           "api/v1/notifications",
@@ -18,9 +18,16 @@
 package processing
 
 import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
 	"net/http"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
@@ -38,3 +45,37 @@ func (p *Processor) OAuthValidateBearerToken(r *http.Request) (oauth2.TokenInfo,
 	// todo: some kind of metrics stuff here
 	return p.oauthServer.ValidationBearerToken(r)
 }
+
+// OAuthRevokeAccessToken revokes the given access token on behalf of the client with the given
+// id and secret, as described in RFC 7009. Revoking a token which doesn't exist is not an error.
+func (p *Processor) OAuthRevokeAccessToken(ctx context.Context, clientID string, clientSecret string, accessToken string) gtserror.WithCode {
+	client := &gtsmodel.Client{}
+	if err := p.state.DB.GetByID(ctx, clientID, client); err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.NewErrorInternalError(fmt.Errorf("db error getting client: %w", err))
+		}
+		return gtserror.NewErrorUnauthorized(oauth.InvalidClient(), "client authentication failed")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(client.Secret), []byte(clientSecret)) != 1 {
+		return gtserror.NewErrorUnauthorized(oauth.InvalidClient(), "client authentication failed")
+	}
+
+	token, err := p.state.DB.GetTokenByAccess(ctx, accessToken)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.NewErrorInternalError(fmt.Errorf("db error getting token: %w", err))
+		}
+		return nil
+	}
+
+	if token.ClientID != client.ID {
+		return gtserror.NewErrorForbidden(oauth.UnauthorizedClient(), "token was not issued to this client")
+	}
+
+	if err := p.state.DB.DeleteTokenByID(ctx, token.ID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("db error deleting token: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package polls
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"codeberg.org/gruf/go-runners"
+	"codeberg.org/gruf/go-sched"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// closeInterval is how often the
+// job for closing polls should run.
+const closeInterval = time.Minute
+
+// CloseExpired closes all open polls which expired before the given time,
+// and enqueues a message for each closed poll so that voters can be
+// notified of the results, and (for local polls) the results federated.
+func (p *Processor) CloseExpired(ctx context.Context, now time.Time) error {
+	polls, err := p.state.DB.GetOpenPollsExpiredBefore(ctx, now)
+	if err != nil {
+		return fmt.Errorf("CloseExpired: db error getting expired polls: %w", err)
+	}
+
+	for _, poll := range polls {
+		if err := p.state.DB.PopulatePoll(ctx, poll); err != nil {
+			log.Errorf(ctx, "error populating poll %s: %v", poll.ID, err)
+			continue
+		}
+
+		poll.ClosedAt = now
+		if err := p.state.DB.UpdatePoll(ctx, poll, "closed_at"); err != nil {
+			log.Errorf(ctx, "error closing poll %s: %v", poll.ID, err)
+			continue
+		}
+
+		status := poll.Status
+		if status.Account == nil {
+			status.Account, err = p.state.DB.GetAccountByID(ctx, status.AccountID)
+			if err != nil {
+				log.Errorf(ctx, "error getting author of poll %s: %v", poll.ID, err)
+				continue
+			}
+		}
+
+		p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+			APObjectType:   ap.ActivityQuestion,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       status,
+			OriginAccount:  status.Account,
+		})
+	}
+
+	return nil
+}
+
+func scheduleClosePolls(p *Processor) {
+	// Get ctx associated with scheduler run state.
+	done := p.state.Workers.Scheduler.Done()
+	doneCtx := runners.CancelCtx(done)
+
+	// Schedule the CloseExpired task to execute every minute.
+	p.state.Workers.Scheduler.Schedule(sched.NewJob(func(now time.Time) {
+		if err := p.CloseExpired(doneCtx, now); err != nil {
+			log.Errorf(nil, "error closing expired polls: %v", err)
+		}
+	}).Every(closeInterval))
+}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package polls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Get returns the api model of one poll with the given ID,
+// if the status it's attached to is visible to the requester.
+func (p *Processor) Get(ctx context.Context, requester *gtsmodel.Account, id string) (*apimodel.Poll, gtserror.WithCode) {
+	poll, errWithCode := p.getVisiblePoll(ctx, requester, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiPoll(ctx, requester, poll)
+}
+
+// getVisiblePoll gets the poll with the given ID from the database,
+// with its status populated, returning 404 if it doesn't exist or
+// its status isn't visible to the requester.
+func (p *Processor) getVisiblePoll(ctx context.Context, requester *gtsmodel.Account, id string) (*gtsmodel.Poll, gtserror.WithCode) {
+	poll, err := p.state.DB.GetPollByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("poll with id %s not found", id)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		err = fmt.Errorf("getVisiblePoll: db error getting poll: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.PopulatePoll(ctx, poll); err != nil {
+		err = fmt.Errorf("getVisiblePoll: db error populating poll: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	visible, err := p.filter.StatusVisible(ctx, poll.Status, requester)
+	if err != nil {
+		err = fmt.Errorf("getVisiblePoll: error checking status visibility: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !visible {
+		err = fmt.Errorf("poll with id %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return poll, nil
+}
+
+func (p *Processor) apiPoll(ctx context.Context, requester *gtsmodel.Account, poll *gtsmodel.Poll) (*apimodel.Poll, gtserror.WithCode) {
+	apiPoll, err := p.tc.PollToAPIPoll(ctx, requester, poll)
+	if err != nil {
+		err = fmt.Errorf("error converting poll to api model: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiPoll, nil
+}
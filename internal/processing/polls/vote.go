@@ -0,0 +1,95 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package polls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// Vote casts the given choices as the requester's vote in the poll with the given ID,
+// and returns the api model of the updated poll. If the poll is remote, the vote will
+// be federated to the author of the poll.
+func (p *Processor) Vote(ctx context.Context, requester *gtsmodel.Account, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode) {
+	poll, errWithCode := p.getVisiblePoll(ctx, requester, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if poll.Closed() || poll.Expired(time.Now()) {
+		err := errors.New("poll has already ended")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if poll.Status.AccountID == requester.ID {
+		err := errors.New("you can't vote in your own poll")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if !poll.CheckChoices(choices) {
+		err := errors.New("invalid choices for this poll")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	_, err := p.state.DB.GetPollVoteBy(ctx, poll.ID, requester.ID)
+	if err == nil {
+		err := errors.New("you have already voted in this poll")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	} else if !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("Vote: db error checking for existing vote: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	vote := &gtsmodel.PollVote{
+		ID:        id.NewULID(),
+		Choices:   choices,
+		AccountID: requester.ID,
+		Account:   requester,
+		PollID:    poll.ID,
+		Poll:      poll,
+	}
+
+	if err := p.state.DB.PutPollVote(ctx, vote); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := errors.New("you have already voted in this poll")
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		err = fmt.Errorf("Vote: db error inserting vote: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process side effects (ie., federation) asynchronously.
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityQuestion,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       vote,
+		OriginAccount:  requester,
+	})
+
+	return p.apiPoll(ctx, requester, poll)
+}
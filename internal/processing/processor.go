@@ -28,11 +28,18 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/conversations"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/fedi"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/filters"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/list"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/markers"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/polls"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/push"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/report"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/tags"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/user"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
@@ -46,6 +53,7 @@ type Processor struct {
 	oauthServer     oauth.Server
 	mediaManager    mm.Manager
 	statusTimelines timeline.Manager
+	listTimelines   timeline.Manager
 	state           *state.State
 	filter          visibility.Filter
 	emailSender     email.Sender
@@ -54,14 +62,21 @@ type Processor struct {
 		SUB-PROCESSORS
 	*/
 
-	account account.Processor
-	admin   admin.Processor
-	fedi    fedi.Processor
-	media   media.Processor
-	report  report.Processor
-	status  status.Processor
-	stream  stream.Processor
-	user    user.Processor
+	account       account.Processor
+	admin         admin.Processor
+	conversations conversations.Processor
+	fedi          fedi.Processor
+	filters       filters.Processor
+	list          list.Processor
+	markers       markers.Processor
+	media         media.Processor
+	polls         polls.Processor
+	push          push.Processor
+	report        report.Processor
+	status        status.Processor
+	stream        stream.Processor
+	tags          tags.Processor
+	user          user.Processor
 }
 
 func (p *Processor) Account() *account.Processor {
@@ -72,14 +87,38 @@ func (p *Processor) Admin() *admin.Processor {
 	return &p.admin
 }
 
+func (p *Processor) Conversations() *conversations.Processor {
+	return &p.conversations
+}
+
 func (p *Processor) Fedi() *fedi.Processor {
 	return &p.fedi
 }
 
+func (p *Processor) Filters() *filters.Processor {
+	return &p.filters
+}
+
+func (p *Processor) List() *list.Processor {
+	return &p.list
+}
+
+func (p *Processor) Markers() *markers.Processor {
+	return &p.markers
+}
+
 func (p *Processor) Media() *media.Processor {
 	return &p.media
 }
 
+func (p *Processor) Polls() *polls.Processor {
+	return &p.polls
+}
+
+func (p *Processor) Push() *push.Processor {
+	return &p.push
+}
+
 func (p *Processor) Report() *report.Processor {
 	return &p.report
 }
@@ -92,6 +131,10 @@ func (p *Processor) Stream() *stream.Processor {
 	return &p.stream
 }
 
+func (p *Processor) Tags() *tags.Processor {
+	return &p.tags
+}
+
 func (p *Processor) User() *user.Processor {
 	return &p.user
 }
@@ -117,7 +160,13 @@ func NewProcessor(
 		statusTimelines: timeline.NewManager(
 			StatusGrabFunction(state.DB),
 			StatusFilterFunction(state.DB, filter),
-			StatusPrepareFunction(state.DB, tc),
+			StatusPrepareFunction(state.DB, tc, filter),
+			StatusSkipInsertFunction(),
+		),
+		listTimelines: timeline.NewManager(
+			ListGrabFunction(state.DB),
+			ListFilterFunction(state.DB, filter),
+			ListPrepareFunction(state.DB, tc, filter),
 			StatusSkipInsertFunction(),
 		),
 		state:       state,
@@ -128,11 +177,18 @@ func NewProcessor(
 	// sub processors
 	processor.account = account.New(state, tc, mediaManager, oauthServer, federator, parseMentionFunc)
 	processor.admin = admin.New(state, tc, mediaManager, federator.TransportController(), emailSender)
+	processor.conversations = conversations.New(state, tc, filter)
 	processor.fedi = fedi.New(state, tc, federator)
+	processor.filters = filters.New(state, tc, processor.statusTimelines, processor.listTimelines)
+	processor.list = list.New(state, tc, processor.listTimelines)
+	processor.markers = markers.New(state, tc, &processor.stream)
 	processor.media = media.New(state, tc, mediaManager, federator.TransportController())
+	processor.polls = polls.New(state, tc)
+	processor.push = push.New(state, tc, federator.TransportController().WebPushSender())
 	processor.report = report.New(state, tc)
 	processor.status = status.New(state, tc, parseMentionFunc)
 	processor.stream = stream.New(state, oauthServer)
+	processor.tags = tags.New(state, tc, filter)
 	processor.user = user.New(state, emailSender)
 
 	return processor
@@ -158,10 +214,16 @@ func (p *Processor) EnqueueFederator(ctx context.Context, msg messages.FromFeder
 
 // Start starts the Processor.
 func (p *Processor) Start() error {
-	return p.statusTimelines.Start()
+	if err := p.statusTimelines.Start(); err != nil {
+		return err
+	}
+	return p.listTimelines.Start()
 }
 
 // Stop stops the processor cleanly.
 func (p *Processor) Stop() error {
-	return p.statusTimelines.Stop()
+	if err := p.statusTimelines.Stop(); err != nil {
+		return err
+	}
+	return p.listTimelines.Stop()
 }
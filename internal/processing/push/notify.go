@@ -0,0 +1,187 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+)
+
+// maxBodyLength is the maximum length in runes of the
+// body of a push message, so that the encrypted payload
+// comfortably fits in a single record.
+const maxBodyLength = 140
+
+// Notify queues the given notification for delivery to each of the push subscriptions
+// of the target account which want pushes of this type of notification, from this origin
+// account. The api notification should be the already-converted form of notif, as will
+// have been streamed to the target account.
+func (p *Processor) Notify(ctx context.Context, notif *gtsmodel.Notification, apiNotif *apimodel.Notification) error {
+	subscriptions, err := p.state.DB.GetWebPushSubscriptionsByAccountID(ctx, notif.TargetAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Nothing to push to.
+			return nil
+		}
+		return fmt.Errorf("Notify: db error getting push subscriptions: %w", err)
+	}
+
+	keyPair, err := p.state.DB.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return fmt.Errorf("Notify: db error getting vapid key pair: %w", err)
+	}
+
+	var locale string
+	if user, err := p.state.DB.GetUserByAccountID(ctx, notif.TargetAccountID); err == nil {
+		locale = user.Locale
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.Alert(notif.NotificationType) {
+			continue
+		}
+
+		allowed, err := p.policyAllows(ctx, subscription.Policy, notif)
+		if err != nil {
+			log.Errorf(ctx, "error checking policy of push subscription %s: %v", subscription.ID, err)
+			continue
+		}
+
+		if !allowed {
+			continue
+		}
+
+		token := &gtsmodel.Token{}
+		if err := p.state.DB.GetByID(ctx, subscription.TokenID, token); err != nil {
+			log.Errorf(ctx, "db error getting token of push subscription %s: %v", subscription.ID, err)
+			continue
+		}
+
+		payload, err := json.Marshal(pushNotification(apiNotif, token.Access, locale))
+		if err != nil {
+			return fmt.Errorf("Notify: error marshalling push notification: %w", err)
+		}
+
+		subscription := subscription
+		_ = p.state.Workers.WebPush.MustEnqueueCtx(ctx, func(ctx context.Context) {
+			p.send(ctx, subscription, keyPair, payload)
+		})
+	}
+
+	return nil
+}
+
+// send delivers one push message to one push subscription,
+// removing the subscription if the push service says it's gone.
+func (p *Processor) send(ctx context.Context, subscription *gtsmodel.WebPushSubscription, keyPair *gtsmodel.VAPIDKeyPair, payload []byte) {
+	err := p.sender.Send(ctx, subscription, keyPair, payload)
+	if err == nil {
+		return
+	}
+
+	if !errors.Is(err, webpush.ErrGone) {
+		log.Warnf(ctx, "error sending push message to subscription %s: %v", subscription.ID, err)
+		return
+	}
+
+	log.Infof(ctx, "push subscription %s is gone, removing it", subscription.ID)
+	if err := p.state.DB.DeleteWebPushSubscriptionByID(ctx, subscription.ID); err != nil {
+		log.Errorf(ctx, "db error removing push subscription %s: %v", subscription.ID, err)
+	}
+}
+
+// policyAllows returns whether the given push
+// policy allows pushes of the given notification.
+func (p *Processor) policyAllows(ctx context.Context, policy gtsmodel.WebPushPolicy, notif *gtsmodel.Notification) (bool, error) {
+	switch policy {
+	case gtsmodel.WebPushPolicyNone:
+		return false, nil
+	case gtsmodel.WebPushPolicyFollowed:
+		return p.state.DB.IsFollowing(ctx, notif.TargetAccount, notif.OriginAccount)
+	case gtsmodel.WebPushPolicyFollower:
+		return p.state.DB.IsFollowing(ctx, notif.OriginAccount, notif.TargetAccount)
+	default:
+		return true, nil
+	}
+}
+
+// pushNotification returns the plaintext payload of a
+// push message for the given api notification.
+func pushNotification(apiNotif *apimodel.Notification, accessToken string, locale string) *apimodel.WebPushNotification {
+	name := apiNotif.Account.DisplayName
+	if name == "" {
+		name = apiNotif.Account.Acct
+	}
+
+	var title string
+	switch gtsmodel.NotificationType(apiNotif.Type) {
+	case gtsmodel.NotificationFollow:
+		title = name + " followed you"
+	case gtsmodel.NotificationFollowRequest:
+		title = name + " requested to follow you"
+	case gtsmodel.NotificationFave:
+		title = name + " favourited your post"
+	case gtsmodel.NotificationMention:
+		title = name + " mentioned you"
+	case gtsmodel.NotificationReblog:
+		title = name + " boosted your post"
+	case gtsmodel.NotificationPoll:
+		title = "A poll has ended"
+	case gtsmodel.NotificationStatus:
+		title = name + " just posted"
+	case gtsmodel.NotificationAdminReport:
+		title = "New report from " + name
+	default:
+		title = "New notification from " + name
+	}
+
+	var body string
+	switch {
+	case apiNotif.Status != nil && apiNotif.Status.SpoilerText != "":
+		body = apiNotif.Status.SpoilerText
+	case apiNotif.Status != nil:
+		body = text.SanitizePlaintext(apiNotif.Status.Content)
+	case apiNotif.Report != nil:
+		body = apiNotif.Report.Comment
+	default:
+		body = text.SanitizePlaintext(apiNotif.Account.Note)
+	}
+
+	if runes := []rune(body); len(runes) > maxBodyLength {
+		body = string(runes[:maxBodyLength-1]) + "…"
+	}
+
+	return &apimodel.WebPushNotification{
+		AccessToken:      accessToken,
+		NotificationID:   apiNotif.ID,
+		NotificationType: apiNotif.Type,
+		Icon:             apiNotif.Account.Avatar,
+		Title:            title,
+		Body:             body,
+		PreferredLocale:  locale,
+	}
+}
@@ -0,0 +1,203 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// SubscriptionGet returns the push subscription
+// created by the access token of the given auth.
+func (p *Processor) SubscriptionGet(ctx context.Context, authed *oauth.Auth) (*apimodel.PushSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getSubscription(ctx, authed)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
+
+// SubscriptionCreate creates a push subscription for the access token of the given
+// auth, replacing any existing subscription of that token. The params in the form
+// should have already been validated by the time they reach this function.
+func (p *Processor) SubscriptionCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.PushSubscriptionCreateRequest) (*apimodel.PushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, authed)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// There's only one subscription per
+	// token, so remove any existing one.
+	if err := p.state.DB.DeleteWebPushSubscriptionByTokenID(ctx, token.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:        id.NewULID(),
+		AccountID: authed.Account.ID,
+		TokenID:   token.ID,
+		Endpoint:  form.Subscription.Endpoint,
+		P256dh:    form.Subscription.Keys.P256dh,
+		Auth:      form.Subscription.Keys.Auth,
+		Policy:    gtsmodel.WebPushPolicyAll,
+	}
+	applyAlerts(subscription, &form.Data.Alerts)
+
+	if form.Data.Policy != nil {
+		subscription.Policy = gtsmodel.WebPushPolicy(*form.Data.Policy)
+	}
+
+	if err := p.state.DB.PutWebPushSubscription(ctx, subscription); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
+
+// SubscriptionUpdate updates the alerts and policy of the push subscription created
+// by the access token of the given auth. Alerts that are not set are left unchanged.
+// The params in the form should have already been validated by the time they reach
+// this function.
+func (p *Processor) SubscriptionUpdate(ctx context.Context, authed *oauth.Auth, form *apimodel.PushSubscriptionUpdateRequest) (*apimodel.PushSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getSubscription(ctx, authed)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := applyAlerts(subscription, &form.Data.Alerts)
+
+	if form.Data.Policy != nil {
+		subscription.Policy = gtsmodel.WebPushPolicy(*form.Data.Policy)
+		columns = append(columns, "policy")
+	}
+
+	if len(columns) != 0 {
+		if err := p.state.DB.UpdateWebPushSubscription(ctx, subscription, columns...); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
+
+// SubscriptionDelete removes the push subscription created by
+// the access token of the given auth, if there is one.
+func (p *Processor) SubscriptionDelete(ctx context.Context, authed *oauth.Auth) gtserror.WithCode {
+	token, errWithCode := p.getToken(ctx, authed)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteWebPushSubscriptionByTokenID(ctx, token.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+// getToken returns the database token corresponding to the access token of the given auth.
+func (p *Processor) getToken(ctx context.Context, authed *oauth.Auth) (*gtsmodel.Token, gtserror.WithCode) {
+	if authed.Token == nil || authed.Token.GetAccess() == "" {
+		err := errors.New("push subscriptions require an access token")
+		return nil, gtserror.NewErrorUnauthorized(err, err.Error())
+	}
+
+	token := &gtsmodel.Token{}
+	if err := p.state.DB.GetWhere(ctx, []db.Where{{Key: "access", Value: authed.Token.GetAccess()}}, token); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting token: %w", err))
+	}
+
+	return token, nil
+}
+
+// getSubscription returns the push subscription created by
+// the access token of the given auth, or a 404 if there isn't one.
+func (p *Processor) getSubscription(ctx context.Context, authed *oauth.Auth) (*gtsmodel.WebPushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, authed)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	subscription, err := p.state.DB.GetWebPushSubscriptionByTokenID(ctx, token.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := errors.New("no push subscription exists for this access token")
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return subscription, nil
+}
+
+func (p *Processor) apiSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) (*apimodel.PushSubscription, gtserror.WithCode) {
+	keyPair, err := p.state.DB.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting vapid key pair: %w", err))
+	}
+
+	apiSubscription, err := p.tc.WebPushSubscriptionToAPIPushSubscription(ctx, subscription, keyPair.PublicKey)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiSubscription, nil
+}
+
+// applyAlerts sets the alerts which are set in the form on the
+// subscription, defaulting unset alerts of a new subscription to
+// false, and returns the columns of the alerts that were set.
+func applyAlerts(subscription *gtsmodel.WebPushSubscription, form *apimodel.PushSubscriptionRequestAlerts) []string {
+	columns := []string{}
+
+	for _, alert := range []struct {
+		field  **bool
+		value  *bool
+		column string
+	}{
+		{&subscription.AlertFollow, form.Follow, "alert_follow"},
+		{&subscription.AlertFollowRequest, form.FollowRequest, "alert_follow_request"},
+		{&subscription.AlertFavourite, form.Favourite, "alert_favourite"},
+		{&subscription.AlertMention, form.Mention, "alert_mention"},
+		{&subscription.AlertReblog, form.Reblog, "alert_reblog"},
+		{&subscription.AlertPoll, form.Poll, "alert_poll"},
+		{&subscription.AlertStatus, form.Status, "alert_status"},
+		{&subscription.AlertUpdate, form.Update, "alert_update"},
+		{&subscription.AlertAdminReport, form.AdminReport, "alert_admin_report"},
+	} {
+		if alert.value != nil {
+			v := *alert.value
+			*alert.field = &v
+			columns = append(columns, alert.column)
+		} else if *alert.field == nil {
+			v := false
+			*alert.field = &v
+		}
+	}
+
+	return columns
+}
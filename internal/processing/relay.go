@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// federateToRelays delivers the given activity about the given local status
+// to each relay which has accepted this instance's subscription, and which
+// public posts from this instance should be published to. Only activities
+// about public statuses are delivered to relays.
+func (p *Processor) federateToRelays(ctx context.Context, status *gtsmodel.Status, activity vocab.Type) error {
+	if status.Visibility != gtsmodel.VisibilityPublic {
+		return nil
+	}
+
+	relays, err := p.state.DB.GetRelays(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil
+		}
+		return fmt.Errorf("federateToRelays: db error getting relays: %w", err)
+	}
+
+	inboxes := make([]*url.URL, 0, len(relays))
+	for _, relay := range relays {
+		if relay.State != gtsmodel.RelayStateAccepted || !*relay.Publish {
+			continue
+		}
+
+		inboxURI, err := url.Parse(relay.InboxURI)
+		if err != nil {
+			log.Errorf(ctx, "error parsing inbox %s of relay %s: %v", relay.InboxURI, relay.ID, err)
+			continue
+		}
+
+		inboxes = append(inboxes, inboxURI)
+	}
+
+	if len(inboxes) == 0 {
+		return nil
+	}
+
+	m, err := streams.Serialize(activity)
+	if err != nil {
+		return fmt.Errorf("federateToRelays: error serializing activity: %w", err)
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("federateToRelays: error marshaling activity: %w", err)
+	}
+
+	tp, err := p.federator.TransportController().NewTransportForUsername(ctx, status.Account.Username)
+	if err != nil {
+		return fmt.Errorf("federateToRelays: error creating transport for %s: %w", status.Account.Username, err)
+	}
+
+	return tp.BatchDeliver(ctx, b, inboxes)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -65,16 +66,18 @@ func (p *Processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		Hashtags: []apimodel.Tag{},
 	}
 
-	// currently the search will only ever return one result,
-	// so return nothing if the offset is greater than 0
-	if search.Offset > 0 {
-		return searchResult, nil
-	}
+	// searches by mention or by URI will only ever return one
+	// result, so only do them when fetching the first page
+	firstPage := search.Offset <= 0
 
 	foundAccounts := []*gtsmodel.Account{}
 	foundStatuses := []*gtsmodel.Status{}
+	foundTags := []*gtsmodel.Tag{}
 
-	var foundOne bool
+	var (
+		foundOne   bool
+		queryIsURI bool
+	)
 
 	/*
 		SEARCH BY MENTION
@@ -85,7 +88,7 @@ func (p *Processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		maybeNamestring = "@" + maybeNamestring
 	}
 
-	if username, domain, err := util.ExtractNamestringParts(maybeNamestring); err == nil {
+	if username, domain, err := util.ExtractNamestringParts(maybeNamestring); err == nil && firstPage {
 		l.Trace("search term is a mention, looking it up...")
 		blocked, err := p.state.DB.IsDomainBlocked(ctx, domain)
 		if err != nil {
@@ -103,12 +106,14 @@ func (p *Processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 				// return a proper error only if it wasn't just not retrievable
 				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error looking up account: %w", err))
 			}
-			return searchResult, nil
+			// not an exact match, but the query
+			// might still match some text below
+			l.Trace("no account found by searching by mention")
+		} else {
+			foundAccounts = append(foundAccounts, foundAccount)
+			foundOne = true
+			l.Trace("got an account by searching by mention")
 		}
-
-		foundAccounts = append(foundAccounts, foundAccount)
-		foundOne = true
-		l.Trace("got an account by searching by mention")
 	}
 
 	/*
@@ -118,6 +123,10 @@ func (p *Processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 	if !foundOne {
 		if uri, err := url.Parse(query); err == nil {
 			if uri.Scheme == "https" || uri.Scheme == "http" {
+				queryIsURI = true
+			}
+
+			if queryIsURI && firstPage {
 				l.Trace("search term is a uri, looking it up...")
 				blocked, err := p.state.DB.IsURIBlocked(ctx, uri)
 				if err != nil {
@@ -165,6 +174,76 @@ func (p *Processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		}
 	}
 
+	/*
+		SEARCH BY TEXT
+		if the query wasn't an exact match for anything, look for accounts, statuses and hashtags with text matching the query
+	*/
+	searchText := !foundOne && !queryIsURI
+
+	limit := search.Limit
+	if limit <= 0 || limit > 40 {
+		limit = 20
+	}
+
+	if searchText && (search.Type == "" || search.Type == "accounts") {
+		accounts, err := p.state.DB.SearchForAccounts(ctx,
+			authed.Account.ID,
+			query,
+			search.MaxID,
+			search.MinID,
+			limit,
+			search.Offset,
+			search.Following,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error searching accounts: %w", err))
+		}
+
+		if len(accounts) != 0 {
+			foundAccounts = append(foundAccounts, accounts...)
+			foundOne = true
+			l.Trace("got accounts by searching by text")
+		}
+	}
+
+	if searchText && (search.Type == "" || search.Type == "statuses") {
+		statuses, err := p.state.DB.SearchForStatuses(ctx,
+			authed.Account.ID,
+			query,
+			search.AccountID,
+			search.MaxID,
+			search.MinID,
+			limit,
+			search.Offset,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error searching statuses: %w", err))
+		}
+
+		if len(statuses) != 0 {
+			foundStatuses = append(foundStatuses, statuses...)
+			foundOne = true
+			l.Trace("got statuses by searching by text")
+		}
+	}
+
+	// hashtags are searched by name prefix rather than paged
+	// through by ID, so they're only included on the first page
+	if searchText && firstPage && (search.Type == "" || search.Type == "hashtags") {
+		if tagName, ok := text.NormalizeHashtag(query); ok {
+			tags, err := p.state.DB.GetTagsByNamePrefix(ctx, tagName, limit)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error searching tags: %w", err))
+			}
+
+			if len(tags) != 0 {
+				foundTags = append(foundTags, tags...)
+				foundOne = true
+				l.Trace("got tags by searching by name prefix")
+			}
+		}
+	}
+
 	if !foundOne {
 		// we got nothing, we can return early
 		l.Trace("found nothing, returning")
@@ -219,6 +298,16 @@ func (p *Processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		searchResult.Statuses = append(searchResult.Statuses, *apiStatus)
 	}
 
+	for _, foundTag := range foundTags {
+		apiTag, err := p.tc.TagToAPITag(ctx, foundTag)
+		if err != nil {
+			err = fmt.Errorf("SearchGet: error converting tag %s to api tag: %s", foundTag.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		searchResult.Hashtags = append(searchResult.Hashtags, apiTag)
+	}
+
 	return searchResult, nil
 }
 
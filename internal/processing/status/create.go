@@ -72,6 +72,8 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	processPoll(form, newStatus)
+
 	if err := processLanguage(ctx, form, account.Language, newStatus); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -177,7 +179,8 @@ func processMediaIDs(ctx context.Context, dbService db.DB, form *apimodel.Advanc
 			return gtserror.NewErrorBadRequest(err, err.Error())
 		}
 
-		if attachment.StatusID != "" || attachment.ScheduledStatusID != "" {
+		// attachments may already be attached to this status when it's being edited
+		if (attachment.StatusID != "" && attachment.StatusID != status.ID) || attachment.ScheduledStatusID != "" {
 			err = fmt.Errorf("ProcessMediaIDs: media with id %s is already attached to a status", mediaID)
 			return gtserror.NewErrorBadRequest(err, err.Error())
 		}
@@ -197,6 +200,40 @@ func processMediaIDs(ctx context.Context, dbService db.DB, form *apimodel.Advanc
 	return nil
 }
 
+func processPoll(form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) {
+	if form.Poll == nil {
+		return
+	}
+
+	options := make([]string, 0, len(form.Poll.Options))
+	for _, option := range form.Poll.Options {
+		options = append(options, text.SanitizePlaintext(option))
+	}
+
+	now := time.Now()
+	multiple := form.Poll.Multiple
+	hideCounts := form.Poll.HideTotals
+	voters := 0
+
+	status.Poll = &gtsmodel.Poll{
+		ID:         id.NewULID(),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Multiple:   &multiple,
+		HideCounts: &hideCounts,
+		Options:    options,
+		Votes:      make([]int, len(options)),
+		Voters:     &voters,
+		StatusID:   status.ID,
+		Status:     status,
+		ExpiresAt:  now.Add(time.Duration(form.Poll.ExpiresIn) * time.Second),
+	}
+	status.PollID = status.Poll.ID
+
+	// statuses with polls are federated as questions
+	status.ActivityStreamsType = ap.ActivityQuestion
+}
+
 func processVisibility(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultVis gtsmodel.Visibility, status *gtsmodel.Status) error {
 	// by default all flags are set to true
 	federated := true
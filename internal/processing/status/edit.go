@@ -0,0 +1,246 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"golang.org/x/exp/slices"
+)
+
+// Edit processes the given form to edit the status with the given ID, storing the previous
+// version of the status in its edit history, and returning the api model representation
+// of the edited status if it's OK.
+func (p *Processor) Edit(ctx context.Context, account *gtsmodel.Account, targetStatusID string, form *apimodel.StatusEditRequest) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getOwnStatus(ctx, account, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if targetStatus.BoostOfID != "" {
+		err := errors.New("boosts cannot be edited")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// Keep the current version of the status
+	// so it can be stored in the edit history.
+	edit := &gtsmodel.StatusEdit{
+		ID:             id.NewULID(),
+		CreatedAt:      targetStatus.EditedAt,
+		Content:        targetStatus.Content,
+		ContentWarning: targetStatus.ContentWarning,
+		Text:           targetStatus.Text,
+		Language:       targetStatus.Language,
+		Sensitive:      targetStatus.Sensitive,
+		AttachmentIDs:  targetStatus.AttachmentIDs,
+		StatusID:       targetStatus.ID,
+	}
+	if edit.CreatedAt.IsZero() {
+		edit.CreatedAt = targetStatus.CreatedAt
+	}
+	if targetStatus.Poll != nil {
+		edit.PollOptions = targetStatus.Poll.Options
+	}
+	oldMentionIDs := targetStatus.MentionIDs
+
+	// Reuse the status creation logic for the edited fields.
+	createForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      form.Status,
+			MediaIDs:    form.MediaIDs,
+			Poll:        form.Poll,
+			Sensitive:   form.Sensitive,
+			SpoilerText: form.SpoilerText,
+			Language:    form.Language,
+			ContentType: form.ContentType,
+		},
+	}
+
+	sensitive := form.Sensitive
+	targetStatus.ContentWarning = text.SanitizePlaintext(form.SpoilerText)
+	targetStatus.Sensitive = &sensitive
+	targetStatus.Text = form.Status
+	targetStatus.Attachments = nil
+	targetStatus.AttachmentIDs = nil
+
+	if errWithCode := processMediaIDs(ctx, p.state.DB, createForm, account.ID, targetStatus); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := processLanguage(ctx, createForm, account.Language, targetStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := processContent(ctx, p.state.DB, p.formatter, p.parseMention, createForm, account.ID, targetStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.processEditPoll(ctx, createForm, targetStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	targetStatus.EditedAt = time.Now()
+
+	if err := p.state.DB.PutStatusEdit(ctx, edit); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting status edit: %w", err))
+	}
+
+	if err := p.state.DB.UpdateStatus(ctx, targetStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error updating status: %w", err))
+	}
+
+	// The content was formatted again, creating new mentions,
+	// so the mentions of the previous version can be removed.
+	for _, mentionID := range oldMentionIDs {
+		if err := p.state.DB.DeleteByID(ctx, mentionID, &gtsmodel.Mention{}); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error deleting old mention %s: %w", mentionID, err))
+		}
+	}
+
+	// send it back to the processor for async processing
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       targetStatus,
+		OriginAccount:  account,
+	})
+
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, targetStatus, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %s", targetStatus.ID, err))
+	}
+
+	return apiStatus, nil
+}
+
+// HistoryGet returns the edit history of the given status, from oldest to
+// newest version, taking account of privacy settings and blocks etc.
+func (p *Processor) HistoryGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) ([]*apimodel.StatusEdit, gtserror.WithCode) {
+	targetStatus, err := p.state.DB.GetStatusByID(ctx, targetStatusID)
+	if err != nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error fetching status %s: %s", targetStatusID, err))
+	}
+
+	visible, err := p.filter.StatusVisible(ctx, targetStatus, requestingAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error seeing if status %s is visible: %s", targetStatus.ID, err))
+	}
+	if !visible {
+		return nil, gtserror.NewErrorNotFound(errors.New("status is not visible"))
+	}
+
+	edits, err := p.state.DB.GetStatusEditsByStatusID(ctx, targetStatus.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting edits of status %s: %w", targetStatus.ID, err))
+	}
+
+	apiEdits, err := p.tc.StatusToAPIEdits(ctx, targetStatus, edits)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting edits of status %s to frontend representation: %w", targetStatus.ID, err))
+	}
+
+	return apiEdits, nil
+}
+
+// SourceGet returns the plain-text source of the given status, for use when editing it.
+func (p *Processor) SourceGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.StatusSource, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getOwnStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return &apimodel.StatusSource{
+		ID:          targetStatus.ID,
+		Text:        targetStatus.Text,
+		SpoilerText: targetStatus.ContentWarning,
+	}, nil
+}
+
+// getOwnStatus fetches the status with the given ID, making sure it belongs to the requesting account.
+func (p *Processor) getOwnStatus(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*gtsmodel.Status, gtserror.WithCode) {
+	targetStatus, err := p.state.DB.GetStatusByID(ctx, targetStatusID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("status %s not found", targetStatusID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching status %s: %w", targetStatusID, err))
+	}
+
+	if targetStatus.AccountID != requestingAccount.ID {
+		return nil, gtserror.NewErrorForbidden(errors.New("status doesn't belong to requesting account"))
+	}
+
+	return targetStatus, nil
+}
+
+// processEditPoll updates the poll of the given status to match the given form.
+// If the poll options are unchanged, the existing poll is kept along with its
+// votes, otherwise the existing poll is removed and replaced with a new one.
+func (p *Processor) processEditPoll(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) error {
+	if oldPoll := status.Poll; oldPoll != nil {
+		if form.Poll != nil && pollOptionsUnchanged(oldPoll, form.Poll) {
+			// nothing changed, keep the poll
+			return nil
+		}
+
+		if err := p.state.DB.DeletePollByID(ctx, oldPoll.ID); err != nil {
+			return fmt.Errorf("error deleting old poll %s: %w", oldPoll.ID, err)
+		}
+
+		status.Poll = nil
+		status.PollID = ""
+		status.ActivityStreamsType = ap.ObjectNote
+	}
+
+	if form.Poll == nil {
+		return nil
+	}
+
+	processPoll(form, status)
+	if err := p.state.DB.PutPoll(ctx, status.Poll); err != nil {
+		return fmt.Errorf("error putting new poll: %w", err)
+	}
+
+	return nil
+}
+
+// pollOptionsUnchanged returns whether the given poll request
+// has the same options, and multiple choice setting, as the poll.
+func pollOptionsUnchanged(poll *gtsmodel.Poll, form *apimodel.PollRequest) bool {
+	if (poll.Multiple != nil && *poll.Multiple) != form.Multiple {
+		return false
+	}
+
+	options := make([]string, 0, len(form.Options))
+	for _, option := range form.Options {
+		options = append(options, text.SanitizePlaintext(option))
+	}
+
+	return slices.Equal(poll.Options, options)
+}
@@ -83,11 +83,8 @@ func (p *Processor) ContextGet(ctx context.Context, requestingAccount *gtsmodel.
 	}
 
 	for _, status := range parents {
-		if v, err := p.filter.StatusVisible(ctx, status, requestingAccount); err == nil && v {
-			apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, requestingAccount)
-			if err == nil {
-				context.Ancestors = append(context.Ancestors, *apiStatus)
-			}
+		if apiStatus := p.contextStatus(ctx, status, requestingAccount); apiStatus != nil {
+			context.Ancestors = append(context.Ancestors, *apiStatus)
 		}
 	}
 
@@ -101,13 +98,36 @@ func (p *Processor) ContextGet(ctx context.Context, requestingAccount *gtsmodel.
 	}
 
 	for _, status := range children {
-		if v, err := p.filter.StatusVisible(ctx, status, requestingAccount); err == nil && v {
-			apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, requestingAccount)
-			if err == nil {
-				context.Descendants = append(context.Descendants, *apiStatus)
-			}
+		if apiStatus := p.contextStatus(ctx, status, requestingAccount); apiStatus != nil {
+			context.Descendants = append(context.Descendants, *apiStatus)
 		}
 	}
 
 	return context, nil
 }
+
+// contextStatus returns the api representation of the given status, for
+// inclusion in a thread context served to the requesting account. It
+// returns nil if the status isn't visible to the requesting account,
+// or if it's hidden by one of their filters.
+func (p *Processor) contextStatus(ctx context.Context, status *gtsmodel.Status, requestingAccount *gtsmodel.Account) *apimodel.Status {
+	if v, err := p.filter.StatusVisible(ctx, status, requestingAccount); err != nil || !v {
+		return nil
+	}
+
+	hide, results, err := p.filter.StatusFiltered(ctx, status, requestingAccount, gtsmodel.FilterContextThread)
+	if err != nil || hide {
+		return nil
+	}
+
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, requestingAccount)
+	if err != nil {
+		return nil
+	}
+
+	if apiStatus.Filtered, err = p.tc.FilterResultsToAPIFilterResults(ctx, results); err != nil {
+		return nil
+	}
+
+	return apiStatus
+}
@@ -0,0 +1,122 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// MuteCreate mutes the thread that the given status belongs to, for the requestingAccount.
+// Muting a thread suppresses notifications for all statuses in it (no-op if already muted).
+func (p *Processor) MuteCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getMuteTarget(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// thread mutes are stored against the root of the thread
+	root, err := p.state.DB.GetStatusThreadRoot(ctx, targetStatus)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting thread root of status %s: %s", targetStatus.ID, err))
+	}
+
+	muted, err := p.state.DB.IsStatusMutedBy(ctx, root, requestingAccount.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error checking existence of thread mute: %s", err))
+	}
+
+	if !muted {
+		gtsMute := &gtsmodel.StatusMute{
+			ID:              id.NewULID(),
+			AccountID:       requestingAccount.ID,
+			Account:         requestingAccount,
+			TargetAccountID: root.AccountID,
+			TargetAccount:   root.Account,
+			StatusID:        root.ID,
+			Status:          root,
+		}
+
+		if err := p.state.DB.Put(ctx, gtsMute); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting thread mute in database: %s", err))
+		}
+	}
+
+	// return the api representation of the target status
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, targetStatus, requestingAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %w", targetStatus.ID, err))
+	}
+
+	return apiStatus, nil
+}
+
+// MuteRemove unmutes the thread that the given status belongs to, for the requestingAccount (no-op if not muted).
+func (p *Processor) MuteRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getMuteTarget(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	root, err := p.state.DB.GetStatusThreadRoot(ctx, targetStatus)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting thread root of status %s: %s", targetStatus.ID, err))
+	}
+
+	// remove mutes of both the thread root and the status itself, in
+	// case the status was muted individually before it joined a thread
+	for _, statusID := range []string{root.ID, targetStatus.ID} {
+		if err := p.state.DB.DeleteWhere(ctx, []db.Where{{Key: "status_id", Value: statusID}, {Key: "account_id", Value: requestingAccount.ID}}, &[]*gtsmodel.StatusMute{}); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error removing thread mute from database: %s", err))
+		}
+	}
+
+	// return the api representation of the target status
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, targetStatus, requestingAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %w", targetStatus.ID, err))
+	}
+
+	return apiStatus, nil
+}
+
+func (p *Processor) getMuteTarget(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) (*gtsmodel.Status, gtserror.WithCode) {
+	targetStatus, err := p.state.DB.GetStatusByID(ctx, targetStatusID)
+	if err != nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error fetching status %s: %s", targetStatusID, err))
+	}
+	if targetStatus.Account == nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("no status owner for status %s", targetStatusID))
+	}
+	visible, err := p.filter.StatusVisible(ctx, targetStatus, requestingAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error seeing if status %s is visible: %s", targetStatus.ID, err))
+	}
+	if !visible {
+		return nil, gtserror.NewErrorNotFound(errors.New("status is not visible"))
+	}
+
+	return targetStatus, nil
+}
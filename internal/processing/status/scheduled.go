@@ -0,0 +1,259 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// scheduledMinDelay is how far in the future
+// a status must be scheduled to be published.
+const scheduledMinDelay = 5 * time.Minute
+
+// ScheduledCreate processes the given form to schedule a new status for publishing at
+// form.ScheduledAt, returning the api model representation of the scheduled status.
+func (p *Processor) ScheduledCreate(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledAt, errWithCode := parseScheduledAt(form.ScheduledAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Check the reply and media now using a scratch status, so
+	// the caller finds out about problems when scheduling rather
+	// than having the status silently fail to publish later on.
+	scratch := &gtsmodel.Status{}
+
+	if errWithCode := processReplyToID(ctx, p.state.DB, form, account.ID, scratch); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := processMediaIDs(ctx, p.state.DB, form, account.ID, scratch); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	sensitive := form.Sensitive
+	scheduledStatus := &gtsmodel.ScheduledStatus{
+		ID:            id.NewULID(),
+		ScheduledAt:   scheduledAt,
+		AccountID:     account.ID,
+		ApplicationID: application.ID,
+		Text:          form.Status,
+		ContentType:   string(form.ContentType),
+		MediaIDs:      scratch.AttachmentIDs,
+		InReplyToID:   form.InReplyToID,
+		SpoilerText:   form.SpoilerText,
+		Sensitive:     &sensitive,
+		Language:      form.Language,
+		Federated:     form.Federated,
+		Boostable:     form.Boostable,
+		Replyable:     form.Replyable,
+		Likeable:      form.Likeable,
+	}
+
+	if form.Visibility != "" {
+		scheduledStatus.Visibility = typeutils.APIVisToVis(form.Visibility)
+	}
+
+	if form.Poll != nil {
+		multiple := form.Poll.Multiple
+		hideTotals := form.Poll.HideTotals
+		scheduledStatus.PollOptions = form.Poll.Options
+		scheduledStatus.PollExpiresIn = form.Poll.ExpiresIn
+		scheduledStatus.PollMultiple = &multiple
+		scheduledStatus.PollHideTotals = &hideTotals
+	}
+
+	if err := p.state.DB.PutScheduledStatus(ctx, scheduledStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ScheduledCreate: db error putting scheduled status: %w", err))
+	}
+
+	// Mark attachments as belonging to the scheduled
+	// status, so they're not pruned as unattached and
+	// can't be used by any other status in the meantime.
+	for _, attachment := range scratch.Attachments {
+		attachment.ScheduledStatusID = scheduledStatus.ID
+		if err := p.state.DB.UpdateAttachment(ctx, attachment, "scheduled_status_id"); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("ScheduledCreate: db error updating attachment: %w", err))
+		}
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
+
+// ScheduledGet returns the scheduled status with the given id, if it belongs to the given account.
+func (p *Processor) ScheduledGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledStatus, errWithCode := p.getOwnScheduledStatus(ctx, account, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
+
+// ScheduledGetAll returns a page of the scheduled statuses of the given account.
+func (p *Processor) ScheduledGetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	scheduledStatuses, err := p.state.DB.GetScheduledStatuses(ctx, account.ID, maxID, sinceID, minID, limit)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return util.EmptyPageableResponse(), nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(scheduledStatuses)
+	items := make([]interface{}, 0, count)
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+	for i, s := range scheduledStatuses {
+		item, errWithCode := p.apiScheduledStatus(ctx, s)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if i == count-1 {
+			nextMaxIDValue = item.ID
+		}
+
+		if i == 0 {
+			prevMinIDValue = item.ID
+		}
+
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "/api/v1/scheduled_statuses",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
+// ScheduledUpdate reschedules the scheduled status with the given id, if it belongs to the given account.
+func (p *Processor) ScheduledUpdate(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.ScheduledStatusUpdateRequest) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledStatus, errWithCode := p.getOwnScheduledStatus(ctx, account, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	scheduledAt, errWithCode := parseScheduledAt(form.ScheduledAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	scheduledStatus.ScheduledAt = scheduledAt
+	if err := p.state.DB.UpdateScheduledStatus(ctx, scheduledStatus, "scheduled_at"); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("ScheduledUpdate: db error updating scheduled status: %w", err))
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
+
+// ScheduledDelete cancels the scheduled status with the given id, if it belongs to the given account.
+// Any media attached to the scheduled status is released, so that it can be used again.
+func (p *Processor) ScheduledDelete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	scheduledStatus, errWithCode := p.getOwnScheduledStatus(ctx, account, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.state.DB.DeleteScheduledStatusByID(ctx, scheduledStatus.ID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("ScheduledDelete: db error deleting scheduled status: %w", err))
+	}
+
+	if err := p.releaseScheduledMedia(ctx, scheduledStatus); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("ScheduledDelete: %w", err))
+	}
+
+	return nil
+}
+
+func (p *Processor) getOwnScheduledStatus(ctx context.Context, account *gtsmodel.Account, id string) (*gtsmodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledStatus, err := p.state.DB.GetScheduledStatusByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if scheduledStatus.AccountID != account.ID {
+		err = fmt.Errorf("scheduled status with id %s does not belong to account %s", scheduledStatus.ID, account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return scheduledStatus, nil
+}
+
+func (p *Processor) apiScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	apiScheduledStatus, err := p.tc.ScheduledStatusToAPIScheduledStatus(ctx, scheduledStatus)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting scheduled status %s to frontend representation: %w", scheduledStatus.ID, err))
+	}
+
+	return apiScheduledStatus, nil
+}
+
+// releaseScheduledMedia unsets the scheduled status
+// id of all media attached to the given scheduled status.
+func (p *Processor) releaseScheduledMedia(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error {
+	for _, mediaID := range scheduledStatus.MediaIDs {
+		attachment, err := p.state.DB.GetAttachmentByID(ctx, mediaID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// Already gone, nothing to release.
+				continue
+			}
+			return fmt.Errorf("db error getting attachment %s: %w", mediaID, err)
+		}
+
+		attachment.ScheduledStatusID = ""
+		if err := p.state.DB.UpdateAttachment(ctx, attachment, "scheduled_status_id"); err != nil {
+			return fmt.Errorf("db error updating attachment %s: %w", mediaID, err)
+		}
+	}
+
+	return nil
+}
+
+func parseScheduledAt(in string) (time.Time, gtserror.WithCode) {
+	scheduledAt, err := time.Parse(time.RFC3339, in)
+	if err != nil {
+		err = fmt.Errorf("scheduled_at %s could not be parsed as an ISO 8601 datetime", in)
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if scheduledAt.Before(time.Now().Add(scheduledMinDelay)) {
+		err = fmt.Errorf("scheduled_at must be at least %s in the future", scheduledMinDelay)
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return scheduledAt, nil
+}
@@ -0,0 +1,135 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type StatusScheduledTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusScheduledTestSuite) newScheduledForm(scheduledAt time.Time, mediaIDs ...string) *apimodel.AdvancedStatusCreateForm {
+	return &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this is the big announcement!",
+			MediaIDs:    mediaIDs,
+			SpoilerText: "announcement",
+			Visibility:  apimodel.VisibilityUnlisted,
+			ScheduledAt: scheduledAt.Format(time.RFC3339),
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+}
+
+func (suite *StatusScheduledTestSuite) TestScheduledCreateAndPublish() {
+	ctx := context.Background()
+
+	account := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	attachment := suite.testAttachments["local_account_1_unattached_1"]
+	scheduledAt := time.Now().Add(time.Hour)
+
+	apiScheduledStatus, errWithCode := suite.status.ScheduledCreate(ctx, account, application, suite.newScheduledForm(scheduledAt, attachment.ID))
+	suite.NoError(errWithCode)
+	suite.NotEmpty(apiScheduledStatus.ID)
+	suite.Equal("this is the big announcement!", apiScheduledStatus.Params.Text)
+	suite.Equal(apimodel.VisibilityUnlisted, apiScheduledStatus.Params.Visibility)
+	suite.Len(apiScheduledStatus.MediaAttachments, 1)
+
+	// The attachment should now belong to the scheduled status.
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.Equal(apiScheduledStatus.ID, dbAttachment.ScheduledStatusID)
+
+	// Nothing should be published before the scheduled time.
+	err = suite.status.PublishScheduled(ctx, time.Now())
+	suite.NoError(err)
+	_, err = suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.NoError(err)
+
+	// Once the scheduled time has passed, the status
+	// should be published and the scheduled status removed.
+	err = suite.status.PublishScheduled(ctx, scheduledAt.Add(time.Minute))
+	suite.NoError(err)
+	_, err = suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	dbAttachment, err = suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+	suite.NotEmpty(dbAttachment.StatusID)
+
+	status, err := suite.db.GetStatusByID(ctx, dbAttachment.StatusID)
+	suite.NoError(err)
+	suite.Equal(account.ID, status.AccountID)
+	suite.Equal(application.ID, status.CreatedWithApplicationID)
+	suite.Equal("this is the big announcement!", status.Text)
+	suite.Equal("announcement", status.ContentWarning)
+	suite.Equal([]string{attachment.ID}, status.AttachmentIDs)
+}
+
+func (suite *StatusScheduledTestSuite) TestScheduledCreateTooSoon() {
+	ctx := context.Background()
+
+	account := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+
+	apiScheduledStatus, errWithCode := suite.status.ScheduledCreate(ctx, account, application, suite.newScheduledForm(time.Now().Add(time.Minute)))
+	suite.Nil(apiScheduledStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *StatusScheduledTestSuite) TestScheduledDeleteReleasesMedia() {
+	ctx := context.Background()
+
+	account := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	attachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	apiScheduledStatus, errWithCode := suite.status.ScheduledCreate(ctx, account, application, suite.newScheduledForm(time.Now().Add(time.Hour), attachment.ID))
+	suite.NoError(errWithCode)
+
+	// Another account shouldn't be able to see or delete it.
+	errWithCode = suite.status.ScheduledDelete(ctx, suite.testAccounts["local_account_2"], apiScheduledStatus.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	errWithCode = suite.status.ScheduledDelete(ctx, account, apiScheduledStatus.ID)
+	suite.NoError(errWithCode)
+
+	_, err := suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, attachment.ID)
+	suite.NoError(err)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+	suite.Empty(dbAttachment.StatusID)
+}
+
+func TestStatusScheduledTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusScheduledTestSuite))
+}
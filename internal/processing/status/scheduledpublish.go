@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"codeberg.org/gruf/go-runners"
+	"codeberg.org/gruf/go-sched"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// publishInterval is how often the job
+// for publishing scheduled statuses should run.
+const publishInterval = time.Minute
+
+// PublishScheduled publishes all scheduled statuses that are due at or before
+// the given time, by creating each of them as though its account had just posted
+// it, and then removing the scheduled status.
+func (p *Processor) PublishScheduled(ctx context.Context, now time.Time) error {
+	scheduledStatuses, err := p.state.DB.GetScheduledStatusesDueBy(ctx, now)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil
+		}
+		return fmt.Errorf("PublishScheduled: db error getting due scheduled statuses: %w", err)
+	}
+
+	for _, scheduledStatus := range scheduledStatuses {
+		if err := p.publishScheduled(ctx, scheduledStatus); err != nil {
+			log.Errorf(ctx, "error publishing scheduled status %s: %v", scheduledStatus.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Processor) publishScheduled(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error {
+	account, err := p.state.DB.GetAccountByID(ctx, scheduledStatus.AccountID)
+	if err != nil {
+		return fmt.Errorf("db error getting account %s: %w", scheduledStatus.AccountID, err)
+	}
+
+	application := &gtsmodel.Application{}
+	if err := p.state.DB.GetByID(ctx, scheduledStatus.ApplicationID, application); err != nil {
+		return fmt.Errorf("db error getting application %s: %w", scheduledStatus.ApplicationID, err)
+	}
+
+	// Remove the scheduled status before creating the status,
+	// so that it's only ever published once, even if the
+	// create fails part way through for whatever reason.
+	if err := p.state.DB.DeleteScheduledStatusByID(ctx, scheduledStatus.ID); err != nil {
+		return fmt.Errorf("db error deleting scheduled status: %w", err)
+	}
+
+	// Release the media so that it can
+	// be attached to the status proper.
+	if err := p.releaseScheduledMedia(ctx, scheduledStatus); err != nil {
+		return err
+	}
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      scheduledStatus.Text,
+			MediaIDs:    scheduledStatus.MediaIDs,
+			InReplyToID: scheduledStatus.InReplyToID,
+			Sensitive:   scheduledStatus.Sensitive != nil && *scheduledStatus.Sensitive,
+			SpoilerText: scheduledStatus.SpoilerText,
+			Language:    scheduledStatus.Language,
+			ContentType: apimodel.StatusContentType(scheduledStatus.ContentType),
+		},
+		AdvancedVisibilityFlagsForm: apimodel.AdvancedVisibilityFlagsForm{
+			Federated: scheduledStatus.Federated,
+			Boostable: scheduledStatus.Boostable,
+			Replyable: scheduledStatus.Replyable,
+			Likeable:  scheduledStatus.Likeable,
+		},
+	}
+
+	if scheduledStatus.Visibility != "" {
+		form.Visibility = p.tc.VisToAPIVis(ctx, scheduledStatus.Visibility)
+	}
+
+	if len(scheduledStatus.PollOptions) != 0 {
+		form.Poll = &apimodel.PollRequest{
+			Options:    scheduledStatus.PollOptions,
+			ExpiresIn:  scheduledStatus.PollExpiresIn,
+			Multiple:   scheduledStatus.PollMultiple != nil && *scheduledStatus.PollMultiple,
+			HideTotals: scheduledStatus.PollHideTotals != nil && *scheduledStatus.PollHideTotals,
+		}
+	}
+
+	if _, errWithCode := p.Create(ctx, account, application, form); errWithCode != nil {
+		return fmt.Errorf("error creating status: %w", errWithCode)
+	}
+
+	return nil
+}
+
+func schedulePublishScheduled(p *Processor) {
+	// Get ctx associated with scheduler run state.
+	done := p.state.Workers.Scheduler.Done()
+	doneCtx := runners.CancelCtx(done)
+
+	// Schedule the PublishScheduled task to execute every minute.
+	p.state.Workers.Scheduler.Schedule(sched.NewJob(func(now time.Time) {
+		if err := p.PublishScheduled(doneCtx, now); err != nil {
+			log.Errorf(nil, "error publishing scheduled statuses: %v", err)
+		}
+	}).Every(publishInterval))
+}
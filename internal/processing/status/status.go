@@ -35,11 +35,15 @@ type Processor struct {
 
 // New returns a new status processor.
 func New(state *state.State, tc typeutils.TypeConverter, parseMention gtsmodel.ParseMentionFunc) Processor {
-	return Processor{
+	p := Processor{
 		state:        state,
 		tc:           tc,
 		filter:       visibility.NewFilter(state.DB),
 		formatter:    text.NewFormatter(state.DB),
 		parseMention: parseMention,
 	}
+
+	schedulePublishScheduled(&p)
+
+	return p
 }
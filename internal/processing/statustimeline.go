@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -74,12 +75,21 @@ func StatusFilterFunction(database db.DB, filter visibility.Filter) timeline.Fil
 			log.Warnf(ctx, "error checking hometimelineability of status %s for account %s: %s", status.ID, timelineAccountID, err)
 		}
 
-		return timelineable, nil // we don't return the error here because we want to just skip this item if something goes wrong
+		if !timelineable {
+			return false, nil // we don't return the error here because we want to just skip this item if something goes wrong
+		}
+
+		hide, _, err := filter.StatusFiltered(ctx, status, requestingAccount, gtsmodel.FilterContextHome)
+		if err != nil {
+			log.Warnf(ctx, "error checking filters of account %s against status %s: %s", timelineAccountID, status.ID, err)
+		}
+
+		return !hide, nil
 	}
 }
 
 // StatusPrepareFunction returns a function that satisfies the PrepareFunction interface in internal/timeline.
-func StatusPrepareFunction(database db.DB, tc typeutils.TypeConverter) timeline.PrepareFunction {
+func StatusPrepareFunction(database db.DB, tc typeutils.TypeConverter, filter visibility.Filter) timeline.PrepareFunction {
 	return func(ctx context.Context, timelineAccountID string, itemID string) (timeline.Preparable, error) {
 		status, err := database.GetStatusByID(ctx, itemID)
 		if err != nil {
@@ -91,8 +101,55 @@ func StatusPrepareFunction(database db.DB, tc typeutils.TypeConverter) timeline.
 			return nil, fmt.Errorf("statusPrepareFunction: error getting account with id %s", timelineAccountID)
 		}
 
-		return tc.StatusToAPIStatus(ctx, status, requestingAccount)
+		// Hide filters are applied when the status is indexed, but the
+		// account's filters may have changed since, so check them again.
+		apiStatus, hide, err := filteredAPIStatus(ctx, tc, filter, status, requestingAccount, gtsmodel.FilterContextHome)
+		if err != nil {
+			return nil, fmt.Errorf("statusPrepareFunction: %w", err)
+		}
+
+		if hide {
+			// Treat hidden statuses as missing,
+			// so they're skipped when preparing.
+			return nil, db.ErrNoEntries
+		}
+
+		return apiStatus, nil
+	}
+}
+
+// filteredAPIStatus converts the given status to its API representation for the
+// requesting account, annotated with the results of any of the account's filters
+// that the status matches in the given filter context. If the status matches a
+// filter with the "hide" action, hide will be true and the status will be nil.
+func filteredAPIStatus(
+	ctx context.Context,
+	tc typeutils.TypeConverter,
+	filter visibility.Filter,
+	status *gtsmodel.Status,
+	requestingAccount *gtsmodel.Account,
+	filterContext gtsmodel.FilterContext,
+) (apiStatus *apimodel.Status, hide bool, err error) {
+	hide, results, err := filter.StatusFiltered(ctx, status, requestingAccount, filterContext)
+	if err != nil {
+		return nil, false, fmt.Errorf("error checking filters against status %s: %w", status.ID, err)
+	}
+
+	if hide {
+		return nil, true, nil
+	}
+
+	apiStatus, err = tc.StatusToAPIStatus(ctx, status, requestingAccount)
+	if err != nil {
+		return nil, false, fmt.Errorf("error converting status %s to api: %w", status.ID, err)
+	}
+
+	apiStatus.Filtered, err = tc.FilterResultsToAPIFilterResults(ctx, results)
+	if err != nil {
+		return nil, false, fmt.Errorf("error converting filter results for status %s to api: %w", status.ID, err)
 	}
+
+	return apiStatus, false, nil
 }
 
 // StatusSkipInsertFunction returns a function that satisifes the SkipInsertFunction interface in internal/timeline.
@@ -216,6 +273,67 @@ func (p *Processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, m
 	})
 }
 
+func (p *Processor) TagTimelineGet(ctx context.Context, authed *oauth.Auth, tagName string, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
+	normalized, ok := text.NormalizeHashtag(tagName)
+	if !ok {
+		err := fmt.Errorf("%q is not a valid hashtag", tagName)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.state.DB.GetTagByName(ctx, normalized)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			// nobody has used this tag yet
+			return util.EmptyPageableResponse(), nil
+		}
+		// there's an actual error
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	statuses, err := p.state.DB.GetTagTimeline(ctx, tag.ID, maxID, sinceID, minID, limit, local)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			// there are just no entries left
+			return util.EmptyPageableResponse(), nil
+		}
+		// there's an actual error
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	filtered, err := p.filterPublicStatuses(ctx, authed, statuses)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(filtered)
+
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := []interface{}{}
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+	for i, item := range filtered {
+		if i == count-1 {
+			nextMaxIDValue = item.GetID()
+		}
+
+		if i == 0 {
+			prevMinIDValue = item.GetID()
+		}
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/timelines/tag/" + normalized,
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
 func (p *Processor) FavedTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
 	statuses, nextMaxID, prevMinID, err := p.state.DB.GetFavedTimeline(ctx, authed.Account.ID, maxID, minID, limit)
 	if err != nil {
@@ -271,11 +389,14 @@ func (p *Processor) filterPublicStatuses(ctx context.Context, authed *oauth.Auth
 			continue
 		}
 
-		apiStatus, err := p.tc.StatusToAPIStatus(ctx, s, authed.Account)
+		apiStatus, hide, err := filteredAPIStatus(ctx, p.tc, p.filter, s, authed.Account, gtsmodel.FilterContextPublic)
 		if err != nil {
 			log.Debugf(ctx, "skipping status %s because it couldn't be converted to its api representation: %s", s.ID, err)
 			continue
 		}
+		if hide {
+			continue
+		}
 
 		apiStatuses = append(apiStatuses, apiStatus)
 	}
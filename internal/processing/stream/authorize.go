@@ -24,6 +24,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
 // Authorize returns an oauth2 token info in response to an access token query from the streaming API
@@ -40,6 +41,11 @@ func (p *Processor) Authorize(ctx context.Context, accessToken string) (*gtsmode
 		return nil, gtserror.NewErrorUnauthorized(err)
 	}
 
+	if !oauth.ScopesPermit(ti.GetScope(), oauth.ScopeReadStatuses) {
+		err := fmt.Errorf("token with scope %q does not have required scope %q", ti.GetScope(), oauth.ScopeReadStatuses)
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
 	user, err := p.state.DB.GetUserByID(ctx, uid)
 	if err != nil {
 		if err == db.ErrNoEntries {
@@ -19,6 +19,7 @@ package stream
 
 import (
 	"errors"
+	"strings"
 	"sync"
 
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -62,9 +63,9 @@ func (p *Processor) toAccount(payload string, event string, timelines []string,
 		}
 
 		for _, t := range timelines {
-			if _, found := s.Timelines[t]; found {
+			if streamTimeline, found := subscribedTimeline(s, t); found {
 				s.Messages <- &stream.Message{
-					Stream:  []string{string(t)},
+					Stream:  messageStream(streamTimeline),
 					Event:   string(event),
 					Payload: payload,
 				}
@@ -77,3 +78,73 @@ func (p *Processor) toAccount(payload string, event string, timelines []string,
 
 	return nil
 }
+
+// SubscribedAccountIDs returns the IDs of all accounts
+// with an open stream subscribed to the given timeline.
+func (p *Processor) SubscribedAccountIDs(timeline string) []string {
+	accountIDs := []string{}
+	p.streamMap.Range(func(k any, v any) bool {
+		streamsForAccount, ok := v.(*stream.StreamsForAccount)
+		if !ok {
+			return true
+		}
+
+		streamsForAccount.Lock()
+		defer streamsForAccount.Unlock()
+		for _, s := range streamsForAccount.Streams {
+			s.Lock()
+			_, subscribed := s.Timelines[timeline]
+			subscribed = subscribed && s.Connected
+			s.Unlock()
+
+			if subscribed {
+				accountIDs = append(accountIDs, k.(string))
+				break
+			}
+		}
+
+		return true
+	})
+
+	return accountIDs
+}
+
+// subscribedTimeline returns the timeline of the given stream that matches
+// the given timeline, if the stream is subscribed to it. The bare list
+// timeline matches a subscription to any list, eg., "list:<list id>", and
+// likewise the bare hashtag timelines match a subscription to any hashtag.
+func subscribedTimeline(s *stream.Stream, timeline string) (string, bool) {
+	if _, found := s.Timelines[timeline]; found {
+		return timeline, true
+	}
+
+	switch timeline {
+	case stream.TimelineList, stream.TimelineHashtag, stream.TimelineHashtagLocal:
+		for t := range s.Timelines {
+			if strings.HasPrefix(t, timeline+":") {
+				return t, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// messageStream returns the stream field for a message sent on the given
+// timeline. List and hashtag timelines are split into the timeline and the
+// list ID or tag name respectively, as per the Mastodon streaming API.
+func messageStream(timeline string) []string {
+	if listID, ok := strings.CutPrefix(timeline, stream.TimelineList+":"); ok {
+		return []string{stream.TimelineList, listID}
+	}
+
+	if tagName, ok := strings.CutPrefix(timeline, stream.TimelineHashtagLocal+":"); ok {
+		return []string{stream.TimelineHashtagLocal, tagName}
+	}
+
+	if tagName, ok := strings.CutPrefix(timeline, stream.TimelineHashtag+":"); ok {
+		return []string{stream.TimelineHashtag, tagName}
+	}
+
+	return []string{timeline}
+}
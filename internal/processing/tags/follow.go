@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// Follow makes the given account follow the tag with the given name,
+// creating the tag if it hasn't been seen on this instance before.
+// Public statuses using the tag will then be put in the account's
+// home timeline. Following a tag twice is a no-op.
+func (p *Processor) Follow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	normalized, ok := text.NormalizeHashtag(name)
+	if !ok {
+		err := fmt.Errorf("%q is not a valid hashtag", name)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.state.DB.TagStringToTag(ctx, normalized, account.ID)
+	if err != nil {
+		err = fmt.Errorf("Follow: error getting tag %s: %w", normalized, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if err := p.state.DB.Put(ctx, tag); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		err = fmt.Errorf("Follow: error putting tag %s: %w", normalized, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	following, err := p.state.DB.IsFollowingTag(ctx, account.ID, tag.ID)
+	if err != nil {
+		err = fmt.Errorf("Follow: error checking existing follow of tag %s: %w", tag.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !following {
+		if err := p.state.DB.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+			ID:        id.NewULID(),
+			AccountID: account.ID,
+			Account:   account,
+			TagID:     tag.ID,
+			Tag:       tag,
+		}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("Follow: error putting followed tag %s: %w", tag.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.apiTag(ctx, account, tag)
+}
+
+// Unfollow makes the given account unfollow the tag with the given
+// name. Unfollowing a tag that isn't followed is a no-op.
+func (p *Processor) Unfollow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteFollowedTag(ctx, account.ID, tag.ID); err != nil {
+		err = fmt.Errorf("Unfollow: error deleting followed tag %s: %w", tag.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiTag(ctx, account, tag)
+}
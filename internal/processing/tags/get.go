@@ -0,0 +1,138 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Get returns the tag with the given name. If account is set,
+// the returned tag will show whether the account follows it.
+func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiTag(ctx, account, tag)
+}
+
+// FollowedTagsGet returns tags followed by the given account, most
+// recently followed first. The additional parameters can be used for
+// paging, and refer to the IDs of the follows rather than of the tags.
+func (p *Processor) FollowedTagsGet(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	followedTags, err := p.state.DB.GetFollowedTagsForAccountID(ctx, account.ID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("FollowedTagsGet: error getting followed tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(followedTags)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	var (
+		items = make([]interface{}, 0, count)
+
+		// Set next + prev values before API converting,
+		// so caller can still page properly.
+		nextMaxIDValue = followedTags[count-1].ID
+		prevMinIDValue = followedTags[0].ID
+	)
+
+	following := true
+	for _, followedTag := range followedTags {
+		apiTag, err := p.tc.TagToAPITag(ctx, followedTag.Tag)
+		if err != nil {
+			log.Errorf(ctx, "error converting tag %s to api: %v", followedTag.TagID, err)
+			continue
+		}
+		apiTag.Following = &following
+
+		items = append(items, apiTag)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/followed_tags",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
+// getTag is a shortcut to get one tag from the database by name.
+// Will return appropriate errors so caller doesn't need to bother.
+func (p *Processor) getTag(ctx context.Context, name string) (*gtsmodel.Tag, gtserror.WithCode) {
+	normalized, ok := text.NormalizeHashtag(name)
+	if !ok {
+		err := fmt.Errorf("%q is not a valid hashtag", name)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.state.DB.GetTagByName(ctx, normalized)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Tag doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return tag, nil
+}
+
+// apiTag converts the given tag to its api representation,
+// showing whether the given account follows it, if set.
+func (p *Processor) apiTag(ctx context.Context, account *gtsmodel.Account, tag *gtsmodel.Tag) (*apimodel.Tag, gtserror.WithCode) {
+	apiTag, err := p.tc.TagToAPITag(ctx, tag)
+	if err != nil {
+		err = fmt.Errorf("error converting tag %s to api: %w", tag.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if account != nil {
+		following, err := p.state.DB.IsFollowingTag(ctx, account.ID, tag.ID)
+		if err != nil {
+			err = fmt.Errorf("error checking if account %s follows tag %s: %w", account.ID, tag.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiTag.Following = &following
+	}
+
+	return &apiTag, nil
+}
@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// WebTimelineGet returns public statuses created on this instance which
+// use the tag with the given name, suitable for serving on the tag's web page.
+func (p *Processor) WebTimelineGet(ctx context.Context, name string, maxID string) (*apimodel.PageableResponse, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if tag.Listable != nil && !*tag.Listable {
+		err := fmt.Errorf("tag %s is not listable", tag.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	statuses, err := p.state.DB.GetTagTimeline(ctx, tag.ID, maxID, "", "", 10, true)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("WebTimelineGet: error getting statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(statuses)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	var (
+		items = make([]interface{}, 0, count)
+
+		// Set next + prev values before filtering and API
+		// converting, so caller can still page properly.
+		nextMaxIDValue = statuses[count-1].ID
+		prevMinIDValue = statuses[0].ID
+	)
+
+	for _, s := range statuses {
+		if s.Federated != nil && !*s.Federated {
+			// Local-only statuses
+			// don't go on the web.
+			continue
+		}
+
+		timelineable, err := p.filter.StatusPublictimelineable(ctx, s, nil)
+		if err != nil {
+			log.Debugf(ctx, "skipping status %s because of an error checking status visibility: %s", s.ID, err)
+			continue
+		}
+
+		if !timelineable {
+			continue
+		}
+
+		item, err := p.tc.StatusToAPIStatus(ctx, s, nil)
+		if err != nil {
+			log.Debugf(ctx, "skipping status %s because it couldn't be converted to its api representation: %s", s.ID, err)
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:            items,
+		Path:             "/tags/" + tag.Name,
+		NextMaxIDValue:   nextMaxIDValue,
+		PrevMinIDValue:   prevMinIDValue,
+		ExtraQueryParams: []string{},
+	})
+}
@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"golang.org/x/exp/slices"
+)
+
+// AuthorizedAppsGet returns the applications which the given user has granted
+// access tokens to, along with when and from where they were last used.
+func (p *Processor) AuthorizedAppsGet(ctx context.Context, user *gtsmodel.User) ([]*apimodel.AuthorizedApplication, gtserror.WithCode) {
+	tokens, err := p.state.DB.GetAccessTokensByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting tokens: %w", err))
+	}
+
+	// Group tokens by application, keeping
+	// the order in which they were authorized.
+	apps := []*apimodel.AuthorizedApplication{}
+	byClientID := make(map[string]*apimodel.AuthorizedApplication)
+
+	for _, token := range tokens {
+		app, ok := byClientID[token.ClientID]
+		if !ok {
+			dbApp, err := p.state.DB.GetApplicationByClientID(ctx, token.ClientID)
+			if err != nil {
+				log.Errorf(ctx, "error getting application for token %s: %s", token.ID, err)
+				continue
+			}
+
+			app = &apimodel.AuthorizedApplication{
+				ID:        dbApp.ID,
+				Name:      dbApp.Name,
+				Website:   dbApp.Website,
+				Scopes:    []string{},
+				CreatedAt: util.FormatISO8601(token.AccessCreateAt),
+			}
+			apps = append(apps, app)
+			byClientID[token.ClientID] = app
+		}
+
+		for _, scope := range strings.Fields(token.Scope) {
+			if !slices.Contains(app.Scopes, scope) {
+				app.Scopes = append(app.Scopes, scope)
+			}
+		}
+
+		if token.LastUsedAt.IsZero() {
+			continue
+		}
+
+		lastUsedAt := util.FormatISO8601(token.LastUsedAt)
+		if app.LastUsedAt == nil || *app.LastUsedAt < lastUsedAt {
+			app.LastUsedAt = &lastUsedAt
+			app.LastUsedIP = nil
+			if token.LastUsedIP != nil {
+				lastUsedIP := token.LastUsedIP.String()
+				app.LastUsedIP = &lastUsedIP
+			}
+		}
+	}
+
+	return apps, nil
+}
+
+// AuthorizedAppRevoke revokes all tokens which the given user has granted
+// to the application with the given id, so that it can no longer act on their behalf.
+func (p *Processor) AuthorizedAppRevoke(ctx context.Context, user *gtsmodel.User, appID string) gtserror.WithCode {
+	app, err := p.state.DB.GetApplicationByID(ctx, appID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("application %s not found", appID)
+			return gtserror.NewErrorNotFound(err)
+		}
+		return gtserror.NewErrorInternalError(fmt.Errorf("db error getting application: %w", err))
+	}
+
+	tokens, err := p.state.DB.GetAccessTokensByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(fmt.Errorf("db error getting tokens: %w", err))
+	}
+
+	var authorized bool
+	for _, token := range tokens {
+		if token.ClientID == app.ClientID {
+			authorized = true
+			break
+		}
+	}
+
+	if !authorized {
+		err := fmt.Errorf("application %s is not authorized by user %s", appID, user.ID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	// delete every token granted to this app,
+	// not just the ones with an access code
+	if err := p.state.DB.DeleteTokensByUserID(ctx, user.ID, app.ClientID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("db error deleting tokens: %w", err))
+	}
+
+	return nil
+}
+
+// revokeAllTokens revokes all tokens granted by the given user,
+// including authorization codes which haven't been used yet.
+func (p *Processor) revokeAllTokens(ctx context.Context, user *gtsmodel.User) error {
+	if err := p.state.DB.DeleteTokensByUserID(ctx, user.ID, ""); err != nil {
+		return fmt.Errorf("db error deleting tokens: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package user_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type AuthorizedAppsTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *AuthorizedAppsTestSuite) TestAuthorizedAppsGet() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	apps, errWithCode := suite.user.AuthorizedAppsGet(ctx, user)
+	suite.NoError(errWithCode)
+
+	b, err := json.MarshalIndent(apps, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`[
+  {
+    "id": "01F8MGY43H3N2C8EWPR2FPYEXG",
+    "name": "really cool gts application",
+    "website": "https://reallycool.app",
+    "scopes": [
+      "read",
+      "write",
+      "follow",
+      "push"
+    ],
+    "created_at": "2022-06-10T15:22:08.000Z",
+    "last_used_at": null,
+    "last_used_ip": null
+  }
+]`, string(b))
+
+	// Use the token, the app
+	// should now show where from.
+	if err := suite.db.UpdateTokenLastUsed(ctx, "NZAZOTC0OWITMDU0NC0ZODG4LWE4NJITMWUXM2M4MTRHZDEX", net.ParseIP("192.0.2.1")); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apps, errWithCode = suite.user.AuthorizedAppsGet(ctx, user)
+	suite.NoError(errWithCode)
+	if suite.Len(apps, 1) {
+		suite.NotNil(apps[0].LastUsedAt)
+		suite.Equal("192.0.2.1", *apps[0].LastUsedIP)
+	}
+}
+
+func (suite *AuthorizedAppsTestSuite) TestAuthorizedAppsGetNone() {
+	apps, errWithCode := suite.user.AuthorizedAppsGet(context.Background(), suite.testUsers["unconfirmed_account"])
+	suite.NoError(errWithCode)
+	suite.Empty(apps)
+}
+
+func (suite *AuthorizedAppsTestSuite) TestAuthorizedAppRevoke() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	errWithCode := suite.user.AuthorizedAppRevoke(ctx, user, "01F8MGY43H3N2C8EWPR2FPYEXG")
+	suite.NoError(errWithCode)
+
+	// all of the user's tokens for the app should be gone
+	_, err := suite.db.GetAccessTokensByUserID(ctx, user.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// so the app isn't authorized anymore
+	errWithCode = suite.user.AuthorizedAppRevoke(ctx, user, "01F8MGY43H3N2C8EWPR2FPYEXG")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// tokens of other users should be untouched
+	tokens, err := suite.db.GetAccessTokensByUserID(ctx, suite.testUsers["local_account_2"].ID)
+	suite.NoError(err)
+	suite.Len(tokens, 1)
+}
+
+func (suite *AuthorizedAppsTestSuite) TestAuthorizedAppRevokeUnknown() {
+	errWithCode := suite.user.AuthorizedAppRevoke(context.Background(), suite.testUsers["local_account_1"], "01GZZZZZZZZZZZZZZZZZZZZZZZ")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestAuthorizedAppsTestSuite(t *testing.T) {
+	suite.Run(t, &AuthorizedAppsTestSuite{})
+}
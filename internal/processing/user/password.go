@@ -27,6 +27,7 @@ import (
 )
 
 // PasswordChange processes a password change request for the given user.
+// All access tokens granted by the user are revoked on success.
 func (p *Processor) PasswordChange(ctx context.Context, user *gtsmodel.User, oldPassword string, newPassword string) gtserror.WithCode {
 	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(oldPassword)); err != nil {
 		return gtserror.NewErrorUnauthorized(err, "old password was incorrect")
@@ -47,5 +48,11 @@ func (p *Processor) PasswordChange(ctx context.Context, user *gtsmodel.User, old
 		return gtserror.NewErrorInternalError(err)
 	}
 
+	// Revoke all existing tokens, since they may have
+	// been granted by whoever knew the old password.
+	if err := p.revokeAllTokens(ctx, user); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -48,6 +49,29 @@ func (suite *ChangePasswordTestSuite) TestChangePasswordOK() {
 	// check the password has changed
 	err = bcrypt.CompareHashAndPassword([]byte(dbUser.EncryptedPassword), []byte("verygoodnewpassword"))
 	suite.NoError(err)
+
+	// check the user's tokens have been revoked
+	_, err = suite.db.GetAccessTokensByUserID(context.Background(), user.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ChangePasswordTestSuite) TestChangePasswordRevokesAuthorizationCodes() {
+	ctx := context.Background()
+	user := suite.testUsers["local_account_1"]
+
+	// the user has a pending authorization code, which
+	// could otherwise be exchanged for an access token
+	codeToken := &gtsmodel.Token{}
+	err := suite.db.GetWhere(ctx, []db.Where{{Key: "code", Value: "ZJYYMZQ0MTQTZTU1NC0ZNJK4LWE2ZWITYTM1MDHHOTAXNJHL"}}, codeToken)
+	suite.NoError(err)
+	suite.Empty(codeToken.Access)
+
+	errWithCode := suite.user.PasswordChange(ctx, user, "password", "verygoodnewpassword")
+	suite.NoError(errWithCode)
+
+	// no tokens of any kind should be left for the user
+	err = suite.db.GetWhere(ctx, []db.Where{{Key: "user_id", Value: user.ID}}, &gtsmodel.Token{})
+	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
 func (suite *ChangePasswordTestSuite) TestChangePasswordIncorrectOld() {
@@ -0,0 +1,187 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rfc9421
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// AlgorithmRSAv15SHA256 is RSASSA-PKCS1-v1_5 using SHA-256.
+	AlgorithmRSAv15SHA256 = "rsa-v1_5-sha256"
+	// AlgorithmRSAPSSSHA512 is RSASSA-PSS using SHA-512.
+	AlgorithmRSAPSSSHA512 = "rsa-pss-sha512"
+	// AlgorithmECDSAP256SHA256 is ECDSA using curve P-256 and SHA-256.
+	AlgorithmECDSAP256SHA256 = "ecdsa-p256-sha256"
+	// AlgorithmEd25519 is EdDSA using curve edwards25519.
+	AlgorithmEd25519 = "ed25519"
+
+	// maxClockSkew is how far created and expires
+	// may be off before a signature is rejected.
+	maxClockSkew = 5 * time.Minute
+
+	// maxSignatureAge is how long after it was created a signature
+	// is accepted for, regardless of expires, so that captured requests
+	// can't be replayed indefinitely. This is the same window commonly
+	// allowed for the Date header of draft-cavage signatures.
+	maxSignatureAge = 12 * time.Hour
+
+	// maxBodySize is the maximum size of a request
+	// body that will be read to check its Content-Digest.
+	maxBodySize = 8 * 1024 * 1024
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// Signed returns whether the given request
+// carries an RFC 9421 message signature.
+func Signed(r *http.Request) bool {
+	return r.Header.Get("Signature-Input") != ""
+}
+
+// component is one covered component identifier
+// from a signature input, e.g. "@method" or "host".
+type component struct {
+	name string
+}
+
+// coveredComponents returns the covered components of
+// the given signature input, rejecting any parameters
+// on component identifiers, which aren't supported.
+func coveredComponents(input innerList) ([]component, error) {
+	components := make([]component, 0, len(input.items))
+
+	for _, it := range input.items {
+		name, ok := it.value.(string)
+		if !ok {
+			return nil, errors.New("covered component identifier is not a string")
+		}
+
+		if len(it.params) > 0 {
+			return nil, fmt.Errorf("unsupported parameters on component %q", name)
+		}
+
+		if name != strings.ToLower(name) {
+			return nil, fmt.Errorf("component %q is not lowercase", name)
+		}
+
+		components = append(components, component{name: name})
+	}
+
+	return components, nil
+}
+
+// signatureBase builds the signature base of
+// r for the given covered components and input.
+func signatureBase(r *http.Request, scheme string, covered []component, input innerList) ([]byte, error) {
+	var b strings.Builder
+
+	for _, c := range covered {
+		value, err := componentValue(r, scheme, c.name)
+		if err != nil {
+			return nil, err
+		}
+
+		b.WriteString(serializeBareItem(c.name))
+		b.WriteString(": ")
+		b.WriteString(value)
+		b.WriteByte('\n')
+	}
+
+	b.WriteString(`"@signature-params": `)
+	b.WriteString(serializeInnerList(input))
+
+	return []byte(b.String()), nil
+}
+
+// componentValue returns the value of the given
+// component identifier for the given request.
+func componentValue(r *http.Request, scheme string, name string) (string, error) {
+	switch name {
+	case "@method":
+		return r.Method, nil
+	case "@authority":
+		return strings.ToLower(requestHost(r)), nil
+	case "@scheme":
+		return strings.ToLower(scheme), nil
+	case "@target-uri":
+		return strings.ToLower(scheme) + "://" + strings.ToLower(requestHost(r)) + r.URL.RequestURI(), nil
+	case "@request-target":
+		return r.URL.RequestURI(), nil
+	case "@path":
+		path := r.URL.EscapedPath()
+		if path == "" {
+			path = "/"
+		}
+		return path, nil
+	case "@query":
+		return "?" + r.URL.RawQuery, nil
+	}
+
+	if strings.HasPrefix(name, "@") {
+		return "", fmt.Errorf("unsupported derived component %q", name)
+	}
+
+	if name == "host" {
+		return requestHost(r), nil
+	}
+
+	values := r.Header.Values(name)
+	if len(values) == 0 {
+		return "", fmt.Errorf("covered header %q is not present", name)
+	}
+
+	trimmed := make([]string, len(values))
+	for i, v := range values {
+		trimmed[i] = strings.TrimSpace(v)
+	}
+
+	return strings.Join(trimmed, ", "), nil
+}
+
+// requestHost returns the host of r; Go moves
+// the Host header out of the header map.
+func requestHost(r *http.Request) string {
+	if r.Host != "" {
+		return r.Host
+	}
+	return r.URL.Host
+}
+
+// joinHeader returns all values of the given
+// header in h, joined as a single field value.
+func joinHeader(h http.Header, key string) string {
+	return strings.Join(h.Values(key), ", ")
+}
+
+// stringParam returns the string value of the
+// parameter with the given key, if any.
+func stringParam(params []param, key string) (string, bool) {
+	v, ok := paramValue(params, key)
+	if !ok {
+		return "", false
+	}
+
+	s, ok := v.(string)
+	return s, ok
+}
@@ -0,0 +1,318 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rfc9421
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-fed/httpsig"
+	"github.com/stretchr/testify/suite"
+)
+
+type RFC9421TestSuite struct {
+	suite.Suite
+	rsaKey *rsa.PrivateKey
+}
+
+func (suite *RFC9421TestSuite) SetupSuite() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.rsaKey = key
+}
+
+func (suite *RFC9421TestSuite) TearDownTest() {
+	now = time.Now
+}
+
+// incoming turns an outgoing client request into
+// the request a server would see when receiving it.
+func (suite *RFC9421TestSuite) incoming(out *http.Request, body []byte) *http.Request {
+	in := httptest.NewRequest(out.Method, out.URL.RequestURI(), bytes.NewReader(body))
+	in.Host = out.URL.Host
+	for k, v := range out.Header {
+		in.Header[k] = v
+	}
+	return in
+}
+
+// signCovering signs r with suite.rsaKey, covering
+// the given components instead of those SignRequest does.
+func (suite *RFC9421TestSuite) signCovering(r *http.Request, names ...string) {
+	items := make([]item, len(names))
+	for i, name := range names {
+		items[i] = item{value: name}
+	}
+
+	input := innerList{
+		items: items,
+		params: []param{
+			{key: "created", value: now().Unix()},
+			{key: "keyid", value: "https://example.org/users/someone/main-key"},
+		},
+	}
+
+	covered, err := coveredComponents(input)
+	suite.NoError(err)
+
+	base, err := signatureBase(r, r.URL.Scheme, covered, input)
+	suite.NoError(err)
+
+	sig, err := sign(suite.rsaKey, base)
+	suite.NoError(err)
+
+	r.Header.Set("Signature-Input", signatureLabel+"="+serializeInnerList(input))
+	r.Header.Set("Signature", signatureLabel+"="+serializeBareItem(sig))
+}
+
+func (suite *RFC9421TestSuite) TestParseDictionary() {
+	members, err := parseDictionary(`sig1=("@method" "@target-uri");created=1618884473;keyid="test-key", sig2=:dGVzdA==:, flag`)
+	suite.NoError(err)
+	suite.Len(members, 3)
+
+	list, ok := members[0].value.(innerList)
+	suite.True(ok)
+	suite.Len(list.items, 2)
+	suite.Equal("@method", list.items[0].value)
+	suite.Equal(`("@method" "@target-uri");created=1618884473;keyid="test-key"`, serializeInnerList(list))
+
+	it, ok := members[1].value.(item)
+	suite.True(ok)
+	suite.Equal([]byte("test"), it.value)
+
+	it, ok = members[2].value.(item)
+	suite.True(ok)
+	suite.Equal(true, it.value)
+
+	_, err = parseDictionary(`sig1=("@method"`)
+	suite.Error(err)
+}
+
+func (suite *RFC9421TestSuite) TestVerifyRFCExample() {
+	// Test vector from RFC 9421 appendix B.2.6.
+	pubKeyDER, err := base64.StdEncoding.DecodeString("MCowBQYDK2VwAyEAJrQLj5P/89iXES9+vFgrIy29clF9CC/oPPsw3c5D0bs=")
+	suite.NoError(err)
+	pubKey, err := x509.ParsePKIXPublicKey(pubKeyDER)
+	suite.NoError(err)
+
+	// Signature is from 2021, don't let it count as expired.
+	now = func() time.Time { return time.Unix(1618884473, 0) }
+
+	body := `{"hello": "world"}`
+	r := httptest.NewRequest(http.MethodPost, "/foo?param=Value&Pet=dog", bytes.NewReader([]byte(body)))
+	r.Host = "example.com"
+	r.Header.Set("Date", "Tue, 20 Apr 2021 02:07:55 GMT")
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Content-Length", "18")
+	r.Header.Set("Signature-Input", `sig-b26=("date" "@method" "@path" "@authority" "content-type" "content-length");created=1618884473;keyid="test-key-ed25519"`)
+	r.Header.Set("Signature", `sig-b26=:wqcAqbmYJ2ji2glfAMaRy4gruYYnx2nEFN2HN6jrnDnQCK1u02Gb04v9EDgwUPiu4A0w6vuQv5lIp5WPpBKRCw==:`)
+
+	// The example covers neither the target URI
+	// nor a digest, so build the base directly.
+	label, input, sig, err := parseSignatureHeaders(r.Header)
+	suite.NoError(err)
+	suite.Equal("sig-b26", label)
+
+	covered, err := coveredComponents(input)
+	suite.NoError(err)
+
+	base, err := signatureBase(r, "https", covered, input)
+	suite.NoError(err)
+
+	v := &Verifier{keyID: "test-key-ed25519", base: base, signature: sig}
+	suite.NoError(v.Verify(pubKey, httpsig.ED25519))
+}
+
+func (suite *RFC9421TestSuite) TestSignVerifyGET() {
+	out, err := http.NewRequest(http.MethodGet, "https://example.org/users/someone", nil)
+	suite.NoError(err)
+	suite.NoError(SignRequest(suite.rsaKey, "https://example.org/users/someone/main-key", out, nil, 60))
+
+	in := suite.incoming(out, nil)
+	suite.True(Signed(in))
+
+	v, err := NewVerifier(in, "https")
+	suite.NoError(err)
+	suite.Equal("https://example.org/users/someone/main-key", v.KeyId())
+	suite.NoError(v.Verify(&suite.rsaKey.PublicKey, httpsig.RSA_SHA256))
+
+	// Wrong key should not verify.
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.NoError(err)
+	suite.Error(v.Verify(&otherKey.PublicKey, httpsig.RSA_SHA256))
+
+	// Different path should not verify.
+	in = suite.incoming(out, nil)
+	in.URL.Path = "/users/someone_else"
+	in.RequestURI = in.URL.RequestURI()
+	v, err = NewVerifier(in, "https")
+	suite.NoError(err)
+	suite.Error(v.Verify(&suite.rsaKey.PublicKey, httpsig.RSA_SHA256))
+}
+
+func (suite *RFC9421TestSuite) TestSignVerifyPOST() {
+	body := []byte(`{"type":"Create"}`)
+
+	out, err := http.NewRequest(http.MethodPost, "https://example.org/users/someone/inbox", bytes.NewReader(body))
+	suite.NoError(err)
+	suite.NoError(SignRequest(suite.rsaKey, "https://example.org/users/someone/main-key", out, body, 60))
+	suite.NotEmpty(out.Header.Get("Content-Digest"))
+
+	in := suite.incoming(out, body)
+	v, err := NewVerifier(in, "https")
+	suite.NoError(err)
+	suite.NoError(v.Verify(&suite.rsaKey.PublicKey, httpsig.RSA_SHA256))
+
+	// Body must still be readable after verification.
+	b, err := io.ReadAll(in.Body)
+	suite.NoError(err)
+	suite.Equal(body, b)
+
+	// Tampered body should be rejected.
+	_, err = NewVerifier(suite.incoming(out, []byte(`{"type":"Delete"}`)), "https")
+	suite.ErrorContains(err, "does not match request body")
+}
+
+func (suite *RFC9421TestSuite) TestSignVerifyEd25519() {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	out, err := http.NewRequest(http.MethodGet, "https://example.org/users/someone", nil)
+	suite.NoError(err)
+	suite.NoError(SignRequest(privKey, "https://example.org/users/someone/main-key", out, nil, 60))
+
+	v, err := NewVerifier(suite.incoming(out, nil), "https")
+	suite.NoError(err)
+	suite.NoError(v.Verify(pubKey, httpsig.ED25519))
+}
+
+func (suite *RFC9421TestSuite) TestVerifyExpired() {
+	out, err := http.NewRequest(http.MethodGet, "https://example.org/users/someone", nil)
+	suite.NoError(err)
+	suite.NoError(SignRequest(suite.rsaKey, "https://example.org/users/someone/main-key", out, nil, 60))
+
+	now = func() time.Time { return time.Now().Add(time.Hour) }
+
+	_, err = NewVerifier(suite.incoming(out, nil), "https")
+	suite.ErrorContains(err, "expired")
+}
+
+func (suite *RFC9421TestSuite) TestVerifyReplayOld() {
+	// Sign with an expiry far in the future, as
+	// a server that doesn't care might well do.
+	out, err := http.NewRequest(http.MethodGet, "https://example.org/users/someone", nil)
+	suite.NoError(err)
+	suite.NoError(SignRequest(suite.rsaKey, "https://example.org/users/someone/main-key", out, nil, 7*24*60*60))
+
+	// Fresh signature is fine.
+	_, err = NewVerifier(suite.incoming(out, nil), "https")
+	suite.NoError(err)
+
+	// Replaying it much later isn't.
+	now = func() time.Time { return time.Now().Add(13 * time.Hour) }
+
+	_, err = NewVerifier(suite.incoming(out, nil), "https")
+	suite.ErrorContains(err, "too old")
+}
+
+func (suite *RFC9421TestSuite) TestVerifyNoCreated() {
+	r := httptest.NewRequest(http.MethodGet, "/users/someone", nil)
+	r.Header.Set("Signature-Input", `sig1=("@method" "@target-uri");keyid="some-key"`)
+	r.Header.Set("Signature", `sig1=:dGVzdA==:`)
+
+	_, err := NewVerifier(r, "https")
+	suite.ErrorContains(err, "no created parameter")
+}
+
+func (suite *RFC9421TestSuite) TestVerifyBodyTooLarge() {
+	body := bytes.Repeat([]byte("a"), maxBodySize+1)
+
+	out, err := http.NewRequest(http.MethodPost, "https://example.org/users/someone/inbox", bytes.NewReader(body))
+	suite.NoError(err)
+	suite.NoError(SignRequest(suite.rsaKey, "https://example.org/users/someone/main-key", out, body, 60))
+
+	_, err = NewVerifier(suite.incoming(out, body), "https")
+	suite.ErrorContains(err, "exceeds maximum size")
+}
+
+func (suite *RFC9421TestSuite) TestVerifyNotCovered() {
+	now = func() time.Time { return time.Unix(1618884473, 0) }
+
+	r := httptest.NewRequest(http.MethodGet, "/users/someone", nil)
+	r.Header.Set("Signature-Input", `sig1=("host");created=1618884473;keyid="some-key"`)
+	r.Header.Set("Signature", `sig1=:dGVzdA==:`)
+
+	_, err := NewVerifier(r, "https")
+	suite.ErrorContains(err, "@method is not covered")
+}
+
+func (suite *RFC9421TestSuite) TestVerifyQueryChanged() {
+	// Query covered by @target-uri.
+	out, err := http.NewRequest(http.MethodGet, "https://example.org/users/someone/outbox?page=true", nil)
+	suite.NoError(err)
+	suite.NoError(SignRequest(suite.rsaKey, "https://example.org/users/someone/main-key", out, nil, 60))
+
+	in := suite.incoming(out, nil)
+	in.URL.RawQuery = "page=true&max_id=01F8MH1H7YV1Z7D2C8K2730QBF"
+	in.RequestURI = in.URL.RequestURI()
+	v, err := NewVerifier(in, "https")
+	suite.NoError(err)
+	suite.Error(v.Verify(&suite.rsaKey.PublicKey, httpsig.RSA_SHA256))
+
+	// Query covered by @query.
+	out, err = http.NewRequest(http.MethodGet, "https://example.org/users/someone/outbox?page=true", nil)
+	suite.NoError(err)
+	suite.signCovering(out, "@method", "@path", "@authority", "@query")
+
+	v, err = NewVerifier(suite.incoming(out, nil), "https")
+	suite.NoError(err)
+	suite.NoError(v.Verify(&suite.rsaKey.PublicKey, httpsig.RSA_SHA256))
+
+	in = suite.incoming(out, nil)
+	in.URL.RawQuery = "page=true&max_id=01F8MH1H7YV1Z7D2C8K2730QBF"
+	in.RequestURI = in.URL.RequestURI()
+	v, err = NewVerifier(in, "https")
+	suite.NoError(err)
+	suite.Error(v.Verify(&suite.rsaKey.PublicKey, httpsig.RSA_SHA256))
+
+	// Query not covered at all.
+	out, err = http.NewRequest(http.MethodGet, "https://example.org/users/someone/outbox?page=true", nil)
+	suite.NoError(err)
+	suite.signCovering(out, "@method", "@path", "@authority")
+
+	in = suite.incoming(out, nil)
+	in.URL.RawQuery = "page=true&max_id=01F8MH1H7YV1Z7D2C8K2730QBF"
+	in.RequestURI = in.URL.RequestURI()
+	_, err = NewVerifier(in, "https")
+	suite.ErrorContains(err, "@query are covered")
+}
+
+func TestRFC9421TestSuite(t *testing.T) {
+	suite.Run(t, &RFC9421TestSuite{})
+}
@@ -0,0 +1,438 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rfc9421
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// This file contains just enough of RFC 8941 (Structured Field Values
+// for HTTP) to parse and serialize the Signature-Input and Signature
+// dictionaries. Decimals aren't supported, since neither uses them.
+
+// token is a structured field token, which
+// is serialized without quotes, unlike strings.
+type token string
+
+// param is one structured field parameter.
+type param struct {
+	key   string
+	value interface{}
+}
+
+// item is a structured field item: a bare
+// value (string, token, int64, []byte or bool)
+// along with its parameters.
+type item struct {
+	value  interface{}
+	params []param
+}
+
+// innerList is a structured field inner
+// list of items, along with its parameters.
+type innerList struct {
+	items  []item
+	params []param
+}
+
+// member is one member of a structured field
+// dictionary, either an item or an innerList.
+type member struct {
+	key   string
+	value interface{}
+}
+
+// paramValue returns the value of the
+// parameter with the given key, if any.
+func paramValue(params []param, key string) (interface{}, bool) {
+	for _, p := range params {
+		if p.key == key {
+			return p.value, true
+		}
+	}
+	return nil, false
+}
+
+// parser parses structured field values from a string.
+type parser struct {
+	s string
+	i int
+}
+
+func (p *parser) eof() bool {
+	return p.i >= len(p.s)
+}
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.s[p.i]
+}
+
+func (p *parser) skipSP() {
+	for !p.eof() && p.s[p.i] == ' ' {
+		p.i++
+	}
+}
+
+func (p *parser) skipOWS() {
+	for !p.eof() && (p.s[p.i] == ' ' || p.s[p.i] == '\t') {
+		p.i++
+	}
+}
+
+// parseDictionary parses a structured field dictionary.
+func parseDictionary(s string) ([]member, error) {
+	p := &parser{s: s}
+	members := []member{}
+
+	p.skipSP()
+	for !p.eof() {
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+
+		var value interface{}
+		if p.peek() == '=' {
+			p.i++
+			if value, err = p.parseItemOrInnerList(); err != nil {
+				return nil, err
+			}
+		} else {
+			params, err := p.parseParams()
+			if err != nil {
+				return nil, err
+			}
+			value = item{value: true, params: params}
+		}
+
+		members = append(members, member{key: key, value: value})
+
+		p.skipOWS()
+		if p.eof() {
+			break
+		}
+
+		if p.peek() != ',' {
+			return nil, fmt.Errorf("expected ',' at position %d", p.i)
+		}
+		p.i++
+
+		p.skipOWS()
+		if p.eof() {
+			return nil, errors.New("trailing ',' in dictionary")
+		}
+	}
+
+	return members, nil
+}
+
+func (p *parser) parseKey() (string, error) {
+	start := p.i
+	if c := p.peek(); !(isLCAlpha(c) || c == '*') {
+		return "", fmt.Errorf("invalid key at position %d", p.i)
+	}
+
+	for !p.eof() {
+		c := p.s[p.i]
+		if !(isLCAlpha(c) || isDigit(c) || c == '_' || c == '-' || c == '.' || c == '*') {
+			break
+		}
+		p.i++
+	}
+
+	return p.s[start:p.i], nil
+}
+
+func (p *parser) parseItemOrInnerList() (interface{}, error) {
+	if p.peek() == '(' {
+		return p.parseInnerList()
+	}
+	return p.parseItem()
+}
+
+func (p *parser) parseInnerList() (innerList, error) {
+	var list innerList
+
+	// Skip the opening '('.
+	p.i++
+
+	for !p.eof() {
+		p.skipSP()
+
+		if p.peek() == ')' {
+			p.i++
+
+			params, err := p.parseParams()
+			if err != nil {
+				return innerList{}, err
+			}
+			list.params = params
+
+			return list, nil
+		}
+
+		it, err := p.parseItem()
+		if err != nil {
+			return innerList{}, err
+		}
+		list.items = append(list.items, it)
+
+		if c := p.peek(); c != ' ' && c != ')' {
+			return innerList{}, fmt.Errorf("expected ' ' or ')' at position %d", p.i)
+		}
+	}
+
+	return innerList{}, errors.New("unterminated inner list")
+}
+
+func (p *parser) parseItem() (item, error) {
+	value, err := p.parseBareItem()
+	if err != nil {
+		return item{}, err
+	}
+
+	params, err := p.parseParams()
+	if err != nil {
+		return item{}, err
+	}
+
+	return item{value: value, params: params}, nil
+}
+
+func (p *parser) parseParams() ([]param, error) {
+	params := []param{}
+
+	for p.peek() == ';' {
+		p.i++
+		p.skipSP()
+
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+
+		var value interface{} = true
+		if p.peek() == '=' {
+			p.i++
+			if value, err = p.parseBareItem(); err != nil {
+				return nil, err
+			}
+		}
+
+		params = append(params, param{key: key, value: value})
+	}
+
+	return params, nil
+}
+
+func (p *parser) parseBareItem() (interface{}, error) {
+	switch c := p.peek(); {
+	case c == '-' || isDigit(c):
+		return p.parseInteger()
+	case c == '"':
+		return p.parseString()
+	case c == ':':
+		return p.parseByteSequence()
+	case c == '?':
+		return p.parseBoolean()
+	case c == '*' || isAlpha(c):
+		return p.parseToken(), nil
+	default:
+		return nil, fmt.Errorf("invalid bare item at position %d", p.i)
+	}
+}
+
+func (p *parser) parseInteger() (int64, error) {
+	start := p.i
+	if p.peek() == '-' {
+		p.i++
+	}
+
+	for !p.eof() && isDigit(p.s[p.i]) {
+		p.i++
+	}
+
+	if p.peek() == '.' {
+		return 0, fmt.Errorf("unsupported decimal at position %d", start)
+	}
+
+	if p.i-start > 15 {
+		return 0, fmt.Errorf("integer too long at position %d", start)
+	}
+
+	return strconv.ParseInt(p.s[start:p.i], 10, 64)
+}
+
+func (p *parser) parseString() (string, error) {
+	var b strings.Builder
+
+	// Skip the opening '"'.
+	p.i++
+
+	for !p.eof() {
+		c := p.s[p.i]
+		p.i++
+
+		switch {
+		case c == '\\':
+			if p.eof() {
+				return "", errors.New("unterminated string")
+			}
+			next := p.s[p.i]
+			if next != '"' && next != '\\' {
+				return "", fmt.Errorf("invalid escape at position %d", p.i)
+			}
+			b.WriteByte(next)
+			p.i++
+		case c == '"':
+			return b.String(), nil
+		case c < 0x20 || c > 0x7e:
+			return "", fmt.Errorf("invalid string character at position %d", p.i-1)
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", errors.New("unterminated string")
+}
+
+func (p *parser) parseByteSequence() ([]byte, error) {
+	// Skip the opening ':'.
+	p.i++
+
+	end := strings.IndexByte(p.s[p.i:], ':')
+	if end < 0 {
+		return nil, errors.New("unterminated byte sequence")
+	}
+
+	b, err := base64.StdEncoding.DecodeString(p.s[p.i : p.i+end])
+	if err != nil {
+		return nil, fmt.Errorf("invalid byte sequence: %w", err)
+	}
+	p.i += end + 1
+
+	return b, nil
+}
+
+func (p *parser) parseBoolean() (bool, error) {
+	// Skip the '?'.
+	p.i++
+
+	switch p.peek() {
+	case '1':
+		p.i++
+		return true, nil
+	case '0':
+		p.i++
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid boolean at position %d", p.i)
+	}
+}
+
+func (p *parser) parseToken() token {
+	start := p.i
+	for !p.eof() {
+		c := p.s[p.i]
+		if !(isTChar(c) || c == ':' || c == '/') {
+			break
+		}
+		p.i++
+	}
+	return token(p.s[start:p.i])
+}
+
+// serializeInnerList serializes the given inner list.
+func serializeInnerList(list innerList) string {
+	var b strings.Builder
+
+	b.WriteByte('(')
+	for i, it := range list.items {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(serializeBareItem(it.value))
+		b.WriteString(serializeParams(it.params))
+	}
+	b.WriteByte(')')
+	b.WriteString(serializeParams(list.params))
+
+	return b.String()
+}
+
+func serializeParams(params []param) string {
+	var b strings.Builder
+
+	for _, p := range params {
+		b.WriteByte(';')
+		b.WriteString(p.key)
+		if v, ok := p.value.(bool); ok && v {
+			continue
+		}
+		b.WriteByte('=')
+		b.WriteString(serializeBareItem(p.value))
+	}
+
+	return b.String()
+}
+
+func serializeBareItem(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(v) + `"`
+	case token:
+		return string(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []byte:
+		return ":" + base64.StdEncoding.EncodeToString(v) + ":"
+	case bool:
+		if v {
+			return "?1"
+		}
+		return "?0"
+	default:
+		panic(fmt.Sprintf("unsupported structured field bare item %T", v))
+	}
+}
+
+func isLCAlpha(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func isAlpha(c byte) bool {
+	return isLCAlpha(c) || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isTChar(c byte) bool {
+	if isAlpha(c) || isDigit(c) {
+		return true
+	}
+	return strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0
+}
@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rfc9421
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+)
+
+// signatureLabel is the label used for signatures we create.
+const signatureLabel = "sig1"
+
+// SignRequest signs r with the given private key, covering the method
+// and target URI of the request and, if body is not nil, a sha-256
+// Content-Digest of body. The Signature-Input, Signature and (for
+// requests with a body) Content-Digest headers are set on r.
+//
+// The signature expires expiresIn seconds after it was created.
+func SignRequest(privKey crypto.PrivateKey, keyID string, r *http.Request, body []byte, expiresIn int64) error {
+	alg, err := algorithmFor(privKey)
+	if err != nil {
+		return err
+	}
+
+	covered := []item{
+		{value: "@method"},
+		{value: "@target-uri"},
+	}
+
+	if body != nil {
+		sum := sha256.Sum256(body)
+		r.Header.Set("Content-Digest", "sha-256="+serializeBareItem(sum[:]))
+		covered = append(covered, item{value: "content-digest"})
+	}
+
+	created := now().Unix()
+	input := innerList{
+		items: covered,
+		params: []param{
+			{key: "created", value: created},
+			{key: "expires", value: created + expiresIn},
+			{key: "keyid", value: keyID},
+			{key: "alg", value: alg},
+		},
+	}
+
+	components, err := coveredComponents(input)
+	if err != nil {
+		return err
+	}
+
+	base, err := signatureBase(r, r.URL.Scheme, components, input)
+	if err != nil {
+		return err
+	}
+
+	sig, err := sign(privKey, base)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set("Signature-Input", signatureLabel+"="+serializeInnerList(input))
+	r.Header.Set("Signature", signatureLabel+"="+serializeBareItem(sig))
+
+	return nil
+}
+
+// algorithmFor returns the algorithm we sign with for the given key.
+func algorithmFor(privKey crypto.PrivateKey) (string, error) {
+	switch privKey.(type) {
+	case *rsa.PrivateKey:
+		return AlgorithmRSAv15SHA256, nil
+	case ed25519.PrivateKey:
+		return AlgorithmEd25519, nil
+	case *ecdsa.PrivateKey:
+		return AlgorithmECDSAP256SHA256, nil
+	default:
+		return "", fmt.Errorf("unsupported private key type %T", privKey)
+	}
+}
+
+// sign signs the signature base with the given private key.
+func sign(privKey crypto.PrivateKey, base []byte) ([]byte, error) {
+	switch key := privKey.(type) {
+	case *rsa.PrivateKey:
+		sum := sha256.Sum256(base)
+		return rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, base), nil
+
+	case *ecdsa.PrivateKey:
+		sum := sha256.Sum256(base)
+		r, s, err := ecdsa.Sign(rand.Reader, key, sum[:])
+		if err != nil {
+			return nil, err
+		}
+
+		// Encode as the raw 32 byte r and s values concatenated.
+		sig := make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+		return sig, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", privKey)
+	}
+}
@@ -0,0 +1,354 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package rfc9421
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/go-fed/httpsig"
+)
+
+// Verifier verifies an RFC 9421 HTTP message signature on an
+// incoming request. It satisfies the httpsig.Verifier interface, so
+// it can be used interchangeably with draft-cavage verifiers.
+type Verifier struct {
+	keyID     string
+	alg       string
+	base      []byte
+	signature []byte
+}
+
+// NewVerifier parses the Signature-Input and Signature headers of
+// the given request, checks the covered components and any
+// Content-Digest, and returns a Verifier for the first signature.
+//
+// Scheme should be the scheme ("http" or "https") that the request
+// was received on, since it isn't available from the request itself
+// when running behind a reverse proxy.
+func NewVerifier(r *http.Request, scheme string) (*Verifier, error) {
+	label, input, signature, err := parseSignatureHeaders(r.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, ok := stringParam(input.params, "keyid")
+	if !ok || keyID == "" {
+		return nil, fmt.Errorf("signature %s has no keyid", label)
+	}
+
+	alg, _ := stringParam(input.params, "alg")
+
+	if err := checkTimes(input.params); err != nil {
+		return nil, fmt.Errorf("signature %s: %w", label, err)
+	}
+
+	covered, err := coveredComponents(input)
+	if err != nil {
+		return nil, fmt.Errorf("signature %s: %w", label, err)
+	}
+
+	if err := checkCovered(r, covered); err != nil {
+		return nil, fmt.Errorf("signature %s: %w", label, err)
+	}
+
+	if err := checkContentDigest(r); err != nil {
+		return nil, err
+	}
+
+	base, err := signatureBase(r, scheme, covered, input)
+	if err != nil {
+		return nil, fmt.Errorf("signature %s: %w", label, err)
+	}
+
+	return &Verifier{
+		keyID:     keyID,
+		alg:       alg,
+		base:      base,
+		signature: signature,
+	}, nil
+}
+
+// KeyId returns the keyid parameter of the signature.
+func (v *Verifier) KeyId() string { //nolint:revive
+	return v.keyID
+}
+
+// Verify checks the signature against the given public key. RFC 9421
+// signatures carry their own algorithm (or imply it from the key), so
+// the draft-cavage algorithm hint is ignored.
+func (v *Verifier) Verify(pubKey crypto.PublicKey, _ httpsig.Algorithm) error {
+	switch key := pubKey.(type) {
+	case *rsa.PublicKey:
+		switch v.alg {
+		case AlgorithmRSAv15SHA256:
+			return verifyRSAv15(key, v.base, v.signature)
+		case AlgorithmRSAPSSSHA512:
+			return verifyRSAPSS(key, v.base, v.signature)
+		case "":
+			// No alg given, try both RSA algorithms.
+			if err := verifyRSAv15(key, v.base, v.signature); err == nil {
+				return nil
+			}
+			return verifyRSAPSS(key, v.base, v.signature)
+		}
+
+	case ed25519.PublicKey:
+		if v.alg == "" || v.alg == AlgorithmEd25519 {
+			if !ed25519.Verify(key, v.base, v.signature) {
+				return errors.New("ed25519 signature did not verify")
+			}
+			return nil
+		}
+
+	case *ecdsa.PublicKey:
+		if v.alg == "" || v.alg == AlgorithmECDSAP256SHA256 {
+			return verifyECDSA(key, v.base, v.signature)
+		}
+
+	default:
+		return fmt.Errorf("unsupported public key type %T", pubKey)
+	}
+
+	return fmt.Errorf("algorithm %q can't be used with public key type %T", v.alg, pubKey)
+}
+
+func verifyRSAv15(key *rsa.PublicKey, base []byte, sig []byte) error {
+	sum := sha256.Sum256(base)
+	return rsa.VerifyPKCS1v15(key, crypto.SHA256, sum[:], sig)
+}
+
+func verifyRSAPSS(key *rsa.PublicKey, base []byte, sig []byte) error {
+	sum := sha512.Sum512(base)
+	return rsa.VerifyPSS(key, crypto.SHA512, sum[:], sig, &rsa.PSSOptions{SaltLength: 64})
+}
+
+func verifyECDSA(key *ecdsa.PublicKey, base []byte, sig []byte) error {
+	// RFC 9421 ecdsa-p256-sha256 signatures are
+	// the raw 32 byte r and s values concatenated.
+	if len(sig) != 64 {
+		return fmt.Errorf("ecdsa signature has invalid length %d", len(sig))
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	sum := sha256.Sum256(base)
+
+	if !ecdsa.Verify(key, sum[:], r, s) {
+		return errors.New("ecdsa signature did not verify")
+	}
+
+	return nil
+}
+
+// parseSignatureHeaders returns the label, signature input and
+// signature bytes of the first signature present in both the
+// Signature-Input and Signature headers of h.
+func parseSignatureHeaders(h http.Header) (string, innerList, []byte, error) {
+	inputs, err := parseDictionary(joinHeader(h, "Signature-Input"))
+	if err != nil {
+		return "", innerList{}, nil, fmt.Errorf("error parsing Signature-Input: %w", err)
+	}
+
+	sigs, err := parseDictionary(joinHeader(h, "Signature"))
+	if err != nil {
+		return "", innerList{}, nil, fmt.Errorf("error parsing Signature: %w", err)
+	}
+
+	for _, in := range inputs {
+		list, ok := in.value.(innerList)
+		if !ok {
+			continue
+		}
+
+		for _, sig := range sigs {
+			if sig.key != in.key {
+				continue
+			}
+
+			it, ok := sig.value.(item)
+			if !ok {
+				break
+			}
+
+			b, ok := it.value.([]byte)
+			if !ok {
+				break
+			}
+
+			return in.key, list, b, nil
+		}
+	}
+
+	return "", innerList{}, nil, errors.New("no usable signature found in Signature-Input and Signature headers")
+}
+
+// checkTimes checks the created and expires parameters of a
+// signature. Created is required, so that the age of the
+// signature can be checked even when it doesn't expire.
+func checkTimes(params []param) error {
+	t := now()
+
+	v, ok := paramValue(params, "created")
+	if !ok {
+		return errors.New("signature has no created parameter")
+	}
+
+	created, ok := v.(int64)
+	if !ok {
+		return errors.New("created parameter is not an integer")
+	}
+
+	if time.Unix(created, 0).After(t.Add(maxClockSkew)) {
+		return errors.New("signature was created in the future")
+	}
+
+	if time.Unix(created, 0).Before(t.Add(-maxSignatureAge - maxClockSkew)) {
+		return errors.New("signature is too old")
+	}
+
+	if v, ok := paramValue(params, "expires"); ok {
+		expires, ok := v.(int64)
+		if !ok {
+			return errors.New("expires parameter is not an integer")
+		}
+
+		if time.Unix(expires, 0).Before(t.Add(-maxClockSkew)) {
+			return errors.New("signature has expired")
+		}
+	}
+
+	return nil
+}
+
+// checkCovered makes sure that the covered components
+// bind the signature to the method and target of r.
+func checkCovered(r *http.Request, covered []component) error {
+	has := func(name string) bool {
+		for _, c := range covered {
+			if c.name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !has("@method") {
+		return errors.New("@method is not covered")
+	}
+
+	if !has("@target-uri") {
+		if !(has("@path") && has("@authority")) {
+			return errors.New("neither @target-uri nor @path and @authority are covered")
+		}
+
+		// @path doesn't include the query, so
+		// without @query it could be swapped.
+		if r.URL.RawQuery != "" && !has("@query") {
+			return errors.New("request has a query but neither @target-uri nor @query are covered")
+		}
+	}
+
+	if requestHasBody(r) && !has("content-digest") {
+		return errors.New("request has a body but content-digest is not covered")
+	}
+
+	return nil
+}
+
+// checkContentDigest verifies the Content-Digest header of
+// r against its body, if present. The body is read and then
+// replaced, so it can still be read by later handlers.
+func checkContentDigest(r *http.Request) error {
+	header := joinHeader(r.Header, "Content-Digest")
+	if header == "" {
+		return nil
+	}
+
+	digests, err := parseDictionary(header)
+	if err != nil {
+		return fmt.Errorf("error parsing Content-Digest: %w", err)
+	}
+
+	var body []byte
+	if r.Body != nil {
+		// Read one byte more than the
+		// limit to detect larger bodies.
+		body, err = io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		if err != nil {
+			return fmt.Errorf("error reading request body: %w", err)
+		}
+		if len(body) > maxBodySize {
+			return fmt.Errorf("request body exceeds maximum size of %d bytes", maxBodySize)
+		}
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	checked := false
+	for _, d := range digests {
+		it, ok := d.value.(item)
+		if !ok {
+			continue
+		}
+
+		want, ok := it.value.([]byte)
+		if !ok {
+			continue
+		}
+
+		var got []byte
+		switch d.key {
+		case "sha-256":
+			sum := sha256.Sum256(body)
+			got = sum[:]
+		case "sha-512":
+			sum := sha512.Sum512(body)
+			got = sum[:]
+		default:
+			continue
+		}
+
+		if !bytes.Equal(want, got) {
+			return fmt.Errorf("content-digest %s does not match request body", d.key)
+		}
+		checked = true
+	}
+
+	if !checked {
+		return errors.New("content-digest contains no supported digest")
+	}
+
+	return nil
+}
+
+// requestHasBody returns whether r looks like it has a body.
+func requestHasBody(r *http.Request) bool {
+	return r.ContentLength > 0 || (r.ContentLength < 0 && r.Body != nil && r.Body != http.NoBody)
+}
@@ -20,6 +20,7 @@ package router
 import (
 	"fmt"
 	"html/template"
+	"math"
 	"os"
 	"path/filepath"
 	"time"
@@ -124,6 +125,21 @@ func timestampVague(stamp string) string {
 	return t.Format(monthYear)
 }
 
+// pollPercent returns the share of votes of the given poll option, as
+// a rounded percentage of all voters (if known) or all votes in the poll.
+func pollPercent(poll *apimodel.Poll, option apimodel.PollOption) int {
+	total := poll.VotesCount
+	if poll.VotersCount != nil {
+		total = *poll.VotersCount
+	}
+
+	if total <= 0 || option.VotesCount == nil {
+		return 0
+	}
+
+	return int(math.Round(float64(*option.VotesCount) * 100 / float64(total)))
+}
+
 type iconWithLabel struct {
 	faIcon string
 	label  string
@@ -169,5 +185,6 @@ func LoadTemplateFunctions(engine *gin.Engine) {
 		"timestampVague":   timestampVague,
 		"timestampPrecise": timestampPrecise,
 		"emojify":          emojify,
+		"pollPercent":      pollPercent,
 	})
 }
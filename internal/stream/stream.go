@@ -26,6 +26,12 @@ const (
 	EventTypeUpdate string = "update"
 	// EventTypeDelete -- something should be deleted from a user
 	EventTypeDelete string = "delete"
+	// EventTypeStatusUpdate -- a user should be shown an edit of a status in their timeline
+	EventTypeStatusUpdate string = "status.update"
+	// EventTypeConversation -- a user should be shown an updated direct message conversation
+	EventTypeConversation string = "conversation"
+	// EventTypeMarker -- a user's timeline read markers have been updated by another client
+	EventTypeMarker string = "marker"
 )
 
 const (
@@ -39,6 +45,15 @@ const (
 	TimelineNotifications string = "user:notification"
 	// TimelineDirect -- statuses sent to a user directly.
 	TimelineDirect string = "direct"
+	// TimelineList -- statuses for a user's list timeline.
+	// Streams for a specific list are keyed as "list:<list id>".
+	TimelineList string = "list"
+	// TimelineHashtag -- public statuses using a hashtag.
+	// Streams for a specific hashtag are keyed as "hashtag:<tag name>".
+	TimelineHashtag string = "hashtag"
+	// TimelineHashtagLocal -- public statuses from the LOCAL timeline using a hashtag.
+	// Streams for a specific hashtag are keyed as "hashtag:local:<tag name>".
+	TimelineHashtagLocal string = "hashtag:local"
 )
 
 // AllStatusTimelines contains all Timelines that a status could conceivably be delivered to -- useful for doing deletes.
@@ -47,6 +62,9 @@ var AllStatusTimelines = []string{
 	TimelinePublic,
 	TimelineHome,
 	TimelineDirect,
+	TimelineList,
+	TimelineHashtag,
+	TimelineHashtagLocal,
 }
 
 // StreamsForAccount is a wrapper for the multiple streams that one account can have running at the same time.
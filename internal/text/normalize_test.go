@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+type NormalizeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *NormalizeTestSuite) TestNormalizeHashtag() {
+	for _, test := range []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{input: "#welcome", expected: "welcome", ok: true},
+		{input: "Hashtag", expected: "Hashtag", ok: true},
+		{input: "#", expected: "", ok: false},
+		{input: "#not a tag", expected: "", ok: false},
+		{input: "#thisisaverylonghashtagthatgoesonforever", expected: "", ok: false},
+	} {
+		normalized, ok := text.NormalizeHashtag(test.input)
+		suite.Equal(test.ok, ok, test.input)
+		if ok {
+			suite.Equal(test.expected, normalized, test.input)
+		}
+	}
+}
+
+func TestNormalizeTestSuite(t *testing.T) {
+	suite.Run(t, new(NormalizeTestSuite))
+}
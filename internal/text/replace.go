@@ -23,12 +23,6 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
-	"github.com/superseriousbusiness/gotosocial/internal/util"
-	"golang.org/x/text/unicode/norm"
-)
-
-const (
-	maximumHashtagLength = 30
 )
 
 // given a mention or a hashtag string, the methods in this file will attempt to parse it,
@@ -90,17 +84,9 @@ func (r *customRenderer) replaceMention(text string) string {
 // replaceMention takes a string in the form #HashedTag, and will normalize it before
 // adding it to the db and turning it into HTML.
 func (r *customRenderer) replaceHashtag(text string) string {
-	// this normalization is specifically to avoid cases where visually-identical
-	// hashtags are stored with different unicode representations (e.g. with combining
-	// diacritics). It allows a tasteful number of combining diacritics to be used,
-	// as long as they can be combined with parent characters to form regular letter
-	// symbols.
-	normalized := norm.NFC.String(text[1:])
-
-	for i, r := range normalized {
-		if i >= maximumHashtagLength || !util.IsPermittedInHashtag(r) {
-			return text
-		}
+	normalized, ok := NormalizeHashtag(text)
+	if !ok {
+		return text
 	}
 
 	tag, err := r.f.db.TagStringToTag(r.ctx, normalized, r.accountID)
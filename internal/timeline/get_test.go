@@ -60,7 +60,7 @@ func (suite *GetTestSuite) SetupTest() {
 		suite.testAccounts["local_account_1"].ID,
 		processing.StatusGrabFunction(suite.db),
 		processing.StatusFilterFunction(suite.db, suite.filter),
-		processing.StatusPrepareFunction(suite.db, suite.tc),
+		processing.StatusPrepareFunction(suite.db, suite.tc, suite.filter),
 		processing.StatusSkipInsertFunction(),
 	)
 	if err != nil {
@@ -119,6 +119,53 @@ func (suite *GetTestSuite) TestGetDefault() {
 	suite.WithinDuration(time.Now(), suite.timeline.LastGot(), 1*time.Second)
 }
 
+func (suite *GetTestSuite) TestGetAfterHideFilterAdded() {
+	ctx := context.Background()
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	// add a hide filter which matches
+	// a status that's already prepared
+	filter := &gtsmodel.Filter{
+		ID:                   "01HPQ8D9R6E3ZQ5Y1K7C2W4M0T",
+		AccountID:            suite.testAccounts["local_account_1"].ID,
+		Title:                "no first posts",
+		Action:               gtsmodel.FilterActionHide,
+		ContextHome:          testrig.TrueBool(),
+		ContextNotifications: testrig.FalseBool(),
+		ContextPublic:        testrig.FalseBool(),
+		ContextThread:        testrig.FalseBool(),
+		ContextAccount:       testrig.FalseBool(),
+		Keywords: []*gtsmodel.FilterKeyword{
+			{
+				ID:        "01HPQ8DZ1B9T4G6V3X0N8S2R5J",
+				AccountID: suite.testAccounts["local_account_1"].ID,
+				FilterID:  "01HPQ8D9R6E3ZQ5Y1K7C2W4M0T",
+				Keyword:   "first post on the instance",
+				WholeWord: testrig.FalseBool(),
+			},
+		},
+	}
+	if err := suite.db.PutFilter(ctx, filter); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// repreparing the status should
+	// drop it from the timeline
+	if _, err := suite.timeline.Reprepare(ctx, targetStatus.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statuses, err := suite.timeline.Get(ctx, 20, "", "", "", false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(statuses, 16)
+	for _, s := range statuses {
+		suite.NotEqual(targetStatus.ID, s.GetID())
+	}
+}
+
 func (suite *GetTestSuite) TestGetDefaultPrepareNext() {
 	// get 10 from the top and prepare the next query
 	statuses, err := suite.timeline.Get(context.Background(), 10, "", "", "", true)
@@ -69,8 +69,13 @@ type Manager interface {
 	Remove(ctx context.Context, timelineAccountID string, itemID string) (int, error)
 	// WipeItemFromAllTimelines removes one item from the index and prepared items of all timelines
 	WipeItemFromAllTimelines(ctx context.Context, itemID string) error
+	// ReprepareItemInAllTimelines prepares again one item, and any boosts of it, in the prepared items of all timelines
+	ReprepareItemInAllTimelines(ctx context.Context, itemID string) error
 	// WipeStatusesFromAccountID removes all items by the given accountID from the timelineAccountID's timelines.
 	WipeItemsFromAccountID(ctx context.Context, timelineAccountID string, accountID string) error
+	// UnloadTimeline removes the timeline with the given timelineAccountID from memory, if it's loaded.
+	// The timeline will be recreated and reindexed from scratch the next time it's accessed.
+	UnloadTimeline(ctx context.Context, timelineAccountID string)
 	// Start starts hourly cleanup jobs for this timeline manager.
 	Start() error
 	// Stop stops the timeline manager (currently a stub, doesn't do anything).
@@ -246,6 +251,29 @@ func (m *manager) WipeItemFromAllTimelines(ctx context.Context, statusID string)
 	return err
 }
 
+func (m *manager) ReprepareItemInAllTimelines(ctx context.Context, itemID string) error {
+	errors := []string{}
+	m.accountTimelines.Range(func(k interface{}, i interface{}) bool {
+		t, ok := i.(Timeline)
+		if !ok {
+			panic("couldn't parse entry as Timeline, this should never happen so panic")
+		}
+
+		if _, err := t.Reprepare(ctx, itemID); err != nil {
+			errors = append(errors, err.Error())
+		}
+
+		return true
+	})
+
+	var err error
+	if len(errors) > 0 {
+		err = fmt.Errorf("one or more errors repreparing item %s in all timelines: %s", itemID, strings.Join(errors, ";"))
+	}
+
+	return err
+}
+
 func (m *manager) WipeItemsFromAccountID(ctx context.Context, timelineAccountID string, accountID string) error {
 	t, err := m.getOrCreateTimeline(ctx, timelineAccountID)
 	if err != nil {
@@ -256,6 +284,14 @@ func (m *manager) WipeItemsFromAccountID(ctx context.Context, timelineAccountID
 	return err
 }
 
+func (m *manager) UnloadTimeline(ctx context.Context, timelineAccountID string) {
+	log.WithContext(ctx).
+		WithFields(kv.Fields{{"timelineAccountID", timelineAccountID}}...).
+		Trace("unloading timeline")
+
+	m.accountTimelines.Delete(timelineAccountID)
+}
+
 func (m *manager) getOrCreateTimeline(ctx context.Context, timelineAccountID string) (Timeline, error) {
 	var t Timeline
 	i, ok := m.accountTimelines.Load(timelineAccountID)
@@ -54,7 +54,7 @@ func (suite *ManagerTestSuite) SetupTest() {
 	manager := timeline.NewManager(
 		processing.StatusGrabFunction(suite.db),
 		processing.StatusFilterFunction(suite.db, suite.filter),
-		processing.StatusPrepareFunction(suite.db, suite.tc),
+		processing.StatusPrepareFunction(suite.db, suite.tc, suite.filter),
 		processing.StatusSkipInsertFunction(),
 	)
 	suite.manager = manager
@@ -240,6 +240,61 @@ func (t *timeline) prepare(ctx context.Context, itemID string) error {
 	return t.preparedItems.insertPrepared(ctx, preparedItemsEntry)
 }
 
+func (t *timeline) Reprepare(ctx context.Context, itemID string) (int, error) {
+	l := log.WithContext(ctx).
+		WithFields(kv.Fields{
+			{"accountTimeline", t.accountID},
+			{"itemID", itemID},
+		}...)
+
+	t.Lock()
+	defer t.Unlock()
+	var reprepared int
+
+	if t.preparedItems == nil || t.preparedItems.data == nil {
+		// nothing prepared yet, so nothing to reprepare
+		return reprepared, nil
+	}
+
+	// entries which can no longer be prepared
+	removePrepared := []*list.Element{}
+
+	for e := t.preparedItems.data.Front(); e != nil; e = e.Next() {
+		entry, ok := e.Value.(*preparedItemsEntry)
+		if !ok {
+			return reprepared, errors.New("Reprepare: could not parse e as a preparedItemsEntry")
+		}
+
+		if entry.itemID != itemID && entry.boostOfID != itemID {
+			continue
+		}
+
+		// prepare the item again and swap
+		// it in place of the existing entry
+		prepared, err := t.prepareFunction(ctx, t.accountID, entry.itemID)
+		if err != nil {
+			if err == db.ErrNoEntries {
+				// the item shouldn't be shown (anymore),
+				// so take it out of the prepared items
+				removePrepared = append(removePrepared, e)
+				continue
+			}
+			return reprepared, fmt.Errorf("Reprepare: error preparing item with id %s: %w", entry.itemID, err)
+		}
+
+		l.Debug("found item in preparedItems")
+		entry.prepared = prepared
+		reprepared++
+	}
+
+	for _, e := range removePrepared {
+		t.preparedItems.data.Remove(e)
+	}
+
+	l.Debugf("reprepared %d entries, removed %d entries", reprepared, len(removePrepared))
+	return reprepared, nil
+}
+
 // oldestPreparedItemID returns the id of the rearmost (ie., the oldest) prepared item, or an error if something goes wrong.
 // If nothing goes wrong but there's no oldest item, an empty string will be returned so make sure to check for this.
 func (t *timeline) oldestPreparedItemID(ctx context.Context) (string, error) {
@@ -59,7 +59,7 @@ func (suite *PruneTestSuite) SetupTest() {
 		suite.testAccounts["local_account_1"].ID,
 		processing.StatusGrabFunction(suite.db),
 		processing.StatusFilterFunction(suite.db, suite.filter),
-		processing.StatusPrepareFunction(suite.db, suite.tc),
+		processing.StatusPrepareFunction(suite.db, suite.tc, suite.filter),
 		processing.StatusSkipInsertFunction(),
 	)
 	if err != nil {
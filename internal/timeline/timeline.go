@@ -126,6 +126,12 @@ type Timeline interface {
 	//
 	// The returned int indicates the amount of entries that were removed.
 	RemoveAllBy(ctx context.Context, accountID string) (int, error)
+	// Reprepare prepares again any prepared items with the given itemID, or which are boosts of
+	// the given itemID, replacing the existing prepared items. Useful when an item has been edited.
+	// Items which should no longer be shown are removed from the prepared items.
+	//
+	// The returned int indicates the amount of entries that were reprepared.
+	Reprepare(ctx context.Context, itemID string) (int, error)
 }
 
 // timeline fulfils the Timeline interface
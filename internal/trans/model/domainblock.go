@@ -30,4 +30,6 @@ type DomainBlock struct {
 	PublicComment      string     `json:"publicComment,omitempty" bun:",nullzero"`
 	Obfuscate          *bool      `json:"obfuscate" bun:",nullzero,notnull,default:false"`
 	SubscriptionID     string     `json:"subscriptionID,omitempty" bun:",nullzero"`
+	Severity           string     `json:"severity,omitempty" bun:",nullzero"`
+	RejectMedia        *bool      `json:"rejectMedia" bun:",nullzero,notnull,default:false"`
 }
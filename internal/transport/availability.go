@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// unavailableProbeInterval is how often a delivery to an instance which
+	// has been marked as unavailable is let through, to see if it's back.
+	unavailableProbeInterval = 6 * time.Hour
+
+	// deliverySuccessRecordInterval is how often a continued run of successful
+	// deliveries to an instance is recorded, to save writing on every delivery.
+	deliverySuccessRecordInterval = time.Hour
+)
+
+// inboxDomain returns the domain of the instance which the given inbox belongs to. This is
+// normally the inbox's host, but an instance may serve its inboxes from another host, in which
+// case the domain is looked up from the accounts which use the inbox. If there's nothing
+// known about the inbox, its host is returned.
+func (c *controller) inboxDomain(ctx context.Context, inbox *url.URL) string {
+	if _, err := c.state.DB.GetInstance(ctx, inbox.Host); err == nil {
+		return inbox.Host
+	}
+
+	instance, err := c.state.DB.GetInstanceByInboxURI(ctx, inbox.String())
+	if err != nil {
+		return inbox.Host
+	}
+
+	return instance.Domain
+}
+
+// deliveryDomain returns the domain of the instance which the given delivery is
+// to, falling back to the inbox host for deliveries queued without one.
+func deliveryDomain(delivery *gtsmodel.Delivery) string {
+	if delivery.Domain != "" {
+		return delivery.Domain
+	}
+	return delivery.Host
+}
+
+// deliveryAllowed returns whether delivery to an inbox on the given host, belonging to the instance
+// with the given domain, should be attempted; never if either is blocked, and if the instance has
+// been marked as unavailable, only an occasional probe is. A probe is claimed on the instance before
+// it's allowed, so only one caller gets to make it, and probe is returned true for that caller.
+func (c *controller) deliveryAllowed(ctx context.Context, host string, domain string) (allowed bool, probe bool) {
+	if err := c.checkHost(ctx, host); err != nil {
+		return false, false
+	}
+
+	if domain != host {
+		if err := c.checkHost(ctx, domain); err != nil {
+			return false, false
+		}
+	}
+
+	instance, err := c.state.DB.GetInstance(ctx, domain)
+	if err != nil {
+		// We don't know anything
+		// about this instance, so try.
+		return true, false
+	}
+
+	if instance.UnavailableAt.IsZero() {
+		return true, false
+	}
+
+	claimed, err := c.state.DB.ClaimInstanceProbe(ctx, instance, time.Now().Add(-unavailableProbeInterval))
+	if err != nil {
+		log.Errorf(ctx, "error claiming probe of instance %s: %v", domain, err)
+		return false, false
+	}
+
+	return claimed, claimed
+}
+
+// recordDelivery records the outcome of a delivery on the instance with the given domain,
+// marking the instance as unavailable if delivery has been failing for longer than the configured
+// window, or as available again on success. It returns whether the instance is now unavailable.
+func (c *controller) recordDelivery(ctx context.Context, domain string, deliveryErr error) bool {
+	instance, err := c.state.DB.GetInstance(ctx, domain)
+	if err != nil {
+		// Nowhere to record it.
+		return false
+	}
+
+	var (
+		now     = time.Now()
+		columns []string
+	)
+
+	if deliveryReachedHost(deliveryErr) {
+		if instance.DeliveryFailingSince.IsZero() &&
+			instance.UnavailableAt.IsZero() &&
+			now.Sub(instance.LastDeliverySuccessAt) < deliverySuccessRecordInterval {
+			// Nothing new to record.
+			return false
+		}
+
+		if !instance.UnavailableAt.IsZero() {
+			log.Infof(ctx, "marking instance %s as available again", domain)
+		}
+
+		instance.LastDeliverySuccessAt = now
+		instance.DeliveryFailingSince = time.Time{}
+		instance.UnavailableAt = time.Time{}
+		columns = []string{"last_delivery_success_at", "delivery_failing_since", "unavailable_at"}
+	} else {
+		instance.LastDeliveryFailureAt = now
+		columns = []string{"last_delivery_failure_at"}
+
+		if instance.DeliveryFailingSince.IsZero() {
+			instance.DeliveryFailingSince = now
+			columns = append(columns, "delivery_failing_since")
+		}
+
+		window := config.GetInstanceUnavailableAfter()
+		if instance.UnavailableAt.IsZero() && window > 0 &&
+			now.Sub(instance.DeliveryFailingSince) >= window {
+			log.Warnf(ctx, "marking instance %s as unavailable, delivery has been failing since %s", domain, instance.DeliveryFailingSince)
+			instance.UnavailableAt = now
+			columns = append(columns, "unavailable_at")
+		}
+	}
+
+	if err := c.state.DB.UpdateInstance(ctx, instance, columns...); err != nil {
+		log.Errorf(ctx, "error updating instance %s: %v", domain, err)
+	}
+
+	return !instance.UnavailableAt.IsZero()
+}
+
+// deliveryReachedHost returns whether the given delivery error (or lack
+// of one) shows that the receiving host is up, even if it rejected the delivery.
+func deliveryReachedHost(err error) bool {
+	if err == nil {
+		return true
+	}
+
+	code := gtserror.StatusCode(err)
+	return code >= 400 && code < 500
+}
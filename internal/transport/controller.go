@@ -34,6 +34,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 )
 
 // Controller generates transports for use in making federation requests to other servers.
@@ -43,6 +44,13 @@ type Controller interface {
 
 	// NewTransportForUsername searches for account with username, and returns result of .NewTransport().
 	NewTransportForUsername(ctx context.Context, username string) (Transport, error)
+
+	// ProcessDeliveryQueue hands every queued delivery which is due an attempt to the delivery worker pool.
+	ProcessDeliveryQueue(ctx context.Context) error
+
+	// WebPushSender returns a sender for delivering Web Push messages
+	// to push services, which uses the same http client as transports.
+	WebPushSender() webpush.Sender
 }
 
 type controller struct {
@@ -53,6 +61,7 @@ type controller struct {
 	trspCache cache.Cache[string, *transport]
 	badHosts  cache.Cache[string, struct{}]
 	userAgent string
+	webPush   webpush.Sender
 }
 
 // NewController returns an implementation of the Controller interface for creating new transports
@@ -72,6 +81,9 @@ func NewController(state *state.State, federatingDB federatingdb.DB, clock pub.C
 		userAgent: fmt.Sprintf("%s (+%s://%s) gotosocial/%s", applicationName, proto, host, version),
 	}
 
+	// Web Push messages are sent with the same client + user agent
+	c.webPush = webpush.NewSender(client, c.userAgent)
+
 	// Transport cache has TTL=1hr freq=1min
 	c.trspCache.SetTTL(time.Hour, false)
 	if !c.trspCache.Start(time.Minute) {
@@ -84,9 +96,16 @@ func NewController(state *state.State, federatingDB federatingdb.DB, clock pub.C
 		log.Panic(nil, "failed to start transport controller cache")
 	}
 
+	// Retry queued deliveries as they fall due
+	scheduleProcessDeliveryQueue(c)
+
 	return c
 }
 
+func (c *controller) WebPushSender() webpush.Sender {
+	return c.webPush
+}
+
 func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Transport, error) {
 	// Generate public key string for cache key
 	//
@@ -150,6 +169,22 @@ func (c *controller) NewTransportForUsername(ctx context.Context, username strin
 	return transport, nil
 }
 
+// checkHost returns an error if the given host is blocked, either explicitly
+// or by not being allowed when running in allowlist federation mode, so
+// that no requests are ever made to it.
+func (c *controller) checkHost(ctx context.Context, host string) error {
+	blocked, err := c.state.DB.IsDomainBlocked(ctx, host)
+	if err != nil {
+		return fmt.Errorf("error checking domain block for %s: %w", host, err)
+	}
+
+	if blocked {
+		return fmt.Errorf("host %s is blocked", host)
+	}
+
+	return nil
+}
+
 // dereferenceLocalFollowers is a shortcut to dereference followers of an
 // account on this instance, without making any external api/http calls.
 //
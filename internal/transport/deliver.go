@@ -22,46 +22,38 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
-	"sync"
+	"time"
 
 	"codeberg.org/gruf/go-byteutil"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*url.URL) error {
-	// concurrently deliver to recipients; for each delivery, buffer the error if it fails
-	wg := sync.WaitGroup{}
-	errCh := make(chan error, len(recipients))
-	for _, recipient := range recipients {
-		wg.Add(1)
-		go func(r *url.URL) {
-			defer wg.Done()
-			if err := t.Deliver(ctx, b, r); err != nil {
-				errCh <- err
+	// Rather than delivering straight away, persist a delivery per
+	// recipient inbox, so that failed deliveries can be retried with
+	// backoff, and pending ones survive a restart. The deliveries are
+	// then handed to the delivery worker pool.
+	deliveries, err := t.controller.queueDeliveries(ctx, t.pubKeyID, b, recipients)
+
+	for _, delivery := range deliveries {
+		delivery := delivery
+		if !t.controller.state.Workers.Delivery.EnqueueNow(func(ctx context.Context) {
+			t.controller.deliver(ctx, t, delivery)
+		}) {
+			// Worker queue is full (or stopped), so leave
+			// this one for the next run of the queue.
+			delivery.NextAttemptAt = time.Now()
+			if err := t.controller.state.DB.UpdateDelivery(ctx, delivery, "next_attempt_at"); err != nil {
+				log.Errorf(ctx, "error updating delivery %s: %v", delivery.ID, err)
 			}
-		}(recipient)
-	}
-
-	// wait until all deliveries have succeeded or failed
-	wg.Wait()
-
-	// receive any buffered errors
-	errs := make([]string, 0, len(errCh))
-outer:
-	for {
-		select {
-		case e := <-errCh:
-			errs = append(errs, e.Error())
-		default:
-			break outer
 		}
 	}
 
-	if len(errs) > 0 {
-		return fmt.Errorf("BatchDeliver: at least one failure: %s", strings.Join(errs, "; "))
+	if err != nil {
+		return fmt.Errorf("BatchDeliver: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,360 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DeliverTestSuite struct {
+	TransportTestSuite
+}
+
+func (suite *DeliverTestSuite) TestBatchDeliverQueue() {
+	ctx := context.Background()
+
+	var (
+		delivered   = make(map[string]int)
+		deliveredMu sync.Mutex
+	)
+
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		deliveredMu.Lock()
+		delivered[req.URL.String()]++
+		deliveredMu.Unlock()
+
+		code := http.StatusAccepted
+		switch req.URL.Host {
+		case "down.example.org":
+			code = http.StatusServiceUnavailable
+		case "gone.example.org":
+			code = http.StatusGone
+		}
+
+		return &http.Response{
+			StatusCode: code,
+			Status:     http.StatusText(code),
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}, "../../testrig/media")
+
+	controller := testrig.NewTestTransportController(&suite.state, httpClient)
+	tsport, err := controller.NewTransportForUsername(ctx, "the_mighty_zork")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recipients := []*url.URL{
+		testrig.URLMustParse("https://up.example.org/inbox"),
+		testrig.URLMustParse("https://down.example.org/inbox"),
+		testrig.URLMustParse("https://gone.example.org/inbox"),
+		testrig.URLMustParse("https://up.example.org/inbox"),                   // duplicate
+		testrig.URLMustParse("http://localhost:8080/users/1happyturtle/inbox"), // local
+	}
+	activity := []byte(`{"id":"http://localhost:8080/users/the_mighty_zork/activity/01H0XYZ","type":"Create"}`)
+
+	suite.NoError(tsport.BatchDeliver(ctx, activity, recipients))
+
+	// Once the worker has had a go at all the deliveries,
+	// only the delivery to the down host should be left.
+	if !testrig.WaitFor(func() bool {
+		total, failing, err := suite.db.CountDeliveries(ctx)
+		return err == nil && total == 1 && failing == 1
+	}) {
+		suite.FailNow("timed out waiting for deliveries")
+	}
+
+	deliveredMu.Lock()
+	suite.Equal(map[string]int{
+		"https://up.example.org/inbox":   1,
+		"https://down.example.org/inbox": 1,
+		"https://gone.example.org/inbox": 1,
+	}, delivered)
+	deliveredMu.Unlock()
+
+	hosts, err := suite.db.GetDeliveryHosts(ctx, 10)
+	suite.NoError(err)
+	suite.Len(hosts, 1)
+	suite.Equal("down.example.org", hosts[0].Host)
+	suite.Equal(1, hosts[0].Deliveries)
+
+	// The failed delivery should be backed off, and
+	// sending the same activity again shouldn't queue
+	// another delivery to the same inbox.
+	suite.NoError(tsport.BatchDeliver(ctx, activity, recipients[1:2]))
+
+	deliveries, err := suite.db.GetDueDeliveries(ctx, time.Now().Add(time.Hour), 0)
+	suite.NoError(err)
+	suite.Len(deliveries, 1)
+
+	delivery := deliveries[0]
+	suite.Equal("https://down.example.org/inbox", delivery.InboxURI)
+	suite.Equal(1, delivery.Attempts)
+	suite.NotEmpty(delivery.LastError)
+	suite.WithinDuration(time.Now().Add(time.Minute), delivery.NextAttemptAt, 10*time.Second)
+
+	// Make the delivery due, and process the queue,
+	// which should retry and back off some more.
+	delivery.NextAttemptAt = time.Now().Add(-time.Second)
+	suite.NoError(suite.db.UpdateDelivery(ctx, delivery, "next_attempt_at"))
+	suite.NoError(controller.ProcessDeliveryQueue(ctx))
+
+	if !testrig.WaitFor(func() bool {
+		delivery, err = suite.db.GetDeliveryByID(ctx, delivery.ID)
+		return err == nil && delivery.Attempts == 2
+	}) {
+		suite.FailNow("timed out waiting for retry")
+	}
+	suite.WithinDuration(time.Now().Add(2*time.Minute), delivery.NextAttemptAt, 10*time.Second)
+
+	deliveredMu.Lock()
+	suite.Equal(2, delivered["https://down.example.org/inbox"])
+	deliveredMu.Unlock()
+}
+
+func (suite *DeliverTestSuite) TestUnavailableInstance() {
+	ctx := context.Background()
+
+	var (
+		down        = true
+		delivered   int
+		deliveredMu sync.Mutex
+	)
+
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		deliveredMu.Lock()
+		defer deliveredMu.Unlock()
+		delivered++
+
+		code := http.StatusAccepted
+		if down {
+			code = http.StatusBadGateway
+		}
+
+		return &http.Response{
+			StatusCode: code,
+			Status:     http.StatusText(code),
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}, "../../testrig/media")
+
+	controller := testrig.NewTestTransportController(&suite.state, httpClient)
+	tsport, err := controller.NewTransportForUsername(ctx, "the_mighty_zork")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Delivery to example.org has been failing for longer than the window.
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	instance.DeliveryFailingSince = time.Now().Add(-8 * 24 * time.Hour)
+	instance.LastDeliveryFailureAt = time.Now().Add(-time.Hour)
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "delivery_failing_since", "last_delivery_failure_at"))
+
+	recipients := []*url.URL{testrig.URLMustParse("https://example.org/inbox")}
+
+	// This delivery fails, so the instance should
+	// be marked as unavailable, and the delivery
+	// dropped instead of being retried.
+	suite.NoError(tsport.BatchDeliver(ctx, []byte(`{"id":"https://localhost:8080/activity/1"}`), recipients))
+
+	if !testrig.WaitFor(func() bool {
+		instance, err = suite.db.GetInstance(ctx, "example.org")
+		return err == nil && !instance.UnavailableAt.IsZero()
+	}) {
+		suite.FailNow("timed out waiting for instance to be marked unavailable")
+	}
+
+	if !testrig.WaitFor(func() bool {
+		total, _, err := suite.db.CountDeliveries(ctx)
+		return err == nil && total == 0
+	}) {
+		suite.FailNow("timed out waiting for delivery to be dropped")
+	}
+
+	// Further deliveries should be skipped altogether.
+	suite.NoError(tsport.BatchDeliver(ctx, []byte(`{"id":"https://localhost:8080/activity/2"}`), recipients))
+	total, _, err := suite.db.CountDeliveries(ctx)
+	suite.NoError(err)
+	suite.Zero(total)
+
+	deliveredMu.Lock()
+	suite.Equal(1, delivered)
+	down = false
+	deliveredMu.Unlock()
+
+	// Until it's time for a probe, which succeeds
+	// and marks the instance as available again.
+	instance.LastDeliveryFailureAt = time.Now().Add(-7 * time.Hour)
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "last_delivery_failure_at"))
+	suite.NoError(tsport.BatchDeliver(ctx, []byte(`{"id":"https://localhost:8080/activity/3"}`), recipients))
+
+	if !testrig.WaitFor(func() bool {
+		instance, err = suite.db.GetInstance(ctx, "example.org")
+		return err == nil && instance.UnavailableAt.IsZero()
+	}) {
+		suite.FailNow("timed out waiting for instance to be marked available")
+	}
+	suite.Zero(instance.DeliveryFailingSince)
+	suite.NotZero(instance.LastDeliverySuccessAt)
+
+	deliveredMu.Lock()
+	suite.Equal(2, delivered)
+	deliveredMu.Unlock()
+}
+
+func (suite *DeliverTestSuite) TestUnavailableInstanceProbedOnce() {
+	ctx := context.Background()
+
+	var (
+		delivered   int
+		deliveredMu sync.Mutex
+	)
+
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		deliveredMu.Lock()
+		delivered++
+		deliveredMu.Unlock()
+
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Status:     http.StatusText(http.StatusBadGateway),
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}, "../../testrig/media")
+
+	controller := testrig.NewTestTransportController(&suite.state, httpClient)
+	tsport, err := controller.NewTransportForUsername(ctx, "the_mighty_zork")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// example.org is unavailable, and due a probe.
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	instance.DeliveryFailingSince = time.Now().Add(-8 * 24 * time.Hour)
+	instance.UnavailableAt = time.Now().Add(-24 * time.Hour)
+	instance.LastDeliveryFailureAt = time.Now().Add(-7 * time.Hour)
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "delivery_failing_since", "unavailable_at", "last_delivery_failure_at"))
+
+	// Only one of these deliveries should be let through as
+	// the probe, and nothing sent after it while it's claimed.
+	suite.NoError(tsport.BatchDeliver(ctx, []byte(`{"id":"https://localhost:8080/activity/1"}`), []*url.URL{
+		testrig.URLMustParse("https://example.org/inbox"),
+		testrig.URLMustParse("https://example.org/users/Some_User/inbox"),
+	}))
+	suite.NoError(tsport.BatchDeliver(ctx, []byte(`{"id":"https://localhost:8080/activity/2"}`), []*url.URL{
+		testrig.URLMustParse("https://example.org/inbox"),
+	}))
+
+	if !testrig.WaitFor(func() bool {
+		total, _, err := suite.db.CountDeliveries(ctx)
+		return err == nil && total == 0
+	}) {
+		suite.FailNow("timed out waiting for probe to be dropped")
+	}
+
+	instance, err = suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	suite.NotZero(instance.UnavailableAt)
+	suite.WithinDuration(time.Now(), instance.LastDeliveryFailureAt, 10*time.Second)
+
+	deliveredMu.Lock()
+	suite.Equal(1, delivered)
+	deliveredMu.Unlock()
+}
+
+func (suite *DeliverTestSuite) TestUnavailableInstanceInboxOnOtherHost() {
+	ctx := context.Background()
+
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Status:     http.StatusText(http.StatusBadGateway),
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}, "../../testrig/media")
+
+	controller := testrig.NewTestTransportController(&suite.state, httpClient)
+	tsport, err := controller.NewTransportForUsername(ctx, "the_mighty_zork")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// example.org serves its shared inbox from another host.
+	account := suite.testAccounts["remote_account_2"]
+	sharedInbox := "https://inbox.example.net/inbox"
+	account.SharedInboxURI = &sharedInbox
+	suite.NoError(suite.db.UpdateAccount(ctx, account))
+
+	// Delivery to example.org has been failing for longer than the window.
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	instance.DeliveryFailingSince = time.Now().Add(-8 * 24 * time.Hour)
+	instance.LastDeliveryFailureAt = time.Now().Add(-time.Hour)
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "delivery_failing_since", "last_delivery_failure_at"))
+
+	// This delivery fails, so example.org should be
+	// marked as unavailable, even though the inbox
+	// isn't on example.org itself.
+	suite.NoError(tsport.BatchDeliver(ctx, []byte(`{"id":"https://localhost:8080/activity/1"}`), []*url.URL{
+		testrig.URLMustParse(sharedInbox),
+	}))
+
+	if !testrig.WaitFor(func() bool {
+		instance, err = suite.db.GetInstance(ctx, "example.org")
+		return err == nil && !instance.UnavailableAt.IsZero()
+	}) {
+		suite.FailNow("timed out waiting for instance to be marked unavailable")
+	}
+
+	if !testrig.WaitFor(func() bool {
+		total, _, err := suite.db.CountDeliveries(ctx)
+		return err == nil && total == 0
+	}) {
+		suite.FailNow("timed out waiting for delivery to be dropped")
+	}
+
+	// Further deliveries to the shared inbox should be skipped.
+	suite.NoError(tsport.BatchDeliver(ctx, []byte(`{"id":"https://localhost:8080/activity/2"}`), []*url.URL{
+		testrig.URLMustParse(sharedInbox),
+	}))
+	total, _, err := suite.db.CountDeliveries(ctx)
+	suite.NoError(err)
+	suite.Zero(total)
+}
+
+func TestDeliverTestSuite(t *testing.T) {
+	suite.Run(t, &DeliverTestSuite{})
+}
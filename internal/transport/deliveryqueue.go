@@ -0,0 +1,382 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"codeberg.org/gruf/go-runners"
+	"codeberg.org/gruf/go-sched"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// deliveryLease is how long a delivery handed to the delivery
+	// worker pool is hidden from the queue, in case the attempt is
+	// interrupted (eg., by a restart) before it can be rescheduled.
+	deliveryLease = 15 * time.Minute
+
+	// deliveryBaseBackoff is the backoff after the first
+	// failed attempt, which doubles on each further failure.
+	deliveryBaseBackoff = time.Minute
+
+	// deliveryMaxBackoff is the maximum backoff between attempts.
+	deliveryMaxBackoff = 12 * time.Hour
+
+	// deliveryMaxAge is how long delivery is retried
+	// for, before the delivery is given up on.
+	deliveryMaxAge = 5 * 24 * time.Hour
+
+	// deliveryQueuePage is how many due deliveries
+	// are fetched from the queue at a time.
+	deliveryQueuePage = 100
+
+	// processDeliveryQueueInterval is how often queued
+	// deliveries which are due a retry are picked up.
+	processDeliveryQueueInterval = time.Minute
+)
+
+// queueDeliveries persists one delivery of b per unique recipient inbox, skipping inboxes on
+// this instance, inboxes on instances which are unavailable, and inboxes to which the same
+// activity is already queued for delivery.
+func (c *controller) queueDeliveries(ctx context.Context, pubKeyID string, b []byte, recipients []*url.URL) ([]*gtsmodel.Delivery, error) {
+	var activity struct {
+		ID string `json:"id"`
+	}
+
+	// The id is only used for deduplication,
+	// so not being able to get it is fine.
+	_ = json.Unmarshal(b, &activity)
+
+	var (
+		now        = time.Now()
+		deliveries = make([]*gtsmodel.Delivery, 0, len(recipients))
+		seen       = make(map[string]struct{}, len(recipients))
+		domains    = make(map[string]string)
+		allowed    = make(map[string]bool)
+		errs       gtserror.MultiError
+	)
+
+	for _, recipient := range recipients {
+		// if the recipient host is our own, just skip this delivery since we by definition already have the message!
+		if recipient.Host == config.GetHost() || recipient.Host == config.GetAccountDomain() {
+			continue
+		}
+
+		inboxURI := recipient.String()
+		if _, ok := seen[inboxURI]; ok {
+			continue
+		}
+		seen[inboxURI] = struct{}{}
+
+		domain, checked := domains[recipient.Host]
+		if !checked {
+			domain = c.inboxDomain(ctx, recipient)
+			if domain == recipient.Host {
+				// Only cache hosts which are an instance's own
+				// domain; other inboxes on the same host may
+				// belong to different instances.
+				domains[recipient.Host] = domain
+			}
+		}
+
+		key := recipient.Host + " " + domain
+		ok, checked := allowed[key]
+		if !checked {
+			var probe bool
+			ok, probe = c.deliveryAllowed(ctx, recipient.Host, domain)
+
+			// If this is a probe of an unavailable
+			// instance, only let this delivery through.
+			allowed[key] = ok && !probe
+		}
+
+		if !ok {
+			log.Debugf(ctx, "skipping delivery to %s, instance is blocked or unavailable", inboxURI)
+			continue
+		}
+
+		if activity.ID != "" {
+			queued, err := c.state.DB.IsDeliveryQueued(ctx, activity.ID, inboxURI)
+			if err != nil {
+				errs.Appendf("error checking delivery queue for %s: %v", inboxURI, err)
+				continue
+			}
+
+			if queued {
+				// Already on its way (eg., the same
+				// activity was sent to a shared inbox).
+				continue
+			}
+		}
+
+		delivery := &gtsmodel.Delivery{
+			ID:         id.NewULID(),
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			PubKeyID:   pubKeyID,
+			ActivityID: activity.ID,
+			InboxURI:   inboxURI,
+			Host:       recipient.Host,
+			Domain:     domain,
+			Body:       b,
+
+			// Hidden from the queue while the first
+			// attempt is made by the delivery worker pool.
+			NextAttemptAt: now.Add(deliveryLease),
+		}
+
+		if err := c.state.DB.PutDelivery(ctx, delivery); err != nil {
+			errs.Appendf("error queueing delivery to %s: %v", inboxURI, err)
+			continue
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, errs.Combine()
+}
+
+// ProcessDeliveryQueue hands every queued delivery which is due an attempt to the delivery worker pool.
+func (c *controller) ProcessDeliveryQueue(ctx context.Context) error {
+	var (
+		// Transports for each sending public key,
+		// to save looking up the same account.
+		transports = make(map[string]*transport)
+
+		// Whether delivery to each host and domain is allowed.
+		allowed = make(map[string]bool)
+	)
+
+	for {
+		now := time.Now()
+
+		deliveries, err := c.state.DB.GetDueDeliveries(ctx, now, deliveryQueuePage)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				return nil
+			}
+			return fmt.Errorf("ProcessDeliveryQueue: db error getting due deliveries: %w", err)
+		}
+
+		for _, delivery := range deliveries {
+			key := delivery.Host + " " + deliveryDomain(delivery)
+			ok, checked := allowed[key]
+			if !checked {
+				var probe bool
+				ok, probe = c.deliveryAllowed(ctx, delivery.Host, deliveryDomain(delivery))
+
+				// If this is a probe of an unavailable
+				// instance, only let this delivery through.
+				allowed[key] = ok && !probe
+			}
+
+			if !ok {
+				// The instance has been blocked or marked as
+				// unavailable since this delivery was queued, so drop it.
+				log.Debugf(ctx, "dropping delivery %s to %s, instance is blocked or unavailable", delivery.ID, delivery.InboxURI)
+				if err := c.state.DB.DeleteDeliveryByID(ctx, delivery.ID); err != nil {
+					return fmt.Errorf("ProcessDeliveryQueue: db error deleting delivery: %w", err)
+				}
+				continue
+			}
+
+			t, ok := transports[delivery.PubKeyID]
+			if !ok {
+				t, err = c.transportForPubKeyID(ctx, delivery.PubKeyID)
+				if err != nil {
+					// The sending account is gone, so there's no way to
+					// sign the request; there's nothing to do but drop it.
+					log.Warnf(ctx, "dropping delivery %s to %s: %v", delivery.ID, delivery.InboxURI, err)
+					if err := c.state.DB.DeleteDeliveryByID(ctx, delivery.ID); err != nil {
+						return fmt.Errorf("ProcessDeliveryQueue: db error deleting delivery: %w", err)
+					}
+					continue
+				}
+				transports[delivery.PubKeyID] = t
+			}
+
+			// Hide the delivery from the queue while it's attempted.
+			due := delivery.NextAttemptAt
+			delivery.NextAttemptAt = now.Add(deliveryLease)
+			if err := c.state.DB.UpdateDelivery(ctx, delivery, "next_attempt_at"); err != nil {
+				return fmt.Errorf("ProcessDeliveryQueue: db error updating delivery: %w", err)
+			}
+
+			delivery := delivery
+			if !c.state.Workers.Delivery.EnqueueNow(func(ctx context.Context) {
+				c.deliver(ctx, t, delivery)
+			}) {
+				// Worker queue is full (or stopped), so put this
+				// delivery back and leave the rest for next time.
+				delivery.NextAttemptAt = due
+				if err := c.state.DB.UpdateDelivery(ctx, delivery, "next_attempt_at"); err != nil {
+					return fmt.Errorf("ProcessDeliveryQueue: db error updating delivery: %w", err)
+				}
+				return nil
+			}
+		}
+
+		if len(deliveries) < deliveryQueuePage {
+			// That's everything due.
+			return nil
+		}
+	}
+}
+
+// deliver attempts the given queued delivery using the given transport. On success (or
+// permanent failure, or if the instance is now unavailable) the delivery is removed from
+// the queue; otherwise it's rescheduled with exponential backoff, until it's been retried
+// for longer than deliveryMaxAge.
+func (c *controller) deliver(ctx context.Context, t *transport, delivery *gtsmodel.Delivery) {
+	to, err := url.Parse(delivery.InboxURI)
+	if err != nil {
+		// Can't ever succeed.
+		log.Errorf(ctx, "dropping delivery %s: error parsing inbox uri: %v", delivery.ID, err)
+		c.deleteDelivery(ctx, delivery)
+		return
+	}
+
+	// The queue takes care of retries, so
+	// don't hold up a worker backing off.
+	err = t.Deliver(WithFastfail(ctx), delivery.Body, to)
+
+	if err != nil && ctx.Err() != nil {
+		// Worker pool is stopping; the delivery will
+		// be picked up again once its lease expires.
+		return
+	}
+
+	unavailable := c.recordDelivery(ctx, deliveryDomain(delivery), err)
+
+	if err == nil {
+		c.deleteDelivery(ctx, delivery)
+		return
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = now
+	delivery.LastError = err.Error()
+
+	if deliveryFailedPermanently(err) {
+		log.Warnf(ctx, "dropping delivery %s to %s: %v", delivery.ID, delivery.InboxURI, err)
+		c.deleteDelivery(ctx, delivery)
+		return
+	}
+
+	if unavailable {
+		log.Infof(ctx, "dropping delivery %s to %s, instance is unavailable: %v", delivery.ID, delivery.InboxURI, err)
+		c.deleteDelivery(ctx, delivery)
+		return
+	}
+
+	if now.Sub(delivery.CreatedAt) > deliveryMaxAge {
+		log.Warnf(ctx, "dropping delivery %s to %s after %d attempts: %v", delivery.ID, delivery.InboxURI, delivery.Attempts, err)
+		c.deleteDelivery(ctx, delivery)
+		return
+	}
+
+	delivery.NextAttemptAt = now.Add(deliveryBackoff(delivery.Attempts))
+	log.Infof(ctx, "delivery %s to %s failed, retrying at %s: %v", delivery.ID, delivery.InboxURI, delivery.NextAttemptAt, err)
+
+	if err := c.state.DB.UpdateDelivery(ctx, delivery,
+		"attempts",
+		"last_attempt_at",
+		"last_error",
+		"next_attempt_at",
+	); err != nil {
+		log.Errorf(ctx, "error updating delivery %s: %v", delivery.ID, err)
+	}
+}
+
+func (c *controller) deleteDelivery(ctx context.Context, delivery *gtsmodel.Delivery) {
+	if err := c.state.DB.DeleteDeliveryByID(ctx, delivery.ID); err != nil {
+		log.Errorf(ctx, "error deleting delivery %s: %v", delivery.ID, err)
+	}
+}
+
+// transportForPubKeyID returns a transport for the local account with the given public key.
+func (c *controller) transportForPubKeyID(ctx context.Context, pubKeyID string) (*transport, error) {
+	account, err := c.state.DB.GetAccountByPubkeyID(ctx, pubKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account with public key %s: %w", pubKeyID, err)
+	}
+
+	if account.PrivateKey == nil {
+		return nil, fmt.Errorf("account with public key %s has no private key", pubKeyID)
+	}
+
+	t, err := c.NewTransport(account.PublicKeyURI, account.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.(*transport), nil
+}
+
+// deliveryBackoff returns how long to wait before the
+// next attempt, after the given number of failed attempts.
+func deliveryBackoff(attempts int) time.Duration {
+	backoff := deliveryBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= deliveryMaxBackoff {
+			return deliveryMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// deliveryFailedPermanently returns whether the given delivery error
+// indicates that retrying delivery later won't make any difference.
+func deliveryFailedPermanently(err error) bool {
+	switch code := gtserror.StatusCode(err); {
+	case code == http.StatusRequestTimeout,
+		code == http.StatusTooManyRequests:
+		return false
+	case code >= 400 && code < 500:
+		return true
+	default:
+		return false
+	}
+}
+
+func scheduleProcessDeliveryQueue(c *controller) {
+	// Get ctx associated with scheduler run state.
+	done := c.state.Workers.Scheduler.Done()
+	doneCtx := runners.CancelCtx(done)
+
+	// Schedule the ProcessDeliveryQueue task to execute every minute.
+	c.state.Workers.Scheduler.Schedule(sched.NewJob(func(now time.Time) {
+		if err := c.ProcessDeliveryQueue(doneCtx); err != nil {
+			log.Errorf(nil, "error processing delivery queue: %v", err)
+		}
+	}).Every(processDeliveryQueueInterval))
+}
@@ -0,0 +1,56 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+func (t *transport) DereferenceDomainBlocks(ctx context.Context, iri *url.URL, contentType string) (io.ReadCloser, error) {
+	// Build IRI just once
+	iriStr := iri.String()
+
+	// Prepare HTTP request to this list's IRI
+	req, err := http.NewRequestWithContext(ctx, "GET", iriStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", contentType+", */*;q=0.5") // lists are often served as plain text, whatever their format
+	req.Header.Set("Host", iri.Host)
+
+	// Perform the HTTP request
+	rsp, err := t.GET(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for an expected status code
+	if rsp.StatusCode != http.StatusOK {
+		rsp.Body.Close()
+		err := fmt.Errorf("GET request to %s failed: %s", iriStr, rsp.Status)
+		return nil, gtserror.WithStatusCode(err, rsp.StatusCode)
+	}
+
+	return rsp.Body, nil
+}
@@ -45,6 +45,11 @@ func (t *transport) Dereference(ctx context.Context, iri *url.URL) ([]byte, erro
 		}
 	}
 
+	// Make sure we're allowed to talk to this host
+	if err := t.controller.checkHost(ctx, iri.Host); err != nil {
+		return nil, err
+	}
+
 	// Build IRI just once
 	iriStr := iri.String()
 
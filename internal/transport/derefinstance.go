@@ -41,6 +41,11 @@ func (t *transport) DereferenceInstance(ctx context.Context, iri *url.URL) (*gts
 	var i *gtsmodel.Instance
 	var err error
 
+	// Make sure we're allowed to talk to this host
+	if err := t.controller.checkHost(ctx, iri.Host); err != nil {
+		return nil, err
+	}
+
 	// First try to dereference using /api/v1/instance.
 	// This will provide the most complete picture of an instance, and avoid unnecessary api calls.
 	//
@@ -28,6 +28,11 @@ import (
 )
 
 func (t *transport) DereferenceMedia(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, error) {
+	// Make sure we're allowed to talk to this host
+	if err := t.controller.checkHost(ctx, iri.Host); err != nil {
+		return nil, 0, err
+	}
+
 	// Build IRI just once
 	iriStr := iri.String()
 
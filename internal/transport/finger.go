@@ -67,6 +67,11 @@ func prepWebfingerReq(ctx context.Context, loc, domain, username string) (*http.
 }
 
 func (t *transport) Finger(ctx context.Context, targetUsername string, targetDomain string) ([]byte, error) {
+	// Make sure we're allowed to talk to this host
+	if err := t.controller.checkHost(ctx, targetDomain); err != nil {
+		return nil, err
+	}
+
 	// Generate new GET request
 	url, cached := t.webfingerURLFor(targetDomain)
 	req, err := prepWebfingerReq(ctx, url, targetDomain, targetUsername)
@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// signatureScheme returns the http signature scheme to sign requests to the given
+// host with, along with the scheme currently recorded for the host's instance (if any).
+//
+// RFC 9421 is only used if enabled in the config, and the host's instance hasn't
+// previously been recorded as only accepting draft-cavage signatures.
+func (c *controller) signatureScheme(ctx context.Context, host string) (use gtsmodel.SignatureScheme, recorded gtsmodel.SignatureScheme) {
+	if !config.GetInstanceSignRFC9421() {
+		return gtsmodel.SignatureSchemeCavage, ""
+	}
+
+	instance, err := c.state.DB.GetInstance(ctx, host)
+	if err == nil {
+		recorded = instance.SignatureScheme
+	}
+
+	if recorded == gtsmodel.SignatureSchemeCavage {
+		return gtsmodel.SignatureSchemeCavage, recorded
+	}
+
+	return gtsmodel.SignatureSchemeRFC9421, recorded
+}
+
+// recordSignatureScheme records on the given host's instance
+// that it accepts requests signed with the given scheme.
+func (c *controller) recordSignatureScheme(ctx context.Context, host string, scheme gtsmodel.SignatureScheme) {
+	if !config.GetInstanceSignRFC9421() {
+		// Only relevant
+		// when opted in.
+		return
+	}
+
+	instance, err := c.state.DB.GetInstance(ctx, host)
+	if err != nil {
+		// Nowhere to record it.
+		return
+	}
+
+	if instance.SignatureScheme == scheme {
+		// Nothing new to record.
+		return
+	}
+
+	instance.SignatureScheme = scheme
+	if err := c.state.DB.UpdateInstance(ctx, instance, "signature_scheme"); err != nil {
+		log.Errorf(ctx, "error updating instance %s: %v", host, err)
+	}
+}
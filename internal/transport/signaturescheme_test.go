@@ -0,0 +1,133 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/rfc9421"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SignatureSchemeTestSuite struct {
+	TransportTestSuite
+}
+
+func (suite *SignatureSchemeTestSuite) TestDoubleKnock() {
+	ctx := context.Background()
+	config.SetInstanceSignRFC9421(true)
+
+	var (
+		schemes   []gtsmodel.SignatureScheme
+		schemesMu sync.Mutex
+	)
+
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		schemesMu.Lock()
+		defer schemesMu.Unlock()
+
+		// example.org only understands draft-cavage signatures.
+		code := http.StatusOK
+		if rfc9421.Signed(req) {
+			schemes = append(schemes, gtsmodel.SignatureSchemeRFC9421)
+			if req.URL.Host == "example.org" {
+				code = http.StatusUnauthorized
+			}
+		} else {
+			schemes = append(schemes, gtsmodel.SignatureSchemeCavage)
+		}
+
+		return &http.Response{
+			StatusCode: code,
+			Status:     http.StatusText(code),
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{}`))),
+		}, nil
+	}, "../../testrig/media")
+
+	controller := testrig.NewTestTransportController(&suite.state, httpClient)
+	tsport, err := controller.NewTransportForUsername(ctx, "the_mighty_zork")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// First request to example.org should be
+	// retried with draft-cavage after a 401.
+	_, err = tsport.Dereference(ctx, testrig.URLMustParse("https://example.org/users/someone"))
+	suite.NoError(err)
+	suite.Equal([]gtsmodel.SignatureScheme{gtsmodel.SignatureSchemeRFC9421, gtsmodel.SignatureSchemeCavage}, schemes)
+
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	suite.Equal(gtsmodel.SignatureSchemeCavage, instance.SignatureScheme)
+
+	// Next request should go straight to draft-cavage.
+	schemes = nil
+	_, err = tsport.Dereference(ctx, testrig.URLMustParse("https://example.org/users/someone"))
+	suite.NoError(err)
+	suite.Equal([]gtsmodel.SignatureScheme{gtsmodel.SignatureSchemeCavage}, schemes)
+
+	// fossbros-anonymous.io accepts RFC 9421.
+	schemes = nil
+	_, err = tsport.Dereference(ctx, testrig.URLMustParse("https://fossbros-anonymous.io/users/foss_satan"))
+	suite.NoError(err)
+	suite.Equal([]gtsmodel.SignatureScheme{gtsmodel.SignatureSchemeRFC9421}, schemes)
+
+	instance, err = suite.db.GetInstance(ctx, "fossbros-anonymous.io")
+	suite.NoError(err)
+	suite.Equal(gtsmodel.SignatureSchemeRFC9421, instance.SignatureScheme)
+}
+
+func (suite *SignatureSchemeTestSuite) TestCavageByDefault() {
+	ctx := context.Background()
+
+	var signedRFC9421 bool
+	httpClient := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		signedRFC9421 = rfc9421.Signed(req)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     http.StatusText(http.StatusOK),
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{}`))),
+		}, nil
+	}, "../../testrig/media")
+
+	controller := testrig.NewTestTransportController(&suite.state, httpClient)
+	tsport, err := controller.NewTransportForUsername(ctx, "the_mighty_zork")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = tsport.Dereference(ctx, testrig.URLMustParse("https://example.org/users/someone"))
+	suite.NoError(err)
+	suite.False(signedRFC9421)
+
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	suite.Empty(instance.SignatureScheme)
+}
+
+func TestSignatureSchemeTestSuite(t *testing.T) {
+	suite.Run(t, &SignatureSchemeTestSuite{})
+}
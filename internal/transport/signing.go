@@ -21,6 +21,10 @@ import (
 	"github.com/go-fed/httpsig"
 )
 
+// signatureExpiry is how long, in
+// seconds, our http signatures are valid.
+const signatureExpiry = 120
+
 var (
 	// http signer preferences
 	prefs       = []httpsig.Algorithm{httpsig.RSA_SHA256}
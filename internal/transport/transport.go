@@ -40,6 +40,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/rfc9421"
 )
 
 // Transport implements the pub.Transport interface with some additional functionality for fetching remote media.
@@ -71,6 +72,9 @@ type Transport interface {
 	// DereferenceMedia fetches the given media attachment IRI, returning the reader and filesize.
 	DereferenceMedia(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, error)
 
+	// DereferenceDomainBlocks fetches the list of domains to block located at this IRI, returning the reader.
+	DereferenceDomainBlocks(ctx context.Context, iri *url.URL, contentType string) (io.ReadCloser, error)
+
 	// DereferenceInstance dereferences remote instance information, first by checking /api/v1/instance, and then by checking /.well-known/nodeinfo.
 	DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error)
 
@@ -95,8 +99,8 @@ func (t *transport) GET(r *http.Request) (*http.Response, error) {
 	if r.Method != http.MethodGet {
 		return nil, errors.New("must be GET request")
 	}
-	return t.do(r, func(r *http.Request) error {
-		return t.signGET(r)
+	return t.do(r, func(r *http.Request, scheme gtsmodel.SignatureScheme) error {
+		return t.signGET(r, scheme)
 	})
 }
 
@@ -105,12 +109,12 @@ func (t *transport) POST(r *http.Request, body []byte) (*http.Response, error) {
 	if r.Method != http.MethodPost {
 		return nil, errors.New("must be POST request")
 	}
-	return t.do(r, func(r *http.Request) error {
-		return t.signPOST(r, body)
+	return t.do(r, func(r *http.Request, scheme gtsmodel.SignatureScheme) error {
+		return t.signPOST(r, body, scheme)
 	})
 }
 
-func (t *transport) do(r *http.Request, signer func(*http.Request) error) (*http.Response, error) {
+func (t *transport) do(r *http.Request, signer func(*http.Request, gtsmodel.SignatureScheme) error) (*http.Response, error) {
 	const (
 		// max no. attempts
 		maxRetries = 5
@@ -144,6 +148,9 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error) (*http
 
 	r.Header.Set("User-Agent", t.controller.userAgent)
 
+	// Determine which http signature scheme to sign with.
+	scheme, recorded := t.controller.signatureScheme(r.Context(), r.URL.Host)
+
 	for i := 0; i < maxRetries; i++ {
 		var backoff time.Duration
 
@@ -151,7 +158,9 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error) (*http
 		now := t.controller.clock.Now().UTC()
 		r.Header.Set("Date", now.Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
 		r.Header.Del("Signature")
+		r.Header.Del("Signature-Input")
 		r.Header.Del("Digest")
+		r.Header.Del("Content-Digest")
 
 		// Rewind body reader and content-length if set.
 		if rc, ok := r.Body.(*byteutil.ReadNopCloser); ok {
@@ -160,7 +169,7 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error) (*http
 		}
 
 		// Perform request signing
-		if err := signer(r); err != nil {
+		if err := signer(r, scheme); err != nil {
 			return nil, err
 		}
 
@@ -174,6 +183,23 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error) (*http
 			// 500 generally indicate temp. outages.
 			if code := rsp.StatusCode; code < 500 &&
 				code != http.StatusTooManyRequests {
+				if code == http.StatusUnauthorized &&
+					scheme == gtsmodel.SignatureSchemeRFC9421 {
+					// The remote may not understand RFC 9421
+					// signatures, so knock again with a draft-cavage
+					// signature. This doesn't count as a retry.
+					_ = rsp.Body.Close()
+					l.Info("retrying with draft-cavage http signature after 401 response")
+					scheme = gtsmodel.SignatureSchemeCavage
+					i--
+					continue
+				}
+
+				if code != http.StatusUnauthorized && scheme != recorded {
+					// Remember which scheme the remote accepts.
+					t.controller.recordSignatureScheme(r.Context(), r.URL.Host, scheme)
+				}
+
 				return rsp, nil
 			}
 
@@ -246,16 +272,22 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error) (*http
 	return nil, errors.New("transport reached max retries")
 }
 
-// signGET will safely sign an HTTP GET request.
-func (t *transport) signGET(r *http.Request) (err error) {
+// signGET will safely sign an HTTP GET request with the given signature scheme.
+func (t *transport) signGET(r *http.Request, scheme gtsmodel.SignatureScheme) (err error) {
+	if scheme == gtsmodel.SignatureSchemeRFC9421 {
+		return rfc9421.SignRequest(t.privkey, t.pubKeyID, r, nil, signatureExpiry)
+	}
 	t.safesign(func() {
 		err = t.getSigner.SignRequest(t.privkey, t.pubKeyID, r, nil)
 	})
 	return
 }
 
-// signPOST will safely sign an HTTP POST request for given body.
-func (t *transport) signPOST(r *http.Request, body []byte) (err error) {
+// signPOST will safely sign an HTTP POST request for given body with the given signature scheme.
+func (t *transport) signPOST(r *http.Request, body []byte, scheme gtsmodel.SignatureScheme) (err error) {
+	if scheme == gtsmodel.SignatureSchemeRFC9421 {
+		return rfc9421.SignRequest(t.privkey, t.pubKeyID, r, body, signatureExpiry)
+	}
 	t.safesign(func() {
 		err = t.postSigner.SignRequest(t.privkey, t.pubKeyID, r, body)
 	})
@@ -270,12 +302,10 @@ func (t *transport) safesign(sign func()) {
 	defer t.signerMu.Unlock()
 
 	if now := time.Now(); now.After(t.signerExp) {
-		const expiry = 120
-
 		// Signers have expired and require renewal
-		t.getSigner, _ = NewGETSigner(expiry)
-		t.postSigner, _ = NewPOSTSigner(expiry)
-		t.signerExp = now.Add(time.Second * expiry)
+		t.getSigner, _ = NewGETSigner(signatureExpiry)
+		t.postSigner, _ = NewPOSTSigner(signatureExpiry)
+		t.signerExp = now.Add(time.Second * signatureExpiry)
 	}
 
 	// Perform signing
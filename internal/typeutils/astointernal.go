@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
@@ -192,7 +193,17 @@ func (c *converter) ASRepresentationToAccount(ctx context.Context, accountable a
 
 	// TODO: FeaturedTagsURI
 
-	// TODO: alsoKnownAs
+	// alsoKnownAs
+	alsoKnownAs := ap.ExtractAlsoKnownAs(accountable)
+	acct.AlsoKnownAsURIs = make([]string, 0, len(alsoKnownAs))
+	for _, aka := range alsoKnownAs {
+		acct.AlsoKnownAsURIs = append(acct.AlsoKnownAsURIs, aka.String())
+	}
+
+	// movedTo
+	if movedTo := ap.ExtractMovedTo(accountable); movedTo != nil {
+		acct.MovedToURI = movedTo.String()
+	}
 
 	// publicKey
 	pkey, pkeyURL, err := ap.ExtractPublicKeyForOwner(accountable, uri)
@@ -302,6 +313,11 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		status.UpdatedAt = published
 	}
 
+	// was this status edited since it was created?
+	if updated := ap.ExtractUpdated(statusable); updated.After(status.CreatedAt) {
+		status.EditedAt = updated
+	}
+
 	// which account posted this status?
 	// if we don't know the account yet we can dereference it later
 	attributedTo, err := ap.ExtractAttributedTo(statusable)
@@ -367,6 +383,17 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	// language
 	// we might be able to extract this from the contentMap field
 
+	// poll, if this status is a question
+	if pollable, ok := statusable.(ap.Pollable); ok {
+		if poll, err := ap.ExtractPoll(pollable); err != nil {
+			l.Infof("ASStatusToStatus: error extracting status poll: %s", err)
+		} else {
+			poll.ID = id.NewULID()
+			status.PollID = poll.ID
+			status.Poll = poll
+		}
+	}
+
 	// ActivityStreamsType
 	status.ActivityStreamsType = statusable.GetTypeName()
 
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
@@ -106,6 +107,29 @@ func (suite *ASToInternalTestSuite) TestParsePublicStatus() {
 	suite.Equal(`<p>&gt; So we have to examine critical thinking as a signifier, dynamic and ambiguous.  It has a normative definition, a tacit definition, and an ideal definition.  One of the hallmarks of graduate training is learning to comprehend those definitions and applying the correct one as needed for professional success.</p>`, status.Content)
 }
 
+func (suite *ASToInternalTestSuite) TestParseQuestion() {
+	t := suite.jsonToType(publicQuestionJson)
+	rep, ok := t.(ap.Statusable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	status, err := suite.typeconverter.ASStatusToStatus(context.Background(), rep)
+	suite.NoError(err)
+
+	suite.Equal("Question", status.ActivityStreamsType)
+	suite.Equal(`<p>which is better?</p>`, status.Content)
+	if suite.NotNil(status.Poll) {
+		suite.Equal(status.PollID, status.Poll.ID)
+		suite.Equal([]string{"tea", "coffee"}, status.Poll.Options)
+		suite.Equal([]int{4, 2}, status.Poll.Votes)
+		suite.Equal(5, *status.Poll.Voters)
+		suite.True(*status.Poll.Multiple)
+		suite.Equal("2022-04-17T10:00:00Z", status.Poll.ExpiresAt.UTC().Format(time.RFC3339))
+		suite.False(status.Poll.Closed())
+	}
+}
+
 func (suite *ASToInternalTestSuite) TestParsePublicStatusNoURL() {
 	t := suite.jsonToType(publicStatusActivityJsonNoURL)
 	rep, ok := t.(ap.Statusable)
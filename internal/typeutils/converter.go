@@ -82,16 +82,53 @@ type TypeConverter interface {
 	InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.InstanceV1, error)
 	// InstanceToAPIV2Instance converts a gts instance into its api equivalent for serving at /api/v2/instance
 	InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.InstanceV2, error)
+	// InstanceToAdminAPIInstance converts a gts instance into an admin view instance, for serving at /api/v1/admin/instances
+	InstanceToAdminAPIInstance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.AdminInstance, error)
+	// AccountKeyChangeToAdminAPIAccountKeyChange converts a gts account key change into an admin view key change, for serving at /api/v1/admin/key_changes
+	AccountKeyChangeToAdminAPIAccountKeyChange(ctx context.Context, k *gtsmodel.AccountKeyChange) (*apimodel.AdminAccountKeyChange, error)
+	// RelayToAdminAPIRelay converts a gts relay into an admin view relay, for serving at /api/v1/admin/relays
+	RelayToAdminAPIRelay(ctx context.Context, r *gtsmodel.Relay) (*apimodel.AdminRelay, error)
 	// RelationshipToAPIRelationship converts a gts relationship into its api equivalent for serving in various places
 	RelationshipToAPIRelationship(ctx context.Context, r *gtsmodel.Relationship) (*apimodel.Relationship, error)
 	// NotificationToAPINotification converts a gts notification into a api notification
 	NotificationToAPINotification(ctx context.Context, n *gtsmodel.Notification) (*apimodel.Notification, error)
 	// DomainBlockToAPIDomainBlock converts a gts model domin block into a api domain block, for serving at /api/v1/admin/domain_blocks
 	DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel.DomainBlock, export bool) (*apimodel.DomainBlock, error)
+	// DomainAllowToAPIDomainAllow converts a gts model domain allow into an api domain allow, for serving at /api/v1/admin/domain_allows
+	DomainAllowToAPIDomainAllow(ctx context.Context, a *gtsmodel.DomainAllow) (*apimodel.DomainAllow, error)
+	// DomainBlockSubscriptionToAPIDomainBlockSubscription converts a gts model domain block subscription into an api domain block subscription, for serving at /api/v1/admin/domain_block_subscriptions
+	DomainBlockSubscriptionToAPIDomainBlockSubscription(ctx context.Context, s *gtsmodel.DomainBlockSubscription) (*apimodel.DomainBlockSubscription, error)
 	// ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
 	ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error)
 	// ReportToAdminAPIReport converts a gts model report into an admin view report, for serving at /api/v1/admin/reports
 	ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Report, requestingAccount *gtsmodel.Account) (*apimodel.AdminReport, error)
+	// ListToAPIList converts one gts model list into an api model list, for serving at /api/v1/lists/{id}
+	ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error)
+	// PollToAPIPoll converts one gts model poll into an api model poll, from the point of view of the
+	// requesting account (which may be nil), for serving at /api/v1/polls/{id} and attaching to statuses
+	PollToAPIPoll(ctx context.Context, requestingAccount *gtsmodel.Account, p *gtsmodel.Poll) (*apimodel.Poll, error)
+	// StatusToAPIEdits converts the given previous versions of a status, plus the current version, into
+	// api model status edits ordered from oldest to newest, for serving at /api/v1/statuses/{id}/history
+	StatusToAPIEdits(ctx context.Context, s *gtsmodel.Status, edits []*gtsmodel.StatusEdit) ([]*apimodel.StatusEdit, error)
+	// FilterToAPIFilterV2 converts one gts model filter into an api model v2 filter, for serving at /api/v2/filters/{id}
+	FilterToAPIFilterV2(ctx context.Context, f *gtsmodel.Filter) (*apimodel.FilterV2, error)
+	// FilterKeywordToAPIFilterV1 converts one gts model filter keyword into an api model v1 filter, for serving at /api/v1/filters/{id}
+	FilterKeywordToAPIFilterV1(ctx context.Context, k *gtsmodel.FilterKeyword) (*apimodel.FilterV1, error)
+	// FilterKeywordToAPIFilterKeyword converts one gts model filter keyword into an api model filter keyword, for serving at /api/v2/filters/keywords/{id}
+	FilterKeywordToAPIFilterKeyword(ctx context.Context, k *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, error)
+	// FilterResultsToAPIFilterResults converts gts model filter results into api model filter results, for attaching to statuses
+	FilterResultsToAPIFilterResults(ctx context.Context, results []*gtsmodel.FilterResult) ([]apimodel.FilterResult, error)
+	// ConversationToAPIConversation converts one gts model conversation into an api model conversation, from the point of
+	// view of the requesting account, for serving at /api/v1/conversations and streaming on the direct timeline
+	ConversationToAPIConversation(ctx context.Context, conversation *gtsmodel.Conversation, requestingAccount *gtsmodel.Account) (*apimodel.Conversation, error)
+	// WebPushSubscriptionToAPIPushSubscription converts one gts model web push subscription into an api model push subscription,
+	// including the given VAPID public key of this instance, for serving at /api/v1/push/subscription
+	WebPushSubscriptionToAPIPushSubscription(ctx context.Context, s *gtsmodel.WebPushSubscription, serverKey string) (*apimodel.PushSubscription, error)
+	// MarkersToAPIMarker converts gts model timeline markers into one api model marker, for serving at /api/v1/markers
+	MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, error)
+	// ScheduledStatusToAPIScheduledStatus converts one gts model scheduled status into an api model scheduled status,
+	// for serving at /api/v1/scheduled_statuses
+	ScheduledStatusToAPIScheduledStatus(ctx context.Context, s *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, error)
 
 	/*
 		INTERNAL (gts) MODEL TO FRONTEND (rss) MODEL
@@ -145,13 +182,16 @@ type TypeConverter interface {
 	// suitable for serving to requesters to whom we want to give as little information as possible because
 	// we don't trust them (yet).
 	AccountToASMinimal(ctx context.Context, a *gtsmodel.Account) (vocab.ActivityStreamsPerson, error)
-	// StatusToAS converts a gts model status into an activity streams note, suitable for federation
-	StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsNote, error)
+	// StatusToAS converts a gts model status into an activity streams note, suitable for federation.
+	// If the status has a poll attached, it will be converted into an activity streams question instead.
+	StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Statusable, error)
 	// StatusToASDelete converts a gts model status into a Delete of that status, using just the
 	// URI of the status as object, and addressing the Delete appropriately.
 	StatusToASDelete(ctx context.Context, status *gtsmodel.Status) (vocab.ActivityStreamsDelete, error)
 	// FollowToASFollow converts a gts model Follow into an activity streams Follow, suitable for federation
 	FollowToAS(ctx context.Context, f *gtsmodel.Follow, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error)
+	// RelayToASFollow converts a gts model Relay into the activity streams Follow sent by the instance account to subscribe to the relay.
+	RelayToASFollow(ctx context.Context, r *gtsmodel.Relay, instanceAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error)
 	// MentionToAS converts a gts model mention into an activity streams Mention, suitable for federation
 	MentionToAS(ctx context.Context, m *gtsmodel.Mention) (vocab.ActivityStreamsMention, error)
 	// EmojiToAS converts a gts emoji into a mastodon ns Emoji, suitable for federation
@@ -160,10 +200,16 @@ type TypeConverter interface {
 	AttachmentToAS(ctx context.Context, a *gtsmodel.MediaAttachment) (vocab.ActivityStreamsDocument, error)
 	// FaveToAS converts a gts model status fave into an activityStreams LIKE, suitable for federation.
 	FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab.ActivityStreamsLike, error)
+	// PollVoteToASCreates converts a gts model poll vote into a Create of a Note for each of the vote's
+	// choices, addressed to the author of the poll, suitable for federation. This is how votes are
+	// represented by Mastodon and others: a Note with the chosen option as name, in reply to the poll.
+	PollVoteToASCreates(ctx context.Context, vote *gtsmodel.PollVote) ([]vocab.ActivityStreamsCreate, error)
 	// BoostToAS converts a gts model boost into an activityStreams ANNOUNCE, suitable for federation
 	BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) (vocab.ActivityStreamsAnnounce, error)
 	// BlockToAS converts a gts model block into an activityStreams BLOCK, suitable for federation.
 	BlockToAS(ctx context.Context, block *gtsmodel.Block) (vocab.ActivityStreamsBlock, error)
+	// AccountToASMove converts a move of the origin account to the target account into an activity streams Move, addressed to the followers of the origin account.
+	AccountToASMove(ctx context.Context, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsMove, error)
 	// StatusToASRepliesCollection converts a gts model status into an activityStreams REPLIES collection.
 	StatusToASRepliesCollection(ctx context.Context, status *gtsmodel.Status, onlyOtherAccounts bool) (vocab.ActivityStreamsCollection, error)
 	// StatusURIsToASRepliesPage returns a collection page with appropriate next/part of pagination.
@@ -201,12 +247,14 @@ type TypeConverter interface {
 
 	// WrapPersonInUpdate
 	WrapPersonInUpdate(person vocab.ActivityStreamsPerson, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error)
-	// WrapNoteInCreate wraps a Note with a Create activity.
+	// WrapStatusableInCreate wraps a Statusable (Note, Question, etc) with a Create activity.
 	//
-	// If objectIRIOnly is set to true, then the function won't put the *entire* note in the Object field of the Create,
-	// but just the AP URI of the note. This is useful in cases where you want to give a remote server something to dereference,
+	// If objectIRIOnly is set to true, then the function won't put the *entire* statusable in the Object field of the Create,
+	// but just the AP URI of the statusable. This is useful in cases where you want to give a remote server something to dereference,
 	// and still have control over whether or not they're allowed to actually see the contents.
-	WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
+	WrapStatusableInCreate(statusable ap.Statusable, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
+	// WrapStatusableInUpdate wraps a Statusable (Note, Question, etc) with an Update activity, from the status author.
+	WrapStatusableInUpdate(statusable ap.Statusable, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error)
 }
 
 type converter struct {
@@ -464,6 +464,50 @@ const (
 		"type": "Service",
 		"url": "https://owncast.example.org/federation/user/rgh"
 	} 
+`
+	publicQuestionJson = `
+	{
+		"@context": [
+		  "https://www.w3.org/ns/activitystreams",
+		  {
+			"sensitive": "as:sensitive",
+			"toot": "http://joinmastodon.org/ns#",
+			"votersCount": "toot:votersCount"
+		  }
+		],
+		"id": "http://fossbros-anonymous.io/users/foss_satan/statuses/108138763199405168",
+		"type": "Question",
+		"published": "2022-04-16T10:00:00.00Z",
+		"attributedTo": "http://fossbros-anonymous.io/users/foss_satan",
+		"to": [
+		  "https://www.w3.org/ns/activitystreams#Public"
+		],
+		"cc": [
+		  "http://fossbros-anonymous.io/users/foss_satan/followers"
+		],
+		"sensitive": false,
+		"content": "<p>which is better?</p>",
+		"endTime": "2022-04-17T10:00:00Z",
+		"votersCount": 5,
+		"anyOf": [
+		  {
+			"type": "Note",
+			"name": "tea",
+			"replies": {
+			  "type": "Collection",
+			  "totalItems": 4
+			}
+		  },
+		  {
+			"type": "Note",
+			"name": "coffee",
+			"replies": {
+			  "type": "Collection",
+			  "totalItems": 2
+			}
+		  }
+		]
+	}
 `
 )
 
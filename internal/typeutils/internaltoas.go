@@ -24,15 +24,19 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 // Converts a gts model account into an Activity Streams person type.
@@ -168,7 +172,17 @@ func (c *converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 
 	// alsoKnownAs
 	// Required for Move activity.
-	// TODO: NOT IMPLEMENTED **YET** -- this needs to be added as an activitypub extension to https://github.com/go-fed/activity, see https://github.com/go-fed/activity/tree/master/astool
+	// Not supported by our activitypub library, so set it as an unknown property.
+	if len(a.AlsoKnownAsURIs) != 0 {
+		person.GetUnknownProperties()[ap.PropertyAlsoKnownAs] = a.AlsoKnownAsURIs
+	}
+
+	// movedTo
+	// Set when this account has moved to another account.
+	// Not supported by our activitypub library, so set it as an unknown property.
+	if a.MovedToURI != "" {
+		person.GetUnknownProperties()[ap.PropertyMovedTo] = a.MovedToURI
+	}
 
 	// publicKey
 	// Required for signatures.
@@ -381,7 +395,7 @@ func (c *converter) AccountToASMinimal(ctx context.Context, a *gtsmodel.Account)
 	return person, nil
 }
 
-func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsNote, error) {
+func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Statusable, error) {
 	// ensure prerequisites here before we get stuck in
 
 	// check if author account is already attached to status and attach it if not
@@ -431,6 +445,13 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 	publishedProp.Set(s.CreatedAt)
 	status.SetActivityStreamsPublished(publishedProp)
 
+	// updated
+	if !s.EditedAt.IsZero() {
+		updatedProp := streams.NewActivityStreamsUpdatedProperty()
+		updatedProp.Set(s.EditedAt)
+		status.SetActivityStreamsUpdated(updatedProp)
+	}
+
 	// url
 	if s.URL != "" {
 		sURL, err := url.Parse(s.URL)
@@ -603,9 +624,108 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 	sensitiveProp.AppendXMLSchemaBoolean(*s.Sensitive)
 	status.SetActivityStreamsSensitive(sensitiveProp)
 
+	if s.PollID != "" {
+		// this status has a poll attached,
+		// so it needs to be a Question instead
+		return c.noteToASQuestion(ctx, s, status)
+	}
+
 	return status, nil
 }
 
+// noteToASQuestion converts the given note representation of status s
+// into a Question, with the options and vote counts of the status poll.
+func (c *converter) noteToASQuestion(ctx context.Context, s *gtsmodel.Status, note vocab.ActivityStreamsNote) (vocab.ActivityStreamsQuestion, error) {
+	poll := s.Poll
+	if poll == nil {
+		var err error
+		poll, err = c.db.GetPollByID(ctx, s.PollID)
+		if err != nil {
+			return nil, fmt.Errorf("StatusToAS: error getting poll %s from database: %w", s.PollID, err)
+		}
+	}
+
+	question := streams.NewActivityStreamsQuestion()
+
+	// copy over all the properties of the note
+	question.SetJSONLDId(note.GetJSONLDId())
+	question.SetActivityStreamsSummary(note.GetActivityStreamsSummary())
+	question.SetActivityStreamsInReplyTo(note.GetActivityStreamsInReplyTo())
+	question.SetActivityStreamsPublished(note.GetActivityStreamsPublished())
+	question.SetActivityStreamsUpdated(note.GetActivityStreamsUpdated())
+	question.SetActivityStreamsUrl(note.GetActivityStreamsUrl())
+	question.SetActivityStreamsAttributedTo(note.GetActivityStreamsAttributedTo())
+	question.SetActivityStreamsTag(note.GetActivityStreamsTag())
+	question.SetActivityStreamsTo(note.GetActivityStreamsTo())
+	question.SetActivityStreamsCc(note.GetActivityStreamsCc())
+	question.SetActivityStreamsContent(note.GetActivityStreamsContent())
+	question.SetActivityStreamsAttachment(note.GetActivityStreamsAttachment())
+	question.SetActivityStreamsReplies(note.GetActivityStreamsReplies())
+	question.SetActivityStreamsSensitive(note.GetActivityStreamsSensitive())
+
+	// don't leak vote counts of a poll with hidden counts until it's closed
+	hideCounts := *poll.HideCounts && !poll.Closed()
+
+	// options, each one a Note with a name and replies collection
+	oneOfProp := streams.NewActivityStreamsOneOfProperty()
+	anyOfProp := streams.NewActivityStreamsAnyOfProperty()
+	for i, option := range poll.Options {
+		optionNote := streams.NewActivityStreamsNote()
+
+		nameProp := streams.NewActivityStreamsNameProperty()
+		nameProp.AppendXMLSchemaString(option)
+		optionNote.SetActivityStreamsName(nameProp)
+
+		votes := 0
+		if !hideCounts && i < len(poll.Votes) {
+			votes = poll.Votes[i]
+		}
+
+		totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+		totalItemsProp.Set(votes)
+		repliesCollection := streams.NewActivityStreamsCollection()
+		repliesCollection.SetActivityStreamsTotalItems(totalItemsProp)
+		repliesProp := streams.NewActivityStreamsRepliesProperty()
+		repliesProp.SetActivityStreamsCollection(repliesCollection)
+		optionNote.SetActivityStreamsReplies(repliesProp)
+
+		if *poll.Multiple {
+			anyOfProp.AppendActivityStreamsNote(optionNote)
+		} else {
+			oneOfProp.AppendActivityStreamsNote(optionNote)
+		}
+	}
+
+	if *poll.Multiple {
+		question.SetActivityStreamsAnyOf(anyOfProp)
+	} else {
+		question.SetActivityStreamsOneOf(oneOfProp)
+	}
+
+	// endTime
+	if !poll.ExpiresAt.IsZero() {
+		endTimeProp := streams.NewActivityStreamsEndTimeProperty()
+		endTimeProp.Set(poll.ExpiresAt)
+		question.SetActivityStreamsEndTime(endTimeProp)
+	}
+
+	// closed
+	if poll.Closed() {
+		closedProp := streams.NewActivityStreamsClosedProperty()
+		closedProp.AppendXMLSchemaDateTime(poll.ClosedAt)
+		question.SetActivityStreamsClosed(closedProp)
+	}
+
+	// votersCount
+	if !hideCounts {
+		votersCountProp := streams.NewTootVotersCountProperty()
+		votersCountProp.Set(*poll.Voters)
+		question.SetTootVotersCount(votersCountProp)
+	}
+
+	return question, nil
+}
+
 func (c *converter) StatusToASDelete(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsDelete, error) {
 	// Parse / fetch some information
 	// we need to create the Delete.
@@ -787,6 +907,46 @@ func (c *converter) FollowToAS(ctx context.Context, f *gtsmodel.Follow, originAc
 	return follow, nil
 }
 
+func (c *converter) RelayToASFollow(ctx context.Context, r *gtsmodel.Relay, instanceAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error) {
+	instanceAccountURI, err := url.Parse(instanceAccount.URI)
+	if err != nil {
+		return nil, fmt.Errorf("RelayToASFollow: error parsing instance account uri: %w", err)
+	}
+
+	followURI, err := url.Parse(r.FollowURI)
+	if err != nil {
+		return nil, fmt.Errorf("RelayToASFollow: error parsing follow uri: %w", err)
+	}
+
+	// Relays expect to be sent a Follow
+	// of the public collection, rather
+	// than a Follow of the relay actor.
+	publicURI, err := url.Parse(pub.PublicActivityPubIRI)
+	if err != nil {
+		return nil, fmt.Errorf("RelayToASFollow: error parsing url %s: %w", pub.PublicActivityPubIRI, err)
+	}
+
+	follow := streams.NewActivityStreamsFollow()
+
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(instanceAccountURI)
+	follow.SetActivityStreamsActor(actorProp)
+
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.SetIRI(followURI)
+	follow.SetJSONLDId(idProp)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(publicURI)
+	follow.SetActivityStreamsObject(objectProp)
+
+	toProp := streams.NewActivityStreamsToProperty()
+	toProp.AppendIRI(publicURI)
+	follow.SetActivityStreamsTo(toProp)
+
+	return follow, nil
+}
+
 func (c *converter) MentionToAS(ctx context.Context, m *gtsmodel.Mention) (vocab.ActivityStreamsMention, error) {
 	if m.TargetAccount == nil {
 		a, err := c.db.GetAccountByID(ctx, m.TargetAccountID)
@@ -929,6 +1089,98 @@ We want to end up with something like this:
 "type": "Like"
 }
 */
+func (c *converter) PollVoteToASCreates(ctx context.Context, vote *gtsmodel.PollVote) ([]vocab.ActivityStreamsCreate, error) {
+	if err := c.db.PopulatePollVote(ctx, vote); err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error populating vote: %w", err)
+	}
+
+	poll := vote.Poll
+	if err := c.db.PopulatePoll(ctx, poll); err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error populating poll: %w", err)
+	}
+
+	status := poll.Status
+	if status.Account == nil {
+		a, err := c.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error getting poll author: %w", err)
+		}
+		status.Account = a
+	}
+
+	statusURI, err := url.Parse(status.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing url %s: %w", status.URI, err)
+	}
+
+	authorURI, err := url.Parse(status.Account.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing url %s: %w", status.Account.URI, err)
+	}
+
+	voterURI, err := url.Parse(vote.Account.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing url %s: %w", vote.Account.URI, err)
+	}
+
+	published := vote.CreatedAt
+	if published.IsZero() {
+		published = time.Now()
+	}
+
+	creates := make([]vocab.ActivityStreamsCreate, 0, len(vote.Choices))
+	for _, choice := range vote.Choices {
+		if choice < 0 || choice >= len(poll.Options) {
+			continue
+		}
+
+		note := streams.NewActivityStreamsNote()
+
+		// id
+		noteURI, err := url.Parse(fmt.Sprintf("%s#votes/%s/%d", vote.Account.URI, vote.ID, choice))
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error parsing vote url: %w", err)
+		}
+		idProp := streams.NewJSONLDIdProperty()
+		idProp.SetIRI(noteURI)
+		note.SetJSONLDId(idProp)
+
+		// name, ie., the chosen option
+		nameProp := streams.NewActivityStreamsNameProperty()
+		nameProp.AppendXMLSchemaString(poll.Options[choice])
+		note.SetActivityStreamsName(nameProp)
+
+		// inReplyTo
+		inReplyToProp := streams.NewActivityStreamsInReplyToProperty()
+		inReplyToProp.AppendIRI(statusURI)
+		note.SetActivityStreamsInReplyTo(inReplyToProp)
+
+		// attributedTo
+		attributedToProp := streams.NewActivityStreamsAttributedToProperty()
+		attributedToProp.AppendIRI(voterURI)
+		note.SetActivityStreamsAttributedTo(attributedToProp)
+
+		// to
+		toProp := streams.NewActivityStreamsToProperty()
+		toProp.AppendIRI(authorURI)
+		note.SetActivityStreamsTo(toProp)
+
+		// published
+		publishedProp := streams.NewActivityStreamsPublishedProperty()
+		publishedProp.Set(published)
+		note.SetActivityStreamsPublished(publishedProp)
+
+		create, err := c.WrapStatusableInCreate(note, false)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error wrapping vote in create: %w", err)
+		}
+
+		creates = append(creates, create)
+	}
+
+	return creates, nil
+}
+
 func (c *converter) FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab.ActivityStreamsLike, error) {
 	// check if targetStatus is already pinned to this fave, and fetch it if not
 	if f.Status == nil {
@@ -1146,6 +1398,53 @@ func (c *converter) BlockToAS(ctx context.Context, b *gtsmodel.Block) (vocab.Act
 	return block, nil
 }
 
+func (c *converter) AccountToASMove(ctx context.Context, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsMove, error) {
+	move := streams.NewActivityStreamsMove()
+
+	// set the ID property to a new move URI
+	idProp := streams.NewJSONLDIdProperty()
+	idString := uris.GenerateURIForMove(originAccount.Username, id.NewULID())
+	idIRI, err := url.Parse(idString)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", idString, err)
+	}
+	idProp.Set(idIRI)
+	move.SetJSONLDId(idProp)
+
+	// set the actor and object properties to the moving account's URI
+	originIRI, err := url.Parse(originAccount.URI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", originAccount.URI, err)
+	}
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(originIRI)
+	move.SetActivityStreamsActor(actorProp)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(originIRI)
+	move.SetActivityStreamsObject(objectProp)
+
+	// set the target property to the URI of the account being moved to
+	targetIRI, err := url.Parse(targetAccount.URI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", targetAccount.URI, err)
+	}
+	targetProp := streams.NewActivityStreamsTargetProperty()
+	targetProp.AppendIRI(targetIRI)
+	move.SetActivityStreamsTarget(targetProp)
+
+	// set the TO property to the moving account's followers
+	followersIRI, err := url.Parse(originAccount.FollowersURI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", originAccount.FollowersURI, err)
+	}
+	toProp := streams.NewActivityStreamsToProperty()
+	toProp.AppendIRI(followersIRI)
+	move.SetActivityStreamsTo(toProp)
+
+	return move, nil
+}
+
 /*
 the goal is to end up with something like this:
 
@@ -1339,12 +1638,12 @@ func (c *converter) StatusesToASOutboxPage(ctx context.Context, outboxID string,
 	var highest string
 	var lowest string
 	for _, s := range statuses {
-		note, err := c.StatusToAS(ctx, s)
+		statusable, err := c.StatusToAS(ctx, s)
 		if err != nil {
 			return nil, err
 		}
 
-		create, err := c.WrapNoteInCreate(note, true)
+		create, err := c.WrapStatusableInCreate(statusable, true)
 		if err != nil {
 			return nil, err
 		}
@@ -259,6 +259,55 @@ func (suite *InternalToASTestSuite) TestStatusToAS() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestStatusWithPollToAS() {
+	testStatus := &gtsmodel.Status{}
+	*testStatus = *suite.testStatuses["local_account_1_status_1"]
+	testStatus.PollID = "01GYGTSV8K2ZMTQKFJ9J4YMPZQ"
+	testStatus.Poll = &gtsmodel.Poll{
+		ID:         testStatus.PollID,
+		Multiple:   testrig.FalseBool(),
+		HideCounts: testrig.FalseBool(),
+		Options:    []string{"tea", "coffee"},
+		Votes:      []int{3, 1},
+		Voters:     func() *int { i := 4; return &i }(),
+		StatusID:   testStatus.ID,
+		ExpiresAt:  testrig.TimeMustParse("2022-06-10T15:22:08Z"),
+	}
+	ctx := context.Background()
+
+	asStatus, err := suite.typeconverter.StatusToAS(ctx, testStatus)
+	suite.NoError(err)
+	suite.Equal("Question", asStatus.GetTypeName())
+
+	ser, err := streams.Serialize(asStatus)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Contains(string(bytes), `"endTime": "2022-06-10T15:22:08Z"`)
+	suite.Contains(string(bytes), `"votersCount": 4`)
+	suite.Contains(string(bytes), `"oneOf": [
+    {
+      "name": "tea",
+      "replies": {
+        "totalItems": 3,
+        "type": "Collection"
+      },
+      "type": "Note"
+    },
+    {
+      "name": "coffee",
+      "replies": {
+        "totalItems": 1,
+        "type": "Collection"
+      },
+      "type": "Note"
+    }
+  ]`)
+	suite.NotContains(string(bytes), `"closed"`)
+}
+
 func (suite *InternalToASTestSuite) TestStatusWithTagsToASWithIDs() {
 	// use the status with just IDs of attachments and emojis pinned on it
 	testStatus := suite.testStatuses["admin_account_status_1"]
@@ -19,10 +19,12 @@ package typeutils
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -84,12 +86,38 @@ func (c *converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		Note:                a.NoteRaw,
 		Fields:              apiAccount.Fields,
 		FollowRequestsCount: frc,
+		AlsoKnownAsURIs:     a.AlsoKnownAsURIs,
 	}
 
 	return apiAccount, nil
 }
 
 func (c *converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.Account) (*apimodel.Account, error) {
+	apiAccount, err := c.accountToAPIAccountPublic(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.MovedToAccountID != "" {
+		// this account has moved, so include the account it moved to;
+		// that one's converted without its own moved account, so that
+		// we don't end up following chains (or loops) of moved accounts
+		movedTo, err := c.db.GetAccountByID(ctx, a.MovedToAccountID)
+		if err != nil {
+			log.Errorf(ctx, "error getting moved to account %s: %v", a.MovedToAccountID, err)
+			return apiAccount, nil
+		}
+
+		apiAccount.Moved, err = c.accountToAPIAccountPublic(ctx, movedTo)
+		if err != nil {
+			log.Errorf(ctx, "error converting moved to account %s: %v", a.MovedToAccountID, err)
+		}
+	}
+
+	return apiAccount, nil
+}
+
+func (c *converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.Account) (*apimodel.Account, error) {
 	// count followers
 	followersCount, err := c.db.CountAccountFollowedBy(ctx, a.ID, false)
 	if err != nil {
@@ -492,6 +520,75 @@ func (c *converter) EmojiToAdminAPIEmoji(ctx context.Context, e *gtsmodel.Emoji)
 	}, nil
 }
 
+func (c *converter) InstanceToAdminAPIInstance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.AdminInstance, error) {
+	instance := &apimodel.AdminInstance{
+		Domain:      i.Domain,
+		URI:         i.URI,
+		Title:       i.Title,
+		Version:     i.Version,
+		CreatedAt:   util.FormatISO8601(i.CreatedAt),
+		Unavailable: !i.UnavailableAt.IsZero(),
+	}
+
+	if !i.SuspendedAt.IsZero() {
+		instance.SuspendedAt = util.FormatISO8601(i.SuspendedAt)
+	}
+
+	if !i.LastDeliverySuccessAt.IsZero() {
+		instance.LastDeliverySuccessAt = util.FormatISO8601(i.LastDeliverySuccessAt)
+	}
+
+	if !i.LastDeliveryFailureAt.IsZero() {
+		instance.LastDeliveryFailureAt = util.FormatISO8601(i.LastDeliveryFailureAt)
+	}
+
+	if !i.DeliveryFailingSince.IsZero() {
+		instance.DeliveryFailingSince = util.FormatISO8601(i.DeliveryFailingSince)
+	}
+
+	if !i.UnavailableAt.IsZero() {
+		instance.UnavailableAt = util.FormatISO8601(i.UnavailableAt)
+	}
+
+	return instance, nil
+}
+
+func (c *converter) AccountKeyChangeToAdminAPIAccountKeyChange(ctx context.Context, k *gtsmodel.AccountKeyChange) (*apimodel.AdminAccountKeyChange, error) {
+	if k.Account == nil {
+		account, err := c.db.GetAccountByID(ctx, k.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("AccountKeyChangeToAdminAPIAccountKeyChange: error getting account with id %s: %w", k.AccountID, err)
+		}
+		k.Account = account
+	}
+
+	apiAccount, err := c.AccountToAPIAccountPublic(ctx, k.Account)
+	if err != nil {
+		return nil, fmt.Errorf("AccountKeyChangeToAdminAPIAccountKeyChange: error converting account with id %s: %w", k.AccountID, err)
+	}
+
+	return &apimodel.AdminAccountKeyChange{
+		ID:           k.ID,
+		CreatedAt:    util.FormatISO8601(k.CreatedAt),
+		Account:      apiAccount,
+		PublicKeyURI: k.PublicKeyURI,
+		OldPublicKey: k.OldPublicKey,
+		NewPublicKey: k.NewPublicKey,
+	}, nil
+}
+
+func (c *converter) RelayToAdminAPIRelay(ctx context.Context, r *gtsmodel.Relay) (*apimodel.AdminRelay, error) {
+	return &apimodel.AdminRelay{
+		ID:        r.ID,
+		CreatedAt: util.FormatISO8601(r.CreatedAt),
+		InboxURI:  r.InboxURI,
+		ActorURI:  r.ActorURI,
+		State:     string(r.State),
+		Publish:   *r.Publish,
+		CreatedBy: r.CreatedByAccountID,
+	}, nil
+}
+
 func (c *converter) EmojiCategoryToAPIEmojiCategory(ctx context.Context, category *gtsmodel.EmojiCategory) (*apimodel.EmojiCategory, error) {
 	return &apimodel.EmojiCategory{
 		ID:   category.ID,
@@ -642,7 +739,7 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		Tags:               apiTags,
 		Emojis:             apiEmojis,
 		Card:               nil, // TODO: implement cards
-		Poll:               nil, // TODO: implement polls
+		Poll:               nil,
 		Text:               s.Text,
 	}
 
@@ -657,6 +754,28 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		apiStatus.InReplyToAccountID = &i
 	}
 
+	if !s.EditedAt.IsZero() {
+		editedAt := util.FormatISO8601(s.EditedAt)
+		apiStatus.EditedAt = &editedAt
+	}
+
+	if s.PollID != "" {
+		if s.Poll == nil {
+			// the poll might have been set on this struct already so check first before doing db calls
+			poll, err := c.db.GetPollByID(ctx, s.PollID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting poll of status %s: %w", s.ID, err)
+			}
+			poll.Status = s
+			s.Poll = poll
+		}
+
+		apiStatus.Poll, err = c.PollToAPIPoll(ctx, requestingAccount, s.Poll)
+		if err != nil {
+			return nil, fmt.Errorf("error converting status poll: %w", err)
+		}
+	}
+
 	if apiRebloggedStatus != nil {
 		apiStatus.Reblog = &apimodel.StatusReblogged{Status: apiRebloggedStatus}
 	}
@@ -936,12 +1055,30 @@ func (c *converter) NotificationToAPINotification(ctx context.Context, n *gtsmod
 		apiStatus = apiStatus.Reblog.Status
 	}
 
+	var apiReport *apimodel.AdminReport
+	if n.ReportID != "" {
+		if n.Report == nil {
+			report, err := c.db.GetReportByID(ctx, n.ReportID)
+			if err != nil {
+				return nil, fmt.Errorf("NotificationToapi: error getting report with id %s from the db: %s", n.ReportID, err)
+			}
+			n.Report = report
+		}
+
+		var err error
+		apiReport, err = c.ReportToAdminAPIReport(ctx, n.Report, n.TargetAccount)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationToapi: error converting report to api: %s", err)
+		}
+	}
+
 	return &apimodel.Notification{
 		ID:        n.ID,
 		Type:      string(n.NotificationType),
 		CreatedAt: util.FormatISO8601(n.CreatedAt),
 		Account:   apiAccount,
 		Status:    apiStatus,
+		Report:    apiReport,
 	}, nil
 }
 
@@ -951,6 +1088,8 @@ func (c *converter) DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel
 			Domain:        b.Domain,
 			PublicComment: b.PublicComment,
 		},
+		Severity:    string(b.Severity),
+		RejectMedia: *b.RejectMedia,
 	}
 
 	// if we're exporting a domain block, return it with minimal information attached
@@ -966,6 +1105,58 @@ func (c *converter) DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel
 	return domainBlock, nil
 }
 
+func (c *converter) DomainAllowToAPIDomainAllow(ctx context.Context, a *gtsmodel.DomainAllow) (*apimodel.DomainAllow, error) {
+	return &apimodel.DomainAllow{
+		Domain: apimodel.Domain{
+			Domain:        a.Domain,
+			PublicComment: a.PublicComment,
+		},
+		ID:             a.ID,
+		PrivateComment: a.PrivateComment,
+		CreatedBy:      a.CreatedByAccountID,
+		CreatedAt:      util.FormatISO8601(a.CreatedAt),
+	}, nil
+}
+
+func (c *converter) DomainBlockSubscriptionToAPIDomainBlockSubscription(ctx context.Context, s *gtsmodel.DomainBlockSubscription) (*apimodel.DomainBlockSubscription, error) {
+	blocks, err := c.db.GetDomainBlocksBySubscriptionID(ctx, s.ID)
+	if err != nil {
+		return nil, fmt.Errorf("DomainBlockSubscriptionToAPIDomainBlockSubscription: error getting blocks for subscription %s: %w", s.ID, err)
+	}
+
+	subscription := &apimodel.DomainBlockSubscription{
+		ID:             s.ID,
+		Title:          s.Title,
+		URI:            s.URI,
+		ContentType:    s.ContentType,
+		Obfuscate:      *s.Obfuscate,
+		CreatedBy:      s.CreatedByAccountID,
+		CreatedAt:      util.FormatISO8601(s.CreatedAt),
+		Error:          s.Error,
+		BlockCount:     len(blocks),
+		AddedDomains:   s.AddedDomains,
+		RemovedDomains: s.RemovedDomains,
+	}
+
+	if !s.FetchedAt.IsZero() {
+		subscription.FetchedAt = util.FormatISO8601(s.FetchedAt)
+	}
+
+	if !s.SuccessfullyFetchedAt.IsZero() {
+		subscription.SuccessfullyFetchedAt = util.FormatISO8601(s.SuccessfullyFetchedAt)
+	}
+
+	if subscription.AddedDomains == nil {
+		subscription.AddedDomains = []string{}
+	}
+
+	if subscription.RemovedDomains == nil {
+		subscription.RemovedDomains = []string{}
+	}
+
+	return subscription, nil
+}
+
 func (c *converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
 	report := &apimodel.Report{
 		ID:          r.ID,
@@ -1233,3 +1424,375 @@ func (c *converter) convertTagsToAPITags(ctx context.Context, tags []*gtsmodel.T
 
 	return apiTags, errs.Combine()
 }
+
+func (c *converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error) {
+	return &apimodel.List{
+		ID:            l.ID,
+		Title:         l.Title,
+		RepliesPolicy: string(l.RepliesPolicy),
+	}, nil
+}
+
+func (c *converter) PollToAPIPoll(ctx context.Context, requestingAccount *gtsmodel.Account, p *gtsmodel.Poll) (*apimodel.Poll, error) {
+	if p.Status == nil {
+		status, err := c.db.GetStatusByID(ctx, p.StatusID)
+		if err != nil {
+			return nil, fmt.Errorf("PollToAPIPoll: error getting status %s of poll %s: %w", p.StatusID, p.ID, err)
+		}
+		p.Status = status
+	}
+
+	var (
+		voted    *bool
+		ownVotes *[]int
+	)
+
+	if requestingAccount != nil {
+		isAuthor := requestingAccount.ID == p.Status.AccountID
+		choices := []int{}
+
+		vote, err := c.db.GetPollVoteBy(ctx, p.ID, requestingAccount.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("PollToAPIPoll: error getting vote of account %s in poll %s: %w", requestingAccount.ID, p.ID, err)
+		}
+
+		if vote != nil {
+			choices = vote.Choices
+		}
+
+		// The author of a poll counts as having voted in it.
+		hasVoted := isAuthor || vote != nil
+		voted = &hasVoted
+		ownVotes = &choices
+	}
+
+	// Vote counts are hidden from everyone but the
+	// author until the poll closes, if requested.
+	hideCounts := p.HideCounts != nil && *p.HideCounts &&
+		!p.Closed() && (requestingAccount == nil || requestingAccount.ID != p.Status.AccountID)
+
+	var totalVotes int
+	options := make([]apimodel.PollOption, 0, len(p.Options))
+	for i, title := range p.Options {
+		var count int
+		if i < len(p.Votes) {
+			count = p.Votes[i]
+		}
+		totalVotes += count
+
+		option := apimodel.PollOption{Title: title}
+		if !hideCounts {
+			option.VotesCount = &count
+		}
+		options = append(options, option)
+	}
+
+	var votersCount *int
+	if p.Multiple != nil && *p.Multiple {
+		voters := 0
+		if p.Voters != nil {
+			voters = *p.Voters
+		}
+		votersCount = &voters
+	}
+
+	var expiresAt *string
+	if !p.ExpiresAt.IsZero() {
+		e := util.FormatISO8601(p.ExpiresAt)
+		expiresAt = &e
+	}
+
+	return &apimodel.Poll{
+		ID:          p.ID,
+		ExpiresAt:   expiresAt,
+		Expired:     p.Closed() || p.Expired(time.Now()),
+		Multiple:    p.Multiple != nil && *p.Multiple,
+		VotesCount:  totalVotes,
+		VotersCount: votersCount,
+		Voted:       voted,
+		OwnVotes:    ownVotes,
+		Options:     options,
+		Emojis:      []apimodel.Emoji{},
+	}, nil
+}
+
+func (c *converter) StatusToAPIEdits(ctx context.Context, s *gtsmodel.Status, edits []*gtsmodel.StatusEdit) ([]*apimodel.StatusEdit, error) {
+	if s.Account == nil {
+		a, err := c.db.GetAccountByID(ctx, s.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("StatusToAPIEdits: error getting status author: %w", err)
+		}
+		s.Account = a
+	}
+
+	apiAuthorAccount, err := c.AccountToAPIAccountPublic(ctx, s.Account)
+	if err != nil {
+		return nil, fmt.Errorf("StatusToAPIEdits: error converting status author: %w", err)
+	}
+
+	// we don't store emojis per version, so
+	// just use the current emojis of the status
+	apiEmojis, err := c.convertEmojisToAPIEmojis(ctx, s.Emojis, s.EmojiIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting status emojis: %v", err)
+	}
+
+	// the current version of the status is
+	// the most recent entry in its history
+	current := &gtsmodel.StatusEdit{
+		CreatedAt:      s.EditedAt,
+		Content:        s.Content,
+		ContentWarning: s.ContentWarning,
+		Sensitive:      s.Sensitive,
+		AttachmentIDs:  s.AttachmentIDs,
+	}
+	if current.CreatedAt.IsZero() {
+		current.CreatedAt = s.CreatedAt
+	}
+	if s.Poll != nil {
+		current.PollOptions = s.Poll.Options
+	}
+
+	apiEdits := make([]*apimodel.StatusEdit, 0, len(edits)+1)
+	for _, edit := range append(edits, current) {
+		apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, nil, edit.AttachmentIDs)
+		if err != nil {
+			log.Errorf(ctx, "error converting status edit attachments: %v", err)
+		}
+
+		var apiPoll *apimodel.StatusEditPoll
+		if len(edit.PollOptions) > 0 {
+			options := make([]apimodel.StatusEditPollOption, 0, len(edit.PollOptions))
+			for _, title := range edit.PollOptions {
+				options = append(options, apimodel.StatusEditPollOption{Title: title})
+			}
+			apiPoll = &apimodel.StatusEditPoll{Options: options}
+		}
+
+		apiEdits = append(apiEdits, &apimodel.StatusEdit{
+			Content:          edit.Content,
+			SpoilerText:      edit.ContentWarning,
+			Sensitive:        edit.Sensitive != nil && *edit.Sensitive,
+			CreatedAt:        util.FormatISO8601(edit.CreatedAt),
+			Account:          apiAuthorAccount,
+			Poll:             apiPoll,
+			MediaAttachments: apiAttachments,
+			Emojis:           apiEmojis,
+		})
+	}
+
+	return apiEdits, nil
+}
+
+func (c *converter) FilterToAPIFilterV2(ctx context.Context, f *gtsmodel.Filter) (*apimodel.FilterV2, error) {
+	keywords := make([]apimodel.FilterKeyword, 0, len(f.Keywords))
+	for _, k := range f.Keywords {
+		apiKeyword, err := c.FilterKeywordToAPIFilterKeyword(ctx, k)
+		if err != nil {
+			return nil, fmt.Errorf("FilterToAPIFilterV2: error converting filter keyword %s: %w", k.ID, err)
+		}
+		keywords = append(keywords, *apiKeyword)
+	}
+
+	return &apimodel.FilterV2{
+		ID:           f.ID,
+		Title:        f.Title,
+		Context:      filterToAPIContexts(f),
+		ExpiresAt:    filterToAPIExpiresAt(f),
+		FilterAction: string(f.Action),
+		Keywords:     keywords,
+		Statuses:     []apimodel.FilterStatus{},
+	}, nil
+}
+
+func (c *converter) FilterKeywordToAPIFilterV1(ctx context.Context, k *gtsmodel.FilterKeyword) (*apimodel.FilterV1, error) {
+	if k.Filter == nil {
+		return nil, fmt.Errorf("FilterKeywordToAPIFilterV1: filter keyword %s had no filter set", k.ID)
+	}
+
+	return &apimodel.FilterV1{
+		// v1 filters have a single keyword each,
+		// so the keyword ID doubles as the filter ID.
+		ID:           k.ID,
+		Phrase:       k.Keyword,
+		Context:      filterToAPIContexts(k.Filter),
+		WholeWord:    k.WholeWord != nil && *k.WholeWord,
+		ExpiresAt:    filterToAPIExpiresAt(k.Filter),
+		Irreversible: k.Filter.Action == gtsmodel.FilterActionHide,
+	}, nil
+}
+
+func (c *converter) FilterKeywordToAPIFilterKeyword(ctx context.Context, k *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, error) {
+	return &apimodel.FilterKeyword{
+		ID:        k.ID,
+		Keyword:   k.Keyword,
+		WholeWord: k.WholeWord != nil && *k.WholeWord,
+	}, nil
+}
+
+func (c *converter) FilterResultsToAPIFilterResults(ctx context.Context, results []*gtsmodel.FilterResult) ([]apimodel.FilterResult, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	apiResults := make([]apimodel.FilterResult, 0, len(results))
+	for _, r := range results {
+		apiFilter, err := c.FilterToAPIFilterV2(ctx, r.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("FilterResultsToAPIFilterResults: error converting filter %s: %w", r.Filter.ID, err)
+		}
+
+		apiResults = append(apiResults, apimodel.FilterResult{
+			Filter:         *apiFilter,
+			KeywordMatches: r.KeywordMatches,
+			StatusMatches:  []string{},
+		})
+	}
+
+	return apiResults, nil
+}
+
+// filterToAPIContexts returns the api contexts in which the given filter applies.
+func filterToAPIContexts(f *gtsmodel.Filter) []string {
+	contexts := []string{}
+	for _, context := range []gtsmodel.FilterContext{
+		gtsmodel.FilterContextHome,
+		gtsmodel.FilterContextNotifications,
+		gtsmodel.FilterContextPublic,
+		gtsmodel.FilterContextThread,
+		gtsmodel.FilterContextAccount,
+	} {
+		if f.AppliesTo(context) {
+			contexts = append(contexts, string(context))
+		}
+	}
+	return contexts
+}
+
+// filterToAPIExpiresAt returns the api representation of the
+// expiry time of the given filter, or nil if it doesn't expire.
+func filterToAPIExpiresAt(f *gtsmodel.Filter) *string {
+	if f.ExpiresAt.IsZero() {
+		return nil
+	}
+	expiresAt := util.FormatISO8601(f.ExpiresAt)
+	return &expiresAt
+}
+
+func (c *converter) ConversationToAPIConversation(ctx context.Context, conversation *gtsmodel.Conversation, requestingAccount *gtsmodel.Account) (*apimodel.Conversation, error) {
+	if err := c.db.PopulateConversation(ctx, conversation); err != nil {
+		return nil, fmt.Errorf("ConversationToAPIConversation: error populating conversation %s: %w", conversation.ID, err)
+	}
+
+	apiAccounts := make([]apimodel.Account, 0, len(conversation.OtherAccounts))
+	for _, account := range conversation.OtherAccounts {
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			return nil, fmt.Errorf("ConversationToAPIConversation: error converting account %s: %w", account.ID, err)
+		}
+		apiAccounts = append(apiAccounts, *apiAccount)
+	}
+
+	apiLastStatus, err := c.StatusToAPIStatus(ctx, conversation.LastStatus, requestingAccount)
+	if err != nil {
+		return nil, fmt.Errorf("ConversationToAPIConversation: error converting status %s: %w", conversation.LastStatusID, err)
+	}
+
+	read := conversation.Read != nil && *conversation.Read
+
+	return &apimodel.Conversation{
+		ID:         conversation.ID,
+		Accounts:   apiAccounts,
+		Unread:     !read,
+		LastStatus: apiLastStatus,
+	}, nil
+}
+
+func (c *converter) WebPushSubscriptionToAPIPushSubscription(ctx context.Context, s *gtsmodel.WebPushSubscription, serverKey string) (*apimodel.PushSubscription, error) {
+	return &apimodel.PushSubscription{
+		ID:        s.ID,
+		Endpoint:  s.Endpoint,
+		ServerKey: serverKey,
+		Alerts: &apimodel.PushSubscriptionAlerts{
+			Follow:        *s.AlertFollow,
+			FollowRequest: *s.AlertFollowRequest,
+			Favourite:     *s.AlertFavourite,
+			Mention:       *s.AlertMention,
+			Reblog:        *s.AlertReblog,
+			Poll:          *s.AlertPoll,
+			Status:        *s.AlertStatus,
+			Update:        *s.AlertUpdate,
+			AdminReport:   *s.AlertAdminReport,
+		},
+		Policy: string(s.Policy),
+	}, nil
+}
+
+func (c *converter) MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, error) {
+	apiMarker := &apimodel.Marker{}
+	for _, marker := range markers {
+		apiTimelineMarker := &apimodel.TimelineMarker{
+			LastReadID: marker.LastReadID,
+			UpdatedAt:  util.FormatISO8601(marker.UpdatedAt),
+			Version:    marker.Version,
+		}
+		switch marker.Name {
+		case gtsmodel.MarkerNameHome:
+			apiMarker.Home = apiTimelineMarker
+		case gtsmodel.MarkerNameNotifications:
+			apiMarker.Notifications = apiTimelineMarker
+		default:
+			return nil, fmt.Errorf("MarkersToAPIMarker: unknown marker timeline name: %s", marker.Name)
+		}
+	}
+	return apiMarker, nil
+}
+
+func (c *converter) ScheduledStatusToAPIScheduledStatus(ctx context.Context, s *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, error) {
+	scheduledAt := util.FormatISO8601(s.ScheduledAt)
+
+	apiAttachments := make([]apimodel.Attachment, 0, len(s.MediaIDs))
+	for _, mediaID := range s.MediaIDs {
+		attachment, err := c.db.GetAttachmentByID(ctx, mediaID)
+		if err != nil {
+			return nil, fmt.Errorf("ScheduledStatusToAPIScheduledStatus: error getting attachment %s: %w", mediaID, err)
+		}
+
+		apiAttachment, err := c.AttachmentToAPIAttachment(ctx, attachment)
+		if err != nil {
+			return nil, fmt.Errorf("ScheduledStatusToAPIScheduledStatus: error converting attachment %s: %w", mediaID, err)
+		}
+		apiAttachments = append(apiAttachments, apiAttachment)
+	}
+
+	params := &apimodel.StatusParams{
+		Text:          s.Text,
+		MediaIDs:      s.MediaIDs,
+		Sensitive:     s.Sensitive != nil && *s.Sensitive,
+		SpoilerText:   s.SpoilerText,
+		InReplyToID:   s.InReplyToID,
+		Language:      s.Language,
+		ScheduledAt:   scheduledAt,
+		ApplicationID: s.ApplicationID,
+	}
+
+	if s.Visibility != "" {
+		params.Visibility = c.VisToAPIVis(ctx, s.Visibility)
+	}
+
+	if len(s.PollOptions) != 0 {
+		params.Poll = &apimodel.PollRequest{
+			Options:    s.PollOptions,
+			ExpiresIn:  s.PollExpiresIn,
+			Multiple:   s.PollMultiple != nil && *s.PollMultiple,
+			HideTotals: s.PollHideTotals != nil && *s.PollHideTotals,
+		}
+	}
+
+	return &apimodel.ScheduledStatus{
+		ID:               s.ID,
+		ScheduledAt:      scheduledAt,
+		Params:           params,
+		MediaAttachments: apiAttachments,
+	}, nil
+}
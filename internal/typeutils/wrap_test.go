@@ -30,13 +30,13 @@ type WrapTestSuite struct {
 	TypeUtilsTestSuite
 }
 
-func (suite *WrapTestSuite) TestWrapNoteInCreateIRIOnly() {
+func (suite *WrapTestSuite) TestWrapStatusableInCreateIRIOnly() {
 	testStatus := suite.testStatuses["local_account_1_status_1"]
 
 	note, err := suite.typeconverter.StatusToAS(context.Background(), testStatus)
 	suite.NoError(err)
 
-	create, err := suite.typeconverter.WrapNoteInCreate(note, true)
+	create, err := suite.typeconverter.WrapStatusableInCreate(note, true)
 	suite.NoError(err)
 	suite.NotNil(create)
 
@@ -58,13 +58,13 @@ func (suite *WrapTestSuite) TestWrapNoteInCreateIRIOnly() {
 }`, string(bytes))
 }
 
-func (suite *WrapTestSuite) TestWrapNoteInCreate() {
+func (suite *WrapTestSuite) TestWrapStatusableInCreate() {
 	testStatus := suite.testStatuses["local_account_1_status_1"]
 
 	note, err := suite.typeconverter.StatusToAS(context.Background(), testStatus)
 	suite.NoError(err)
 
-	create, err := suite.typeconverter.WrapNoteInCreate(note, false)
+	create, err := suite.typeconverter.WrapStatusableInCreate(note, false)
 	suite.NoError(err)
 	suite.NotNil(create)
 
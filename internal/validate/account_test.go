@@ -53,7 +53,8 @@ func happyAccount() *gtsmodel.Account {
 		Fields:                  []gtsmodel.Field{},
 		Note:                    "hey yo this is my profile!",
 		Memorial:                testrig.FalseBool(),
-		AlsoKnownAs:             "",
+		AlsoKnownAsURIs:         []string{},
+		MovedToURI:              "",
 		MovedToAccountID:        "",
 		Bot:                     testrig.FalseBool(),
 		Reason:                  "I wanna be on this damned webbed site so bad! Please! Wow",
@@ -38,6 +38,8 @@ func happyDomainBlock() *gtsmodel.DomainBlock {
 		PublicComment:      "poo poo dudes",
 		Obfuscate:          testrig.FalseBool(),
 		SubscriptionID:     "",
+		Severity:           gtsmodel.DomainBlockSeveritySuspend,
+		RejectMedia:        testrig.FalseBool(),
 	}
 }
 
@@ -117,6 +119,18 @@ func (suite *DomainBlockValidateTestSuite) TestValidateDomainSubscriptionID() {
 	suite.NoError(err)
 }
 
+func (suite *DomainBlockValidateTestSuite) TestValidateDomainBlockSeverity() {
+	d := happyDomainBlock()
+
+	d.Severity = "silence"
+	err := validate.Struct(d)
+	suite.EqualError(err, "Key: 'DomainBlock.Severity' Error:Field validation for 'Severity' failed on the 'oneof' tag")
+
+	d.Severity = gtsmodel.DomainBlockSeverityLimit
+	err = validate.Struct(d)
+	suite.NoError(err)
+}
+
 func TestDomainBlockValidateTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainBlockValidateTestSuite))
 }
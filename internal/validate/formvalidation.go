@@ -21,10 +21,12 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"net/url"
 	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/regexes"
 	pwv "github.com/wagslane/go-password-validator"
 	"golang.org/x/text/language"
@@ -44,6 +46,9 @@ const (
 	maximumEmojiCategoryLength    = 64
 	maximumProfileFieldLength     = 255
 	maximumProfileFields          = 4
+	maximumListTitleLength        = 200
+	maximumFilterTitleLength      = 200
+	maximumFilterKeywordLength    = 100
 )
 
 // NewPassword returns an error if the given password is not sufficiently strong, or nil if it's ok.
@@ -228,6 +233,134 @@ func SiteTerms(t string) error {
 	return nil
 }
 
+// ListTitle validates the title of a new or updated List.
+func ListTitle(title string) error {
+	if title == "" {
+		return errors.New("list title must be provided")
+	}
+
+	if length := len([]rune(title)); length > maximumListTitleLength {
+		return fmt.Errorf("list title length must be no more than %d chars, provided title was %d chars", maximumListTitleLength, length)
+	}
+
+	return nil
+}
+
+// ListRepliesPolicy validates the replies_policy of a new or updated list.
+func ListRepliesPolicy(repliesPolicy gtsmodel.RepliesPolicy) error {
+	switch repliesPolicy {
+	case "", gtsmodel.RepliesPolicyFollowed, gtsmodel.RepliesPolicyList, gtsmodel.RepliesPolicyNone:
+		// No problem.
+		return nil
+	default:
+		// Uh oh.
+		return fmt.Errorf("list replies_policy must be either empty or one of 'followed', 'list', 'none'")
+	}
+}
+
+// FilterTitle validates the title of a new or updated Filter.
+func FilterTitle(title string) error {
+	if title == "" {
+		return errors.New("filter title must be provided")
+	}
+
+	if length := len([]rune(title)); length > maximumFilterTitleLength {
+		return fmt.Errorf("filter title length must be no more than %d chars, provided title was %d chars", maximumFilterTitleLength, length)
+	}
+
+	return nil
+}
+
+// FilterKeyword validates the keyword or phrase of a new or updated FilterKeyword.
+func FilterKeyword(keyword string) error {
+	if strings.TrimSpace(keyword) == "" {
+		return errors.New("filter keyword must be provided")
+	}
+
+	if length := len([]rune(keyword)); length > maximumFilterKeywordLength {
+		return fmt.Errorf("filter keyword length must be no more than %d chars, provided keyword was %d chars", maximumFilterKeywordLength, length)
+	}
+
+	return nil
+}
+
+// FilterContexts validates the contexts of a new or updated Filter.
+func FilterContexts(contexts []string) error {
+	if len(contexts) == 0 {
+		return errors.New("at least one filter context must be provided")
+	}
+
+	for _, context := range contexts {
+		switch gtsmodel.FilterContext(context) {
+		case gtsmodel.FilterContextHome,
+			gtsmodel.FilterContextNotifications,
+			gtsmodel.FilterContextPublic,
+			gtsmodel.FilterContextThread,
+			gtsmodel.FilterContextAccount:
+			// No problem.
+		default:
+			// Uh oh.
+			return fmt.Errorf("filter context %q not recognised, must be one of 'home', 'notifications', 'public', 'thread', 'account'", context)
+		}
+	}
+
+	return nil
+}
+
+// FilterAction validates the filter_action of a new or updated Filter.
+func FilterAction(action gtsmodel.FilterAction) error {
+	switch action {
+	case "", gtsmodel.FilterActionWarn, gtsmodel.FilterActionHide:
+		// No problem.
+		return nil
+	default:
+		// Uh oh.
+		return fmt.Errorf("filter_action must be either empty or one of 'warn', 'hide'")
+	}
+}
+
+// PushEndpoint validates the endpoint URL of a new push subscription.
+func PushEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return errors.New("push subscription endpoint must be provided")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("push subscription endpoint could not be parsed as a url: %w", err)
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.New("push subscription endpoint must be an absolute https url")
+	}
+
+	return nil
+}
+
+// PushKeys validates the p256dh and auth keys of a new push subscription.
+func PushKeys(p256dh string, auth string) error {
+	if p256dh == "" || auth == "" {
+		return errors.New("push subscription keys p256dh and auth must both be provided")
+	}
+
+	return nil
+}
+
+// PushPolicy validates the policy of a new or updated push subscription.
+func PushPolicy(policy gtsmodel.WebPushPolicy) error {
+	switch policy {
+	case gtsmodel.WebPushPolicyAll,
+		gtsmodel.WebPushPolicyFollowed,
+		gtsmodel.WebPushPolicyFollower,
+		gtsmodel.WebPushPolicyNone:
+		// No problem.
+		return nil
+	default:
+		// Uh oh.
+		return fmt.Errorf("push subscription policy must be one of 'all', 'followed', 'follower', 'none'")
+	}
+}
+
 // ULID returns true if the passed string is a valid ULID.
 func ULID(i string) bool {
 	return regexes.ULID.MatchString(i)
@@ -20,11 +20,13 @@ package validate_test
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
@@ -317,6 +319,98 @@ func (suite *ValidationTestSuite) TestValidateProfileField() {
 	assert.Equal(suite.T(), trimmedProfileField, validated)
 }
 
+func (suite *ValidationTestSuite) TestValidateListTitle() {
+	err := validate.ListTitle("")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("list title must be provided"), err)
+	}
+
+	err = validate.ListTitle(strings.Repeat("a", 201))
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("list title length must be no more than 200 chars, provided title was 201 chars"), err)
+	}
+
+	err = validate.ListTitle("cool people")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ValidationTestSuite) TestValidateListRepliesPolicy() {
+	for _, rp := range []gtsmodel.RepliesPolicy{
+		"",
+		gtsmodel.RepliesPolicyFollowed,
+		gtsmodel.RepliesPolicyList,
+		gtsmodel.RepliesPolicyNone,
+	} {
+		assert.NoError(suite.T(), validate.ListRepliesPolicy(rp))
+	}
+
+	err := validate.ListRepliesPolicy("everyone")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *ValidationTestSuite) TestValidateFilterKeyword() {
+	err := validate.FilterKeyword("  ")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("filter keyword must be provided"), err)
+	}
+
+	err = validate.FilterKeyword(strings.Repeat("a", 101))
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("filter keyword length must be no more than 100 chars, provided keyword was 101 chars"), err)
+	}
+
+	err = validate.FilterKeyword("fnord")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ValidationTestSuite) TestValidateFilterContexts() {
+	err := validate.FilterContexts(nil)
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("at least one filter context must be provided"), err)
+	}
+
+	err = validate.FilterContexts([]string{"home", "everywhere"})
+	assert.Error(suite.T(), err)
+
+	err = validate.FilterContexts([]string{"home", "notifications", "public", "thread", "account"})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ValidationTestSuite) TestValidatePushEndpoint() {
+	err := validate.PushEndpoint("")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("push subscription endpoint must be provided"), err)
+	}
+
+	err = validate.PushEndpoint("http://push.example.org/push/123")
+	if assert.Error(suite.T(), err) {
+		assert.Equal(suite.T(), errors.New("push subscription endpoint must be an absolute https url"), err)
+	}
+
+	err = validate.PushEndpoint("push.example.org/push/123")
+	assert.Error(suite.T(), err)
+
+	err = validate.PushEndpoint("https://push.example.org/push/123")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ValidationTestSuite) TestValidatePushPolicy() {
+	for _, p := range []gtsmodel.WebPushPolicy{
+		gtsmodel.WebPushPolicyAll,
+		gtsmodel.WebPushPolicyFollowed,
+		gtsmodel.WebPushPolicyFollower,
+		gtsmodel.WebPushPolicyNone,
+	} {
+		assert.NoError(suite.T(), validate.PushPolicy(p))
+	}
+
+	err := validate.PushPolicy("")
+	assert.Error(suite.T(), err)
+
+	err = validate.PushPolicy("mutuals")
+	assert.Error(suite.T(), err)
+}
+
 func TestValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidationTestSuite))
 }
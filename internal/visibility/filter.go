@@ -49,6 +49,11 @@ type Filter interface {
 	//
 	// this function will call StatusVisible internally so it's not necessary to call it beforehand.
 	StatusBoostable(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account) (bool, error)
+
+	// StatusFiltered checks targetStatus against any unexpired filters of the requesting account which apply
+	// in the given filter context. It returns true if the status matched a filter with the "hide" action, and
+	// so shouldn't be shown at all. Otherwise, it returns any matches of filters with the "warn" action.
+	StatusFiltered(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account, filterContext gtsmodel.FilterContext) (bool, []*gtsmodel.FilterResult, error)
 }
 
 type filter struct {
@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+func (f *filter) StatusFiltered(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account, filterContext gtsmodel.FilterContext) (bool, []*gtsmodel.FilterResult, error) {
+	if requestingAccount == nil || targetStatus.AccountID == requestingAccount.ID {
+		// Nothing to filter for logged-out requesters,
+		// and filters never apply to your own statuses.
+		return false, nil, nil
+	}
+
+	filters, err := f.db.GetFiltersForAccountID(ctx, requestingAccount.ID)
+	if err != nil {
+		return false, nil, fmt.Errorf("StatusFiltered: error getting filters for account %s: %w", requestingAccount.ID, err)
+	}
+
+	if len(filters) == 0 {
+		// Nothing to do.
+		return false, nil, nil
+	}
+
+	// Filters apply to the content of the
+	// boosted status, not the boost wrapper.
+	if targetStatus.BoostOfID != "" {
+		if targetStatus.BoostOf == nil {
+			boostOf, err := f.db.GetStatusByID(ctx, targetStatus.BoostOfID)
+			if err != nil {
+				return false, nil, fmt.Errorf("StatusFiltered: error getting boosted status %s: %w", targetStatus.BoostOfID, err)
+			}
+			targetStatus.BoostOf = boostOf
+		}
+		targetStatus = targetStatus.BoostOf
+	}
+
+	fields := filterableFields(targetStatus)
+	now := time.Now()
+
+	var results []*gtsmodel.FilterResult
+
+	for _, filter := range filters {
+		if filter.Expired(now) || !filter.AppliesTo(filterContext) {
+			continue
+		}
+
+		var keywordMatches []string
+		for _, keyword := range filter.Keywords {
+			if keyword.Regexp == nil {
+				continue
+			}
+
+			for _, field := range fields {
+				if keyword.Regexp.MatchString(field) {
+					keywordMatches = append(keywordMatches, keyword.Keyword)
+					break
+				}
+			}
+		}
+
+		if len(keywordMatches) == 0 {
+			continue
+		}
+
+		if filter.Action == gtsmodel.FilterActionHide {
+			// No point checking the
+			// rest, we're hiding it.
+			return true, nil, nil
+		}
+
+		results = append(results, &gtsmodel.FilterResult{
+			Filter:         filter,
+			KeywordMatches: keywordMatches,
+		})
+	}
+
+	return false, results, nil
+}
+
+// filterableFields returns the plaintext fields of the
+// given status that filter keywords should be checked against.
+func filterableFields(status *gtsmodel.Status) []string {
+	fields := []string{}
+
+	if status.ContentWarning != "" {
+		fields = append(fields, status.ContentWarning)
+	}
+
+	if content := text.SanitizePlaintext(status.Content); content != "" {
+		fields = append(fields, content)
+	}
+
+	for _, attachment := range status.Attachments {
+		if description := strings.TrimSpace(attachment.Description); description != "" {
+			fields = append(fields, description)
+		}
+	}
+
+	return fields
+}
@@ -48,6 +48,17 @@ func (f *filter) StatusHometimelineable(ctx context.Context, targetStatus *gtsmo
 		return true, nil
 	}
 
+	// statuses from muted accounts shouldn't be in the timeline, even if they mention the owner
+	muted, err := f.statusMuted(ctx, targetStatus, timelineOwnerAccount)
+	if err != nil {
+		return false, fmt.Errorf("StatusHometimelineable: error checking mutes for status with id %s: %s", targetStatus.ID, err)
+	}
+
+	if muted {
+		l.Debug("status is not hometimelineable because the timeline owner has muted its author")
+		return false, nil
+	}
+
 	v, err := f.StatusVisible(ctx, targetStatus, timelineOwnerAccount)
 	if err != nil {
 		return false, fmt.Errorf("StatusHometimelineable: error checking visibility of status with id %s: %s", targetStatus.ID, err)
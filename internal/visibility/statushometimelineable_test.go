@@ -55,6 +55,27 @@ func (suite *StatusStatusHometimelineableTestSuite) TestFollowingStatusHometimel
 	suite.True(timelineable)
 }
 
+func (suite *StatusStatusHometimelineableTestSuite) TestMutedStatusNotHometimelineable() {
+	testStatus := suite.testStatuses["local_account_2_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	// mute the author of the status, but not their notifications
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              id.NewULID(),
+		AccountID:       testAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+		Notifications:   testrig.FalseBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err := suite.filter.StatusHometimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+
+	suite.False(timelineable)
+}
+
 func (suite *StatusStatusHometimelineableTestSuite) TestNotFollowingStatusHometimelineable() {
 	testStatus := suite.testStatuses["remote_account_1_status_1"]
 	testAccount := suite.testAccounts["local_account_1"]
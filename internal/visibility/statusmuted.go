@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// statusMuted returns true if requestingAccount has muted the author of targetStatus,
+// or, if targetStatus is a boost, the author of the boosted status.
+func (f *filter) statusMuted(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account) (bool, error) {
+	muted, err := f.db.IsMuted(ctx, requestingAccount.ID, targetStatus.AccountID, false)
+	if err != nil {
+		return false, fmt.Errorf("error checking if %s mutes %s: %w", requestingAccount.ID, targetStatus.AccountID, err)
+	}
+
+	if muted || targetStatus.BoostOfAccountID == "" {
+		return muted, nil
+	}
+
+	muted, err = f.db.IsMuted(ctx, requestingAccount.ID, targetStatus.BoostOfAccountID, false)
+	if err != nil {
+		return false, fmt.Errorf("error checking if %s mutes %s: %w", requestingAccount.ID, targetStatus.BoostOfAccountID, err)
+	}
+
+	return muted, nil
+}
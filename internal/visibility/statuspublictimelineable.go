@@ -58,6 +58,18 @@ func (f *filter) StatusPublictimelineable(ctx context.Context, targetStatus *gts
 		return true, nil
 	}
 
+	if timelineOwnerAccount != nil {
+		muted, err := f.statusMuted(ctx, targetStatus, timelineOwnerAccount)
+		if err != nil {
+			return false, fmt.Errorf("StatusPublictimelineable: error checking mutes for status with id %s: %s", targetStatus.ID, err)
+		}
+
+		if muted {
+			l.Debug("status is not publicTimelineable because the timeline owner has muted its author")
+			return false, nil
+		}
+	}
+
 	v, err := f.StatusVisible(ctx, targetStatus, timelineOwnerAccount)
 	if err != nil {
 		return false, fmt.Errorf("StatusPublictimelineable: error checking visibility of status with id %s: %s", targetStatus.ID, err)
@@ -68,5 +80,51 @@ func (f *filter) StatusPublictimelineable(ctx context.Context, targetStatus *gts
 		return false, nil
 	}
 
+	limited, err := f.statusAuthorLimited(ctx, targetStatus, timelineOwnerAccount)
+	if err != nil {
+		return false, fmt.Errorf("StatusPublictimelineable: error checking domain limit for status with id %s: %s", targetStatus.ID, err)
+	}
+
+	if limited {
+		l.Debug("status is not publicTimelineable because its author's domain is limited")
+		return false, nil
+	}
+
 	return true, nil
 }
+
+// statusAuthorLimited returns true if the author of targetStatus is on a limited
+// domain, and the timeline owner (if any) doesn't follow them. Statuses from
+// limited domains are still shown to the people who explicitly follow their authors.
+func (f *filter) statusAuthorLimited(ctx context.Context, targetStatus *gtsmodel.Status, timelineOwnerAccount *gtsmodel.Account) (bool, error) {
+	author := targetStatus.Account
+	if author == nil {
+		var err error
+		author, err = f.db.GetAccountByID(ctx, targetStatus.AccountID)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if author.Domain == "" {
+		// Local accounts
+		// are never limited.
+		return false, nil
+	}
+
+	limited, err := f.db.IsDomainLimited(ctx, author.Domain)
+	if err != nil || !limited {
+		return false, err
+	}
+
+	if timelineOwnerAccount == nil {
+		return true, nil
+	}
+
+	follows, err := f.db.IsFollowing(ctx, timelineOwnerAccount, author)
+	if err != nil {
+		return false, err
+	}
+
+	return !follows, nil
+}
@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusPublictimelineableTestSuite struct {
+	FilterStandardTestSuite
+}
+
+func (suite *StatusPublictimelineableTestSuite) TestLimitedDomainNotPublictimelineable() {
+	ctx := context.Background()
+
+	testStatus := &gtsmodel.Status{}
+	*testStatus = *suite.testStatuses["remote_account_1_status_1"]
+	testStatus.Visibility = gtsmodel.VisibilityPublic
+
+	testAccount := suite.testAccounts["local_account_1"]
+	remoteAccount := suite.testAccounts["remote_account_1"]
+
+	// status can be timelined to begin with
+	timelineable, err := suite.filter.StatusPublictimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.True(timelineable)
+
+	// limit the domain of the status author
+	if err := suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01H0P2GZ3A5W8T1C6E9R4N7K2M",
+		Domain:             remoteAccount.Domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		Severity:           gtsmodel.DomainBlockSeverityLimit,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// status is still visible, but shouldn't be on public timelines
+	visible, err := suite.filter.StatusVisible(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.True(visible)
+
+	timelineable, err = suite.filter.StatusPublictimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	timelineable, err = suite.filter.StatusPublictimelineable(ctx, testStatus, nil)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// unless the timeline owner follows the status author
+	if err := suite.db.Put(ctx, &gtsmodel.Follow{
+		ID:              "01H0P2K7D4F1X9B3V6Q8S2J5TW",
+		URI:             "http://localhost:8080/users/the_mighty_zork/follow/01H0P2K7D4F1X9B3V6Q8S2J5TW",
+		AccountID:       testAccount.ID,
+		TargetAccountID: remoteAccount.ID,
+		ShowReblogs:     testrig.TrueBool(),
+		Notify:          testrig.FalseBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err = suite.filter.StatusPublictimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.True(timelineable)
+}
+
+func TestStatusPublictimelineableTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusPublictimelineableTestSuite))
+}
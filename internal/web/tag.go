@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+const (
+	tagNameKey = "tag_name"
+	tagPath    = "/tags/:" + tagNameKey
+)
+
+func (m *Module) tagGETHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tagName := c.Param(tagNameKey)
+	if tagName == "" {
+		err := errors.New("no tag name specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	instance, err := m.processor.InstanceGetV1(ctx)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	instanceGet := func(ctx context.Context) (*apimodel.InstanceV1, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	tag, errWithCode := m.processor.Tags().Get(ctx, nil, tagName)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// We need to change our response slightly if the
+	// tag page visitor is paging through statuses.
+	var (
+		paging      bool
+		maxStatusID string
+	)
+
+	if maxStatusIDString := c.Query(MaxStatusIDKey); maxStatusIDString != "" {
+		maxStatusID = maxStatusIDString
+		paging = true
+	}
+
+	statusResp, errWithCode := m.processor.Tags().WebTimelineGet(ctx, tag.Name, maxStatusID)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	ogMeta := ogBase(instance)
+	ogMeta.Title = "#" + tag.Name + " - " + ogMeta.SiteName
+	ogMeta.URL = tag.URL
+	ogMeta.Description = "Public posts tagged #" + tag.Name + " on " + ogMeta.SiteName
+
+	c.HTML(http.StatusOK, "tag.tmpl", gin.H{
+		"instance":         instance,
+		"tag":              tag,
+		"ogMeta":           ogMeta,
+		"statuses":         statusResp.Items,
+		"statuses_next":    statusResp.NextLink,
+		"show_back_to_top": paging,
+		"stylesheets": []string{
+			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
+			distPathPrefix + "/status.css",
+			distPathPrefix + "/profile.css",
+		},
+		"javascript": []string{distPathPrefix + "/frontend.js"},
+	})
+}
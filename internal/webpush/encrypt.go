@@ -0,0 +1,144 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// recordSize is the record size advertised in the aes128gcm
+	// content coding header. Payloads are always sent as a single
+	// record, so this only needs to be larger than the payload.
+	recordSize = 4096
+
+	// MaxPayloadSize is the maximum size of a plaintext payload which
+	// fits in a single record, once the padding delimiter and AEAD
+	// tag are added (RFC 8291 section 4).
+	MaxPayloadSize = recordSize - 1 - 16
+)
+
+// decodeKey decodes a base64url-encoded key sent by a push client.
+// Clients aren't consistent about padding, so accept both forms.
+func decodeKey(key string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(key, "="))
+}
+
+// encrypt encrypts the given plaintext for the user agent with the
+// given p256dh public key and auth secret, using the aes128gcm content
+// coding as described in RFC 8291 and RFC 8188. The returned bytes are
+// the complete request body, including the content coding header.
+func encrypt(p256dh string, auth string, plaintext []byte) ([]byte, error) {
+	if len(plaintext) > MaxPayloadSize {
+		return nil, fmt.Errorf("payload of %d bytes exceeds maximum of %d bytes", len(plaintext), MaxPayloadSize)
+	}
+
+	uaPublicBytes, err := decodeKey(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding p256dh key: %w", err)
+	}
+
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing p256dh key: %w", err)
+	}
+
+	authSecret, err := decodeKey(auth)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding auth secret: %w", err)
+	}
+
+	// Generate a one-off application server key pair.
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating ephemeral key: %w", err)
+	}
+	asPublicBytes := asPrivate.PublicKey().Bytes()
+
+	ecdhSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving shared secret: %w", err)
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+
+	// Combine the shared secret with the auth secret (RFC 8291 section 3.3).
+	keyInfo := make([]byte, 0, 14+len(uaPublicBytes)+len(asPublicBytes))
+	keyInfo = append(keyInfo, "WebPush: info\x00"...)
+	keyInfo = append(keyInfo, uaPublicBytes...)
+	keyInfo = append(keyInfo, asPublicBytes...)
+
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ecdhSecret, authSecret, keyInfo), ikm); err != nil {
+		return nil, fmt.Errorf("error deriving input keying material: %w", err)
+	}
+
+	// Derive the content encryption key and nonce (RFC 8188 section 2.2).
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+
+	cek := make([]byte, 16)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: aes128gcm\x00")), cek); err != nil {
+		return nil, fmt.Errorf("error deriving content encryption key: %w", err)
+	}
+
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: nonce\x00")), nonce); err != nil {
+		return nil, fmt.Errorf("error deriving nonce: %w", err)
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Single record, so append the last record delimiter.
+	record := make([]byte, 0, len(plaintext)+1)
+	record = append(record, plaintext...)
+	record = append(record, 0x02)
+
+	// Write the content coding header: salt, record
+	// size, and the application server public key.
+	body := bytes.NewBuffer(make([]byte, 0, 16+4+1+len(asPublicBytes)+len(record)+gcm.Overhead()))
+	body.Write(salt)
+	_ = binary.Write(body, binary.BigEndian, uint32(recordSize))
+	body.WriteByte(byte(len(asPublicBytes)))
+	body.Write(asPublicBytes)
+	body.Write(gcm.Seal(nil, nonce, record, nil))
+
+	return body.Bytes(), nil
+}
@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package webpush implements sending of notifications to
+// push services using the Web Push protocol (RFC 8030),
+// with message encryption (RFC 8291) and VAPID (RFC 8292).
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// pushTTL is how long the push service should
+// hold on to a message for an offline user agent.
+const pushTTL = 48 * time.Hour
+
+// ErrGone is returned by Send when the push service indicates that
+// the subscription has expired or been unsubscribed, which means the
+// subscription should be removed.
+var ErrGone = errors.New("push subscription is gone")
+
+// HTTPClient is the subset of *http.Client used to POST push messages.
+type HTTPClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Sender contains functions for sending Web Push messages to push services.
+type Sender interface {
+	// Send encrypts the given payload for the given subscription, and POSTs it
+	// to the subscription endpoint, identifying this instance with the given
+	// VAPID key pair. If the push service indicates that the subscription no
+	// longer exists, ErrGone will be returned.
+	Send(ctx context.Context, subscription *gtsmodel.WebPushSubscription, vapidKeyPair *gtsmodel.VAPIDKeyPair, payload []byte) error
+}
+
+// NewSender returns a new Web Push Sender, which will use the given client and user agent to POST messages.
+func NewSender(client HTTPClient, userAgent string) Sender {
+	return &sender{
+		client:    client,
+		subject:   config.GetProtocol() + "://" + config.GetHost(),
+		userAgent: userAgent,
+	}
+}
+
+type sender struct {
+	client    HTTPClient
+	subject   string
+	userAgent string
+}
+
+func (s *sender) Send(ctx context.Context, subscription *gtsmodel.WebPushSubscription, vapidKeyPair *gtsmodel.VAPIDKeyPair, payload []byte) error {
+	endpoint, err := url.Parse(subscription.Endpoint)
+	if err != nil {
+		return fmt.Errorf("Send: error parsing endpoint %s: %w", subscription.Endpoint, err)
+	}
+
+	body, err := encrypt(subscription.P256dh, subscription.Auth, payload)
+	if err != nil {
+		return fmt.Errorf("Send: error encrypting payload: %w", err)
+	}
+
+	authorization, err := vapidAuthorization(endpoint, s.subject, vapidKeyPair.PublicKey, vapidKeyPair.PrivateKey, time.Now())
+	if err != nil {
+		return fmt.Errorf("Send: error creating vapid authorization: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("Send: error creating request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", s.userAgent)
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", strconv.Itoa(int(pushTTL.Seconds())))
+	req.Header.Set("Urgency", "normal")
+
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Send: error POSTing to %s: %w", endpoint, err)
+	}
+	defer rsp.Body.Close()
+
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(rsp.Body, 4096))
+
+	switch code := rsp.StatusCode; {
+	case code == http.StatusNotFound || code == http.StatusGone:
+		return ErrGone
+	case code < 200 || code >= 300:
+		return fmt.Errorf("Send: POST to %s returned %s", endpoint, rsp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+	"golang.org/x/crypto/hkdf"
+)
+
+type SenderTestSuite struct {
+	suite.Suite
+
+	// user agent keys
+	uaPrivate  *ecdh.PrivateKey
+	authSecret []byte
+
+	vapidKeyPair *gtsmodel.VAPIDKeyPair
+}
+
+func (suite *SenderTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+
+	var err error
+	suite.uaPrivate, err = ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.authSecret = make([]byte, 16)
+	if _, err := rand.Read(suite.authSecret); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	publicKey, privateKey, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.vapidKeyPair = &gtsmodel.VAPIDKeyPair{
+		PublicKey:  publicKey,
+		PrivateKey: privateKey,
+	}
+}
+
+func (suite *SenderTestSuite) subscription(endpoint string) *gtsmodel.WebPushSubscription {
+	return &gtsmodel.WebPushSubscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(suite.uaPrivate.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(suite.authSecret),
+	}
+}
+
+// decrypt decrypts an aes128gcm body the way a user agent would.
+func (suite *SenderTestSuite) decrypt(body []byte) []byte {
+	salt := body[:16]
+	rs := binary.BigEndian.Uint32(body[16:20])
+	suite.EqualValues(4096, rs)
+	idlen := int(body[20])
+	asPublicBytes := body[21 : 21+idlen]
+	ciphertext := body[21+idlen:]
+
+	asPublic, err := ecdh.P256().NewPublicKey(asPublicBytes)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ecdhSecret, err := suite.uaPrivate.ECDH(asPublic)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	keyInfo := append([]byte("WebPush: info\x00"), suite.uaPrivate.PublicKey().Bytes()...)
+	keyInfo = append(keyInfo, asPublicBytes...)
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ecdhSecret, suite.authSecret, keyInfo), ikm); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	cek := make([]byte, 16)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: aes128gcm\x00")), cek); err != nil {
+		suite.FailNow(err.Error())
+	}
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: nonce\x00")), nonce); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	record, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Strip the last record delimiter.
+	suite.Equal(byte(0x02), record[len(record)-1])
+	return record[:len(record)-1]
+}
+
+// verifyVAPID checks the vapid JWT signature against the vapid public key.
+func (suite *SenderTestSuite) verifyVAPID(authorization string) {
+	suite.True(strings.HasPrefix(authorization, "vapid t="))
+	parts := strings.SplitN(strings.TrimPrefix(authorization, "vapid t="), ", k=", 2)
+	suite.Len(parts, 2)
+	suite.Equal(suite.vapidKeyPair.PublicKey, parts[1])
+
+	token := strings.Split(parts[0], ".")
+	suite.Len(token, 3)
+
+	sig, err := base64.RawURLEncoding.DecodeString(token[2])
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(sig, 64)
+
+	pub, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	x, y := elliptic.Unmarshal(elliptic.P256(), pub) //nolint:staticcheck
+	key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+
+	digest := sha256.Sum256([]byte(token[0] + "." + token[1]))
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	suite.True(ecdsa.Verify(key, digest[:], r, s))
+}
+
+func (suite *SenderTestSuite) TestSend() {
+	payload := []byte(`{"notification_id":"01GZ8VDRAJ1W9HF1M9YS9NFF2C","title":"hello"}`)
+
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal(http.MethodPost, r.Method)
+		suite.Equal("aes128gcm", r.Header.Get("Content-Encoding"))
+		suite.Equal("172800", r.Header.Get("TTL"))
+		suite.verifyVAPID(r.Header.Get("Authorization"))
+
+		var err error
+		received, err = io.ReadAll(r.Body)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	sender := webpush.NewSender(server.Client(), "gotosocial-test")
+	err := sender.Send(context.Background(), suite.subscription(server.URL+"/push/some_device"), suite.vapidKeyPair, payload)
+	suite.NoError(err)
+
+	suite.True(bytes.Equal(payload, suite.decrypt(received)))
+}
+
+func (suite *SenderTestSuite) TestSendGone() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	sender := webpush.NewSender(server.Client(), "gotosocial-test")
+	err := sender.Send(context.Background(), suite.subscription(server.URL+"/push/some_device"), suite.vapidKeyPair, []byte("{}"))
+	suite.ErrorIs(err, webpush.ErrGone)
+}
+
+func (suite *SenderTestSuite) TestSendPayloadTooLarge() {
+	sender := webpush.NewSender(http.DefaultClient, "gotosocial-test")
+	err := sender.Send(context.Background(), suite.subscription("https://push.example.org/push/some_device"), suite.vapidKeyPair, make([]byte, webpush.MaxPayloadSize+1))
+	suite.ErrorContains(err, "exceeds maximum")
+}
+
+func TestSenderTestSuite(t *testing.T) {
+	suite.Run(t, new(SenderTestSuite))
+}
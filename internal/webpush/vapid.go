@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package webpush
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+)
+
+// vapidTokenTTL is how long a signed VAPID
+// token stays valid for the push service.
+const vapidTokenTTL = 12 * time.Hour
+
+// GenerateVAPIDKeys generates a new P-256 key pair for identifying this
+// instance to push services (RFC 8292). The public key is returned as an
+// unpadded base64url-encoded uncompressed point, and the private key as an
+// unpadded base64url-encoded scalar, as expected by push clients.
+func GenerateVAPIDKeys() (publicKey string, privateKey string, err error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("GenerateVAPIDKeys: error generating key: %w", err)
+	}
+
+	publicKey = base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes())
+	privateKey = base64.RawURLEncoding.EncodeToString(key.Bytes())
+	return publicKey, privateKey, nil
+}
+
+// parseVAPIDPrivateKey parses the given unpadded base64url-encoded
+// P-256 scalar into an ecdsa private key usable for signing.
+func parseVAPIDPrivateKey(privateKey string) (*ecdsa.PrivateKey, error) {
+	d, err := base64.RawURLEncoding.DecodeString(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding vapid private key: %w", err)
+	}
+
+	// Validate the scalar by parsing it as an ecdh key.
+	if _, err := ecdh.P256().NewPrivateKey(d); err != nil {
+		return nil, fmt.Errorf("error parsing vapid private key: %w", err)
+	}
+
+	curve := elliptic.P256()
+	key := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(d)}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(d)
+	return key, nil
+}
+
+// vapidAuthorization returns the value of the Authorization header
+// for a push request to the given endpoint, containing a JWT signed
+// with the VAPID private key, and the matching VAPID public key.
+func vapidAuthorization(endpoint *url.URL, subject string, publicKey string, privateKey string, now time.Time) (string, error) {
+	if publicKey == "" {
+		return "", errors.New("vapid public key was empty")
+	}
+
+	key, err := parseVAPIDPrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{
+		"typ": "JWT",
+		"alg": "ES256",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud": endpoint.Scheme + "://" + endpoint.Host,
+		"exp": now.Add(vapidTokenTTL).Unix(),
+		"sub": subject,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("error signing vapid token: %w", err)
+	}
+
+	// ES256 signatures are the fixed-size
+	// concatenation of r and s (RFC 7518).
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	token := unsigned + "." + base64.RawURLEncoding.EncodeToString(sig)
+	return "vapid t=" + token + ", k=" + publicKey, nil
+}
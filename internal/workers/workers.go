@@ -44,6 +44,12 @@ type Workers struct {
 	// Media manager worker pools.
 	Media runners.WorkerPool
 
+	// Outgoing federation delivery worker pool.
+	Delivery runners.WorkerPool
+
+	// Outgoing Web Push message worker pool.
+	WebPush runners.WorkerPool
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -68,6 +74,14 @@ func (w *Workers) Start() {
 	tryUntil("starting media workerpool", 5, func() bool {
 		return w.Media.Start(8*maxprocs, 80*maxprocs)
 	})
+
+	tryUntil("starting delivery workerpool", 5, func() bool {
+		return w.Delivery.Start(4*maxprocs, 400*maxprocs)
+	})
+
+	tryUntil("starting web push workerpool", 5, func() bool {
+		return w.WebPush.Start(2*maxprocs, 200*maxprocs)
+	})
 }
 
 // Stop will stop all of the contained worker pools (and global scheduler).
@@ -76,6 +90,8 @@ func (w *Workers) Stop() {
 	tryUntil("stopping client API workerpool", 5, w.ClientAPI.Stop)
 	tryUntil("stopping federator workerpool", 5, w.Federator.Stop)
 	tryUntil("stopping media workerpool", 5, w.Media.Stop)
+	tryUntil("stopping delivery workerpool", 5, w.Delivery.Stop)
+	tryUntil("stopping web push workerpool", 5, w.WebPush.Stop)
 }
 
 // nocopy when embedded will signal linter to
@@ -23,6 +23,9 @@ EXPECT=$(cat <<"EOF"
             "block-max-size": 100,
             "block-sweep-freq": 30000000000,
             "block-ttl": 300000000000,
+            "conversation-max-size": 1000,
+            "conversation-sweep-freq": 30000000000,
+            "conversation-ttl": 300000000000,
             "domain-block-max-size": 1000,
             "domain-block-sweep-freq": 60000000000,
             "domain-block-ttl": 86400000000000,
@@ -32,6 +35,18 @@ EXPECT=$(cat <<"EOF"
             "emoji-max-size": 500,
             "emoji-sweep-freq": 30000000000,
             "emoji-ttl": 300000000000,
+            "filter-keyword-max-size": 2000,
+            "filter-keyword-sweep-freq": 30000000000,
+            "filter-keyword-ttl": 300000000000,
+            "filter-max-size": 1000,
+            "filter-sweep-freq": 30000000000,
+            "filter-ttl": 300000000000,
+            "list-entry-max-size": 2000,
+            "list-entry-sweep-freq": 30000000000,
+            "list-entry-ttl": 300000000000,
+            "list-max-size": 2000,
+            "list-sweep-freq": 30000000000,
+            "list-ttl": 300000000000,
             "media-max-size": 500,
             "media-sweep-freq": 30000000000,
             "media-ttl": 300000000000,
@@ -41,9 +56,18 @@ EXPECT=$(cat <<"EOF"
             "notification-max-size": 500,
             "notification-sweep-freq": 30000000000,
             "notification-ttl": 300000000000,
+            "poll-max-size": 1000,
+            "poll-sweep-freq": 30000000000,
+            "poll-ttl": 300000000000,
+            "poll-vote-max-size": 2000,
+            "poll-vote-sweep-freq": 30000000000,
+            "poll-vote-ttl": 300000000000,
             "report-max-size": 100,
             "report-sweep-freq": 30000000000,
             "report-ttl": 300000000000,
+            "status-edit-max-size": 500,
+            "status-edit-sweep-freq": 30000000000,
+            "status-edit-ttl": 300000000000,
             "status-max-size": 500,
             "status-sweep-freq": 30000000000,
             "status-ttl": 300000000000,
@@ -51,6 +75,9 @@ EXPECT=$(cat <<"EOF"
             "tombstone-sweep-freq": 30000000000,
             "tombstone-ttl": 300000000000,
             "user-max-size": 100,
+            "user-mute-max-size": 1000,
+            "user-mute-sweep-freq": 30000000000,
+            "user-mute-ttl": 300000000000,
             "user-sweep-freq": 30000000000,
             "user-ttl": 300000000000,
             "webfinger-max-size": 250,
@@ -75,11 +102,15 @@ EXPECT=$(cat <<"EOF"
     "dry-run": true,
     "email": "",
     "host": "example.com",
+    "instance-authorized-fetch": false,
     "instance-deliver-to-shared-inboxes": false,
     "instance-expose-peers": true,
     "instance-expose-public-timeline": true,
     "instance-expose-suspended": true,
     "instance-expose-suspended-web": true,
+    "instance-federation-mode": "allowlist",
+    "instance-sign-rfc9421": true,
+    "instance-unavailable-after": 604800000000000,
     "landing-page-user": "admin",
     "letsencrypt-cert-dir": "/gotosocial/storage/certs",
     "letsencrypt-email-address": "",
@@ -181,6 +212,9 @@ GTS_INSTANCE_EXPOSE_SUSPENDED=true \
 GTS_INSTANCE_EXPOSE_SUSPENDED_WEB=true \
 GTS_INSTANCE_EXPOSE_PUBLIC_TIMELINE=true \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
+GTS_INSTANCE_AUTHORIZED_FETCH=false \
+GTS_INSTANCE_FEDERATION_MODE='allowlist' \
+GTS_INSTANCE_SIGN_RFC9421=true \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
 GTS_ACCOUNTS_APPROVAL_REQUIRED=false \
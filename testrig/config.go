@@ -66,6 +66,10 @@ var testDefaults = config.Configuration{
 	InstanceExposeSuspended:        true,
 	InstanceExposeSuspendedWeb:     true,
 	InstanceDeliverToSharedInboxes: true,
+	InstanceFederationMode:         config.InstanceFederationModeDefault,
+	InstanceAuthorizedFetch:        true,
+	InstanceUnavailableAfter:       7 * 24 * time.Hour,
+	InstanceSignRFC9421:            false,
 
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
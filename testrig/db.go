@@ -58,6 +58,26 @@ var testModels = []interface{}{
 	&gtsmodel.EmojiCategory{},
 	&gtsmodel.Tombstone{},
 	&gtsmodel.Report{},
+	&gtsmodel.List{},
+	&gtsmodel.ListEntry{},
+	&gtsmodel.Filter{},
+	&gtsmodel.FilterKeyword{},
+	&gtsmodel.Poll{},
+	&gtsmodel.PollVote{},
+	&gtsmodel.StatusEdit{},
+	&gtsmodel.UserMute{},
+	&gtsmodel.Conversation{},
+	&gtsmodel.ConversationToStatus{},
+	&gtsmodel.FollowedTag{},
+	&gtsmodel.DomainBlockSubscription{},
+	&gtsmodel.DomainAllow{},
+	&gtsmodel.Delivery{},
+	&gtsmodel.AccountKeyChange{},
+	&gtsmodel.Relay{},
+	&gtsmodel.WebPushSubscription{},
+	&gtsmodel.VAPIDKeyPair{},
+	&gtsmodel.Marker{},
+	&gtsmodel.ScheduledStatus{},
 }
 
 // NewTestDB returns a new initialized, empty database for testing.
@@ -156,6 +176,30 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestLists() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
+	}
+
+	for _, v := range NewTestListEntries() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
+	}
+
+	for _, v := range NewTestFilters() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
+	}
+
+	for _, v := range NewTestFilterKeywords() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(nil, err)
+		}
+	}
+
 	for _, v := range NewTestDomainBlocks() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(nil, err)
@@ -274,6 +318,10 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		log.Panic(nil, err)
 	}
 
+	if err := db.CreateVAPIDKeyPair(ctx); err != nil {
+		log.Panic(nil, err)
+	}
+
 	log.Debug(nil, "testing db setup complete")
 }
 
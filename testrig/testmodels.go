@@ -321,6 +321,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			Note:                    "",
 			NoteRaw:                 "",
 			Memorial:                FalseBool(),
+			MovedToURI:              "",
 			MovedToAccountID:        "",
 			CreatedAt:               TimeMustParse("2020-05-17T13:10:59Z"),
 			UpdatedAt:               TimeMustParse("2020-05-17T13:10:59Z"),
@@ -341,7 +342,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/localhost:8080/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/localhost:8080/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAsURIs:         []string{},
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			SensitizedAt:            time.Time{},
@@ -360,6 +361,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			Fields:                  []gtsmodel.Field{},
 			Note:                    "",
 			Memorial:                FalseBool(),
+			MovedToURI:              "",
 			MovedToAccountID:        "",
 			CreatedAt:               TimeMustParse("2022-06-04T13:12:00Z"),
 			UpdatedAt:               TimeMustParse("2022-06-04T13:12:00Z"),
@@ -379,7 +381,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/weed_lord420/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/weed_lord420/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAsURIs:         []string{},
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/weed_lord420#main-key",
@@ -399,6 +401,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			Note:                    "",
 			NoteRaw:                 "",
 			Memorial:                FalseBool(),
+			MovedToURI:              "",
 			MovedToAccountID:        "",
 			CreatedAt:               TimeMustParse("2022-05-17T13:10:59Z"),
 			UpdatedAt:               TimeMustParse("2022-05-17T13:10:59Z"),
@@ -419,7 +422,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/admin/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/admin/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAsURIs:         []string{},
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			SensitizedAt:            time.Time{},
@@ -439,6 +442,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			Note:                    "<p>hey yo this is my profile!</p>",
 			NoteRaw:                 "hey yo this is my profile!",
 			Memorial:                FalseBool(),
+			MovedToURI:              "",
 			MovedToAccountID:        "",
 			CreatedAt:               TimeMustParse("2022-05-20T11:09:18Z"),
 			UpdatedAt:               TimeMustParse("2022-05-20T11:09:18Z"),
@@ -458,7 +462,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/the_mighty_zork/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/the_mighty_zork/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAsURIs:         []string{},
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/the_mighty_zork/main-key",
@@ -479,6 +483,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			Note:                    "<p>i post about things that concern me</p>",
 			NoteRaw:                 "i post about things that concern me",
 			Memorial:                FalseBool(),
+			MovedToURI:              "",
 			MovedToAccountID:        "",
 			CreatedAt:               TimeMustParse("2022-06-04T13:12:00Z"),
 			UpdatedAt:               TimeMustParse("2022-06-04T13:12:00Z"),
@@ -498,7 +503,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/1happyturtle/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/1happyturtle/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAsURIs:         []string{},
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/1happyturtle#main-key",
@@ -516,6 +521,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			Fields:                []gtsmodel.Field{},
 			Note:                  "i post about like, i dunno, stuff, or whatever!!!!",
 			Memorial:              FalseBool(),
+			MovedToURI:            "",
 			MovedToAccountID:      "",
 			CreatedAt:             TimeMustParse("2021-09-26T12:52:36+02:00"),
 			UpdatedAt:             TimeMustParse("2022-06-04T13:12:00Z"),
@@ -534,7 +540,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://fossbros-anonymous.io/users/foss_satan/following",
 			FeaturedCollectionURI: "http://fossbros-anonymous.io/users/foss_satan/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
+			AlsoKnownAsURIs:       []string{},
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://fossbros-anonymous.io/users/foss_satan/main-key",
@@ -552,6 +558,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			Fields:                []gtsmodel.Field{},
 			Note:                  "i'm a real son of a gun",
 			Memorial:              FalseBool(),
+			MovedToURI:            "",
 			MovedToAccountID:      "",
 			CreatedAt:             TimeMustParse("2020-08-10T14:13:28+02:00"),
 			UpdatedAt:             TimeMustParse("2022-06-04T13:12:00Z"),
@@ -570,7 +577,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://example.org/users/Some_User/following",
 			FeaturedCollectionURI: "http://example.org/users/Some_User/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
+			AlsoKnownAsURIs:       []string{},
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://example.org/users/Some_User#main-key",
@@ -588,6 +595,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			Fields:                  []gtsmodel.Field{},
 			Note:                    "if i die blame charles don't let that fuck become king",
 			Memorial:                FalseBool(),
+			MovedToURI:              "",
 			MovedToAccountID:        "",
 			CreatedAt:               TimeMustParse("2020-08-10T14:13:28+02:00"),
 			UpdatedAt:               TimeMustParse("2022-06-04T13:12:00Z"),
@@ -606,7 +614,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://thequeenisstillalive.technology/users/her_fuckin_maj/following",
 			FeaturedCollectionURI:   "http://thequeenisstillalive.technology/users/her_fuckin_maj/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAsURIs:         []string{},
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://thequeenisstillalive.technology/users/her_fuckin_maj#main-key",
@@ -1198,6 +1206,8 @@ func NewTestDomainBlocks() map[string]*gtsmodel.DomainBlock {
 			PrivateComment:     "i blocked this domain because they keep replying with pushy + unwarranted linux advice",
 			PublicComment:      "reply-guying to tech posts",
 			Obfuscate:          FalseBool(),
+			Severity:           gtsmodel.DomainBlockSeveritySuspend,
+			RejectMedia:        FalseBool(),
 		},
 	}
 }
@@ -1937,6 +1947,66 @@ func NewTestReports() map[string]*gtsmodel.Report {
 	}
 }
 
+func NewTestLists() map[string]*gtsmodel.List {
+	return map[string]*gtsmodel.List{
+		"local_account_1_list_1": {
+			ID:            "01H0G8E4Q2J3FE3JDWJVWEDCD1",
+			CreatedAt:     TimeMustParse("2022-05-14T12:20:03+02:00"),
+			UpdatedAt:     TimeMustParse("2022-05-14T12:20:03+02:00"),
+			Title:         "Cool Ass Posters From This Instance",
+			AccountID:     "01F8MH1H7YV1Z7D2C8K2730QBF",
+			RepliesPolicy: gtsmodel.RepliesPolicyFollowed,
+		},
+	}
+}
+
+func NewTestListEntries() map[string]*gtsmodel.ListEntry {
+	return map[string]*gtsmodel.ListEntry{
+		"local_account_1_list_1_entry_1": {
+			ID:       "01H0G89MWVQE0M58VD2HQYMQWH",
+			ListID:   "01H0G8E4Q2J3FE3JDWJVWEDCD1",
+			FollowID: "01F8PYDCE8XE23GRE5DPZJDZDP",
+		},
+		"local_account_1_list_1_entry_2": {
+			ID:       "01H0G8FFM1AGQDRNGBGGX8CYJQ",
+			ListID:   "01H0G8E4Q2J3FE3JDWJVWEDCD1",
+			FollowID: "01F8PY8RHWRQZV038T4E8T9YK8",
+		},
+	}
+}
+
+func NewTestFilters() map[string]*gtsmodel.Filter {
+	return map[string]*gtsmodel.Filter{
+		"local_account_1_filter_1": {
+			ID:                   "01HN26VM6KZTW1ANNRVSBMA461",
+			CreatedAt:            TimeMustParse("2022-05-14T12:20:03+02:00"),
+			UpdatedAt:            TimeMustParse("2022-05-14T12:20:03+02:00"),
+			AccountID:            "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Title:                "fnord",
+			Action:               gtsmodel.FilterActionWarn,
+			ContextHome:          TrueBool(),
+			ContextNotifications: FalseBool(),
+			ContextPublic:        TrueBool(),
+			ContextThread:        FalseBool(),
+			ContextAccount:       FalseBool(),
+		},
+	}
+}
+
+func NewTestFilterKeywords() map[string]*gtsmodel.FilterKeyword {
+	return map[string]*gtsmodel.FilterKeyword{
+		"local_account_1_filter_1_keyword_1": {
+			ID:        "01HN272TAVWAXX72ZX4M8JZ0PS",
+			CreatedAt: TimeMustParse("2022-05-14T12:20:03+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-14T12:20:03+02:00"),
+			AccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
+			FilterID:  "01HN26VM6KZTW1ANNRVSBMA461",
+			Keyword:   "fnord",
+			WholeWord: TrueBool(),
+		},
+	}
+}
+
 // ActivityWithSignature wraps a pub.Activity along with its signature headers, for testing.
 type ActivityWithSignature struct {
 	Activity        pub.Activity
@@ -52,6 +52,7 @@ const (
 // PER TEST rather than per suite, so that the do function can be set on a test by test (or even more granular)
 // basis.
 func NewTestTransportController(state *state.State, client pub.HttpClient) transport.Controller {
+	_ = state.Workers.Scheduler.Start(nil) // ensure started
 	return transport.NewController(state, NewTestFederatingDB(state), &federation.Clock{}, client)
 }
 
@@ -38,6 +38,8 @@ func StartWorkers(state *state.State) {
 	_ = state.Workers.ClientAPI.Start(1, 10)
 	_ = state.Workers.Federator.Start(1, 10)
 	_ = state.Workers.Media.Start(1, 10)
+	_ = state.Workers.Delivery.Start(1, 10)
+	_ = state.Workers.WebPush.Start(1, 10)
 }
 
 func StopWorkers(state *state.State) {
@@ -45,6 +47,8 @@ func StopWorkers(state *state.State) {
 	_ = state.Workers.ClientAPI.Stop()
 	_ = state.Workers.Federator.Stop()
 	_ = state.Workers.Media.Stop()
+	_ = state.Workers.Delivery.Stop()
+	_ = state.Workers.WebPush.Stop()
 }
 
 // CreateMultipartFormData is a handy function for taking a fieldname and a filename, and creating a multipart form bytes buffer
@@ -33,7 +33,7 @@ module.exports = function Login({ }) {
 		instance: useTextInput("instance", {
 			defaultValue: window.location.origin
 		}),
-		scopes: useValue("scopes", "user admin")
+		scopes: useValue("scopes", "read write admin")
 	};
 
 	const [formSubmit, result] = useFormSubmit(
@@ -38,6 +38,7 @@ const nav = {
 	"User": {
 		"Profile": require("./user/profile.js"),
 		"Settings": require("./user/settings.js"),
+		"Authorized Apps": require("./user/authorized-apps.js"),
 	},
 	"Admin": {
 		adminOnly: true,
@@ -105,7 +105,7 @@ const endpoints = (build) => ({
 				instance = new URL(formData.instance).origin;
 
 				const stored = state.oauth.instance;
-				if (stored?.instance == instance && stored.registration) {
+				if (stored?.instance == instance && stored.registration?.scopes == formData.scopes) {
 					return stored.registration;
 				}
 
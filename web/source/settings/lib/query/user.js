@@ -39,6 +39,19 @@ const endpoints = (build) => ({
 			url: `/api/v1/user/password_change`,
 			body: data
 		})
+	}),
+	authorizedApps: build.query({
+		query: () => ({
+			url: `/api/v1/user/authorized_apps`
+		}),
+		providesTags: ["AuthorizedApps"]
+	}),
+	revokeAuthorizedApp: build.mutation({
+		query: (id) => ({
+			method: "DELETE",
+			url: `/api/v1/user/authorized_apps/${id}`
+		}),
+		invalidatesTags: ["AuthorizedApps"]
 	})
 });
 
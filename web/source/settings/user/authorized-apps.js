@@ -0,0 +1,98 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+"use strict";
+
+const React = require("react");
+
+const query = require("../lib/query");
+
+const FormWithData = require("../lib/form/form-with-data");
+const MutationButton = require("../components/form/mutation-button");
+const { Error } = require("../components/error");
+
+module.exports = function AuthorizedApps() {
+	return (
+		<div className="authorized-apps">
+			<h1>Authorized apps</h1>
+			<p>
+				These applications have been given access to your account. Revoking an application's access logs it out,
+				and it won't be able to do anything on your behalf until you authorize it again.
+				Changing your password revokes the access of all applications, including this settings panel.
+			</p>
+			<FormWithData
+				dataQuery={query.useAuthorizedAppsQuery}
+				DataForm={AuthorizedAppsList}
+			/>
+		</div>
+	);
+};
+
+function AuthorizedAppsList({ data: apps }) {
+	if (apps.length == 0) {
+		return <i>You haven't authorized any applications.</i>;
+	}
+
+	return (
+		<div className="list">
+			{apps.map((app) => (
+				<AuthorizedApp key={app.id} app={app} />
+			))}
+		</div>
+	);
+}
+
+function AuthorizedApp({ app }) {
+	const [revoke, result] = query.useRevokeAuthorizedAppMutation();
+
+	return (
+		<div className="app entry">
+			<div className="byline">
+				<h3>
+					{app.website
+						? <a href={app.website} target="_blank" rel="noreferrer">{app.name}</a>
+						: app.name
+					}
+				</h3>
+				<MutationButton
+					label="Revoke access"
+					type="button"
+					onClick={() => revoke(app.id)}
+					className="danger"
+					showError={false}
+					result={result}
+				/>
+			</div>
+			{result.error && <Error error={result.error} />}
+			<div className="details">
+				<b>Scopes: </b>
+				<span>{app.scopes.join(" ")}</span>
+
+				<b>Authorized: </b>
+				<span>{new Date(app.created_at).toLocaleString()}</span>
+
+				<b>Last used: </b>
+				{app.last_used_at
+					? <span>{new Date(app.last_used_at).toLocaleString()}{app.last_used_ip && ` from ${app.last_used_ip}`}</span>
+					: <i>never</i>
+				}
+			</div>
+		</div>
+	);
+}